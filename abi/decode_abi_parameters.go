@@ -38,7 +38,7 @@ func DecodeAbiParameters(params []AbiParam, data []byte) ([]any, error) {
 	}
 
 	// Unpack the data
-	unpacked, err := args.Unpack(data)
+	unpacked, err := unpack(args, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode parameters: %w", err)
 	}
@@ -288,7 +288,7 @@ func DecodeAbiParametersInto(params []AbiParam, data []byte, output any) error {
 	}
 
 	// Unpack the data
-	unpacked, err := args.Unpack(data)
+	unpacked, err := unpack(args, data)
 	if err != nil {
 		return fmt.Errorf("failed to decode parameters: %w", err)
 	}
@@ -56,7 +56,7 @@ func (a *ABI) DecodeErrorResult(data []byte) (*DecodedErrorResult, error) {
 			var errSelector [4]byte
 			copy(errSelector[:], e.ID[:4])
 			if errSelector == selector {
-				unpacked, err := e.Inputs.Unpack(data[4:])
+				unpacked, err := unpack(e.Inputs, data[4:])
 				if err != nil {
 					return nil, fmt.Errorf("failed to decode error %q: %w", e.Name, err)
 				}
@@ -129,18 +129,19 @@ func decodePanicError(data []byte) (*DecodedErrorResult, error) {
 // decodeRevertString decodes an ABI-encoded string from error data.
 func decodeRevertString(data []byte) (string, error) {
 	if len(data) < 64 {
-		return "", fmt.Errorf("data too short for string")
+		return "", &AbiDecodingError{Message: "data too short for string"}
 	}
 
 	// First 32 bytes is the offset (should be 32 for a single string)
 	// Next 32 bytes is the length
-	length := new(big.Int).SetBytes(data[32:64]).Uint64()
+	length := new(big.Int).SetBytes(data[32:64])
 
-	if uint64(len(data)) < 64+length {
-		return "", fmt.Errorf("data too short for string content")
+	// Compare before converting so an oversized length cannot overflow
+	if length.Cmp(big.NewInt(int64(len(data)-64))) > 0 {
+		return "", &AbiDecodingError{Message: fmt.Sprintf("string length %s exceeds %d remaining bytes", length, len(data)-64)}
 	}
 
-	return string(data[64 : 64+length]), nil
+	return string(data[64 : 64+length.Uint64()]), nil
 }
 
 // DecodeError is an alias for DecodeErrorResult that returns the error name and args separately.
@@ -12,8 +12,14 @@ import (
 type DecodedEventLog struct {
 	EventName string
 	Args      map[string]any
-	Topics    []common.Hash
-	Data      []byte
+	// HashedArgs marks the indexed args of dynamic type (string, bytes,
+	// arrays and tuples). The EVM stores only the keccak256 hash of such
+	// values in the topic, so their entry in Args is that 32-byte
+	// common.Hash rather than the original value. To filter on one, hash the
+	// candidate value, e.g. crypto.Keccak256Hash([]byte(name)).
+	HashedArgs map[string]bool
+	Topics     []common.Hash
+	Data       []byte
 }
 
 // DecodeEventLog decodes event log data and topics into a structured result.
@@ -56,6 +62,7 @@ func (a *ABI) DecodeEventLogByName(eventName string, topics []common.Hash, data
 	}
 
 	result := make(map[string]any)
+	var hashed map[string]bool
 
 	// Separate indexed and non-indexed inputs
 	var indexedInputs, nonIndexedInputs []int
@@ -81,10 +88,13 @@ func (a *ABI) DecodeEventLogByName(eventName string, topics []common.Hash, data
 		}
 
 		input := e.Inputs[idx]
-		// Indexed dynamic types (string, bytes, arrays) are hashed, so we can only return the hash
-		typeStr := input.Type.String()
-		if typeStr == "string" || typeStr == "bytes" || input.Type.T == gethABI.SliceTy {
+		// Indexed dynamic types are hashed, so we can only return the hash
+		if isHashedTopicType(input.Type) {
 			result[input.Name] = topics[topicIdx]
+			if hashed == nil {
+				hashed = make(map[string]bool)
+			}
+			hashed[input.Name] = true
 		} else {
 			// For fixed-size types, decode the topic as the value
 			result[input.Name] = decodeEventTopic(input.Type, topics[topicIdx])
@@ -93,7 +103,7 @@ func (a *ABI) DecodeEventLogByName(eventName string, topics []common.Hash, data
 
 	// Decode non-indexed data
 	if len(nonIndexedInputs) > 0 && len(data) > 0 {
-		unpacked, err := e.Inputs.UnpackValues(data)
+		unpacked, err := unpack(e.Inputs, data)
 		if err != nil {
 			return nil, fmt.Errorf("failed to decode event data for %q: %w", eventName, err)
 		}
@@ -109,10 +119,11 @@ func (a *ABI) DecodeEventLogByName(eventName string, topics []common.Hash, data
 	}
 
 	return &DecodedEventLog{
-		EventName: eventName,
-		Args:      result,
-		Topics:    topics,
-		Data:      data,
+		EventName:  eventName,
+		Args:       result,
+		HashedArgs: hashed,
+		Topics:     topics,
+		Data:       data,
 	}, nil
 }
 
@@ -124,7 +135,17 @@ func (a *ABI) DecodeEventLogIntoStruct(eventName string, topics []common.Hash, d
 	}
 
 	// Use go-ethereum's built-in unpacking
-	return a.gethABI.UnpackIntoInterface(output, eventName, data)
+	return a.unpackInto(output, eventName, data)
+}
+
+// isHashedTopicType reports whether an indexed parameter of typ is stored in
+// its topic as a keccak256 hash: strings, bytes, arrays and tuples.
+func isHashedTopicType(typ gethABI.Type) bool {
+	switch typ.T {
+	case gethABI.StringTy, gethABI.BytesTy, gethABI.SliceTy, gethABI.ArrayTy, gethABI.TupleTy:
+		return true
+	}
+	return false
 }
 
 // decodeEventTopic decodes an indexed topic value based on its type.
@@ -31,7 +31,7 @@ func (a *ABI) DecodeFunctionData(data []byte) (*DecodedFunctionData, error) {
 		var methodSelector [4]byte
 		copy(methodSelector[:], m.ID)
 		if methodSelector == selector {
-			args, err := m.Inputs.Unpack(data[4:])
+			args, err := unpack(m.Inputs, data[4:])
 			if err != nil {
 				return nil, fmt.Errorf("failed to decode args for function %q: %w", m.Name, err)
 			}
@@ -59,7 +59,7 @@ func (a *ABI) DecodeFunctionDataByName(functionName string, data []byte) ([]any,
 	}
 
 	// Skip the 4-byte selector
-	unpacked, err := m.Inputs.Unpack(data[4:])
+	unpacked, err := unpack(m.Inputs, data[4:])
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode args for function %q: %w", functionName, err)
 	}
@@ -74,7 +74,7 @@ func (a *ABI) DecodeFunctionArgsFromData(functionName string, data []byte) ([]an
 		return nil, fmt.Errorf("function %q not found on ABI", functionName)
 	}
 
-	unpacked, err := m.Inputs.Unpack(data)
+	unpacked, err := unpack(m.Inputs, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode args for function %q: %w", functionName, err)
 	}
@@ -11,14 +11,19 @@ import (
 // DecodeFunctionResult decodes the return data from a function call.
 // Returns a slice of decoded values matching the function's output parameters.
 //
+// If functionName is overloaded, the overload is chosen by which output
+// types decode data. An *AmbiguousFunctionError is returned when more than
+// one overload fits; pass the full signature to pick one explicitly.
+//
 // Example:
 //
 //	result, err := abi.DecodeFunctionResult("balanceOf", returnData)
 func (a *ABI) DecodeFunctionResult(functionName string, data []byte) ([]any, error) {
-	m, ok := a.gethABI.Methods[functionName]
-	if !ok {
-		return nil, fmt.Errorf("function %q not found on ABI", functionName)
+	key, err := a.resolveMethodByOutput(functionName, data)
+	if err != nil {
+		return nil, err
 	}
+	m := a.gethABI.Methods[key]
 
 	if len(data) == 0 {
 		if len(m.Outputs) > 0 {
@@ -27,7 +32,7 @@ func (a *ABI) DecodeFunctionResult(functionName string, data []byte) ([]any, err
 		return nil, nil
 	}
 
-	unpacked, err := m.Outputs.Unpack(data)
+	unpacked, err := unpack(m.Outputs, data)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode function result for %q: %w", functionName, err)
 	}
@@ -52,10 +57,11 @@ func (a *ABI) DecodeFunctionResult(functionName string, data []byte) ([]any, err
 // Field names are matched case-insensitively to the ABI output names, or by position
 // if names don't match.
 func (a *ABI) DecodeFunctionResultInto(functionName string, data []byte, output any) error {
-	m, ok := a.gethABI.Methods[functionName]
-	if !ok {
-		return fmt.Errorf("function %q not found on ABI", functionName)
+	key, err := a.resolveMethodByOutput(functionName, data)
+	if err != nil {
+		return err
 	}
+	m := a.gethABI.Methods[key]
 
 	if len(data) == 0 {
 		if len(m.Outputs) > 0 {
@@ -65,13 +71,12 @@ func (a *ABI) DecodeFunctionResultInto(functionName string, data []byte, output
 	}
 
 	// Try the standard go-ethereum unpack first
-	err := a.gethABI.UnpackIntoInterface(output, functionName, data)
-	if err == nil {
+	if err := a.unpackInto(output, key, data); err == nil {
 		return nil
 	}
 
 	// If standard unpack fails, try our custom struct binding
-	return a.decodeIntoStruct(functionName, data, output)
+	return a.decodeIntoStruct(key, data, output)
 }
 
 // decodeIntoStruct provides custom struct binding for multi-value returns.
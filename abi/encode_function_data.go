@@ -7,22 +7,26 @@ import (
 // EncodeFunctionData encodes a function call with the given function name and arguments.
 // Returns the full calldata including the 4-byte function selector.
 //
+// If functionName is overloaded, the overload is chosen by the number and
+// types of args. An *AmbiguousFunctionError is returned when more than one
+// overload matches; pass the full signature (e.g. "foo(uint256)") to pick
+// one explicitly.
+//
 // Example:
 //
 //	calldata, err := abi.EncodeFunctionData("transfer", to, amount)
 func (a *ABI) EncodeFunctionData(functionName string, args ...any) ([]byte, error) {
-	m, ok := a.gethABI.Methods[functionName]
-	if !ok {
-		return nil, fmt.Errorf("function %q not found on ABI", functionName)
+	key, err := a.resolveMethodByArgs(functionName, args)
+	if err != nil {
+		return nil, err
 	}
 
-	packed, err := a.gethABI.Pack(functionName, args...)
+	// gethABI.Pack already includes the selector
+	packed, err := a.gethABI.Pack(key, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to encode function data for %q: %w", functionName, err)
 	}
 
-	// gethABI.Pack already includes the selector
-	_ = m // method found
 	return packed, nil
 }
 
@@ -49,11 +53,13 @@ func (a *ABI) EncodeFunctionDataWithSelector(selector [4]byte, functionName stri
 
 // EncodeFunctionArgs encodes only the function arguments without the selector.
 // Useful for encoding constructor arguments or when you need raw argument encoding.
+// Overloads are resolved as in EncodeFunctionData.
 func (a *ABI) EncodeFunctionArgs(functionName string, args ...any) ([]byte, error) {
-	m, ok := a.gethABI.Methods[functionName]
-	if !ok {
-		return nil, fmt.Errorf("function %q not found on ABI", functionName)
+	key, err := a.resolveMethodByArgs(functionName, args)
+	if err != nil {
+		return nil, err
 	}
+	m := a.gethABI.Methods[key]
 
 	packed, err := m.Inputs.Pack(args...)
 	if err != nil {
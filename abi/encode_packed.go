@@ -274,6 +274,12 @@ func encodePackedFixedBytes(match []string, value any, isArrayElement bool) ([]b
 
 // encodePackedArray encodes an array of values.
 func encodePackedArray(elementType string, value any) ([]byte, error) {
+	// Solidity's abi.encodePacked cannot represent arrays of dynamic types
+	// or nested arrays unambiguously, so reject them like solc does.
+	if elementType == "string" || elementType == "bytes" || arrayRegex.MatchString(elementType) || strings.HasPrefix(elementType, "tuple") || strings.HasPrefix(elementType, "(") {
+		return nil, fmt.Errorf("unsupported packed encoding type: %s[] (dynamic or nested array elements cannot be packed)", elementType)
+	}
+
 	// Handle the array based on type
 	var result []byte
 
@@ -0,0 +1,59 @@
+package abi
+
+import (
+	"fmt"
+
+	gethABI "github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// AbiDecodingError is returned when ABI-encoded data cannot be decoded, e.g.
+// because it is truncated or holds an offset or length pointing past the end
+// of the data. Return data comes from an untrusted RPC provider, so malformed
+// input is reported as an error rather than a panic.
+type AbiDecodingError struct {
+	Message string
+	Cause   error
+}
+
+func (e *AbiDecodingError) Error() string {
+	msg := "abi decoding failed"
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Cause != nil {
+		msg += ": " + e.Cause.Error()
+	}
+	return msg
+}
+
+func (e *AbiDecodingError) Unwrap() error {
+	return e.Cause
+}
+
+// unpack decodes data as args. go-ethereum bounds-checks offsets and lengths
+// against the data; any input that still makes it panic is reported as an
+// *AbiDecodingError as well.
+func unpack(args gethABI.Arguments, data []byte) (values []any, err error) {
+	defer recoverDecodingPanic(&err)
+
+	values, err = args.Unpack(data)
+	if err != nil {
+		return nil, &AbiDecodingError{Cause: err}
+	}
+	return values, nil
+}
+
+// recoverDecodingPanic turns a panic raised while decoding into an
+// *AbiDecodingError stored in *err. It must be deferred.
+func recoverDecodingPanic(err *error) {
+	if r := recover(); r != nil {
+		*err = &AbiDecodingError{Message: fmt.Sprintf("malformed data: %v", r)}
+	}
+}
+
+// unpackInto decodes data into output using go-ethereum's struct binding,
+// reporting a panic on malformed data as an *AbiDecodingError.
+func (a *ABI) unpackInto(output any, name string, data []byte) (err error) {
+	defer recoverDecodingPanic(&err)
+	return a.gethABI.UnpackIntoInterface(output, name, data)
+}
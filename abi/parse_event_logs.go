@@ -22,9 +22,12 @@ type RawLog struct {
 type ParsedEventLog struct {
 	EventName string
 	Args      map[string]any
-	Address   common.Address
-	Topics    []common.Hash
-	Data      []byte
+	// HashedArgs marks args holding the topic hash of an indexed dynamic
+	// value instead of the value itself. See DecodedEventLog.HashedArgs.
+	HashedArgs map[string]bool
+	Address    common.Address
+	Topics     []common.Hash
+	Data       []byte
 	// Optional fields from RawLog
 	BlockNumber     uint64
 	TransactionHash common.Hash
@@ -119,6 +122,7 @@ func (a *ABI) ParseEventLogs(logs []RawLog, opts *ParseEventLogsOptions) []Parse
 		results = append(results, ParsedEventLog{
 			EventName:       decoded.EventName,
 			Args:            decoded.Args,
+			HashedArgs:      decoded.HashedArgs,
 			Address:         log.Address,
 			Topics:          log.Topics,
 			Data:            log.Data,
@@ -0,0 +1,382 @@
+package abi
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+// ParseHumanReadable parses a human-readable ABI (viem's `parseAbi` format)
+// and returns an ABI instance.
+//
+// Each entry is a Solidity-style signature for a function, event, error,
+// constructor, fallback or receive function. Parameter names, `indexed`
+// event parameters, tuples and named outputs are supported. Data location
+// keywords (memory, calldata, storage) are accepted and ignored.
+//
+// The signatures are converted to the JSON ABI form, which is available
+// through Raw.
+//
+// Example:
+//
+//	erc20, err := abi.ParseHumanReadable([]string{
+//	    "function balanceOf(address owner) view returns (uint256)",
+//	    "function transfer(address to, uint256 amount) returns (bool)",
+//	    "event Transfer(address indexed from, address indexed to, uint256 value)",
+//	})
+func ParseHumanReadable(signatures []string) (*ABI, error) {
+	items := make([]jsonAbiItem, 0, len(signatures))
+	for _, sig := range signatures {
+		item, err := parseHumanReadableItem(sig)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	jsonABI, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode ABI: %w", err)
+	}
+
+	return Parse(jsonABI)
+}
+
+// MustParseHumanReadable parses a human-readable ABI and panics on error.
+func MustParseHumanReadable(signatures []string) *ABI {
+	a, err := ParseHumanReadable(signatures)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// jsonAbiItem is a single entry of a JSON ABI.
+type jsonAbiItem struct {
+	Type            string          `json:"type"`
+	Name            string          `json:"name,omitempty"`
+	Inputs          []jsonAbiParam  `json:"inputs"`
+	Outputs         *[]jsonAbiParam `json:"outputs,omitempty"`
+	StateMutability string          `json:"stateMutability,omitempty"`
+	Anonymous       bool            `json:"anonymous,omitempty"`
+}
+
+// jsonAbiParam is a parameter of a JSON ABI entry.
+type jsonAbiParam struct {
+	Name       string         `json:"name,omitempty"`
+	Type       string         `json:"type"`
+	Indexed    bool           `json:"indexed,omitempty"`
+	Components []jsonAbiParam `json:"components,omitempty"`
+}
+
+var (
+	identifierRegex     = regexp.MustCompile(`^[a-zA-Z$_][a-zA-Z0-9$_]*$`)
+	elementaryTypeRegex = regexp.MustCompile(`^(address|bool|string|function|bytes([1-9]|[12][0-9]|3[0-2])?|u?int(8|16|24|32|40|48|56|64|72|80|88|96|104|112|120|128|136|144|152|160|168|176|184|192|200|208|216|224|232|240|248|256)?)((\[\d*\])*)$`)
+	arraySuffixRegex    = regexp.MustCompile(`^(\[\d*\])*`)
+)
+
+// parseHumanReadableItem parses a single human-readable signature.
+func parseHumanReadableItem(sig string) (jsonAbiItem, error) {
+	s := strings.TrimSpace(sig)
+	s = strings.TrimSuffix(s, ";")
+
+	kind, rest := splitKeyword(s)
+	switch kind {
+	case "function":
+		return parseHumanReadableFunction(sig, rest)
+	case "event":
+		return parseHumanReadableEventOrError(sig, kind, rest)
+	case "error":
+		return parseHumanReadableEventOrError(sig, kind, rest)
+	case "constructor":
+		return parseHumanReadableConstructor(sig, rest)
+	case "fallback", "receive":
+		return parseHumanReadableSpecial(sig, kind, rest)
+	default:
+		return jsonAbiItem{}, invalidSignatureError(sig, "unknown item kind %q", kind)
+	}
+}
+
+func parseHumanReadableFunction(sig, rest string) (jsonAbiItem, error) {
+	name, params, trailer, err := splitNameAndParams(sig, rest)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	inputs, err := parseHumanReadableParams(sig, params, false)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	item := jsonAbiItem{
+		Type:            "function",
+		Name:            name,
+		Inputs:          inputs,
+		StateMutability: NonPayable.String(),
+	}
+
+	outputs := []jsonAbiParam{}
+	for trailer != "" {
+		var word string
+		word, trailer = splitKeyword(trailer)
+		switch word {
+		case "external", "public":
+		case "view", "pure", "payable", "nonpayable":
+			item.StateMutability = word
+		case "returns":
+			inner, after, err := takeParens(sig, trailer)
+			if err != nil {
+				return jsonAbiItem{}, err
+			}
+			if strings.TrimSpace(after) != "" {
+				return jsonAbiItem{}, invalidSignatureError(sig, "unexpected %q after returns", strings.TrimSpace(after))
+			}
+			outputs, err = parseHumanReadableParams(sig, inner, false)
+			if err != nil {
+				return jsonAbiItem{}, err
+			}
+			trailer = ""
+		default:
+			return jsonAbiItem{}, invalidSignatureError(sig, "unexpected modifier %q", word)
+		}
+	}
+	item.Outputs = &outputs
+
+	return item, nil
+}
+
+func parseHumanReadableEventOrError(sig, kind, rest string) (jsonAbiItem, error) {
+	name, params, trailer, err := splitNameAndParams(sig, rest)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	isEvent := kind == "event"
+	inputs, err := parseHumanReadableParams(sig, params, isEvent)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	item := jsonAbiItem{Type: kind, Name: name, Inputs: inputs}
+	switch {
+	case trailer == "":
+	case isEvent && trailer == "anonymous":
+		item.Anonymous = true
+	default:
+		return jsonAbiItem{}, invalidSignatureError(sig, "unexpected %q", trailer)
+	}
+
+	return item, nil
+}
+
+func parseHumanReadableConstructor(sig, rest string) (jsonAbiItem, error) {
+	params, trailer, err := takeParens(sig, rest)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	inputs, err := parseHumanReadableParams(sig, params, false)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+
+	item := jsonAbiItem{Type: "constructor", Inputs: inputs, StateMutability: NonPayable.String()}
+	switch strings.TrimSpace(trailer) {
+	case "":
+	case "payable":
+		item.StateMutability = Payable.String()
+	default:
+		return jsonAbiItem{}, invalidSignatureError(sig, "unexpected %q", strings.TrimSpace(trailer))
+	}
+
+	return item, nil
+}
+
+// parseHumanReadableSpecial parses `fallback() external [payable]` and
+// `receive() external payable`.
+func parseHumanReadableSpecial(sig, kind, rest string) (jsonAbiItem, error) {
+	params, trailer, err := takeParens(sig, rest)
+	if err != nil {
+		return jsonAbiItem{}, err
+	}
+	if strings.TrimSpace(params) != "" {
+		return jsonAbiItem{}, invalidSignatureError(sig, "%s takes no parameters", kind)
+	}
+
+	item := jsonAbiItem{Type: kind, Inputs: []jsonAbiParam{}, StateMutability: NonPayable.String()}
+	for _, word := range strings.Fields(trailer) {
+		switch word {
+		case "external":
+		case "payable":
+			item.StateMutability = Payable.String()
+		default:
+			return jsonAbiItem{}, invalidSignatureError(sig, "unexpected modifier %q", word)
+		}
+	}
+	if kind == "receive" && item.StateMutability != Payable.String() {
+		return jsonAbiItem{}, invalidSignatureError(sig, "receive must be payable")
+	}
+
+	return item, nil
+}
+
+// parseHumanReadableParams parses a comma-separated parameter list.
+func parseHumanReadableParams(sig, s string, allowIndexed bool) ([]jsonAbiParam, error) {
+	parts, err := splitTopLevel(sig, s)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]jsonAbiParam, 0, len(parts))
+	for _, part := range parts {
+		param, err := parseHumanReadableParam(sig, part, allowIndexed)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
+
+// parseHumanReadableParam parses a single parameter such as
+// "address indexed from", "uint[] memory values" or "(uint256 a, bool b) pair".
+func parseHumanReadableParam(sig, s string, allowIndexed bool) (jsonAbiParam, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return jsonAbiParam{}, invalidSignatureError(sig, "empty parameter")
+	}
+
+	var param jsonAbiParam
+	var rest string
+
+	if strings.HasPrefix(s, "(") || strings.HasPrefix(s, "tuple(") {
+		inner, after, err := takeParens(sig, strings.TrimPrefix(s, "tuple"))
+		if err != nil {
+			return jsonAbiParam{}, err
+		}
+		components, err := parseHumanReadableParams(sig, inner, false)
+		if err != nil {
+			return jsonAbiParam{}, err
+		}
+		suffix := arraySuffixRegex.FindString(after)
+		param.Type = "tuple" + suffix
+		param.Components = components
+		rest = after[len(suffix):]
+	} else {
+		fields := strings.Fields(s)
+		typ := fields[0]
+		m := elementaryTypeRegex.FindStringSubmatch(typ)
+		if m == nil {
+			return jsonAbiParam{}, invalidSignatureError(sig, "unknown type %q", typ)
+		}
+		// uint and int are aliases for uint256 and int256.
+		switch m[1] {
+		case "uint", "int":
+			typ = m[1] + "256" + m[4]
+		}
+		param.Type = typ
+		rest = strings.TrimPrefix(s, fields[0])
+	}
+
+	for _, word := range strings.Fields(rest) {
+		switch {
+		case word == "indexed":
+			if !allowIndexed {
+				return jsonAbiParam{}, invalidSignatureError(sig, "indexed is only allowed on event parameters")
+			}
+			param.Indexed = true
+		case word == "memory" || word == "calldata" || word == "storage":
+		case param.Name == "" && identifierRegex.MatchString(word):
+			param.Name = word
+		default:
+			return jsonAbiParam{}, invalidSignatureError(sig, "unexpected %q in parameter %q", word, s)
+		}
+	}
+
+	return param, nil
+}
+
+// splitNameAndParams splits "name(params) trailer" into its parts.
+func splitNameAndParams(sig, s string) (name, params, trailer string, err error) {
+	open := strings.IndexByte(s, '(')
+	if open < 0 {
+		return "", "", "", invalidSignatureError(sig, "missing parameter list")
+	}
+	name = strings.TrimSpace(s[:open])
+	if !identifierRegex.MatchString(name) {
+		return "", "", "", invalidSignatureError(sig, "invalid name %q", name)
+	}
+	params, trailer, err = takeParens(sig, s[open:])
+	if err != nil {
+		return "", "", "", err
+	}
+	return name, params, strings.TrimSpace(trailer), nil
+}
+
+// takeParens expects s to start with a parenthesised group and returns the
+// group's contents and whatever follows the closing parenthesis.
+func takeParens(sig, s string) (inner, after string, err error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") {
+		return "", "", invalidSignatureError(sig, "expected \"(\"")
+	}
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[1:i], s[i+1:], nil
+			}
+		}
+	}
+	return "", "", invalidSignatureError(sig, "unbalanced parentheses")
+}
+
+// splitTopLevel splits s on commas that are not nested in parentheses.
+func splitTopLevel(sig, s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var parts []string
+	depth, start := 0, 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+		if depth < 0 {
+			return nil, invalidSignatureError(sig, "unbalanced parentheses")
+		}
+	}
+	if depth != 0 {
+		return nil, invalidSignatureError(sig, "unbalanced parentheses")
+	}
+	return append(parts, s[start:]), nil
+}
+
+// splitKeyword splits off the leading word of s. A word ends at whitespace
+// or an opening parenthesis.
+func splitKeyword(s string) (word, rest string) {
+	s = strings.TrimSpace(s)
+	end := strings.IndexAny(s, " \t\n(")
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], strings.TrimSpace(s[end:])
+}
+
+func invalidSignatureError(sig, format string, args ...any) error {
+	return fmt.Errorf("invalid human-readable ABI item %q: %s", sig, fmt.Sprintf(format, args...))
+}
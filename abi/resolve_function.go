@@ -0,0 +1,169 @@
+package abi
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// AmbiguousFunctionError is returned when a function name matches more than
+// one overload and the provided arguments (or return data) do not single
+// one out.
+type AmbiguousFunctionError struct {
+	// Name is the function name that was requested.
+	Name string
+	// Signatures are the signatures of the overloads that matched.
+	Signatures []string
+}
+
+func (e *AmbiguousFunctionError) Error() string {
+	return fmt.Sprintf("ambiguous function %q: matches %s", e.Name, strings.Join(e.Signatures, ", "))
+}
+
+// overloads returns the go-ethereum method keys for every overload of name,
+// sorted by key. go-ethereum stores overloads as "foo", "foo0", "foo1", ...
+// with the original name kept in RawName.
+func (a *ABI) overloads(name string) []string {
+	var keys []string
+	for key, m := range a.gethABI.Methods {
+		if m.RawName == name {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// lookupMethod resolves name to a go-ethereum method key without
+// disambiguating overloads. name may be a method key ("foo", "foo0") or a
+// full signature ("foo(uint256)").
+func (a *ABI) lookupMethod(name string) (string, bool) {
+	if _, ok := a.gethABI.Methods[name]; ok {
+		return name, true
+	}
+	if strings.Contains(name, "(") {
+		for key, m := range a.gethABI.Methods {
+			if m.Sig == name {
+				return key, true
+			}
+		}
+	}
+	return "", false
+}
+
+// resolveMethodByArgs resolves name to a go-ethereum method key, choosing
+// between overloads by argument count and types.
+func (a *ABI) resolveMethodByArgs(name string, args []any) (string, error) {
+	keys := a.overloads(name)
+	if len(keys) <= 1 {
+		key, ok := a.lookupMethod(name)
+		if !ok {
+			return "", fmt.Errorf("function %q not found on ABI", name)
+		}
+		return key, nil
+	}
+
+	var matched []string
+	for _, key := range keys {
+		m := a.gethABI.Methods[key]
+		if len(m.Inputs) != len(args) {
+			continue
+		}
+		inputs := convertGethArgumentsToParameters(m.Inputs)
+		allMatch := true
+		for i, arg := range args {
+			if !isArgOfType(arg, inputs[i]) {
+				allMatch = false
+				break
+			}
+		}
+		if allMatch {
+			matched = append(matched, key)
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return "", fmt.Errorf("no overload of function %q matches the %d provided arguments", name, len(args))
+	case 1:
+		return matched[0], nil
+	default:
+		return "", a.ambiguousFunctionError(name, matched)
+	}
+}
+
+// resolveMethodByOutput resolves name to a go-ethereum method key, choosing
+// between overloads by which output types decode data. When several decode
+// successfully, only overloads that re-encode to exactly data are kept.
+// Overloads that differ only in their inputs (e.g. balanceOf(address) and
+// balanceOf(address,uint256)) decode identically, so the first one is used.
+func (a *ABI) resolveMethodByOutput(name string, data []byte) (string, error) {
+	keys := a.overloads(name)
+	if len(keys) <= 1 {
+		key, ok := a.lookupMethod(name)
+		if !ok {
+			return "", fmt.Errorf("function %q not found on ABI", name)
+		}
+		return key, nil
+	}
+
+	var decoded, exact []string
+	for _, key := range keys {
+		outputs := a.gethABI.Methods[key].Outputs
+		if len(data) == 0 {
+			if len(outputs) == 0 {
+				exact = append(exact, key)
+			}
+			continue
+		}
+		values, err := unpack(outputs, data)
+		if err != nil {
+			continue
+		}
+		decoded = append(decoded, key)
+		if packed, err := outputs.Pack(values...); err == nil && bytes.Equal(packed, data) {
+			exact = append(exact, key)
+		}
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = decoded
+	}
+
+	switch {
+	case len(candidates) == 0:
+		return "", fmt.Errorf("no overload of function %q matches the return data", name)
+	case len(candidates) == 1, a.sameOutputTypes(candidates):
+		return candidates[0], nil
+	default:
+		return "", a.ambiguousFunctionError(name, candidates)
+	}
+}
+
+// sameOutputTypes reports whether every method in keys returns the same
+// tuple of types.
+func (a *ABI) sameOutputTypes(keys []string) bool {
+	first := a.gethABI.Methods[keys[0]].Outputs
+	for _, key := range keys[1:] {
+		outputs := a.gethABI.Methods[key].Outputs
+		if len(outputs) != len(first) {
+			return false
+		}
+		for i := range outputs {
+			if outputs[i].Type.String() != first[i].Type.String() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (a *ABI) ambiguousFunctionError(name string, keys []string) *AmbiguousFunctionError {
+	sigs := make([]string, len(keys))
+	for i, key := range keys {
+		sigs[i] = a.gethABI.Methods[key].Sig
+	}
+	return &AmbiguousFunctionError{Name: name, Signatures: sigs}
+}
@@ -0,0 +1,92 @@
+package abi_test
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ChefBingbong/viem-go/abi"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DecodeFunctionResult", func() {
+	Context("basic functions", func() {
+		It("should decode a uint256 result", func() {
+			jsonABI := []byte(`[{"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`)
+			parsed, err := abi.Parse(jsonABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := parsed.DecodeFunctionResult("totalSupply", hexToBytes("0x0000000000000000000000000000000000000000000000000000000000010f2c"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].(*big.Int).Int64()).To(Equal(int64(69420)))
+		})
+	})
+
+	Context("overloaded functions", func() {
+		overloadedABI := []byte(`[
+			{"inputs":[{"name":"a","type":"uint256"}],"name":"foo","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+			{"inputs":[{"name":"a","type":"uint256"},{"name":"b","type":"uint256"}],"name":"foo","outputs":[{"name":"","type":"uint256"},{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+		]`)
+
+		It("should resolve foo(uint256) by output shape", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := parsed.DecodeFunctionResult("foo", hexToBytes("0x0000000000000000000000000000000000000000000000000000000000000001"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].(*big.Int).Int64()).To(Equal(int64(1)))
+		})
+
+		It("should resolve foo(uint256,uint256) by output shape", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := parsed.DecodeFunctionResult("foo", hexToBytes("0x00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].(*big.Int).Int64()).To(Equal(int64(1)))
+			Expect(result[1].(*big.Int).Int64()).To(Equal(int64(2)))
+		})
+
+		It("should decode overloads that share output types with the first one", func() {
+			jsonABI := []byte(`[
+				{"inputs":[{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+				{"inputs":[{"name":"account","type":"address"},{"name":"id","type":"uint256"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}
+			]`)
+			parsed, err := abi.Parse(jsonABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := parsed.DecodeFunctionResult("balanceOf", hexToBytes("0x000000000000000000000000000000000000000000000000000000000000002a"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+			Expect(result[0].(*big.Int).Int64()).To(Equal(int64(42)))
+
+			var balance *big.Int
+			err = parsed.DecodeFunctionResultInto("balanceOf", hexToBytes("0x000000000000000000000000000000000000000000000000000000000000002a"), &balance)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance.Int64()).To(Equal(int64(42)))
+		})
+
+		It("should return AmbiguousFunctionError when several overloads fit with different outputs", func() {
+			jsonABI := []byte(`[
+				{"inputs":[{"name":"a","type":"uint256"}],"name":"foo","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+				{"inputs":[{"name":"a","type":"uint256"},{"name":"b","type":"uint256"}],"name":"foo","outputs":[{"name":"","type":"int256"}],"stateMutability":"view","type":"function"}
+			]`)
+			parsed, err := abi.Parse(jsonABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = parsed.DecodeFunctionResult("foo", hexToBytes("0x0000000000000000000000000000000000000000000000000000000000000001"))
+			var ambiguous *abi.AmbiguousFunctionError
+			Expect(errors.As(err, &ambiguous)).To(BeTrue())
+			Expect(ambiguous.Signatures).To(ConsistOf("foo(uint256)", "foo(uint256,uint256)"))
+
+			// The full signature picks an overload explicitly.
+			result, err := parsed.DecodeFunctionResult("foo(uint256,uint256)", hexToBytes("0x0000000000000000000000000000000000000000000000000000000000000001"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(1))
+		})
+	})
+})
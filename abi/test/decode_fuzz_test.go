@@ -0,0 +1,77 @@
+package abi_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+)
+
+// word returns v as a 32-byte ABI word.
+func word(v *big.Int) []byte {
+	return common.LeftPadBytes(v.Bytes(), 32)
+}
+
+// addDecodeSeeds adds valid, truncated and oversized-length-prefixed
+// encodings of data to the corpus.
+func addDecodeSeeds(f *testing.F, valid []byte) {
+	f.Add(valid)
+	f.Add(valid[:len(valid)/2])
+	f.Add(valid[:len(valid)-1])
+
+	huge := new(big.Int).Lsh(big.NewInt(1), 255)
+	maxInt64 := new(big.Int).SetUint64(1<<63 - 1)
+	for _, length := range []*big.Int{huge, maxInt64, big.NewInt(1 << 40)} {
+		// Oversized offset
+		oversized := append(word(length), valid[32:]...)
+		f.Add(oversized)
+		// Oversized length prefix behind a valid offset
+		f.Add(append(append(word(big.NewInt(32)), word(length)...), valid[64:]...))
+	}
+}
+
+func FuzzDecodeAbiParameters(f *testing.F) {
+	params := []abi.AbiParam{
+		{Type: "string"},
+		{Type: "bytes"},
+		{Type: "uint256[]"},
+		{Type: "tuple", Components: []abi.AbiParam{{Name: "owner", Type: "address"}, {Name: "data", Type: "bytes[]"}}},
+	}
+	valid, err := abi.EncodeAbiParameters(params, []any{
+		"hello",
+		[]byte{0xde, 0xad},
+		[]*big.Int{big.NewInt(1), big.NewInt(2)},
+		struct {
+			Owner common.Address
+			Data  [][]byte
+		}{common.HexToAddress("0x01"), [][]byte{{0x01}, {0x02, 0x03}}},
+	})
+	if err != nil {
+		f.Fatal(err)
+	}
+	addDecodeSeeds(f, valid)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, err := abi.DecodeAbiParameters(params, data)
+		var decodingErr *abi.AbiDecodingError
+		if err != nil && len(data) >= 32 && !errors.As(err, &decodingErr) {
+			t.Fatalf("expected an AbiDecodingError, got %v", err)
+		}
+	})
+}
+
+func FuzzDecodeErrorResult(f *testing.F) {
+	selector := []byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	valid, err := abi.EncodeAbiParameters([]abi.AbiParam{{Type: "string"}}, []any{"reverted"})
+	if err != nil {
+		f.Fatal(err)
+	}
+	addDecodeSeeds(f, valid)
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = abi.DecodeErrorResultWithoutABI(append(append([]byte{}, selector...), data...))
+	})
+}
@@ -1,6 +1,7 @@
 package abi_test
 
 import (
+	"errors"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -86,6 +87,69 @@ var _ = Describe("EncodeFunctionData", func() {
 		})
 	})
 
+	Context("overloaded functions", func() {
+		overloadedABI := []byte(`[
+			{"inputs":[{"name":"a","type":"uint256"}],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"},
+			{"inputs":[{"name":"a","type":"uint256"},{"name":"b","type":"uint256"}],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"}
+		]`)
+
+		It("should resolve foo(uint256) by argument count", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := parsed.EncodeFunctionData("foo", big.NewInt(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytesToHex(encoded)).To(Equal("0x2fbebd380000000000000000000000000000000000000000000000000000000000000001"))
+		})
+
+		It("should resolve foo(uint256,uint256) by argument count", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := parsed.EncodeFunctionData("foo", big.NewInt(1), big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytesToHex(encoded)).To(Equal("0x04bc52f800000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002"))
+		})
+
+		It("should resolve overloads in EncodeFunctionArgs", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := parsed.EncodeFunctionArgs("foo", big.NewInt(1), big.NewInt(2))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encoded).To(HaveLen(64))
+		})
+
+		It("should return error when no overload matches the argument count", func() {
+			parsed, err := abi.Parse(overloadedABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = parsed.EncodeFunctionData("foo", big.NewInt(1), big.NewInt(2), big.NewInt(3))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no overload"))
+		})
+
+		It("should return AmbiguousFunctionError when several overloads match", func() {
+			jsonABI := []byte(`[
+				{"inputs":[{"name":"a","type":"uint256"}],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"},
+				{"inputs":[{"name":"a","type":"int256"}],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"}
+			]`)
+			parsed, err := abi.Parse(jsonABI)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = parsed.EncodeFunctionData("foo", big.NewInt(1))
+			var ambiguous *abi.AmbiguousFunctionError
+			Expect(errors.As(err, &ambiguous)).To(BeTrue())
+			Expect(ambiguous.Name).To(Equal("foo"))
+			Expect(ambiguous.Signatures).To(ConsistOf("foo(uint256)", "foo(int256)"))
+
+			// The full signature picks an overload explicitly.
+			encoded, err := parsed.EncodeFunctionData("foo(uint256)", big.NewInt(1))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytesToHex(encoded[:4])).To(Equal("0x2fbebd38"))
+		})
+	})
+
 	Context("aliases", func() {
 		It("should work with Pack alias", func() {
 			jsonABI := []byte(`[{"inputs":[],"name":"foo","outputs":[],"stateMutability":"nonpayable","type":"function"}]`)
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ChefBingbong/viem-go/abi"
 
@@ -204,6 +205,32 @@ var _ = Describe("EncodePacked", func() {
 		})
 	})
 
+	Context("hashing", func() {
+		It("should match keccak256(abi.encodePacked(uint256(1)))", func() {
+			encoded, err := abi.EncodePacked([]string{"uint256"}, []any{big.NewInt(1)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytesToHex(crypto.Keccak256(encoded))).To(Equal("0xb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6"))
+		})
+
+		It("should match keccak256(abi.encodePacked(address, uint256))", func() {
+			encoded, err := abi.EncodePacked(
+				[]string{"address", "uint256"},
+				[]any{"0x14dC79964da2C08b23698B3D3cc7Ca32193d9955", big.NewInt(420)},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encoded).To(HaveLen(52))
+			Expect(bytesToHex(crypto.Keccak256(encoded))).To(Equal("0x3c4b9c5c3997e1ae37c3fb034036d8fe6e47a886cbfad65283ab93754b2d98c4"))
+		})
+
+		It("should pad array elements to 32 bytes", func() {
+			encoded, err := abi.EncodePacked([]string{"uint8[]"}, []any{[]any{uint8(1), uint8(2)}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encoded).To(HaveLen(64))
+			Expect(encoded[31]).To(Equal(byte(1)))
+			Expect(encoded[63]).To(Equal(byte(2)))
+		})
+	})
+
 	Context("error cases", func() {
 		It("should return error for length mismatch", func() {
 			_, err := abi.EncodePacked(
@@ -222,6 +249,21 @@ var _ = Describe("EncodePacked", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("should reject arrays of dynamic types", func() {
+			_, err := abi.EncodePacked([]string{"string[]"}, []any{[]string{"a", "b"}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported packed encoding type"))
+
+			_, err = abi.EncodePacked([]string{"bytes[]"}, []any{[][]byte{{0x01}}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject nested arrays", func() {
+			_, err := abi.EncodePacked([]string{"uint256[][]"}, []any{[]any{[]any{big.NewInt(1)}}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported packed encoding type"))
+		})
+
 		It("should return error for bytes size mismatch", func() {
 			_, err := abi.EncodePacked(
 				[]string{"bytes4"},
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/ChefBingbong/viem-go/abi"
 
@@ -171,4 +172,59 @@ var _ = Describe("ParseEventLogs", func() {
 			Expect(parsed).To(HaveLen(2))
 		})
 	})
+
+	Context("indexed dynamic args", func() {
+		var namedABI *abi.ABI
+
+		BeforeEach(func() {
+			var err error
+			namedABI, err = abi.Parse([]byte(`[
+				{"type":"event","name":"Named","inputs":[{"name":"name","type":"string","indexed":true},{"name":"id","type":"uint256","indexed":false}]}
+			]`))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		namedLog := func(name string) abi.RawLog {
+			event, err := namedABI.GetEvent("Named")
+			Expect(err).ToNot(HaveOccurred())
+			return abi.RawLog{
+				Topics: []common.Hash{event.Topic, crypto.Keccak256Hash([]byte(name))},
+				Data:   common.LeftPadBytes(big.NewInt(7).Bytes(), 32),
+			}
+		}
+
+		It("should return the topic hash flagged as hashed", func() {
+			log := namedLog("alice")
+
+			decoded, err := namedABI.DecodeEventLog(log.Topics, log.Data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.Args["name"]).To(Equal(crypto.Keccak256Hash([]byte("alice"))))
+			Expect(decoded.HashedArgs).To(Equal(map[string]bool{"name": true}))
+			Expect(decoded.Args["id"]).To(Equal(big.NewInt(7)))
+		})
+
+		It("should match filter values by their hash", func() {
+			logs := []abi.RawLog{namedLog("alice"), namedLog("bob")}
+
+			parsed := namedABI.ParseEventLogs(logs, &abi.ParseEventLogsOptions{
+				Args:   map[string]any{"name": "bob"},
+				Strict: true,
+			})
+			Expect(parsed).To(HaveLen(1))
+			Expect(parsed[0].Args["name"]).To(Equal(crypto.Keccak256Hash([]byte("bob"))))
+			Expect(parsed[0].HashedArgs["name"]).To(BeTrue())
+		})
+
+		It("should not flag statically typed args", func() {
+			from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+			to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+			decoded, err := erc20ABI.DecodeEventLog(
+				[]common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				common.LeftPadBytes(big.NewInt(1).Bytes(), 32),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decoded.HashedArgs).To(BeNil())
+		})
+	})
 })
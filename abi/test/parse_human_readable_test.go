@@ -0,0 +1,150 @@
+package abi_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseHumanReadable", func() {
+	erc20Signatures := []string{
+		"constructor(string name, string symbol)",
+		"function name() view returns (string)",
+		"function balanceOf(address owner) view returns (uint256 balance)",
+		"function transfer(address to, uint amount) returns (bool)",
+		"function approve(address spender, uint256 amount) external returns (bool success)",
+		"event Transfer(address indexed from, address indexed to, uint256 value)",
+		"error InsufficientBalance(uint256 available, uint256 required)",
+	}
+
+	erc20JSON := `[
+		{"type":"constructor","inputs":[{"name":"name","type":"string"},{"name":"symbol","type":"string"}],"stateMutability":"nonpayable"},
+		{"type":"function","name":"name","inputs":[],"outputs":[{"type":"string"}],"stateMutability":"view"},
+		{"type":"function","name":"balanceOf","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"balance","type":"uint256"}],"stateMutability":"view"},
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+		{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"success","type":"bool"}],"stateMutability":"nonpayable"},
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]},
+		{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+	]`
+
+	Context("ERC20", func() {
+		It("should round-trip to the JSON ABI form", func() {
+			parsed, err := abi.ParseHumanReadable(erc20Signatures)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(parsed.Raw())).To(MatchJSON(erc20JSON))
+
+			fromJSON, err := abi.ParseFromString(erc20JSON)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parsed.Functions).To(Equal(fromJSON.Functions))
+			Expect(parsed.Events).To(Equal(fromJSON.Events))
+			Expect(parsed.Errors).To(Equal(fromJSON.Errors))
+		})
+
+		It("should keep named outputs and state mutability", func() {
+			parsed, err := abi.ParseHumanReadable(erc20Signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			fn, err := parsed.GetFunction("balanceOf")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn.StateMutability).To(Equal(abi.View))
+			Expect(fn.Outputs).To(HaveLen(1))
+			Expect(fn.Outputs[0].Name).To(Equal("balance"))
+			Expect(fn.Signature).To(Equal("balanceOf(address)"))
+		})
+
+		It("should mark indexed event parameters", func() {
+			parsed, err := abi.ParseHumanReadable(erc20Signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err := parsed.GetEvent("Transfer")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Inputs[0].Indexed).To(BeTrue())
+			Expect(ev.Inputs[1].Indexed).To(BeTrue())
+			Expect(ev.Inputs[2].Indexed).To(BeFalse())
+			Expect(ev.Topic.Hex()).To(Equal("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"))
+		})
+
+		It("should encode calls like a JSON ABI", func() {
+			parsed, err := abi.ParseHumanReadable(erc20Signatures)
+			Expect(err).ToNot(HaveOccurred())
+
+			encoded, err := parsed.EncodeFunctionData("transfer", common.Address{}, big.NewInt(69420))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytesToHex(encoded)).To(Equal("0xa9059cbb00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000010f2c"))
+		})
+	})
+
+	Context("tuples and modifiers", func() {
+		It("should parse tuple parameters and data locations", func() {
+			parsed, err := abi.ParseHumanReadable([]string{
+				"function submit((uint256 weight, bool voted)[] calldata votes, bytes memory data) payable",
+				"function getVoter(address who) view returns (tuple(uint256 weight, bool voted) voter)",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			fn, err := parsed.GetFunction("submit")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn.StateMutability).To(Equal(abi.Payable))
+			Expect(fn.Signature).To(Equal("submit((uint256,bool)[],bytes)"))
+			Expect(fn.Inputs[0].Name).To(Equal("votes"))
+			Expect(fn.Outputs).To(BeEmpty())
+
+			fn, err = parsed.GetFunction("getVoter")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fn.Outputs).To(HaveLen(1))
+			Expect(fn.Outputs[0].Name).To(Equal("voter"))
+			Expect(fn.Outputs[0].Components).To(HaveLen(2))
+			Expect(fn.Outputs[0].Components[0].Name).To(Equal("weight"))
+		})
+
+		It("should parse anonymous events, fallback and receive", func() {
+			parsed, err := abi.ParseHumanReadable([]string{
+				"event Ping(uint256 indexed id) anonymous",
+				"fallback() external",
+				"receive() external payable",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			ev, err := parsed.GetEvent("Ping")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Anonymous).To(BeTrue())
+			Expect(parsed.GethABI().HasFallback()).To(BeTrue())
+			Expect(parsed.GethABI().HasReceive()).To(BeTrue())
+		})
+	})
+
+	Context("error cases", func() {
+		It("should reject unknown item kinds", func() {
+			_, err := abi.ParseHumanReadable([]string{"modifier onlyOwner()"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown item kind"))
+		})
+
+		It("should reject unknown types", func() {
+			_, err := abi.ParseHumanReadable([]string{"function foo(Foo memory foo)"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown type"))
+		})
+
+		It("should reject indexed outside events", func() {
+			_, err := abi.ParseHumanReadable([]string{"function foo(address indexed to)"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("indexed"))
+		})
+
+		It("should reject unbalanced parentheses", func() {
+			_, err := abi.ParseHumanReadable([]string{"function foo(uint256"})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unbalanced"))
+		})
+
+		It("should panic in MustParseHumanReadable", func() {
+			Expect(func() { abi.MustParseHumanReadable([]string{"nope"}) }).To(Panic())
+		})
+	})
+})
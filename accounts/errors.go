@@ -13,6 +13,10 @@ var (
 	ErrInvalidHDPath = errors.New("invalid HD derivation path")
 	// ErrSigningNotSupported is returned when signing is not supported.
 	ErrSigningNotSupported = errors.New("signing not supported for this account type")
+	// ErrInvalidWebAuthnCredential is returned when a WebAuthn credential is invalid.
+	ErrInvalidWebAuthnCredential = errors.New("invalid webauthn credential")
 	// ErrInvalidWordlist is returned when a wordlist is invalid.
 	ErrInvalidWordlist = errors.New("invalid wordlist")
+	// ErrChainIDRequired is returned when signing offline without a chain ID.
+	ErrChainIDRequired = errors.New("chain ID is required to sign a transaction offline")
 )
@@ -0,0 +1,136 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// NonceManagerParameters is re-exported from the types package for convenience.
+type NonceManagerParameters = types.NonceManagerParameters
+
+// NonceManager tracks the next nonce per address and chain in memory so that
+// transactions sent in quick succession don't reuse the same pending nonce.
+//
+// The first Consume for a sequence syncs from the chain through the Source
+// function; later calls increment locally. Reset discards local state so the
+// next Consume resyncs, which is how gaps left by failed sends are filled.
+//
+// This mirrors viem's `createNonceManager` with the `jsonRpc` source.
+type NonceManager struct {
+	mu    sync.Mutex
+	next  map[string]uint64
+	locks map[string]*sync.Mutex
+}
+
+// NewNonceManager creates a new in-memory nonce manager.
+//
+// Example:
+//
+//	nonceManager := accounts.NewNonceManager()
+//	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+//	    Account:      account,
+//	    NonceManager: nonceManager,
+//	    To:           "0x...",
+//	})
+func NewNonceManager() *NonceManager {
+	return &NonceManager{
+		next:  make(map[string]uint64),
+		locks: make(map[string]*sync.Mutex),
+	}
+}
+
+// Consume returns the next nonce for the sequence and advances it by one.
+// Concurrent calls for the same sequence never return the same nonce.
+func (m *NonceManager) Consume(ctx context.Context, params NonceManagerParameters) (uint64, error) {
+	key := nonceManagerKey(params)
+	lock := m.lockFor(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	nonce, err := m.get(ctx, key, params)
+	if err != nil {
+		return 0, err
+	}
+
+	m.mu.Lock()
+	m.next[key] = nonce + 1
+	m.mu.Unlock()
+
+	return nonce, nil
+}
+
+// Get returns the next nonce for the sequence without advancing it.
+func (m *NonceManager) Get(ctx context.Context, params NonceManagerParameters) (uint64, error) {
+	key := nonceManagerKey(params)
+	lock := m.lockFor(key)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return m.get(ctx, key, params)
+}
+
+// Increment advances the sequence by one without returning a nonce.
+// It has no effect if the sequence has not been synced yet.
+func (m *NonceManager) Increment(params NonceManagerParameters) {
+	key := nonceManagerKey(params)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if next, ok := m.next[key]; ok {
+		m.next[key] = next + 1
+	}
+}
+
+// Reset discards the local state for the sequence. The next Consume or Get
+// resyncs from the chain.
+func (m *NonceManager) Reset(params NonceManagerParameters) {
+	key := nonceManagerKey(params)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.next, key)
+}
+
+// get returns the locally tracked nonce, syncing from the source if there is none.
+// The caller must hold the sequence lock.
+func (m *NonceManager) get(ctx context.Context, key string, params NonceManagerParameters) (uint64, error) {
+	m.mu.Lock()
+	next, ok := m.next[key]
+	m.mu.Unlock()
+	if ok {
+		return next, nil
+	}
+
+	if params.Source == nil {
+		return 0, fmt.Errorf("nonce manager: no source to sync nonce for %s", params.Address.Hex())
+	}
+	nonce, err := params.Source(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("nonce manager: failed to sync nonce for %s: %w", params.Address.Hex(), err)
+	}
+
+	m.mu.Lock()
+	m.next[key] = nonce
+	m.mu.Unlock()
+
+	return nonce, nil
+}
+
+// lockFor returns the per-sequence lock, so a slow sync for one address
+// doesn't block others.
+func (m *NonceManager) lockFor(key string) *sync.Mutex {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lock, ok := m.locks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		m.locks[key] = lock
+	}
+	return lock
+}
+
+// nonceManagerKey returns the map key for a nonce sequence.
+func nonceManagerKey(params NonceManagerParameters) string {
+	return fmt.Sprintf("%s.%d", params.Address.Hex(), params.ChainID)
+}
@@ -1,15 +1,25 @@
 package accounts_test
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
 	"math/big"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/utils"
 	"github.com/ChefBingbong/viem-go/utils/signature"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
+	"github.com/ChefBingbong/viem-go/utils/webauthn"
 )
 
 // Test private key (Anvil account 0)
@@ -118,6 +128,66 @@ var _ = Describe("Accounts", func() {
 			Expect(signedTx).To(HavePrefix("0x02"))
 		})
 
+		It("should sign transactions offline", func() {
+			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			tx := transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP1559,
+				ChainId:              10,
+				Nonce:                7,
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				MaxFeePerGas:         big.NewInt(2000000000),
+				Gas:                  big.NewInt(21000),
+				To:                   "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+				Value:                big.NewInt(1000000000000000000),
+			}
+
+			signedTx, err := account.SignTransactionOffline(tx)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, err := transaction.ParseTransaction(signedTx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.ChainId).To(Equal(10))
+			Expect(parsed.Nonce).To(Equal(7))
+
+			// Recompute the EIP-1559 signing hash independently and ecrecover
+			// the sender from the signature in the serialized transaction.
+			payload, err := rlp.EncodeToBytes([]any{
+				big.NewInt(10), uint64(7), tx.MaxPriorityFeePerGas, tx.MaxFeePerGas, uint64(21000),
+				common.HexToAddress(tx.To), tx.Value, []byte{}, []any{},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			expectedHash := crypto.Keccak256(append([]byte{0x02}, payload...))
+			sig := append(common.LeftPadBytes(common.FromHex(parsed.R), 32), common.LeftPadBytes(common.FromHex(parsed.S), 32)...)
+			sig = append(sig, byte(parsed.YParity))
+			pub, err := crypto.SigToPub(expectedHash, sig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(crypto.PubkeyToAddress(*pub)).To(Equal(common.HexToAddress(testAddress)))
+
+			// Signing the signing hash separately serializes to the same transaction.
+			signingHash, err := transaction.GetSigningHash(tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(signingHash).To(Equal(common.BytesToHash(expectedHash).Hex()))
+			signed, err := account.Sign(signingHash)
+			Expect(err).NotTo(HaveOccurred())
+			serialized, err := transaction.Serialize(tx, signed)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serialized).To(Equal(signedTx))
+		})
+
+		It("should require a chain ID to sign offline", func() {
+			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = account.SignTransactionOffline(transaction.Transaction{
+				Type:         transaction.TransactionTypeEIP1559,
+				MaxFeePerGas: big.NewInt(2000000000),
+				Gas:          big.NewInt(21000),
+			})
+			Expect(errors.Is(err, accounts.ErrChainIDRequired)).To(BeTrue())
+		})
+
 		It("should sign typed data", func() {
 			account, err := accounts.PrivateKeyToAccount(testPrivateKey)
 			Expect(err).NotTo(HaveOccurred())
@@ -246,4 +316,151 @@ var _ = Describe("Accounts", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("NonceManager", func() {
+		var (
+			nonceManager *accounts.NonceManager
+			syncs        int
+			params       accounts.NonceManagerParameters
+		)
+
+		BeforeEach(func() {
+			nonceManager = accounts.NewNonceManager()
+			syncs = 0
+			params = accounts.NonceManagerParameters{
+				Address: common.HexToAddress(testAddress),
+				ChainID: 1,
+				Source: func(ctx context.Context) (uint64, error) {
+					syncs++
+					return 5, nil
+				},
+			}
+		})
+
+		It("should sync once and increment locally", func() {
+			ctx := context.Background()
+			for i := uint64(0); i < 3; i++ {
+				nonce, err := nonceManager.Consume(ctx, params)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nonce).To(Equal(5 + i))
+			}
+			Expect(syncs).To(Equal(1))
+		})
+
+		It("should track chains separately", func() {
+			ctx := context.Background()
+			_, err := nonceManager.Consume(ctx, params)
+			Expect(err).NotTo(HaveOccurred())
+
+			params.ChainID = 10
+			nonce, err := nonceManager.Get(ctx, params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nonce).To(Equal(uint64(5)))
+			Expect(syncs).To(Equal(2))
+		})
+
+		It("should resync after reset", func() {
+			ctx := context.Background()
+			_, err := nonceManager.Consume(ctx, params)
+			Expect(err).NotTo(HaveOccurred())
+
+			nonceManager.Reset(params)
+			nonce, err := nonceManager.Consume(ctx, params)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nonce).To(Equal(uint64(5)))
+			Expect(syncs).To(Equal(2))
+		})
+
+		It("should fail when the source fails", func() {
+			params.Source = func(ctx context.Context) (uint64, error) {
+				return 0, errors.New("rpc unavailable")
+			}
+			_, err := nonceManager.Consume(context.Background(), params)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("ToWebAuthnAccount", func() {
+		var (
+			key        *ecdsa.PrivateKey
+			publicKey  string
+			lastSigned []byte
+			newAccount func() *accounts.WebAuthnAccount
+		)
+
+		BeforeEach(func() {
+			var err error
+			key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			pub := make([]byte, 64)
+			key.X.FillBytes(pub[:32])
+			key.Y.FillBytes(pub[32:])
+			publicKey = utils.BytesToHex(pub)
+
+			// A software authenticator: signs sha256(payload) like a passkey would.
+			newAccount = func() *accounts.WebAuthnAccount {
+				account, err := accounts.ToWebAuthnAccount(accounts.WebAuthnConfig{
+					CredentialID: "credential-1",
+					PublicKey:    publicKey,
+					RpID:         "example.com",
+					Origin:       "https://example.com",
+					Sign: func(payload []byte) ([]byte, error) {
+						lastSigned = payload
+						digest := webauthn.HashPayload(payload)
+						return ecdsa.SignASN1(rand.Reader, key, digest[:])
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				return account
+			}
+		})
+
+		It("should create a webAuthn account", func() {
+			account := newAccount()
+			Expect(account.ID).To(Equal("credential-1"))
+			Expect(account.GetType()).To(Equal(accounts.AccountTypeWebAuthn))
+			Expect(account.GetPublicKey()).To(Equal(publicKey))
+		})
+
+		It("should sign a hash verifiably with the credential public key", func() {
+			hash := common.HexToHash("0xf631058a3ba1116acce12396fad0a125b5041c43f8e15723709f81aa8d5f4ccf")
+
+			sig, err := newAccount().SignHash(hash.Bytes())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sig.Metadata.Payload()).To(Equal(lastSigned))
+			Expect(sig.Metadata.ClientDataJSON).To(ContainSubstring(`"challenge":"9jEFijuhEWrM4SOW-tChJbUEHEP44VcjcJ-Bqo1fTM8"`))
+
+			digest := webauthn.HashPayload(sig.Metadata.Payload())
+			Expect(ecdsa.Verify(&key.PublicKey, digest[:], sig.R, sig.S)).To(BeTrue())
+
+			halfN := new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+			Expect(sig.S.Cmp(halfN)).To(BeNumerically("<=", 0))
+		})
+
+		It("should return an ABI-encoded WebAuthnAuth from SignMessage", func() {
+			sig, err := newAccount().SignMessage(signature.NewSignableMessage("hello world"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sig).To(HavePrefix("0x0000000000000000000000000000000000000000000000000000000000000020"))
+		})
+
+		It("should reject an invalid public key", func() {
+			_, err := accounts.ToWebAuthnAccount(accounts.WebAuthnConfig{
+				CredentialID: "credential-1",
+				PublicKey:    "0x" + strings.Repeat("00", 64),
+			})
+			Expect(errors.Is(err, accounts.ErrInvalidWebAuthnCredential)).To(BeTrue())
+		})
+
+		It("should fail to sign without a signer", func() {
+			account, err := accounts.ToWebAuthnAccount(accounts.WebAuthnConfig{
+				CredentialID: "credential-1",
+				PublicKey:    publicKey,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = account.Sign("0x01")
+			Expect(errors.Is(err, accounts.ErrSigningNotSupported)).To(BeTrue())
+		})
+	})
 })
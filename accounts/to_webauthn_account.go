@@ -0,0 +1,163 @@
+package accounts
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChefBingbong/viem-go/utils"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+	"github.com/ChefBingbong/viem-go/utils/webauthn"
+)
+
+// WebAuthnSignFunc asks an authenticator to sign a WebAuthn payload
+// (authenticatorData || sha256(clientDataJSON)) with its P256 credential.
+// It returns the signature as 64 bytes r||s or ASN.1 DER, as authenticators emit.
+type WebAuthnSignFunc func(payload []byte) ([]byte, error)
+
+// WebAuthnConfig configures ToWebAuthnAccount.
+type WebAuthnConfig struct {
+	// CredentialID is the WebAuthn credential ID.
+	CredentialID string
+	// PublicKey is the credential's uncompressed P256 public key as hex
+	// (x||y, optionally prefixed with 0x04).
+	PublicKey string
+	// Sign delegates signing to the authenticator that holds the private key.
+	Sign WebAuthnSignFunc
+	// RpID is the relying party ID recorded in the authenticator data.
+	RpID string
+	// Origin is the origin recorded in clientDataJSON.
+	Origin string
+}
+
+// WebAuthnSignature is a passkey signature over a hash.
+type WebAuthnSignature struct {
+	// R and S are the P256 signature values, with S normalized to low-s.
+	R, S *big.Int
+	// Metadata is the assertion data the signature was produced over.
+	Metadata webauthn.Metadata
+	// Raw is the signature as returned by the authenticator.
+	Raw []byte
+}
+
+// Hex returns the signature ABI-encoded as a WebAuthnAuth struct, the format
+// Solidity WebAuthn verifiers and smart accounts expect.
+func (s *WebAuthnSignature) Hex() (string, error) {
+	encoded, err := webauthn.EncodeAuth(s.Metadata, s.R, s.S)
+	if err != nil {
+		return "", err
+	}
+	return utils.BytesToHex(encoded), nil
+}
+
+// WebAuthnAccount is a passkey (P256) signer. It has no address of its own;
+// it is used as the owner of a smart account that verifies WebAuthn signatures.
+//
+// Its Sign, SignMessage and SignTypedData methods have the same shape as
+// LocalAccount's, so it can be used wherever a hash, message or typed data
+// signer is expected.
+type WebAuthnAccount struct {
+	ID        string      `json:"id"`
+	PublicKey string      `json:"publicKey"`
+	Type      AccountType `json:"type"`
+
+	sign   WebAuthnSignFunc
+	rpID   string
+	origin string
+}
+
+// ToWebAuthnAccount creates a WebAuthn account from a passkey credential.
+// Signing is delegated to config.Sign, since the private key lives in the
+// authenticator.
+//
+// This is equivalent to viem's `toWebAuthnAccount`.
+//
+// Example:
+//
+//	account, err := accounts.ToWebAuthnAccount(accounts.WebAuthnConfig{
+//		CredentialID: credential.ID,
+//		PublicKey:    credential.PublicKey,
+//		RpID:         "example.com",
+//		Origin:       "https://example.com",
+//		Sign: func(payload []byte) ([]byte, error) {
+//			return authenticator.Sign(payload)
+//		},
+//	})
+//	sig, err := account.Sign(userOpHash)
+func ToWebAuthnAccount(config WebAuthnConfig) (*WebAuthnAccount, error) {
+	if config.CredentialID == "" {
+		return nil, fmt.Errorf("%w: missing credential ID", ErrInvalidWebAuthnCredential)
+	}
+	pub, err := utils.HexToBytes(config.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebAuthnCredential, err)
+	}
+	if _, _, err := webauthn.ParsePublicKey(pub); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidWebAuthnCredential, err)
+	}
+
+	return &WebAuthnAccount{
+		ID:        config.CredentialID,
+		PublicKey: utils.BytesToHex(pub),
+		Type:      AccountTypeWebAuthn,
+		sign:      config.Sign,
+		rpID:      config.RpID,
+		origin:    config.Origin,
+	}, nil
+}
+
+// GetType returns the account type.
+func (a *WebAuthnAccount) GetType() AccountType { return a.Type }
+
+// GetPublicKey returns the credential's public key.
+func (a *WebAuthnAccount) GetPublicKey() string { return a.PublicKey }
+
+// SignHash signs a hash (used as the WebAuthn challenge) and returns the
+// structured signature.
+func (a *WebAuthnAccount) SignHash(hash []byte) (*WebAuthnSignature, error) {
+	if a.sign == nil {
+		return nil, ErrSigningNotSupported
+	}
+
+	payload, metadata := webauthn.GetSignPayload(hash, webauthn.SignPayloadOptions{
+		RpID:   a.rpID,
+		Origin: a.origin,
+	})
+
+	raw, err := a.sign(payload)
+	if err != nil {
+		return nil, err
+	}
+	r, s, err := webauthn.ParseSignature(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebAuthnSignature{R: r, S: s, Metadata: metadata, Raw: raw}, nil
+}
+
+// Sign signs a hex hash and returns the ABI-encoded WebAuthnAuth signature as hex.
+func (a *WebAuthnAccount) Sign(hash string) (string, error) {
+	b, err := utils.HexToBytes(hash)
+	if err != nil {
+		return "", err
+	}
+	sig, err := a.SignHash(b)
+	if err != nil {
+		return "", err
+	}
+	return sig.Hex()
+}
+
+// SignMessage signs an EIP-191 message and returns the ABI-encoded WebAuthnAuth signature as hex.
+func (a *WebAuthnAccount) SignMessage(message signature.SignableMessage) (string, error) {
+	return a.Sign(signature.HashMessage(message))
+}
+
+// SignTypedData signs EIP-712 typed data and returns the ABI-encoded WebAuthnAuth signature as hex.
+func (a *WebAuthnAccount) SignTypedData(data signature.TypedDataDefinition) (string, error) {
+	hash, err := signature.HashTypedData(data)
+	if err != nil {
+		return "", err
+	}
+	return a.Sign(hash)
+}
@@ -22,8 +22,9 @@ type (
 
 // Re-export constants
 const (
-	AccountTypeLocal   = types.AccountTypeLocal
-	AccountTypeJSONRPC = types.AccountTypeJSONRPC
+	AccountTypeLocal    = types.AccountTypeLocal
+	AccountTypeJSONRPC  = types.AccountTypeJSONRPC
+	AccountTypeWebAuthn = types.AccountTypeWebAuthn
 
 	AccountSourcePrivateKey = types.AccountSourcePrivateKey
 	AccountSourceHD         = types.AccountSourceHD
@@ -94,6 +95,12 @@ func (a *LocalAccount) Sign(hash string) (string, error) {
 	return a.sign(hash)
 }
 
+// SignHash signs a raw 32-byte hash, without the EIP-191 message prefix,
+// and returns the signature as hex.
+func (a *LocalAccount) SignHash(hash [32]byte) (string, error) {
+	return a.Sign(common.Hash(hash).Hex())
+}
+
 // SignMessage signs a message and returns the signature as hex.
 func (a *LocalAccount) SignMessage(message signature.SignableMessage) (string, error) {
 	if a.signMessage == nil {
@@ -110,6 +117,20 @@ func (a *LocalAccount) SignTransaction(tx *transaction.Transaction) (string, err
 	return a.signTransaction(tx)
 }
 
+// SignTransactionOffline signs tx without contacting a node and returns the
+// serialized signed transaction, ready to be broadcast later with
+// eth_sendRawTransaction.
+//
+// Nothing is filled in: the nonce, gas, fees and chain ID must all be set.
+// Since the chain ID cannot be checked against a node, it is required, so
+// the signature is never replayable across chains.
+func (a *LocalAccount) SignTransactionOffline(tx transaction.Transaction) (string, error) {
+	if tx.ChainId <= 0 {
+		return "", ErrChainIDRequired
+	}
+	return a.SignTransaction(&tx)
+}
+
 // SignTypedData signs EIP-712 typed data and returns the signature as hex.
 func (a *LocalAccount) SignTypedData(data signature.TypedDataDefinition) (string, error) {
 	if a.signTypedData == nil {
@@ -48,11 +48,21 @@ func SignTransaction(params SignTransactionParameters) (string, error) {
 
 	// Prepare transaction for signing
 	// For EIP-4844 transactions, we sign without sidecars (network wrapper)
-	signableTx := params.Transaction
-	if params.Transaction.Type == transaction.TransactionTypeEIP4844 {
+	tx := params.Transaction
+	signableTx := tx
+	if tx.Type == transaction.TransactionTypeEIP4844 {
+		// Derive versioned hashes and sidecars from raw blobs first, so the
+		// signed payload commits to the same hashes the wrapper carries.
+		filled, err := transaction.FillBlobSidecars(tx)
+		if err != nil {
+			return "", err
+		}
+		tx = filled
+
 		// Create a copy without sidecars for signing
-		txCopy := *params.Transaction
+		txCopy := *tx
 		txCopy.Sidecars = nil
+		txCopy.Kzg = nil
 		signableTx = &txCopy
 	}
 
@@ -80,7 +90,7 @@ func SignTransaction(params SignTransactionParameters) (string, error) {
 	}
 
 	// Serialize the transaction with signature
-	return serializer(params.Transaction, txSig)
+	return serializer(tx, txSig)
 }
 
 // MustSignTransaction signs a transaction or panics on error.
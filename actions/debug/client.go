@@ -0,0 +1,25 @@
+// Package debug provides standalone action functions for the debug_* JSON-RPC
+// namespace (execution tracing). These methods are served by archive and
+// developer nodes such as geth, reth, erigon and anvil, but are usually
+// disabled on public RPC endpoints.
+//
+// This mirrors viem's actions pattern where actions are standalone functions
+// that take a client interface as their first parameter.
+package debug
+
+import (
+	"context"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// Client is the interface that debug actions require from a client.
+// Both public.Client implementations and *client.PublicClient satisfy it.
+type Client interface {
+	// Request sends a raw JSON-RPC request.
+	Request(ctx context.Context, method string, params ...any) (*transport.RPCResponse, error)
+
+	// ExperimentalBlockTag returns the default block tag for RPC requests.
+	ExperimentalBlockTag() types.BlockTag
+}
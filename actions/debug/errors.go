@@ -0,0 +1,21 @@
+package debug
+
+import (
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// MethodNotSupportedError is returned when the node does not expose the
+// debug namespace (or the specific debug method). It is the same type as
+// transport.MethodNotSupportedError, so either can be used with errors.As.
+type MethodNotSupportedError = transport.MethodNotSupportedError
+
+// wrapRequestError maps "method not found" style RPC errors to
+// *MethodNotSupportedError and wraps everything else with the method name.
+func wrapRequestError(method string, err error) error {
+	if transport.IsMethodNotSupported(err) {
+		return &MethodNotSupportedError{Method: method, Cause: err}
+	}
+	return fmt.Errorf("%s failed: %w", method, err)
+}
@@ -0,0 +1,224 @@
+package debug_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/actions/debug"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// mockClient implements the debug.Client interface for testing.
+type mockClient struct {
+	transport transport.Transport
+}
+
+func (c *mockClient) Request(ctx context.Context, method string, params ...any) (*transport.RPCResponse, error) {
+	return c.transport.Request(ctx, transport.RPCRequest{Method: method, Params: params})
+}
+
+func (c *mockClient) ExperimentalBlockTag() types.BlockTag {
+	return ""
+}
+
+// createTestServer creates a test HTTP server that responds to JSON-RPC requests.
+// A handler returning *transport.RPCError produces a JSON-RPC error response.
+func createTestServer(t *testing.T, handler func(method string, params []any) any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch result := handler(req.Method, req.Params).(type) {
+		case *transport.RPCError:
+			resp["error"] = result
+		default:
+			resp["result"] = result
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func createMockClient(t *testing.T, serverURL string) *mockClient {
+	tr, err := transport.HTTP(serverURL)(transport.TransportParams{})
+	require.NoError(t, err)
+	return &mockClient{transport: tr}
+}
+
+// ============================================================================
+// TraceCall Tests
+// ============================================================================
+
+// callTracerResult is a callTracer trace of a reverted call that made one
+// nested balance lookup.
+const callTracerResult = `{
+	"type": "CALL",
+	"from": "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+	"to": "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+	"value": "0x0",
+	"gas": "0x1e8480",
+	"gasUsed": "0x6f5a",
+	"input": "0xa9059cbb",
+	"error": "execution reverted",
+	"calls": [{
+		"type": "STATICCALL",
+		"from": "0x5fbdb2315678afecb367f032d93f642f64180aa3",
+		"to": "0xe7f1725e7734ce288f8367e1bb143e90bb3f0512",
+		"gas": "0x1dfc4a",
+		"gasUsed": "0x9c4",
+		"input": "0x70a08231",
+		"output": "0x0000000000000000000000000000000000000000000000000000000000000064"
+	}]
+}`
+
+func TestTraceCall_CallTracer(t *testing.T) {
+	var captured []any
+	server := createTestServer(t, func(method string, params []any) any {
+		captured = params
+		if method == "debug_traceCall" {
+			return json.RawMessage(callTracerResult)
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	to := common.HexToAddress("0x5fbdb2315678afecb367f032d93f642f64180aa3")
+	blockNumber := uint64(100)
+
+	trace, err := debug.TraceCall(context.Background(), client, debug.TraceCallParameters{
+		CallParameters: public.CallParameters{
+			To:          &to,
+			Data:        common.FromHex("0xa9059cbb"),
+			BlockNumber: &blockNumber,
+		},
+		Tracer:       debug.TracerCall,
+		TracerConfig: debug.CallTracerConfig{WithLog: true},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, captured, 3)
+	assert.Equal(t, "0x64", captured[1])
+	config := captured[2].(map[string]any)
+	assert.Equal(t, "callTracer", config["tracer"])
+	assert.Equal(t, map[string]any{"withLog": true}, config["tracerConfig"])
+
+	frame, err := trace.CallFrame()
+	require.NoError(t, err)
+	assert.Equal(t, "CALL", frame.Type)
+	assert.Equal(t, common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"), frame.From)
+	assert.Equal(t, &to, frame.To)
+	assert.Zero(t, frame.Value.Sign())
+	assert.Equal(t, uint64(0x1e8480), frame.Gas)
+	assert.Equal(t, uint64(0x6f5a), frame.GasUsed)
+	assert.Equal(t, "execution reverted", frame.Error)
+
+	require.Len(t, frame.Calls, 1)
+	sub := frame.Calls[0]
+	assert.Equal(t, "STATICCALL", sub.Type)
+	assert.Equal(t, common.HexToAddress("0xe7f1725e7734ce288f8367e1bb143e90bb3f0512"), *sub.To)
+	assert.Nil(t, sub.Value)
+	assert.Equal(t, uint64(0x9c4), sub.GasUsed)
+	assert.Equal(t, common.FromHex("0x70a08231"), sub.Input)
+	assert.Equal(t, big.NewInt(100), new(big.Int).SetBytes(sub.Output))
+	assert.Empty(t, sub.Error)
+
+	// A callTracer result is not a prestate result
+	_, err = trace.Prestate()
+	assert.Error(t, err)
+}
+
+func TestTraceCall_RejectsDeployless(t *testing.T) {
+	_, err := debug.TraceCall(context.Background(), &mockClient{}, debug.TraceCallParameters{
+		CallParameters: public.CallParameters{Code: []byte{0x60}},
+	})
+	assert.Error(t, err)
+}
+
+// ============================================================================
+// TraceTransaction Tests
+// ============================================================================
+
+func TestTraceTransaction_PrestateDiff(t *testing.T) {
+	account := common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	slot := common.HexToHash("0x01")
+
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "debug_traceTransaction" {
+			return nil
+		}
+		return json.RawMessage(`{
+			"pre":  {"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266": {"balance": "0x64", "nonce": 1, "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000000"}}},
+			"post": {"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266": {"balance": "0x32", "nonce": 2, "storage": {"0x0000000000000000000000000000000000000000000000000000000000000001": "0x000000000000000000000000000000000000000000000000000000000000002a"}}}
+		}`)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	trace, err := debug.TraceTransaction(context.Background(), client, common.HexToHash("0xabc"), debug.TraceOptions{
+		Tracer:       debug.TracerPrestate,
+		TracerConfig: debug.PrestateTracerConfig{DiffMode: true},
+	})
+	require.NoError(t, err)
+
+	diff, err := trace.PrestateDiff()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), diff.Pre[account].Balance)
+	assert.Equal(t, uint64(1), diff.Pre[account].Nonce)
+	assert.Equal(t, big.NewInt(50), diff.Post[account].Balance)
+	assert.Equal(t, common.HexToHash("0x2a"), diff.Post[account].Storage[slot])
+}
+
+func TestTraceTransaction_CustomTracerRaw(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return json.RawMessage(`{"opcodes": 42}`)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	trace, err := debug.TraceTransaction(context.Background(), client, common.HexToHash("0xabc"), debug.TraceOptions{
+		Tracer: "{count: 0, step: function() { this.count++ }, result: function() { return {opcodes: this.count} }}",
+	})
+	require.NoError(t, err)
+
+	var out struct {
+		Opcodes int `json:"opcodes"`
+	}
+	require.NoError(t, trace.Decode(&out))
+	assert.Equal(t, 42, out.Opcodes)
+}
+
+func TestTraceTransaction_MethodNotSupported(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return &transport.RPCError{Code: -32601, Message: "the method debug_traceTransaction does not exist/is not available"}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	_, err := debug.TraceTransaction(context.Background(), client, common.HexToHash("0xabc"), debug.TraceOptions{})
+
+	var notSupported *debug.MethodNotSupportedError
+	require.ErrorAs(t, err, &notSupported)
+	assert.Equal(t, "debug_traceTransaction", notSupported.Method)
+
+	// The debug error is the transport error.
+	var transportNotSupported *transport.MethodNotSupportedError
+	require.ErrorAs(t, err, &transportNotSupported)
+	assert.True(t, transport.IsMethodNotSupported(err))
+}
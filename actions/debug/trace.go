@@ -0,0 +1,258 @@
+package debug
+
+import (
+	"fmt"
+	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Tracer names a debug tracer.
+type Tracer string
+
+// Built-in tracers with typed results. Any other tracer name (or a JS tracer
+// body) can be passed and its output read via TraceResult.Raw.
+const (
+	// TracerStructLogs is the default opcode-level logger, used when Tracer is empty.
+	TracerStructLogs Tracer = ""
+
+	// TracerCall returns the call tree of the execution.
+	TracerCall Tracer = "callTracer"
+
+	// TracerPrestate returns the accounts touched by the execution and their
+	// state before it (or the pre/post diff when DiffMode is set).
+	TracerPrestate Tracer = "prestateTracer"
+)
+
+// TraceOptions configures a debug trace.
+type TraceOptions struct {
+	// Tracer selects the tracer. Defaults to the struct logger.
+	Tracer Tracer
+
+	// TracerConfig is passed to the tracer as-is. Use CallTracerConfig or
+	// PrestateTracerConfig for the built-in tracers.
+	TracerConfig any
+
+	// Timeout overrides the node's default tracing timeout (e.g. "10s").
+	Timeout string
+}
+
+// CallTracerConfig configures the callTracer.
+type CallTracerConfig struct {
+	// OnlyTopCall skips tracing of sub-calls.
+	OnlyTopCall bool `json:"onlyTopCall,omitempty"`
+
+	// WithLog includes the logs emitted by each call frame.
+	WithLog bool `json:"withLog,omitempty"`
+}
+
+// PrestateTracerConfig configures the prestateTracer.
+type PrestateTracerConfig struct {
+	// DiffMode returns both the pre- and post-execution state.
+	DiffMode bool `json:"diffMode,omitempty"`
+}
+
+// rpcTraceConfig is the RPC format for the tracer config object.
+type rpcTraceConfig struct {
+	Tracer         string `json:"tracer,omitempty"`
+	TracerConfig   any    `json:"tracerConfig,omitempty"`
+	Timeout        string `json:"timeout,omitempty"`
+	StateOverrides any    `json:"stateOverrides,omitempty"`
+	BlockOverrides any    `json:"blockOverrides,omitempty"`
+}
+
+func (o TraceOptions) rpcConfig() rpcTraceConfig {
+	return rpcTraceConfig{
+		Tracer:       string(o.Tracer),
+		TracerConfig: o.TracerConfig,
+		Timeout:      o.Timeout,
+	}
+}
+
+// TraceResult is the output of a debug trace.
+type TraceResult struct {
+	// Tracer is the tracer that produced the result.
+	Tracer Tracer
+
+	// Raw is the undecoded tracer output. Use it for custom tracers.
+	Raw json.RawMessage
+}
+
+// Decode unmarshals the raw tracer output into out.
+func (r *TraceResult) Decode(out any) error {
+	if err := json.Unmarshal(r.Raw, out); err != nil {
+		return fmt.Errorf("failed to decode %q trace: %w", r.Tracer, err)
+	}
+	return nil
+}
+
+// CallFrame decodes a callTracer result.
+func (r *TraceResult) CallFrame() (*CallFrame, error) {
+	if r.Tracer != TracerCall {
+		return nil, fmt.Errorf("trace was produced by %q, not %q", r.Tracer, TracerCall)
+	}
+	var frame rpcCallFrame
+	if err := r.Decode(&frame); err != nil {
+		return nil, err
+	}
+	result := frame.format()
+	return &result, nil
+}
+
+// Prestate decodes a prestateTracer result produced without DiffMode.
+func (r *TraceResult) Prestate() (PrestateResult, error) {
+	if r.Tracer != TracerPrestate {
+		return nil, fmt.Errorf("trace was produced by %q, not %q", r.Tracer, TracerPrestate)
+	}
+	var accounts map[common.Address]rpcPrestateAccount
+	if err := r.Decode(&accounts); err != nil {
+		return nil, err
+	}
+	return formatPrestate(accounts), nil
+}
+
+// PrestateDiff decodes a prestateTracer result produced with DiffMode.
+func (r *TraceResult) PrestateDiff() (*PrestateDiffResult, error) {
+	if r.Tracer != TracerPrestate {
+		return nil, fmt.Errorf("trace was produced by %q, not %q", r.Tracer, TracerPrestate)
+	}
+	var diff struct {
+		Pre  map[common.Address]rpcPrestateAccount `json:"pre"`
+		Post map[common.Address]rpcPrestateAccount `json:"post"`
+	}
+	if err := r.Decode(&diff); err != nil {
+		return nil, err
+	}
+	return &PrestateDiffResult{
+		Pre:  formatPrestate(diff.Pre),
+		Post: formatPrestate(diff.Post),
+	}, nil
+}
+
+// CallFrame is a single frame of a callTracer result.
+type CallFrame struct {
+	// Type is the call type (CALL, STATICCALL, DELEGATECALL, CREATE, ...).
+	Type string
+
+	From    common.Address
+	To      *common.Address
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	Input   []byte
+	Output  []byte
+
+	// Error is set if the frame reverted or failed.
+	Error string
+
+	// RevertReason is the decoded Error(string) reason, if any.
+	RevertReason string
+
+	// Calls are the sub-calls made by this frame.
+	Calls []CallFrame
+
+	// Logs are the logs emitted by this frame (requires CallTracerConfig.WithLog).
+	Logs []CallLog
+}
+
+// CallLog is a log emitted within a call frame.
+type CallLog struct {
+	Address common.Address
+	Topics  []common.Hash
+	Data    []byte
+}
+
+// PrestateAccount is the state of an account as reported by the prestateTracer.
+type PrestateAccount struct {
+	Balance *big.Int
+	Nonce   uint64
+	Code    []byte
+	Storage map[common.Hash]common.Hash
+}
+
+// PrestateResult maps each touched account to its state.
+type PrestateResult = map[common.Address]PrestateAccount
+
+// PrestateDiffResult is the prestateTracer output in DiffMode.
+type PrestateDiffResult struct {
+	Pre  PrestateResult
+	Post PrestateResult
+}
+
+// rpcCallFrame is the RPC format for a callTracer frame.
+type rpcCallFrame struct {
+	Type         string          `json:"type"`
+	From         common.Address  `json:"from"`
+	To           *common.Address `json:"to,omitempty"`
+	Value        *hexutil.Big    `json:"value,omitempty"`
+	Gas          hexutil.Uint64  `json:"gas"`
+	GasUsed      hexutil.Uint64  `json:"gasUsed"`
+	Input        hexutil.Bytes   `json:"input"`
+	Output       hexutil.Bytes   `json:"output,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	RevertReason string          `json:"revertReason,omitempty"`
+	Calls        []rpcCallFrame  `json:"calls,omitempty"`
+	Logs         []rpcCallLog    `json:"logs,omitempty"`
+}
+
+type rpcCallLog struct {
+	Address common.Address `json:"address"`
+	Topics  []common.Hash  `json:"topics"`
+	Data    hexutil.Bytes  `json:"data"`
+}
+
+func (f rpcCallFrame) format() CallFrame {
+	frame := CallFrame{
+		Type:         f.Type,
+		From:         f.From,
+		To:           f.To,
+		Gas:          uint64(f.Gas),
+		GasUsed:      uint64(f.GasUsed),
+		Input:        f.Input,
+		Output:       f.Output,
+		Error:        f.Error,
+		RevertReason: f.RevertReason,
+	}
+	if f.Value != nil {
+		frame.Value = f.Value.ToInt()
+	}
+	if len(f.Calls) > 0 {
+		frame.Calls = make([]CallFrame, len(f.Calls))
+		for i, call := range f.Calls {
+			frame.Calls[i] = call.format()
+		}
+	}
+	if len(f.Logs) > 0 {
+		frame.Logs = make([]CallLog, len(f.Logs))
+		for i, log := range f.Logs {
+			frame.Logs[i] = CallLog{Address: log.Address, Topics: log.Topics, Data: log.Data}
+		}
+	}
+	return frame
+}
+
+type rpcPrestateAccount struct {
+	Balance *hexutil.Big                `json:"balance,omitempty"`
+	Nonce   uint64                      `json:"nonce,omitempty"`
+	Code    hexutil.Bytes               `json:"code,omitempty"`
+	Storage map[common.Hash]common.Hash `json:"storage,omitempty"`
+}
+
+func formatPrestate(accounts map[common.Address]rpcPrestateAccount) PrestateResult {
+	result := make(PrestateResult, len(accounts))
+	for addr, account := range accounts {
+		state := PrestateAccount{
+			Nonce:   account.Nonce,
+			Code:    account.Code,
+			Storage: account.Storage,
+		}
+		if account.Balance != nil {
+			state.Balance = account.Balance.ToInt()
+		}
+		result[addr] = state
+	}
+	return result
+}
@@ -0,0 +1,141 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/types"
+	blockoverride "github.com/ChefBingbong/viem-go/utils/block_override"
+	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
+)
+
+// TraceCallParameters contains the parameters for the TraceCall action.
+//
+// The embedded call fields describe the call to trace. Multicall batching and
+// deployless execution (Batch, Code, Factory, FactoryData) are not supported
+// by debug_traceCall; blob fields are ignored.
+type TraceCallParameters struct {
+	public.CallParameters
+
+	// Tracer selects the tracer. Defaults to the struct logger.
+	Tracer Tracer
+
+	// TracerConfig is passed to the tracer as-is. Use CallTracerConfig or
+	// PrestateTracerConfig for the built-in tracers.
+	TracerConfig any
+
+	// Timeout overrides the node's default tracing timeout (e.g. "10s").
+	Timeout string
+}
+
+// rpcCallRequest is the RPC format for the traced call.
+type rpcCallRequest struct {
+	From                 string           `json:"from,omitempty"`
+	To                   string           `json:"to,omitempty"`
+	Data                 string           `json:"data,omitempty"`
+	Value                string           `json:"value,omitempty"`
+	Gas                  string           `json:"gas,omitempty"`
+	GasPrice             string           `json:"gasPrice,omitempty"`
+	MaxFeePerGas         string           `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas string           `json:"maxPriorityFeePerGas,omitempty"`
+	Nonce                string           `json:"nonce,omitempty"`
+	AccessList           types.AccessList `json:"accessList,omitempty"`
+}
+
+// TraceCall executes a call without creating a transaction and returns its trace.
+//
+// JSON-RPC Method: debug_traceCall
+//
+// Returns a *MethodNotSupportedError if the node does not expose the debug namespace.
+//
+// Example:
+//
+//	trace, err := debug.TraceCall(ctx, client, debug.TraceCallParameters{
+//	    CallParameters: public.CallParameters{To: &contract, Data: calldata},
+//	    Tracer:         debug.TracerCall,
+//	})
+//	frame, err := trace.CallFrame()
+func TraceCall(ctx context.Context, client Client, params TraceCallParameters) (*TraceResult, error) {
+	if params.Batch != nil && *params.Batch {
+		return nil, errors.New("debug_traceCall does not support multicall batching")
+	}
+	if len(params.Code) > 0 || params.Factory != nil || len(params.FactoryData) > 0 {
+		return nil, errors.New("debug_traceCall does not support deployless calls")
+	}
+
+	req := rpcCallRequest{AccessList: params.AccessList}
+	if params.Account != nil {
+		req.From = params.Account.Hex()
+	}
+	if params.To != nil {
+		req.To = params.To.Hex()
+	}
+	if len(params.Data) > 0 {
+		req.Data = hexutil.Encode(params.Data)
+	}
+	if params.Value != nil {
+		req.Value = hexutil.EncodeBig(params.Value)
+	}
+	if params.Gas != nil {
+		req.Gas = hexutil.EncodeUint64(*params.Gas)
+	}
+	if params.GasPrice != nil {
+		req.GasPrice = hexutil.EncodeBig(params.GasPrice)
+	}
+	if params.MaxFeePerGas != nil {
+		req.MaxFeePerGas = hexutil.EncodeBig(params.MaxFeePerGas)
+	}
+	if params.MaxPriorityFeePerGas != nil {
+		req.MaxPriorityFeePerGas = hexutil.EncodeBig(params.MaxPriorityFeePerGas)
+	}
+	if params.Nonce != nil {
+		req.Nonce = hexutil.EncodeUint64(*params.Nonce)
+	}
+
+	config := TraceOptions{
+		Tracer:       params.Tracer,
+		TracerConfig: params.TracerConfig,
+		Timeout:      params.Timeout,
+	}.rpcConfig()
+
+	rpcStateOverride, err := stateoverride.SerializeStateOverride(params.StateOverride)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize state override: %w", err)
+	}
+	if rpcStateOverride != nil {
+		config.StateOverrides = rpcStateOverride
+	}
+	if rpcBlockOverrides := blockoverride.SerializeBlockOverrides(params.BlockOverrides); rpcBlockOverrides != nil {
+		config.BlockOverrides = rpcBlockOverrides
+	}
+
+	blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
+
+	resp, err := client.Request(ctx, "debug_traceCall", req, blockTag, config)
+	if err != nil {
+		return nil, wrapRequestError("debug_traceCall", err)
+	}
+
+	return &TraceResult{Tracer: params.Tracer, Raw: json.RawMessage(resp.Result)}, nil
+}
+
+// resolveBlockTag returns the block parameter for a request, defaulting to
+// the client's block tag and then "latest".
+func resolveBlockTag(client Client, blockNumber *uint64, blockTag types.BlockTag) string {
+	if blockNumber != nil {
+		return hexutil.EncodeUint64(*blockNumber)
+	}
+	if blockTag != "" {
+		return string(blockTag)
+	}
+	if experimentalTag := client.ExperimentalBlockTag(); experimentalTag != "" {
+		return string(experimentalTag)
+	}
+	return string(types.BlockTagLatest)
+}
@@ -0,0 +1,31 @@
+package debug
+
+import (
+	"context"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// TraceTransaction replays a mined transaction and returns its trace.
+//
+// JSON-RPC Method: debug_traceTransaction
+//
+// Returns a *MethodNotSupportedError if the node does not expose the debug namespace.
+//
+// Example:
+//
+//	trace, err := debug.TraceTransaction(ctx, client, txHash, debug.TraceOptions{
+//	    Tracer:       debug.TracerPrestate,
+//	    TracerConfig: debug.PrestateTracerConfig{DiffMode: true},
+//	})
+//	diff, err := trace.PrestateDiff()
+func TraceTransaction(ctx context.Context, client Client, hash common.Hash, opts TraceOptions) (*TraceResult, error) {
+	resp, err := client.Request(ctx, "debug_traceTransaction", hash.Hex(), opts.rpcConfig())
+	if err != nil {
+		return nil, wrapRequestError("debug_traceTransaction", err)
+	}
+
+	return &TraceResult{Tracer: opts.Tracer, Raw: json.RawMessage(resp.Result)}, nil
+}
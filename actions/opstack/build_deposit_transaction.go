@@ -0,0 +1,132 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+)
+
+// BuildDepositTransactionParameters contains the parameters for the
+// BuildDepositTransaction action.
+type BuildDepositTransactionParameters struct {
+	// Account is the L2 sender, used to estimate Gas. For a deposit sent from
+	// an EOA this is the L1 sender's address.
+	Account *common.Address
+
+	// To is the L2 recipient. If nil, the deposit creates a contract with Data
+	// as its init code.
+	To *common.Address
+
+	// Value is the wei sent to To on L2.
+	Value *big.Int
+
+	// Mint is the wei minted on L2, paid as the value of the L1 transaction.
+	// Default: 0.
+	Mint *big.Int
+
+	// Gas is the L2 gas limit. If nil, it is estimated on L2.
+	Gas *uint64
+
+	// Data is the L2 calldata, or init code when To is nil.
+	Data []byte
+
+	// PortalAddress overrides the OptimismPortal contract. Defaults to the
+	// client chain's Portal contract.
+	PortalAddress *common.Address
+}
+
+// BuildDepositTransactionReturnType is an L1 transaction that deposits to
+// an OP Stack chain through the OptimismPortal.
+type BuildDepositTransactionReturnType struct {
+	// To is the OptimismPortal address.
+	To common.Address
+
+	// Value is the wei to send with the L1 transaction (the mint amount).
+	Value *big.Int
+
+	// Data is the encoded depositTransaction call.
+	Data []byte
+
+	// Gas is the L2 gas limit of the deposit.
+	Gas uint64
+}
+
+// BuildDepositTransaction builds the L1 transaction for a deposit to an OP
+// Stack chain. The client is the L2 client; the L2 gas limit is estimated
+// against it, and the portal address is taken from its chain.
+//
+// This is equivalent to viem's `buildDepositTransaction` action.
+//
+// Example:
+//
+//	deposit, err := opstack.BuildDepositTransaction(ctx, l2Client, opstack.BuildDepositTransactionParameters{
+//	    Account: &sender,
+//	    To:      &sender,
+//	    Value:   big.NewInt(1e18),
+//	    Mint:    big.NewInt(1e18),
+//	})
+//
+//	hash, err := wallet.SendTransaction(ctx, l1Client, wallet.SendTransactionParameters{
+//	    To:    deposit.To.Hex(),
+//	    Value: deposit.Value,
+//	    Data:  hexutil.Encode(deposit.Data),
+//	})
+func BuildDepositTransaction(ctx context.Context, client Client, params BuildDepositTransactionParameters) (*BuildDepositTransactionReturnType, error) {
+	portal, err := resolvePortal(params.PortalAddress, client.Chain())
+	if err != nil {
+		return nil, err
+	}
+
+	value := params.Value
+	if value == nil {
+		value = new(big.Int)
+	}
+	mint := params.Mint
+	if mint == nil {
+		mint = new(big.Int)
+	}
+
+	gas := params.Gas
+	if gas == nil {
+		if params.Account == nil {
+			return nil, errors.New("opstack: Account is required to estimate the deposit gas")
+		}
+		estimated, err := public.EstimateGas(ctx, client, public.EstimateGasParameters{
+			Account: params.Account,
+			To:      params.To,
+			Value:   value,
+			Data:    params.Data,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate deposit gas: %w", err)
+		}
+		gas = &estimated
+	}
+
+	isCreation := params.To == nil
+	var to common.Address
+	if !isCreation {
+		to = *params.To
+	}
+	data := params.Data
+	if data == nil {
+		data = []byte{}
+	}
+
+	calldata, err := parsedOptimismPortalABI.EncodeFunctionData("depositTransaction", to, value, *gas, isCreation, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode depositTransaction: %w", err)
+	}
+
+	return &BuildDepositTransactionReturnType{
+		To:    portal,
+		Value: mint,
+		Data:  calldata,
+		Gas:   *gas,
+	}, nil
+}
@@ -0,0 +1,30 @@
+// Package opstack provides standalone action functions for OP Stack chains
+// (OP Mainnet, Base, and other Superchain networks).
+//
+// Transactions on OP Stack chains pay an L1 data fee on top of the usual L2
+// execution fee. The L1 portion is priced by the GasPriceOracle predeploy
+// from the serialized transaction, so eth_estimateGas alone underestimates
+// the total cost.
+//
+// Deposits and withdrawals between L1 and L2 go through the OptimismPortal
+// on L1. The portal and L2OutputOracle addresses are read from the L2
+// chain's Contracts, or can be passed explicitly.
+//
+// This mirrors viem's actions pattern where actions are standalone functions
+// that take a client interface as their first parameter.
+package opstack
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+)
+
+// Client is the interface that opstack actions require from a client.
+// Transactions are filled with public actions before estimation, so any
+// public.Client (including *client.PublicClient) can be used.
+type Client = public.Client
+
+// GasPriceOracleAddress is the address of the GasPriceOracle predeploy,
+// which is the same on every OP Stack chain.
+var GasPriceOracleAddress = common.HexToAddress("0x420000000000000000000000000000000000000F")
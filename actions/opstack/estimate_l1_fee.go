@@ -0,0 +1,29 @@
+package opstack
+
+import (
+	"context"
+	"math/big"
+)
+
+// EstimateL1Fee estimates the L1 data fee, in wei, a transaction will pay on
+// an OP Stack chain.
+//
+// The request is filled with public.PrepareTransactionRequest, serialized
+// unsigned, and priced with GasPriceOracle.getL1Fee.
+//
+// Example:
+//
+//	l1Fee, err := opstack.EstimateL1Fee(ctx, client, opstack.EstimateL1FeeParameters{
+//	    PrepareTransactionRequestParameters: public.PrepareTransactionRequestParameters{
+//	        Account: &sender,
+//	        To:      &recipient,
+//	        Value:   big.NewInt(1),
+//	    },
+//	})
+func EstimateL1Fee(ctx context.Context, client Client, params EstimateL1FeeParameters) (*big.Int, error) {
+	_, serialized, err := prepareAndSerialize(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	return readOracle(ctx, client, resolveGasPriceOracle(client, &params), "getL1Fee", serialized)
+}
@@ -0,0 +1,29 @@
+package opstack
+
+import (
+	"context"
+	"math/big"
+)
+
+// EstimateL1Gas estimates the amount of L1 data gas a transaction will be
+// charged for on an OP Stack chain.
+//
+// The request is filled with public.PrepareTransactionRequest, serialized
+// unsigned, and priced with GasPriceOracle.getL1GasUsed.
+//
+// Example:
+//
+//	l1Gas, err := opstack.EstimateL1Gas(ctx, client, opstack.EstimateL1GasParameters{
+//	    PrepareTransactionRequestParameters: public.PrepareTransactionRequestParameters{
+//	        Account: &sender,
+//	        To:      &recipient,
+//	        Value:   big.NewInt(1),
+//	    },
+//	})
+func EstimateL1Gas(ctx context.Context, client Client, params EstimateL1GasParameters) (*big.Int, error) {
+	_, serialized, err := prepareAndSerialize(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+	return readOracle(ctx, client, resolveGasPriceOracle(client, &params), "getL1GasUsed", serialized)
+}
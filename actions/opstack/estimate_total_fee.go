@@ -0,0 +1,42 @@
+package opstack
+
+import (
+	"context"
+	"math/big"
+)
+
+// EstimateTotalFee estimates the total fee, in wei, a transaction will pay on
+// an OP Stack chain: the L1 data fee plus the L2 execution fee.
+//
+// The L2 fee is gas multiplied by gasPrice for legacy transactions, or by
+// maxFeePerGas otherwise, so it is an upper bound for EIP-1559 transactions.
+//
+// Example:
+//
+//	total, err := opstack.EstimateTotalFee(ctx, client, opstack.EstimateTotalFeeParameters{
+//	    PrepareTransactionRequestParameters: public.PrepareTransactionRequestParameters{
+//	        Account: &sender,
+//	        To:      &recipient,
+//	        Value:   big.NewInt(1),
+//	    },
+//	})
+func EstimateTotalFee(ctx context.Context, client Client, params EstimateTotalFeeParameters) (*big.Int, error) {
+	prepared, serialized, err := prepareAndSerialize(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	l1Fee, err := readOracle(ctx, client, resolveGasPriceOracle(client, &params), "getL1Fee", serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := prepared.GasPrice
+	if gasPrice == nil {
+		gasPrice = prepared.MaxFeePerGas
+	}
+	l2Fee := new(big.Int).SetUint64(*prepared.Gas)
+	l2Fee.Mul(l2Fee, gasPrice)
+
+	return l2Fee.Add(l2Fee, l1Fee), nil
+}
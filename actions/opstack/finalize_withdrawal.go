@@ -0,0 +1,54 @@
+package opstack
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// FinalizeWithdrawalParameters contains the parameters for the
+// FinalizeWithdrawal action.
+type FinalizeWithdrawalParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Withdrawal is the proven withdrawal to finalize.
+	Withdrawal Withdrawal
+
+	// TargetChain is the L2 chain. Its Portal contract is used unless
+	// PortalAddress is set.
+	TargetChain *chain.Chain
+
+	// PortalAddress overrides the OptimismPortal contract.
+	PortalAddress *common.Address
+}
+
+// FinalizeWithdrawal executes a proven withdrawal on L1 once its challenge
+// period has passed, and returns the L1 transaction hash. Use
+// GetWithdrawalStatus to check that it is ready to finalize.
+//
+// This is equivalent to viem's `finalizeWithdrawal` action.
+//
+// Example:
+//
+//	hash, err := opstack.FinalizeWithdrawal(ctx, l1WalletClient, opstack.FinalizeWithdrawalParameters{
+//	    Withdrawal:  withdrawals[0],
+//	    TargetChain: &definitions.Optimism,
+//	})
+func FinalizeWithdrawal(ctx context.Context, client wallet.Client, params FinalizeWithdrawalParameters) (string, error) {
+	portal, err := resolvePortal(params.PortalAddress, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      params.Account,
+		Address:      portal.Hex(),
+		ABI:          parsedOptimismPortalABI,
+		FunctionName: "finalizeWithdrawalTransaction",
+		Args:         []any{params.Withdrawal.transaction()},
+	})
+}
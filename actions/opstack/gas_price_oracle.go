@@ -0,0 +1,91 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// GasPriceOracleABI is the subset of the GasPriceOracle predeploy ABI used
+// to price the L1 data of a transaction.
+var GasPriceOracleABI = `[{"inputs":[{"name":"_data","type":"bytes"}],"name":"getL1Fee","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"_data","type":"bytes"}],"name":"getL1GasUsed","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+// parsedGasPriceOracleABI is GasPriceOracleABI parsed once for the actions below.
+var parsedGasPriceOracleABI = abi.MustParse([]byte(GasPriceOracleABI))
+
+// EstimateL1GasParameters contains the parameters for the EstimateL1Gas action.
+//
+// The embedded request is filled with public.PrepareTransactionRequest, so any
+// field left nil (nonce, fees, gas) is fetched from the node before the
+// transaction is serialized for the oracle.
+type EstimateL1GasParameters struct {
+	public.PrepareTransactionRequestParameters
+
+	// GasPriceOracleAddress overrides the GasPriceOracle contract. Defaults to
+	// the client chain's GasPriceOracle contract, then GasPriceOracleAddress.
+	GasPriceOracleAddress *common.Address
+}
+
+// EstimateL1FeeParameters contains the parameters for the EstimateL1Fee action.
+type EstimateL1FeeParameters = EstimateL1GasParameters
+
+// EstimateTotalFeeParameters contains the parameters for the EstimateTotalFee action.
+type EstimateTotalFeeParameters = EstimateL1GasParameters
+
+// resolveGasPriceOracle returns the oracle address to query for params.
+func resolveGasPriceOracle(client Client, params *EstimateL1GasParameters) common.Address {
+	if params.GasPriceOracleAddress != nil {
+		return *params.GasPriceOracleAddress
+	}
+	if ch := client.Chain(); ch != nil && ch.Contracts != nil && ch.Contracts.GasPriceOracle != nil {
+		return ch.Contracts.GasPriceOracle.Address
+	}
+	return GasPriceOracleAddress
+}
+
+// prepareAndSerialize fills the request and returns it along with its
+// unsigned serialization, which is what the oracle prices.
+func prepareAndSerialize(ctx context.Context, client Client, params EstimateL1GasParameters) (*public.PrepareTransactionRequestParameters, []byte, error) {
+	prepared, err := public.PrepareTransactionRequest(ctx, client, params.PrepareTransactionRequestParameters)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serialized, err := transaction.SerializeTransaction(public.PreparedToTransaction(prepared), nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to serialize transaction: %w", err)
+	}
+	return prepared, common.FromHex(serialized), nil
+}
+
+// readOracle calls a (bytes) -> uint256 view on the GasPriceOracle.
+func readOracle(ctx context.Context, client Client, oracle common.Address, functionName string, serialized []byte) (*big.Int, error) {
+	calldata, err := parsedGasPriceOracleABI.EncodeFunctionData(functionName, serialized)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", functionName, err)
+	}
+
+	result, err := public.Call(ctx, client, public.CallParameters{
+		To:   &oracle,
+		Data: calldata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GasPriceOracle.%s failed: %w", functionName, err)
+	}
+
+	decoded, err := parsedGasPriceOracleABI.DecodeFunctionResult(functionName, result.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s result: %w", functionName, err)
+	}
+	value, ok := decoded[0].(*big.Int)
+	if !ok {
+		return nil, fmt.Errorf("unexpected %s result type %T", functionName, decoded[0])
+	}
+	return value, nil
+}
@@ -0,0 +1,126 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// WithdrawalStatus is the stage of a withdrawal on its way from L2 to L1.
+type WithdrawalStatus string
+
+const (
+	// WithdrawalStatusUnproven means no L2 output covering the withdrawal's
+	// block has been proposed yet, so it cannot be proven.
+	WithdrawalStatusUnproven WithdrawalStatus = "unproven"
+	// WithdrawalStatusReadyToProve means the withdrawal can be proven with
+	// ProveWithdrawal.
+	WithdrawalStatusReadyToProve WithdrawalStatus = "ready-to-prove"
+	// WithdrawalStatusInChallengePeriod means the withdrawal is proven and
+	// waiting out the finalization period.
+	WithdrawalStatusInChallengePeriod WithdrawalStatus = "in-challenge-period"
+	// WithdrawalStatusReadyToFinalize means the withdrawal can be finalized
+	// with FinalizeWithdrawal.
+	WithdrawalStatusReadyToFinalize WithdrawalStatus = "ready-to-finalize"
+	// WithdrawalStatusFinalized means the withdrawal has been executed on L1.
+	WithdrawalStatusFinalized WithdrawalStatus = "finalized"
+)
+
+// GetWithdrawalStatusParameters contains the parameters for the
+// GetWithdrawalStatus action.
+type GetWithdrawalStatusParameters struct {
+	// Withdrawal is the withdrawal to check, e.g. from GetWithdrawals.
+	Withdrawal Withdrawal
+
+	// L2BlockNumber is the L2 block the withdrawal was initiated in.
+	L2BlockNumber uint64
+
+	// TargetChain is the L2 chain. Its Portal and L2OutputOracle contracts
+	// are used unless overridden below.
+	TargetChain *chain.Chain
+
+	// PortalAddress overrides the OptimismPortal contract.
+	PortalAddress *common.Address
+
+	// L2OutputOracleAddress overrides the L2OutputOracle contract.
+	L2OutputOracleAddress *common.Address
+}
+
+// GetWithdrawalStatus returns the status of a withdrawal. The client is the
+// L1 client.
+//
+// The challenge period is measured against the timestamp of the latest L1
+// block. Portals that prove against the L2OutputOracle are supported.
+//
+// This is equivalent to viem's `getWithdrawalStatus` action.
+//
+// Example:
+//
+//	status, err := opstack.GetWithdrawalStatus(ctx, l1Client, opstack.GetWithdrawalStatusParameters{
+//	    Withdrawal:    withdrawals[0],
+//	    L2BlockNumber: receipt.BlockNumber,
+//	    TargetChain:   &definitions.Optimism,
+//	})
+func GetWithdrawalStatus(ctx context.Context, client Client, params GetWithdrawalStatusParameters) (WithdrawalStatus, error) {
+	portal, err := resolvePortal(params.PortalAddress, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	oracle, err := resolveL2OutputOracle(params.L2OutputOracleAddress, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	hash, err := withdrawalHash(&params.Withdrawal)
+	if err != nil {
+		return "", err
+	}
+
+	finalized, err := readUint256(ctx, client, parsedOptimismPortalABI, portal, "finalizedWithdrawals", hash)
+	if err != nil {
+		return "", err
+	}
+	if finalized.Sign() != 0 {
+		return WithdrawalStatusFinalized, nil
+	}
+
+	proven, err := readContract(ctx, client, parsedOptimismPortalABI, portal, "provenWithdrawals", hash)
+	if err != nil {
+		return "", err
+	}
+	words, err := staticWords("provenWithdrawals", proven, 3)
+	if err != nil {
+		return "", err
+	}
+	provenAt := new(big.Int).SetBytes(words[1])
+
+	if provenAt.Sign() == 0 {
+		latest, err := readUint256(ctx, client, parsedL2OutputOracleABI, oracle, "latestBlockNumber")
+		if err != nil {
+			return "", err
+		}
+		if latest.Cmp(new(big.Int).SetUint64(params.L2BlockNumber)) < 0 {
+			return WithdrawalStatusUnproven, nil
+		}
+		return WithdrawalStatusReadyToProve, nil
+	}
+
+	period, err := readUint256(ctx, client, parsedL2OutputOracleABI, oracle, "FINALIZATION_PERIOD_SECONDS")
+	if err != nil {
+		return "", err
+	}
+	block, err := public.GetBlock(ctx, client, public.GetBlockParameters{BlockTag: public.BlockTagLatest})
+	if err != nil {
+		return "", fmt.Errorf("failed to get latest L1 block: %w", err)
+	}
+
+	finalizableAt := new(big.Int).Add(provenAt, period)
+	if new(big.Int).SetUint64(block.Timestamp).Cmp(finalizableAt) <= 0 {
+		return WithdrawalStatusInChallengePeriod, nil
+	}
+	return WithdrawalStatusReadyToFinalize, nil
+}
@@ -0,0 +1,112 @@
+package opstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// L2ToL1MessagePasserAddress is the address of the L2ToL1MessagePasser
+// predeploy, which is the same on every OP Stack chain.
+var L2ToL1MessagePasserAddress = common.HexToAddress("0x4200000000000000000000000000000000000016")
+
+// OptimismPortalABI is the subset of the OptimismPortal ABI used to deposit
+// to and withdraw from an OP Stack chain.
+var OptimismPortalABI = `[{"inputs":[{"name":"_to","type":"address"},{"name":"_value","type":"uint256"},{"name":"_gasLimit","type":"uint64"},{"name":"_isCreation","type":"bool"},{"name":"_data","type":"bytes"}],"name":"depositTransaction","outputs":[],"stateMutability":"payable","type":"function"},{"inputs":[{"components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}],"name":"_tx","type":"tuple"},{"name":"_l2OutputIndex","type":"uint256"},{"components":[{"name":"version","type":"bytes32"},{"name":"stateRoot","type":"bytes32"},{"name":"messagePasserStorageRoot","type":"bytes32"},{"name":"latestBlockhash","type":"bytes32"}],"name":"_outputRootProof","type":"tuple"},{"name":"_withdrawalProof","type":"bytes[]"}],"name":"proveWithdrawalTransaction","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"components":[{"name":"nonce","type":"uint256"},{"name":"sender","type":"address"},{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"gasLimit","type":"uint256"},{"name":"data","type":"bytes"}],"name":"_tx","type":"tuple"}],"name":"finalizeWithdrawalTransaction","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"name":"","type":"bytes32"}],"name":"finalizedWithdrawals","outputs":[{"name":"","type":"bool"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"","type":"bytes32"}],"name":"provenWithdrawals","outputs":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2OutputIndex","type":"uint128"}],"stateMutability":"view","type":"function"}]`
+
+// L2OutputOracleABI is the subset of the L2OutputOracle ABI used to prove
+// withdrawals.
+var L2OutputOracleABI = `[{"inputs":[],"name":"latestBlockNumber","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"_l2BlockNumber","type":"uint256"}],"name":"getL2OutputIndexAfter","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"_l2OutputIndex","type":"uint256"}],"name":"getL2Output","outputs":[{"components":[{"name":"outputRoot","type":"bytes32"},{"name":"timestamp","type":"uint128"},{"name":"l2BlockNumber","type":"uint128"}],"name":"","type":"tuple"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"FINALIZATION_PERIOD_SECONDS","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"}]`
+
+// L2ToL1MessagePasserABI is the subset of the L2ToL1MessagePasser ABI used to
+// read withdrawals from L2 receipts.
+var L2ToL1MessagePasserABI = `[{"anonymous":false,"inputs":[{"indexed":true,"name":"nonce","type":"uint256"},{"indexed":true,"name":"sender","type":"address"},{"indexed":true,"name":"target","type":"address"},{"indexed":false,"name":"value","type":"uint256"},{"indexed":false,"name":"gasLimit","type":"uint256"},{"indexed":false,"name":"data","type":"bytes"},{"indexed":false,"name":"withdrawalHash","type":"bytes32"}],"name":"MessagePassed","type":"event"}]`
+
+var (
+	parsedOptimismPortalABI      = abi.MustParse([]byte(OptimismPortalABI))
+	parsedL2OutputOracleABI      = abi.MustParse([]byte(L2OutputOracleABI))
+	parsedL2ToL1MessagePasserABI = abi.MustParse([]byte(L2ToL1MessagePasserABI))
+)
+
+var (
+	// ErrPortalNotConfigured is returned when no OptimismPortal address is
+	// given and the L2 chain does not define one.
+	ErrPortalNotConfigured = errors.New("opstack: no OptimismPortal address; set PortalAddress or the chain's Portal contract")
+
+	// ErrL2OutputOracleNotConfigured is returned when no L2OutputOracle
+	// address is given and the L2 chain does not define one.
+	ErrL2OutputOracleNotConfigured = errors.New("opstack: no L2OutputOracle address; set L2OutputOracleAddress or the chain's L2OutputOracle contract")
+)
+
+// resolvePortal returns override, or the Portal contract of the L2 chain.
+func resolvePortal(override *common.Address, l2Chain *chain.Chain) (common.Address, error) {
+	if override != nil {
+		return *override, nil
+	}
+	if l2Chain != nil && l2Chain.Contracts != nil && l2Chain.Contracts.Portal != nil {
+		return l2Chain.Contracts.Portal.Address, nil
+	}
+	return common.Address{}, ErrPortalNotConfigured
+}
+
+// resolveL2OutputOracle returns override, or the L2OutputOracle contract of
+// the L2 chain.
+func resolveL2OutputOracle(override *common.Address, l2Chain *chain.Chain) (common.Address, error) {
+	if override != nil {
+		return *override, nil
+	}
+	if l2Chain != nil && l2Chain.Contracts != nil && l2Chain.Contracts.L2OutputOracle != nil {
+		return l2Chain.Contracts.L2OutputOracle.Address, nil
+	}
+	return common.Address{}, ErrL2OutputOracleNotConfigured
+}
+
+// readContract calls a view function and returns its raw result.
+func readContract(ctx context.Context, client Client, contractABI *abi.ABI, address common.Address, functionName string, args ...any) ([]byte, error) {
+	calldata, err := contractABI.EncodeFunctionData(functionName, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", functionName, err)
+	}
+
+	result, err := public.Call(ctx, client, public.CallParameters{
+		To:   &address,
+		Data: calldata,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", functionName, err)
+	}
+	return result.Data, nil
+}
+
+// readUint256 calls a view function that returns a single uint256.
+func readUint256(ctx context.Context, client Client, contractABI *abi.ABI, address common.Address, functionName string, args ...any) (*big.Int, error) {
+	data, err := readContract(ctx, client, contractABI, address, functionName, args...)
+	if err != nil {
+		return nil, err
+	}
+	words, err := staticWords(functionName, data, 1)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(words[0]), nil
+}
+
+// staticWords splits a result made of n static ABI words, such as a tuple of
+// bytes32 and uint values.
+func staticWords(functionName string, data []byte, n int) ([][]byte, error) {
+	if len(data) < 32*n {
+		return nil, fmt.Errorf("failed to decode %s result: expected %d bytes, got %d", functionName, 32*n, len(data))
+	}
+	words := make([][]byte, n)
+	for i := range words {
+		words[i] = data[32*i : 32*(i+1)]
+	}
+	return words, nil
+}
@@ -0,0 +1,133 @@
+package opstack
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// ProveWithdrawalParameters contains the parameters for the ProveWithdrawal
+// action.
+type ProveWithdrawalParameters struct {
+	// Account is the L1 account to send from. If nil, uses the client's account.
+	Account wallet.Account
+
+	// Withdrawal is the withdrawal to prove, e.g. from GetWithdrawals.
+	Withdrawal Withdrawal
+
+	// L2BlockNumber is the L2 block the withdrawal was initiated in.
+	L2BlockNumber uint64
+
+	// TargetChain is the L2 chain. Its Portal and L2OutputOracle contracts
+	// are used unless overridden below.
+	TargetChain *chain.Chain
+
+	// PortalAddress overrides the OptimismPortal contract.
+	PortalAddress *common.Address
+
+	// L2OutputOracleAddress overrides the L2OutputOracle contract.
+	L2OutputOracleAddress *common.Address
+}
+
+// outputRootProof is the Types.OutputRootProof tuple taken by the
+// OptimismPortal.
+type outputRootProof struct {
+	Version                  [32]byte
+	StateRoot                [32]byte
+	MessagePasserStorageRoot [32]byte
+	LatestBlockhash          [32]byte
+}
+
+// hash returns the output root committed to by the proof.
+func (p outputRootProof) hash() common.Hash {
+	return crypto.Keccak256Hash(p.Version[:], p.StateRoot[:], p.MessagePasserStorageRoot[:], p.LatestBlockhash[:])
+}
+
+// ProveWithdrawal proves a withdrawal on L1 against the first L2 output that
+// covers its block, and returns the L1 transaction hash.
+//
+// The output is read from the L2OutputOracle through client (the L1 wallet
+// client). The storage proof of the withdrawal in the L2ToL1MessagePasser is
+// fetched at the output's L2 block through l2Client with eth_getProof.
+//
+// This is equivalent to viem's `buildProveWithdrawal` followed by
+// `proveWithdrawal`.
+//
+// Example:
+//
+//	hash, err := opstack.ProveWithdrawal(ctx, l1WalletClient, l2Client, opstack.ProveWithdrawalParameters{
+//	    Withdrawal:    withdrawals[0],
+//	    L2BlockNumber: receipt.BlockNumber,
+//	    TargetChain:   &definitions.Optimism,
+//	})
+func ProveWithdrawal(ctx context.Context, client wallet.Client, l2Client Client, params ProveWithdrawalParameters) (string, error) {
+	portal, err := resolvePortal(params.PortalAddress, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	oracle, err := resolveL2OutputOracle(params.L2OutputOracleAddress, params.TargetChain)
+	if err != nil {
+		return "", err
+	}
+	hash, err := withdrawalHash(&params.Withdrawal)
+	if err != nil {
+		return "", err
+	}
+
+	// Find the first output at or after the withdrawal's block
+	outputIndex, err := readUint256(ctx, client, parsedL2OutputOracleABI, oracle, "getL2OutputIndexAfter", new(big.Int).SetUint64(params.L2BlockNumber))
+	if err != nil {
+		return "", err
+	}
+	output, err := readContract(ctx, client, parsedL2OutputOracleABI, oracle, "getL2Output", outputIndex)
+	if err != nil {
+		return "", err
+	}
+	words, err := staticWords("getL2Output", output, 3)
+	if err != nil {
+		return "", err
+	}
+	outputRoot := common.BytesToHash(words[0])
+	outputBlock := new(big.Int).SetBytes(words[2]).Uint64()
+
+	// Prove the withdrawal's sentMessages slot at the output's block
+	block, err := public.GetBlock(ctx, l2Client, public.GetBlockParameters{BlockNumber: &outputBlock})
+	if err != nil {
+		return "", fmt.Errorf("failed to get L2 block %d: %w", outputBlock, err)
+	}
+	proof, err := public.GetProof(ctx, l2Client, public.GetProofParameters{
+		Address:     L2ToL1MessagePasserAddress,
+		StorageKeys: []common.Hash{withdrawalStorageSlot(hash)},
+		BlockNumber: &outputBlock,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(proof.StorageProof) != 1 {
+		return "", fmt.Errorf("expected 1 storage proof, got %d", len(proof.StorageProof))
+	}
+
+	rootProof := outputRootProof{
+		StateRoot:                block.StateRoot,
+		MessagePasserStorageRoot: proof.StorageHash,
+		LatestBlockhash:          block.Hash,
+	}
+	if rootProof.hash() != outputRoot {
+		return "", fmt.Errorf("L2 block %d does not match output root %s; is l2Client on the target chain?", outputBlock, outputRoot.Hex())
+	}
+
+	return wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      params.Account,
+		Address:      portal.Hex(),
+		ABI:          parsedOptimismPortalABI,
+		FunctionName: "proveWithdrawalTransaction",
+		Args:         []any{params.Withdrawal.transaction(), outputIndex, rootProof, proof.StorageProof[0].Proof},
+	})
+}
@@ -0,0 +1,661 @@
+package opstack_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/opstack"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// mockClient implements the opstack.Client interface for testing.
+type mockClient struct {
+	transport transport.Transport
+	chain     *chain.Chain
+}
+
+func (c *mockClient) Request(ctx context.Context, method string, params ...any) (*transport.RPCResponse, error) {
+	return c.transport.Request(ctx, transport.RPCRequest{Method: method, Params: params})
+}
+
+func (c *mockClient) Chain() *chain.Chain                  { return c.chain }
+func (c *mockClient) CacheTime() time.Duration             { return 4 * time.Second }
+func (c *mockClient) ExperimentalBlockTag() types.BlockTag { return "" }
+func (c *mockClient) Batch() *types.BatchOptions           { return nil }
+func (c *mockClient) CCIPRead() *types.CCIPReadOptions     { return nil }
+func (c *mockClient) UID() string                          { return "test-mock-client" }
+
+// createTestServer creates a test HTTP server that responds to JSON-RPC requests.
+func createTestServer(t *testing.T, handler func(method string, params []any) any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": handler(req.Method, req.Params)}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func createMockClient(t *testing.T, serverURL string, ch *chain.Chain) *mockClient {
+	tr, err := transport.HTTP(serverURL)(transport.TransportParams{})
+	require.NoError(t, err)
+	return &mockClient{transport: tr, chain: ch}
+}
+
+var oracleABI = abi.MustParse([]byte(opstack.GasPriceOracleABI))
+
+// oracleCall is an eth_call made against the mock GasPriceOracle.
+type oracleCall struct {
+	to           common.Address
+	functionName string
+	serialized   []byte
+}
+
+// mockOracle answers getL1Fee and getL1GasUsed eth_calls with fixed values
+// and records each call. A non-nil estimateGas also answers eth_estimateGas.
+func mockOracle(t *testing.T, l1Fee, l1GasUsed *big.Int, estimateGas *uint64, calls *[]oracleCall) func(string, []any) any {
+	return func(method string, params []any) any {
+		switch method {
+		case "eth_estimateGas":
+			require.NotNil(t, estimateGas, "unexpected eth_estimateGas")
+			return hexutil.EncodeUint64(*estimateGas)
+		case "eth_call":
+			req := params[0].(map[string]any)
+			data := common.FromHex(req["data"].(string))
+			decoded, err := oracleABI.DecodeFunctionData(data)
+			require.NoError(t, err)
+			*calls = append(*calls, oracleCall{
+				to:           common.HexToAddress(req["to"].(string)),
+				functionName: decoded.FunctionName,
+				serialized:   decoded.Args[0].([]byte),
+			})
+
+			value := l1Fee
+			if decoded.FunctionName == "getL1GasUsed" {
+				value = l1GasUsed
+			}
+			return hexutil.Encode(common.LeftPadBytes(value.Bytes(), 32))
+		}
+		t.Fatalf("unexpected method %s", method)
+		return nil
+	}
+}
+
+func uint64Ptr(v uint64) *uint64 { return &v }
+func int64Ptr(v int64) *int64    { return &v }
+
+// filledRequest returns a fully populated EIP-1559 request, so no RPC calls
+// are needed to prepare it.
+func filledRequest() public.PrepareTransactionRequestParameters {
+	to := common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+	return public.PrepareTransactionRequestParameters{
+		To:                   &to,
+		Value:                big.NewInt(1_000_000_000_000_000),
+		Data:                 common.FromHex("0xdeadbeef"),
+		Nonce:                uint64Ptr(7),
+		Gas:                  uint64Ptr(21_000),
+		MaxFeePerGas:         big.NewInt(2_000_000_000),
+		MaxPriorityFeePerGas: big.NewInt(1_000_000),
+		ChainID:              int64Ptr(10),
+	}
+}
+
+func TestEstimateL1Gas(t *testing.T) {
+	var calls []oracleCall
+	server := createTestServer(t, mockOracle(t, big.NewInt(1), big.NewInt(1_600), nil, &calls))
+	defer server.Close()
+	client := createMockClient(t, server.URL, nil)
+
+	l1Gas, err := opstack.EstimateL1Gas(context.Background(), client, opstack.EstimateL1GasParameters{
+		PrepareTransactionRequestParameters: filledRequest(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1_600), l1Gas)
+
+	expected, err := transaction.SerializeTransaction(&transaction.Transaction{
+		Type:                 transaction.TransactionTypeEIP1559,
+		ChainId:              10,
+		Nonce:                7,
+		To:                   "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		Value:                big.NewInt(1_000_000_000_000_000),
+		Data:                 "0xdeadbeef",
+		Gas:                  big.NewInt(21_000),
+		MaxFeePerGas:         big.NewInt(2_000_000_000),
+		MaxPriorityFeePerGas: big.NewInt(1_000_000),
+	}, nil)
+	require.NoError(t, err)
+
+	require.Len(t, calls, 1)
+	assert.Equal(t, opstack.GasPriceOracleAddress, calls[0].to)
+	assert.Equal(t, "getL1GasUsed", calls[0].functionName)
+	assert.Equal(t, common.FromHex(expected), calls[0].serialized)
+}
+
+func TestEstimateL1Fee(t *testing.T) {
+	var calls []oracleCall
+	server := createTestServer(t, mockOracle(t, big.NewInt(42_000_000_000), big.NewInt(1), nil, &calls))
+	defer server.Close()
+	client := createMockClient(t, server.URL, nil)
+
+	l1Fee, err := opstack.EstimateL1Fee(context.Background(), client, opstack.EstimateL1FeeParameters{
+		PrepareTransactionRequestParameters: filledRequest(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(42_000_000_000), l1Fee)
+
+	require.Len(t, calls, 1)
+	assert.Equal(t, "getL1Fee", calls[0].functionName)
+}
+
+func TestEstimateL1Fee_OracleAddress(t *testing.T) {
+	chainOracle := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	override := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	ch := &chain.Chain{ID: 10, Contracts: &chain.ChainContracts{
+		GasPriceOracle: &chain.ChainContract{Address: chainOracle},
+	}}
+
+	var calls []oracleCall
+	server := createTestServer(t, mockOracle(t, big.NewInt(1), big.NewInt(1), nil, &calls))
+	defer server.Close()
+	client := createMockClient(t, server.URL, ch)
+
+	_, err := opstack.EstimateL1Fee(context.Background(), client, opstack.EstimateL1FeeParameters{
+		PrepareTransactionRequestParameters: filledRequest(),
+	})
+	require.NoError(t, err)
+
+	_, err = opstack.EstimateL1Fee(context.Background(), client, opstack.EstimateL1FeeParameters{
+		PrepareTransactionRequestParameters: filledRequest(),
+		GasPriceOracleAddress:               &override,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, calls, 2)
+	assert.Equal(t, chainOracle, calls[0].to)
+	assert.Equal(t, override, calls[1].to)
+}
+
+func TestEstimateTotalFee(t *testing.T) {
+	var calls []oracleCall
+	server := createTestServer(t, mockOracle(t, big.NewInt(50_000_000_000), big.NewInt(1), uint64Ptr(30_000), &calls))
+	defer server.Close()
+	client := createMockClient(t, server.URL, nil)
+
+	req := filledRequest()
+	req.Gas = nil
+
+	total, err := opstack.EstimateTotalFee(context.Background(), client, opstack.EstimateTotalFeeParameters{
+		PrepareTransactionRequestParameters: req,
+	})
+	require.NoError(t, err)
+
+	// 30,000 gas * 2 gwei maxFeePerGas + 50 gwei L1 fee.
+	assert.Equal(t, big.NewInt(60_050_000_000_000), total)
+	require.Len(t, calls, 1)
+	assert.Equal(t, "getL1Fee", calls[0].functionName)
+}
+
+func TestEstimateTotalFee_Legacy(t *testing.T) {
+	var calls []oracleCall
+	server := createTestServer(t, mockOracle(t, big.NewInt(7), big.NewInt(1), nil, &calls))
+	defer server.Close()
+	client := createMockClient(t, server.URL, nil)
+
+	req := filledRequest()
+	req.MaxFeePerGas = nil
+	req.MaxPriorityFeePerGas = nil
+	req.GasPrice = big.NewInt(3)
+
+	total, err := opstack.EstimateTotalFee(context.Background(), client, opstack.EstimateTotalFeeParameters{
+		PrepareTransactionRequestParameters: req,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(21_000*3+7), total)
+
+	require.Len(t, calls, 1)
+	assert.GreaterOrEqual(t, calls[0].serialized[0], byte(0xc0), "legacy transactions serialize as a bare RLP list")
+}
+
+// ============================================================================
+// Bridge Tests
+// ============================================================================
+
+var (
+	portalABI         = abi.MustParse([]byte(opstack.OptimismPortalABI))
+	outputOracleABI   = abi.MustParse([]byte(opstack.L2OutputOracleABI))
+	messagePasserABI  = abi.MustParse([]byte(opstack.L2ToL1MessagePasserABI))
+	testPortal        = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	testOutputOracle  = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	testL1Sender      = common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	testL2Chain       = &chain.Chain{ID: 10, Contracts: &chain.ChainContracts{Portal: &chain.ChainContract{Address: testPortal}, L2OutputOracle: &chain.ChainContract{Address: testOutputOracle}}}
+	testWithdrawalL2  = uint64(1_000)
+	testProvenAt      = uint64(1_700_000_000)
+	testFinalizeDelay = uint64(7 * 24 * 60 * 60)
+)
+
+// walletMockClient adds the wallet.Client surface to mockClient.
+type walletMockClient struct {
+	*mockClient
+	account wallet.Account
+}
+
+func (c *walletMockClient) DataSuffix() []byte             { return nil }
+func (c *walletMockClient) PollingInterval() time.Duration { return time.Second }
+func (c *walletMockClient) Account() wallet.Account        { return c.account }
+
+type testAccount struct{ address common.Address }
+
+func (a testAccount) Address() common.Address { return a.address }
+
+func testWithdrawal(t *testing.T) opstack.Withdrawal {
+	w := opstack.Withdrawal{
+		Nonce:    new(big.Int).Lsh(big.NewInt(1), 240),
+		Sender:   common.HexToAddress("0x4200000000000000000000000000000000000010"),
+		Target:   common.HexToAddress("0x99c9fc46f92e8a1c0dec1b1747d010903e884be1"),
+		Value:    big.NewInt(1e18),
+		GasLimit: big.NewInt(200_000),
+		Data:     common.FromHex("0xdeadbeef"),
+	}
+	hash, err := opstack.HashWithdrawal(w)
+	require.NoError(t, err)
+	w.WithdrawalHash = hash
+	return w
+}
+
+func word(v uint64) []byte {
+	return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)
+}
+
+// withdrawalState answers the portal and L2OutputOracle reads made by
+// GetWithdrawalStatus.
+type withdrawalState struct {
+	finalized       bool
+	provenAt        uint64
+	latestL2Output  uint64
+	l1Timestamp     uint64
+	finalizedCalled []common.Hash
+}
+
+func (s *withdrawalState) handler(t *testing.T) func(string, []any) any {
+	return func(method string, params []any) any {
+		switch method {
+		case "eth_getBlockByNumber":
+			return map[string]any{
+				"number":       "0x100",
+				"hash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"parentHash":   "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"timestamp":    hexutil.EncodeUint64(s.l1Timestamp),
+				"transactions": []string{},
+			}
+		case "eth_call":
+			req := params[0].(map[string]any)
+			to := common.HexToAddress(req["to"].(string))
+			data := common.FromHex(req["data"].(string))
+
+			contractABI := portalABI
+			if to == testOutputOracle {
+				contractABI = outputOracleABI
+			} else {
+				require.Equal(t, testPortal, to)
+			}
+			decoded, err := contractABI.DecodeFunctionData(data)
+			require.NoError(t, err)
+
+			switch decoded.FunctionName {
+			case "finalizedWithdrawals":
+				s.finalizedCalled = append(s.finalizedCalled, decoded.Args[0].([32]byte))
+				if s.finalized {
+					return hexutil.Encode(word(1))
+				}
+				return hexutil.Encode(word(0))
+			case "provenWithdrawals":
+				root := word(0)
+				if s.provenAt != 0 {
+					root = common.FromHex("0xaa" + strings.Repeat("00", 31))
+				}
+				return hexutil.Encode(append(append(root, word(s.provenAt)...), word(3)...))
+			case "latestBlockNumber":
+				return hexutil.Encode(word(s.latestL2Output))
+			case "FINALIZATION_PERIOD_SECONDS":
+				return hexutil.Encode(word(testFinalizeDelay))
+			}
+			t.Fatalf("unexpected call %s", decoded.FunctionName)
+		}
+		t.Fatalf("unexpected method %s", method)
+		return nil
+	}
+}
+
+func TestGetWithdrawalStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		state withdrawalState
+		want  opstack.WithdrawalStatus
+	}{
+		{
+			name:  "no output covers the block yet",
+			state: withdrawalState{latestL2Output: testWithdrawalL2 - 1},
+			want:  opstack.WithdrawalStatusUnproven,
+		},
+		{
+			name:  "output proposed",
+			state: withdrawalState{latestL2Output: testWithdrawalL2},
+			want:  opstack.WithdrawalStatusReadyToProve,
+		},
+		{
+			name:  "proven, within the challenge period",
+			state: withdrawalState{provenAt: testProvenAt, l1Timestamp: testProvenAt + testFinalizeDelay - 60},
+			want:  opstack.WithdrawalStatusInChallengePeriod,
+		},
+		{
+			name:  "proven, challenge period ends at this block",
+			state: withdrawalState{provenAt: testProvenAt, l1Timestamp: testProvenAt + testFinalizeDelay},
+			want:  opstack.WithdrawalStatusInChallengePeriod,
+		},
+		{
+			name:  "proven, challenge period over",
+			state: withdrawalState{provenAt: testProvenAt, l1Timestamp: testProvenAt + testFinalizeDelay + 1},
+			want:  opstack.WithdrawalStatusReadyToFinalize,
+		},
+		{
+			name:  "finalized",
+			state: withdrawalState{finalized: true},
+			want:  opstack.WithdrawalStatusFinalized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := tt.state
+			server := createTestServer(t, state.handler(t))
+			defer server.Close()
+			client := createMockClient(t, server.URL, nil)
+
+			withdrawal := testWithdrawal(t)
+			status, err := opstack.GetWithdrawalStatus(context.Background(), client, opstack.GetWithdrawalStatusParameters{
+				Withdrawal:    withdrawal,
+				L2BlockNumber: testWithdrawalL2,
+				TargetChain:   testL2Chain,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, status)
+
+			require.Len(t, state.finalizedCalled, 1)
+			assert.Equal(t, withdrawal.WithdrawalHash, common.Hash(state.finalizedCalled[0]))
+		})
+	}
+}
+
+func TestGetWithdrawalStatus_NoPortal(t *testing.T) {
+	client := createMockClient(t, "http://127.0.0.1:0", nil)
+	_, err := opstack.GetWithdrawalStatus(context.Background(), client, opstack.GetWithdrawalStatusParameters{
+		Withdrawal:  testWithdrawal(t),
+		TargetChain: &chain.Chain{ID: 10},
+	})
+	assert.ErrorIs(t, err, opstack.ErrPortalNotConfigured)
+}
+
+func TestGetWithdrawals(t *testing.T) {
+	withdrawal := testWithdrawal(t)
+	event, err := messagePasserABI.GetEvent("MessagePassed")
+	require.NoError(t, err)
+
+	data, err := abi.EncodeAbiParameters(
+		[]abi.AbiParam{{Type: "uint256"}, {Type: "uint256"}, {Type: "bytes"}, {Type: "bytes32"}},
+		[]any{withdrawal.Value, withdrawal.GasLimit, withdrawal.Data, [32]byte(withdrawal.WithdrawalHash)},
+	)
+	require.NoError(t, err)
+
+	receipt := &types.Receipt{Logs: []types.Log{
+		// An unrelated log from another contract is skipped
+		{Address: testPortal, Topics: []common.Hash{event.Topic}},
+		{
+			Address: opstack.L2ToL1MessagePasserAddress,
+			Topics: []common.Hash{
+				event.Topic,
+				common.BigToHash(withdrawal.Nonce),
+				common.BytesToHash(withdrawal.Sender.Bytes()),
+				common.BytesToHash(withdrawal.Target.Bytes()),
+			},
+			Data: data,
+		},
+	}}
+
+	withdrawals, err := opstack.GetWithdrawals(receipt)
+	require.NoError(t, err)
+	require.Len(t, withdrawals, 1)
+	assert.Equal(t, withdrawal, withdrawals[0])
+}
+
+func TestBuildDepositTransaction(t *testing.T) {
+	var estimated map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_estimateGas", method)
+		estimated = params[0].(map[string]any)
+		return "0x5208"
+	})
+	defer server.Close()
+	client := createMockClient(t, server.URL, testL2Chain)
+
+	to := common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+	deposit, err := opstack.BuildDepositTransaction(context.Background(), client, opstack.BuildDepositTransactionParameters{
+		Account: &testL1Sender,
+		To:      &to,
+		Value:   big.NewInt(1e18),
+		Mint:    big.NewInt(1e18),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, testPortal, deposit.To)
+	assert.Equal(t, big.NewInt(1e18), deposit.Value)
+	assert.Equal(t, uint64(21_000), deposit.Gas)
+	assert.Equal(t, "0xde0b6b3a7640000", estimated["value"])
+
+	decoded, err := portalABI.DecodeFunctionData(deposit.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "depositTransaction", decoded.FunctionName)
+	assert.Equal(t, []any{to, big.NewInt(1e18), uint64(21_000), false, []byte{}}, decoded.Args)
+}
+
+func TestProveWithdrawal(t *testing.T) {
+	withdrawal := testWithdrawal(t)
+	const outputIndex = 42
+	outputBlock := testWithdrawalL2 + 100
+
+	stateRoot := common.HexToHash("0x01")
+	storageRoot := common.HexToHash("0x02")
+	blockHash := common.HexToHash("0x03")
+	outputRoot := crypto.Keccak256Hash(make([]byte, 32), stateRoot.Bytes(), storageRoot.Bytes(), blockHash.Bytes())
+	withdrawalProof := []string{"0xf8518080", "0xe2a0"}
+
+	var proofParams []any
+	l2Server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_getBlockByNumber":
+			assert.Equal(t, hexutil.EncodeUint64(outputBlock), params[0])
+			return map[string]any{
+				"number":       hexutil.EncodeUint64(outputBlock),
+				"hash":         blockHash.Hex(),
+				"parentHash":   common.Hash{}.Hex(),
+				"stateRoot":    stateRoot.Hex(),
+				"timestamp":    "0x1",
+				"transactions": []string{},
+			}
+		case "eth_getProof":
+			proofParams = params
+			return map[string]any{
+				"address":      opstack.L2ToL1MessagePasserAddress.Hex(),
+				"balance":      "0x0",
+				"codeHash":     common.Hash{}.Hex(),
+				"nonce":        "0x0",
+				"storageHash":  storageRoot.Hex(),
+				"accountProof": []string{},
+				"storageProof": []map[string]any{{
+					"key":   params[1].([]any)[0],
+					"value": "0x1",
+					"proof": withdrawalProof,
+				}},
+			}
+		}
+		t.Fatalf("unexpected L2 method %s", method)
+		return nil
+	})
+	defer l2Server.Close()
+
+	var sent map[string]any
+	l1Server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_call":
+			req := params[0].(map[string]any)
+			decoded, err := outputOracleABI.DecodeFunctionData(common.FromHex(req["data"].(string)))
+			require.NoError(t, err)
+			switch decoded.FunctionName {
+			case "getL2OutputIndexAfter":
+				assert.Equal(t, new(big.Int).SetUint64(testWithdrawalL2), decoded.Args[0])
+				return hexutil.Encode(word(outputIndex))
+			case "getL2Output":
+				assert.Equal(t, big.NewInt(outputIndex), decoded.Args[0])
+				return hexutil.Encode(append(append(outputRoot.Bytes(), word(testProvenAt)...), word(outputBlock)...))
+			}
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			sent = params[0].(map[string]any)
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		t.Fatalf("unexpected L1 method %s", method)
+		return nil
+	})
+	defer l1Server.Close()
+
+	l1 := &walletMockClient{mockClient: createMockClient(t, l1Server.URL, nil), account: testAccount{testL1Sender}}
+	l2 := createMockClient(t, l2Server.URL, testL2Chain)
+
+	hash, err := opstack.ProveWithdrawal(context.Background(), l1, l2, opstack.ProveWithdrawalParameters{
+		Withdrawal:    withdrawal,
+		L2BlockNumber: testWithdrawalL2,
+		TargetChain:   testL2Chain,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1", hash)
+
+	// The proof is for the withdrawal's sentMessages slot at the output's block
+	slot := crypto.Keccak256Hash(withdrawal.WithdrawalHash.Bytes(), make([]byte, 32))
+	require.Len(t, proofParams, 3)
+	assert.Equal(t, []any{slot.Hex()}, proofParams[1])
+	assert.Equal(t, hexutil.EncodeUint64(outputBlock), proofParams[2])
+
+	require.NotNil(t, sent)
+	assert.True(t, strings.EqualFold(testPortal.Hex(), sent["to"].(string)))
+	decoded, err := portalABI.DecodeFunctionData(common.FromHex(sent["data"].(string)))
+	require.NoError(t, err)
+	assert.Equal(t, "proveWithdrawalTransaction", decoded.FunctionName)
+	assert.Equal(t, big.NewInt(outputIndex), decoded.Args[1])
+	assert.Equal(t, [][]byte{common.FromHex(withdrawalProof[0]), common.FromHex(withdrawalProof[1])}, decoded.Args[3])
+}
+
+func TestProveWithdrawal_OutputRootMismatch(t *testing.T) {
+	l2Server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_getBlockByNumber":
+			return map[string]any{
+				"number":       "0x1",
+				"hash":         common.HexToHash("0x03").Hex(),
+				"parentHash":   common.Hash{}.Hex(),
+				"stateRoot":    common.HexToHash("0x01").Hex(),
+				"timestamp":    "0x1",
+				"transactions": []string{},
+			}
+		case "eth_getProof":
+			return map[string]any{
+				"address":      opstack.L2ToL1MessagePasserAddress.Hex(),
+				"balance":      "0x0",
+				"codeHash":     common.Hash{}.Hex(),
+				"nonce":        "0x0",
+				"storageHash":  common.HexToHash("0x02").Hex(),
+				"accountProof": []string{},
+				"storageProof": []map[string]any{{"key": params[1].([]any)[0], "value": "0x1", "proof": []string{}}},
+			}
+		}
+		return nil
+	})
+	defer l2Server.Close()
+
+	l1Server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_call" {
+			req := params[0].(map[string]any)
+			decoded, err := outputOracleABI.DecodeFunctionData(common.FromHex(req["data"].(string)))
+			require.NoError(t, err)
+			if decoded.FunctionName == "getL2Output" {
+				return hexutil.Encode(append(append(word(0xbad), word(1)...), word(1)...))
+			}
+			return hexutil.Encode(word(0))
+		}
+		t.Fatalf("unexpected L1 method %s", method)
+		return nil
+	})
+	defer l1Server.Close()
+
+	l1 := &walletMockClient{mockClient: createMockClient(t, l1Server.URL, nil), account: testAccount{testL1Sender}}
+	_, err := opstack.ProveWithdrawal(context.Background(), l1, createMockClient(t, l2Server.URL, nil), opstack.ProveWithdrawalParameters{
+		Withdrawal:  testWithdrawal(t),
+		TargetChain: testL2Chain,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not match output root")
+}
+
+func TestFinalizeWithdrawal(t *testing.T) {
+	withdrawal := testWithdrawal(t)
+
+	var sent map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			sent = params[0].(map[string]any)
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		t.Fatalf("unexpected method %s", method)
+		return nil
+	})
+	defer server.Close()
+
+	l1 := &walletMockClient{mockClient: createMockClient(t, server.URL, nil), account: testAccount{testL1Sender}}
+	_, err := opstack.FinalizeWithdrawal(context.Background(), l1, opstack.FinalizeWithdrawalParameters{
+		Withdrawal:  withdrawal,
+		TargetChain: testL2Chain,
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, sent)
+	assert.True(t, strings.EqualFold(testPortal.Hex(), sent["to"].(string)))
+	decoded, err := portalABI.DecodeFunctionData(common.FromHex(sent["data"].(string)))
+	require.NoError(t, err)
+	assert.Equal(t, "finalizeWithdrawalTransaction", decoded.FunctionName)
+}
@@ -0,0 +1,119 @@
+package opstack
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// Withdrawal is an L2 to L1 message sent through the L2ToL1MessagePasser,
+// e.g. an ETH withdrawal initiated with the L2StandardBridge.
+type Withdrawal struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+
+	// WithdrawalHash is the hash the OptimismPortal tracks the withdrawal by.
+	WithdrawalHash common.Hash
+}
+
+// withdrawalTransaction is the Types.WithdrawalTransaction tuple taken by
+// the OptimismPortal.
+type withdrawalTransaction struct {
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Data     []byte
+}
+
+func (w *Withdrawal) transaction() withdrawalTransaction {
+	return withdrawalTransaction{
+		Nonce:    w.Nonce,
+		Sender:   w.Sender,
+		Target:   w.Target,
+		Value:    w.Value,
+		GasLimit: w.GasLimit,
+		Data:     w.Data,
+	}
+}
+
+// GetWithdrawals returns the withdrawals initiated in an L2 transaction,
+// read from the MessagePassed events in its receipt.
+//
+// Example:
+//
+//	receipt, err := public.WaitForTransactionReceipt(ctx, l2Client, public.WaitForTransactionReceiptParameters{Hash: hash})
+//	withdrawals, err := opstack.GetWithdrawals(receipt)
+func GetWithdrawals(receipt *types.Receipt) ([]Withdrawal, error) {
+	event, err := parsedL2ToL1MessagePasserABI.GetEvent("MessagePassed")
+	if err != nil {
+		return nil, err
+	}
+
+	var withdrawals []Withdrawal
+	for _, log := range receipt.Logs {
+		if log.Address != L2ToL1MessagePasserAddress || len(log.Topics) == 0 || log.Topics[0] != event.Topic {
+			continue
+		}
+		decoded, err := parsedL2ToL1MessagePasserABI.DecodeEventLogByName("MessagePassed", log.Topics, log.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode MessagePassed log %d: %w", log.LogIndex, err)
+		}
+
+		w := Withdrawal{}
+		var ok [7]bool
+		w.Nonce, ok[0] = decoded.Args["nonce"].(*big.Int)
+		w.Sender, ok[1] = decoded.Args["sender"].(common.Address)
+		w.Target, ok[2] = decoded.Args["target"].(common.Address)
+		w.Value, ok[3] = decoded.Args["value"].(*big.Int)
+		w.GasLimit, ok[4] = decoded.Args["gasLimit"].(*big.Int)
+		w.Data, ok[5] = decoded.Args["data"].([]byte)
+		var hash [32]byte
+		hash, ok[6] = decoded.Args["withdrawalHash"].([32]byte)
+		for _, valid := range ok {
+			if !valid {
+				return nil, fmt.Errorf("unexpected MessagePassed log %d layout", log.LogIndex)
+			}
+		}
+		w.WithdrawalHash = hash
+		withdrawals = append(withdrawals, w)
+	}
+	return withdrawals, nil
+}
+
+// HashWithdrawal computes the hash the OptimismPortal tracks a withdrawal by:
+// keccak256(abi.encode(nonce, sender, target, value, gasLimit, data)).
+func HashWithdrawal(w Withdrawal) (common.Hash, error) {
+	encoded, err := abi.EncodeAbiParameters(
+		[]abi.AbiParam{{Type: "uint256"}, {Type: "address"}, {Type: "address"}, {Type: "uint256"}, {Type: "uint256"}, {Type: "bytes"}},
+		[]any{w.Nonce, w.Sender, w.Target, w.Value, w.GasLimit, w.Data},
+	)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to encode withdrawal: %w", err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// withdrawalStorageSlot returns the L2ToL1MessagePasser sentMessages slot
+// for a withdrawal hash: keccak256(abi.encode(hash, 0)).
+func withdrawalStorageSlot(hash common.Hash) common.Hash {
+	return crypto.Keccak256Hash(hash.Bytes(), make([]byte, 32))
+}
+
+// withdrawalHash returns w.WithdrawalHash, computing it if unset.
+func withdrawalHash(w *Withdrawal) (common.Hash, error) {
+	if w.WithdrawalHash != (common.Hash{}) {
+		return w.WithdrawalHash, nil
+	}
+	return HashWithdrawal(*w)
+}
@@ -0,0 +1,149 @@
+package public
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// BuildEventFilter builds a FilterQuery whose topics match the given ABI event.
+//
+// Topic0 is the event signature hash. Each indexed argument present in
+// indexedArgs is encoded into its topic slot; indexed arguments that are
+// omitted (or nil) match any value. Passing a slice for an argument matches
+// any of its elements (OR). Dynamic types (string, bytes) are hashed as the
+// EVM does when indexing them, so pass the candidate value rather than its
+// hash; decoded logs carry the hash for such args (see
+// abi.DecodedEventLog.HashedArgs).
+//
+// The returned query has no address or block range set; callers fill those in.
+//
+// Example:
+//
+//	filter, err := public.BuildEventFilter(erc20ABI, "Transfer", map[string]any{
+//	    "to": []common.Address{alice, bob},
+//	})
+//	filter.Addresses = []common.Address{tokenAddress}
+func BuildEventFilter(contractABI *abi.ABI, eventName string, indexedArgs map[string]any) (types.FilterQuery, error) {
+	if contractABI == nil {
+		return types.FilterQuery{}, fmt.Errorf("abi is required")
+	}
+	event, err := contractABI.GetEvent(eventName)
+	if err != nil {
+		return types.FilterQuery{}, err
+	}
+
+	indexed := make(map[string]bool, len(event.Inputs))
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed[input.Name] = true
+		}
+	}
+	for name := range indexedArgs {
+		if !indexed[name] {
+			return types.FilterQuery{}, fmt.Errorf("event %q has no indexed parameter %q", eventName, name)
+		}
+	}
+
+	var topics [][]common.Hash
+	if !event.Anonymous {
+		topics = append(topics, []common.Hash{event.Topic})
+	}
+
+	for _, input := range event.Inputs {
+		if !input.Indexed {
+			continue
+		}
+
+		value, ok := indexedArgs[input.Name]
+		if !ok || value == nil {
+			topics = append(topics, nil)
+			continue
+		}
+
+		slot, err := encodeIndexedTopicSlot(input, value)
+		if err != nil {
+			return types.FilterQuery{}, fmt.Errorf("failed to encode %q: %w", input.Name, err)
+		}
+		topics = append(topics, slot)
+	}
+
+	// Trailing wildcards are implicit in eth_getLogs
+	for len(topics) > 0 && topics[len(topics)-1] == nil {
+		topics = topics[:len(topics)-1]
+	}
+
+	return types.FilterQuery{Topics: topics}, nil
+}
+
+// encodeIndexedTopicSlot encodes a filter value for one indexed parameter.
+// Slices (other than byte slices) are treated as OR-matching alternatives.
+func encodeIndexedTopicSlot(param abi.Parameter, value any) ([]common.Hash, error) {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() != reflect.Uint8 {
+		slot := make([]common.Hash, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			topic, err := encodeIndexedTopic(param, rv.Index(i).Interface())
+			if err != nil {
+				return nil, fmt.Errorf("alternative %d: %w", i, err)
+			}
+			slot = append(slot, topic)
+		}
+		return slot, nil
+	}
+
+	topic, err := encodeIndexedTopic(param, value)
+	if err != nil {
+		return nil, err
+	}
+	return []common.Hash{topic}, nil
+}
+
+// encodeIndexedTopic encodes a single value as the topic the EVM would emit
+// for an indexed parameter of the given type.
+func encodeIndexedTopic(param abi.Parameter, value any) (common.Hash, error) {
+	// Pre-computed topics pass through unchanged
+	switch v := value.(type) {
+	case common.Hash:
+		return v, nil
+	case *common.Hash:
+		if v == nil {
+			return common.Hash{}, fmt.Errorf("nil topic")
+		}
+		return *v, nil
+	}
+
+	switch {
+	case param.Type == "string":
+		s, ok := value.(string)
+		if !ok {
+			return common.Hash{}, fmt.Errorf("cannot use %T as string", value)
+		}
+		return crypto.Keccak256Hash([]byte(s)), nil
+
+	case param.Type == "bytes":
+		switch v := value.(type) {
+		case []byte:
+			return crypto.Keccak256Hash(v), nil
+		case string:
+			return crypto.Keccak256Hash(common.FromHex(v)), nil
+		default:
+			return common.Hash{}, fmt.Errorf("cannot use %T as bytes", value)
+		}
+
+	case strings.HasPrefix(param.Type, "tuple") || strings.HasSuffix(param.Type, "]"):
+		return common.Hash{}, fmt.Errorf("indexed %s values must be passed as a pre-computed topic hash", param.Type)
+	}
+
+	encoded, err := abi.EncodeAbiParameters([]abi.AbiParam{{Type: param.Type}}, []any{value})
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(encoded), nil
+}
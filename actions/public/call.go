@@ -6,13 +6,13 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
-	"github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/constants"
 	"github.com/ChefBingbong/viem-go/types"
 	blockoverride "github.com/ChefBingbong/viem-go/utils/block_override"
@@ -96,6 +96,11 @@ type CallParameters struct {
 
 	// BlobVersionedHashes is the EIP-4844 blob versioned hashes.
 	BlobVersionedHashes []common.Hash
+
+	// Timeout bounds the call independently of ctx. When it elapses before
+	// the node answers, Call returns a *CallTimeoutError.
+	// Default: no timeout beyond ctx.
+	Timeout time.Duration
 }
 
 // CallReturnType is the return type for the Call action.
@@ -144,6 +149,22 @@ type callRequest struct {
 //	    Data: calldata,
 //	})
 func Call(ctx context.Context, client Client, params CallParameters) (*CallReturnType, error) {
+	if params.Timeout <= 0 {
+		return call(ctx, client, params)
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, params.Timeout)
+	defer cancel()
+
+	result, err := call(callCtx, client, params)
+	if err != nil && ctx.Err() == nil && callCtx.Err() == context.DeadlineExceeded {
+		return nil, newCallTimeoutError(err, params.Timeout, params.To)
+	}
+	return result, err
+}
+
+// call implements Call without the Timeout handling.
+func call(ctx context.Context, client Client, params CallParameters) (*CallReturnType, error) {
 	// Validate mutually exclusive parameters
 	if len(params.Code) > 0 && (params.Factory != nil || len(params.FactoryData) > 0) {
 		return nil, &InvalidCallParamsError{
@@ -334,6 +355,11 @@ func shouldPerformMulticall(req callRequest) bool {
 		return false
 	}
 
+	// Must not depend on msg.sender, which is the multicall3 contract when batched
+	if req.From != "" {
+		return false
+	}
+
 	// Must not already be a multicall
 	if strings.HasPrefix(strings.ToLower(req.Data), strings.ToLower(constants.Aggregate3Signature)) {
 		return false
@@ -385,85 +411,24 @@ func scheduleMulticall(ctx context.Context, client Client, req callRequest, bloc
 		multicallAddress = &chain.Contracts.Multicall3.Address
 	}
 
-	// Encode the aggregate3 call using low-level ABI encoding
-	// aggregate3 signature: aggregate3((address target, bool allowFailure, bytes callData)[])
-	// Function selector: 0x82ad56cb
-
-	// Encode the calls tuple array
-	target := common.HexToAddress(req.To)
-	callData := common.FromHex(req.Data)
-
-	// NOTE: `calls` must be length 1 (not length 2 with a zero-value element).
-	// We only want to encode a single aggregate3 call.
-	calls := make([]Call3, 0, 1)
-	calls = append(calls, Call3{target, true, callData})
-
-	// Encode a single Call3 struct: (address, bool, bytes)
-	callEncoded, err := abi.EncodeAbiParameters(
-		[]abi.AbiParam{
-			{
-				Type: "tuple[]",
-				Components: []abi.AbiParam{
-					{Name: "target", Type: "address"},
-					{Name: "allowFailure", Type: "bool"},
-					{Name: "callData", Type: "bytes"},
-				},
-			},
-		},
-		[]any{calls},
-	)
+	// Join the client's batch for this multicall address and block, so that
+	// concurrent calls (e.g. ReadContract fan-outs) share one aggregate3 eth_call.
+	result, err := scheduleCall(ctx, client, batchOpts.Multicall, multicallAddress, blockNumber, blockTag, Call3{
+		Target:       common.HexToAddress(req.To),
+		AllowFailure: true,
+		CallData:     common.FromHex(req.Data),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode multicall args: %w", err)
-	}
-
-	// Prepend function selector
-	selector := common.FromHex(constants.Aggregate3Signature)
-	calldata := make([]byte, len(selector)+len(callEncoded))
-	copy(calldata, selector)
-	copy(calldata[len(selector):], callEncoded)
-
-	// Build multicall request
-	multicallReq := callRequest{Data: hexutil.Encode(calldata)}
-	if multicallAddress != nil {
-		multicallReq.To = multicallAddress.Hex()
-	} else {
-		// Deployless multicall - wrap in deployless bytecode
-		deploylessData, deploylessErr := deployless.ToDeploylessCallViaBytecodeData(
-			common.FromHex(constants.Multicall3Bytecode),
-			calldata,
-		)
-		if deploylessErr != nil {
-			return nil, fmt.Errorf("failed to encode deployless multicall: %w", deploylessErr)
-		}
-		multicallReq.Data = hexutil.Encode(deploylessData)
-	}
-
-	// Execute multicall
-	block := resolveBlockTag(client, blockNumber, blockTag)
-	resp, requestErr := client.Request(ctx, "eth_call", multicallReq, block)
-	if requestErr != nil {
-		return nil, requestErr
-	}
-
-	var hexResult string
-	if unmarshalErr := json.Unmarshal(resp.Result, &hexResult); unmarshalErr != nil {
-		return nil, fmt.Errorf("failed to unmarshal multicall result: %w", unmarshalErr)
+		return nil, err
 	}
-
-	// Decode multicall result
-	// aggregate3 returns: (bool success, bytes returnData)[]
-	resultData := common.FromHex(hexResult)
-	decodedResults, decodeErr := decodeAggregate3Result(resultData)
-	if decodeErr != nil {
-		return nil, fmt.Errorf("failed to decode multicall result: %w", decodeErr)
+	if !result.Success {
+		return nil, &RawContractError{Data: result.ReturnData}
 	}
 
-	if len(decodedResults) == 0 {
+	if len(result.ReturnData) == 0 {
 		return &CallReturnType{Data: nil}, nil
 	}
-
-	callReturn := CallReturnType{Data: decodedResults[0].ReturnData}
-	return &callReturn, nil
+	return &CallReturnType{Data: result.ReturnData}, nil
 }
 
 // handleCCIPRead handles CCIP-Read offchain lookup.
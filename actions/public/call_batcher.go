@@ -0,0 +1,198 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// callBatch aggregates concurrent Call (and therefore ReadContract) requests
+// that target the same multicall3 contract and block into a single aggregate3
+// eth_call. This mirrors viem's `batch.multicall` scheduler for `call`.
+//
+// Unlike MulticallBatcher, which merges ABI-level contract reads, a callBatch
+// carries raw calldata and hands the undecoded aggregate3 results back to Call.
+//
+// The first call opens a batch window of MulticallBatchOptions.Wait (1ms when
+// unset); every call arriving within the window joins it. The batch is flushed
+// early once its calldata reaches MulticallBatchOptions.BatchSize bytes. A
+// flushed batch is removed from callBatches, so the next call opens a new one.
+type callBatch struct {
+	key              string
+	client           Client
+	multicallAddress *common.Address
+	blockNumber      *uint64
+	blockTag         BlockTag
+	batchSize        int
+
+	pending []pendingCall
+	size    int
+	timer   *time.Timer
+}
+
+type pendingCall struct {
+	ctx      context.Context
+	call     Call3
+	resultCh chan callBatchResult
+}
+
+type callBatchResult struct {
+	result aggregate3Result
+	err    error
+}
+
+// callBatches holds the open batch per client UID, multicall address and
+// block. Entries only live for the duration of their batch window.
+var (
+	callBatches   = make(map[string]*callBatch)
+	callBatchesMu sync.Mutex
+)
+
+// scheduleCall adds a call to the open batch for the given client, multicall
+// address (nil for deployless) and block, opening one if needed, and waits
+// for its result.
+func scheduleCall(ctx context.Context, client Client, opts *types.MulticallBatchOptions, multicallAddress *common.Address, blockNumber *uint64, blockTag BlockTag, call Call3) (aggregate3Result, error) {
+	target := "deployless"
+	if multicallAddress != nil {
+		target = multicallAddress.Hex()
+	}
+	key := fmt.Sprintf("call_batcher.%s.%s.%s", client.UID(), target, resolveBlockTag(client, blockNumber, blockTag))
+
+	resultCh := make(chan callBatchResult, 1)
+
+	callBatchesMu.Lock()
+	batch, ok := callBatches[key]
+	if !ok {
+		batch = newCallBatch(key, client, opts, multicallAddress, blockNumber, blockTag)
+		callBatches[key] = batch
+	}
+	batch.pending = append(batch.pending, pendingCall{ctx: ctx, call: call, resultCh: resultCh})
+	batch.size += len(call.CallData)
+	if batch.size >= batch.batchSize {
+		batch.flushLocked()
+	}
+	callBatchesMu.Unlock()
+
+	select {
+	case result := <-resultCh:
+		return result.result, result.err
+	case <-ctx.Done():
+		return aggregate3Result{}, ctx.Err()
+	}
+}
+
+// newCallBatch creates a batch and starts its wait timer.
+func newCallBatch(key string, client Client, opts *types.MulticallBatchOptions, multicallAddress *common.Address, blockNumber *uint64, blockTag BlockTag) *callBatch {
+	wait := opts.Wait
+	if wait <= 0 {
+		wait = time.Millisecond
+	}
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 1024
+	}
+
+	// Copy the block number so the batch doesn't alias the caller's variable
+	if blockNumber != nil {
+		n := *blockNumber
+		blockNumber = &n
+	}
+
+	batch := &callBatch{
+		key:              key,
+		client:           client,
+		multicallAddress: multicallAddress,
+		blockNumber:      blockNumber,
+		blockTag:         blockTag,
+		batchSize:        batchSize,
+	}
+	batch.timer = time.AfterFunc(wait, func() {
+		callBatchesMu.Lock()
+		batch.flushLocked()
+		callBatchesMu.Unlock()
+	})
+	return batch
+}
+
+// flushLocked executes the batch and removes it from callBatches. Must be
+// called with callBatchesMu held.
+func (b *callBatch) flushLocked() {
+	if callBatches[b.key] == b {
+		delete(callBatches, b.key)
+	}
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+
+	// Callers that already gave up are left out of the aggregate call.
+	var batch []pendingCall
+	for _, p := range b.pending {
+		if p.ctx.Err() == nil {
+			batch = append(batch, p)
+		}
+	}
+	b.pending = nil
+	b.size = 0
+	if len(batch) == 0 {
+		return
+	}
+
+	calls := make([]Call3, len(batch))
+	for i, p := range batch {
+		calls[i] = p.call
+	}
+
+	go func() {
+		ctx, cancel := batchContext(batch)
+		defer cancel()
+
+		results, err := executeChunk(ctx, b.client, calls, b.multicallAddress, MulticallParameters{
+			Deployless:  b.multicallAddress == nil,
+			BlockNumber: b.blockNumber,
+			BlockTag:    b.blockTag,
+		})
+		if err == nil && len(results) != len(batch) {
+			err = fmt.Errorf("call batcher: expected %d results, got %d", len(batch), len(results))
+		}
+
+		for i, p := range batch {
+			result := callBatchResult{err: err}
+			if err == nil {
+				result.result = results[i]
+			}
+			p.resultCh <- result
+		}
+	}()
+}
+
+// batchContext returns a context for executing a batch on behalf of its
+// callers. It carries the first caller's values and is cancelled once every
+// caller's context is done, so one caller giving up does not fail the others.
+func batchContext(batch []pendingCall) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(batch[0].ctx))
+
+	var live atomic.Int32
+	live.Store(int32(len(batch)))
+	stops := make([]func() bool, len(batch))
+	for i, p := range batch {
+		stops[i] = context.AfterFunc(p.ctx, func() {
+			if live.Add(-1) == 0 {
+				cancel()
+			}
+		})
+	}
+
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
@@ -1,7 +1,9 @@
 package public
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -28,6 +30,36 @@ func (e *CallExecutionError) Unwrap() error {
 	return e.Cause
 }
 
+// CallTimeoutError is returned when a call's Timeout elapses before the
+// node answers. It is distinct from CallExecutionError, which reports a
+// failed or reverted call.
+type CallTimeoutError struct {
+	Timeout time.Duration
+	To      *common.Address
+	Cause   error
+}
+
+func (e *CallTimeoutError) Error() string {
+	if e.To != nil {
+		return fmt.Sprintf("call timed out after %s: to=%s", e.Timeout, e.To.Hex())
+	}
+	return fmt.Sprintf("call timed out after %s", e.Timeout)
+}
+
+func (e *CallTimeoutError) Unwrap() error {
+	return e.Cause
+}
+
+// newCallTimeoutError builds a CallTimeoutError for err, dropping the
+// CallExecutionError wrapper so the timeout is not mistaken for a failed call.
+func newCallTimeoutError(err error, timeout time.Duration, to *common.Address) *CallTimeoutError {
+	var execErr *CallExecutionError
+	if errors.As(err, &execErr) && execErr.Cause != nil {
+		err = execErr.Cause
+	}
+	return &CallTimeoutError{Timeout: timeout, To: to, Cause: err}
+}
+
 // CounterfactualDeploymentFailedError is returned when a deployless call via
 // factory fails to deploy the contract.
 type CounterfactualDeploymentFailedError struct {
@@ -7,6 +7,7 @@ package public
 
 import (
 	"context"
+	"log/slog"
 	"time"
 
 	"github.com/ChefBingbong/viem-go/chain"
@@ -43,6 +44,24 @@ type Client interface {
 	UID() string
 }
 
+// LoggerClient is implemented by clients that carry a logger. Actions use it
+// to report recoverable problems, such as a request retried with fewer
+// parameters.
+type LoggerClient interface {
+	Logger() *slog.Logger
+}
+
+// clientLogger returns the client's logger, or slog.Default() if the client
+// has none.
+func clientLogger(client Client) *slog.Logger {
+	if c, ok := client.(LoggerClient); ok {
+		if logger := c.Logger(); logger != nil {
+			return logger
+		}
+	}
+	return slog.Default()
+}
+
 // BlockTag is an alias for types.BlockTag for convenience.
 type BlockTag = types.BlockTag
 
@@ -8,6 +8,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
 )
 
 // CreateEventFilterParameters contains the parameters for the CreateEventFilter action.
@@ -18,7 +20,8 @@ type CreateEventFilterParameters struct {
 	Address any // common.Address or []common.Address
 
 	// Topics is the indexed event topics to filter.
-	// Each topic can be a single value or an array of values (OR condition).
+	// Each topic can be nil (any value), a single value or an array of
+	// values (OR condition), e.g. []any{transferTopic, nil, []common.Hash{a, b}}.
 	Topics []any
 
 	// FromBlock is the block number to start filtering from.
@@ -75,28 +78,7 @@ func CreateEventFilter(ctx context.Context, client Client, params CreateEventFil
 	filterParams := rpcFilterParams{}
 
 	// Handle address (single or array)
-	if params.Address != nil {
-		switch addr := params.Address.(type) {
-		case common.Address:
-			filterParams.Address = addr.Hex()
-		case *common.Address:
-			if addr != nil {
-				filterParams.Address = addr.Hex()
-			}
-		case []common.Address:
-			if len(addr) > 0 {
-				addrs := make([]string, len(addr))
-				for i, a := range addr {
-					addrs[i] = a.Hex()
-				}
-				filterParams.Address = addrs
-			}
-		case string:
-			filterParams.Address = addr
-		case []string:
-			filterParams.Address = addr
-		}
-	}
+	filterParams.Address = formatLogAddress(params.Address)
 
 	// Handle topics
 	if len(params.Topics) > 0 {
@@ -139,7 +121,9 @@ func CreateEventFilter(ctx context.Context, client Client, params CreateEventFil
 	}, nil
 }
 
-// encodeFilterTopic encodes a topic value for the filter.
+// encodeFilterTopic encodes a topic position for the filter: nil (or an
+// empty slice) as null to match any value, a slice as an OR array, and
+// anything else as a single topic.
 func encodeFilterTopic(topic any) any {
 	if topic == nil {
 		return nil
@@ -163,16 +147,25 @@ func encodeFilterTopic(topic any) any {
 	case string:
 		return t
 	case []common.Hash:
-		// Array of topics (OR condition)
+		// Array of topics (OR condition); an empty one matches anything
+		if len(t) == 0 {
+			return nil
+		}
 		result := make([]string, len(t))
 		for i, h := range t {
 			result[i] = h.Hex()
 		}
 		return result
 	case []string:
+		if len(t) == 0 {
+			return nil
+		}
 		return t
 	case []any:
 		// Array of mixed topics
+		if len(t) == 0 {
+			return nil
+		}
 		result := make([]any, len(t))
 		for i, item := range t {
 			result[i] = encodeFilterTopic(item)
@@ -182,3 +175,19 @@ func encodeFilterTopic(topic any) any {
 		return t
 	}
 }
+
+// encodeArgFilterTopic encodes the filter value for an indexed event arg.
+// Indexed string and bytes args are stored in the topic as the keccak256 hash
+// of the value, so their values are hashed; a common.Hash passes through
+// unchanged. Other values are encoded as by encodeFilterTopic.
+func encodeArgFilterTopic(input abi.Parameter, value any) any {
+	if value != nil && (input.Type == "string" || input.Type == "bytes") {
+		if slot, err := encodeIndexedTopicSlot(input, value); err == nil {
+			if len(slot) == 1 {
+				return slot[0].Hex()
+			}
+			return encodeFilterTopic(slot)
+		}
+	}
+	return encodeFilterTopic(value)
+}
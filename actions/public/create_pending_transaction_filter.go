@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
 )
 
 // CreatePendingTransactionFilterReturnType is the return type for the CreatePendingTransactionFilter action.
@@ -33,6 +35,9 @@ func CreatePendingTransactionFilter(ctx context.Context, client Client) (*Create
 	// Execute the request
 	resp, err := client.Request(ctx, "eth_newPendingTransactionFilter")
 	if err != nil {
+		if transport.IsMethodNotSupported(err) {
+			return nil, &transport.MethodNotSupportedError{Method: "eth_newPendingTransactionFilter", Cause: err}
+		}
 		return nil, fmt.Errorf("eth_newPendingTransactionFilter failed: %w", err)
 	}
 
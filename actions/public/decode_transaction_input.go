@@ -0,0 +1,55 @@
+package public
+
+import (
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/abi"
+)
+
+// DecodeTransactionInput decodes transaction input (calldata) against an ABI,
+// returning the called function's name and its arguments keyed by parameter
+// name. Unnamed parameters are keyed by position ("arg0", "arg1", ...).
+//
+// Returns a *FunctionSelectorNotFoundError if the input's 4-byte selector is
+// not in the ABI.
+//
+// Example:
+//
+//	tx, _ := public.GetTransaction(ctx, client, public.GetTransactionParameters{Hash: &hash})
+//	name, args, err := public.DecodeTransactionInput(erc20ABI, tx.Input)
+//	// name == "transfer", args["to"], args["amount"]
+func DecodeTransactionInput(contractABI *abi.ABI, input []byte) (string, map[string]any, error) {
+	if len(input) < 4 {
+		return "", nil, fmt.Errorf("input too short: expected at least 4 bytes, got %d", len(input))
+	}
+
+	var selector [4]byte
+	copy(selector[:], input[:4])
+
+	fn, err := contractABI.GetFunctionBySelector(selector)
+	if err != nil {
+		return "", nil, &FunctionSelectorNotFoundError{Selector: selector}
+	}
+
+	decoded, err := contractABI.DecodeFunctionData(input)
+	if err != nil {
+		return "", nil, err
+	}
+
+	args := make(map[string]any, len(decoded.Args))
+	for i, value := range decoded.Args {
+		name := fmt.Sprintf("arg%d", i)
+		if i < len(fn.Inputs) && fn.Inputs[i].Name != "" {
+			name = fn.Inputs[i].Name
+		}
+		args[name] = value
+	}
+
+	return fn.Name, args, nil
+}
+
+// DecodeInput decodes the transaction's input against an ABI.
+// See DecodeTransactionInput.
+func (t *TransactionResponse) DecodeInput(contractABI *abi.ABI) (string, map[string]any, error) {
+	return DecodeTransactionInput(contractABI, t.Input)
+}
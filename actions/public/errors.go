@@ -22,6 +22,25 @@ func (e *BlockNotFoundError) Error() string {
 	return "block not found"
 }
 
+// UncleIndexOutOfRangeError is returned when an uncle index exceeds the
+// number of uncles in a block.
+type UncleIndexOutOfRangeError struct {
+	BlockHash   *common.Hash
+	BlockNumber *uint64
+	Index       uint64
+	Count       uint64
+}
+
+func (e *UncleIndexOutOfRangeError) Error() string {
+	if e.BlockHash != nil {
+		return fmt.Sprintf("uncle index %d out of range: block %s has %d uncles", e.Index, e.BlockHash.Hex(), e.Count)
+	}
+	if e.BlockNumber != nil {
+		return fmt.Sprintf("uncle index %d out of range: block %d has %d uncles", e.Index, *e.BlockNumber, e.Count)
+	}
+	return fmt.Sprintf("uncle index %d out of range: block has %d uncles", e.Index, e.Count)
+}
+
 // TransactionNotFoundError is returned when a transaction is not found.
 type TransactionNotFoundError struct {
 	Hash        *common.Hash
@@ -42,3 +61,13 @@ func (e *TransactionNotFoundError) Error() string {
 	}
 	return "transaction not found"
 }
+
+// FunctionSelectorNotFoundError is returned when calldata's 4-byte selector
+// does not match any function in the ABI.
+type FunctionSelectorNotFoundError struct {
+	Selector [4]byte
+}
+
+func (e *FunctionSelectorNotFoundError) Error() string {
+	return fmt.Sprintf("function with selector 0x%x not found on ABI", e.Selector)
+}
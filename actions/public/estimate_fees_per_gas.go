@@ -53,6 +53,9 @@ type EstimateFeesPerGasReturnType struct {
 // transaction to be likely included in the next block.
 //
 // This is equivalent to viem's `estimateFeesPerGas` action.
+//
+// If the client implements FeeCacheClient, the result is cached for its
+// FeeCacheTime.
 func EstimateFeesPerGas(
 	ctx context.Context,
 	client Client,
@@ -72,6 +75,32 @@ func EstimateFeesPerGas(
 		return nil, &BaseFeeScalarError{Multiplier: baseFeeMultiplier}
 	}
 
+	// Check cache
+	cacheTime := feeCacheTime(client)
+	cacheKey := estimateFeesPerGasCacheKey(feeType, baseFeeMultiplier)
+	cached, gen, ok := getCachedFee(client, cacheKey)
+	if cacheTime > 0 && ok {
+		return cached.(*EstimateFeesPerGasReturnType).copy(), nil
+	}
+
+	fees, err := estimateFeesPerGas(ctx, client, feeType, baseFeeMultiplier)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheTime > 0 {
+		setCachedFee(client, cacheKey, gen, fees.copy(), cacheTime)
+	}
+
+	return fees, nil
+}
+
+func estimateFeesPerGas(
+	ctx context.Context,
+	client Client,
+	feeType FeeValuesType,
+	baseFeeMultiplier float64,
+) (*EstimateFeesPerGasReturnType, error) {
 	// Fetch latest block once (used by both paths).
 	block, err := GetBlock(ctx, client, GetBlockParameters{
 		BlockTag: BlockTagLatest,
@@ -119,6 +148,16 @@ func EstimateFeesPerGas(
 	}
 }
 
+// copy returns a deep copy of the fee values.
+func (f *EstimateFeesPerGasReturnType) copy() *EstimateFeesPerGasReturnType {
+	return &EstimateFeesPerGasReturnType{
+		Type:                 f.Type,
+		MaxFeePerGas:         copyBigInt(f.MaxFeePerGas),
+		MaxPriorityFeePerGas: copyBigInt(f.MaxPriorityFeePerGas),
+		GasPrice:             copyBigInt(f.GasPrice),
+	}
+}
+
 // applyBaseFeeMultiplier applies the base fee multiplier using integer math
 // to avoid floating point precision issues.
 func applyBaseFeeMultiplier(base *big.Int, multiplier float64) *big.Int {
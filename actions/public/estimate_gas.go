@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"math/big"
+	"strings"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/types"
 	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
@@ -60,7 +63,9 @@ type EstimateGasParameters struct {
 	// Blobs is the EIP-4844 blob data.
 	Blobs [][]byte
 
-	// StateOverride contains state overrides for the estimation.
+	// StateOverride contains state overrides for the estimation, sent as the
+	// third eth_estimateGas parameter. If the node rejects the extra
+	// parameter, the estimate is retried without it.
 	StateOverride types.StateOverride
 
 	// BlockNumber is the block number to estimate at.
@@ -99,6 +104,10 @@ type estimateGasRequest struct {
 // This is equivalent to viem's `estimateGas` action.
 //
 // JSON-RPC Method: eth_estimateGas
+//
+// State overrides are not supported by every node. If the node reports that it
+// doesn't accept the override parameter, the request is retried without it and
+// a warning is logged through the client's logger (see LoggerClient).
 func EstimateGas(
 	ctx context.Context,
 	client Client,
@@ -189,6 +198,12 @@ func EstimateGas(
 
 	// Execute the request.
 	resp, err := client.Request(ctx, "eth_estimateGas", rpcParams...)
+	if err != nil && rpcStateOverride != nil && isStateOverrideUnsupported(err) {
+		// The node doesn't accept state overrides; estimate without them.
+		clientLogger(client).WarnContext(ctx, "eth_estimateGas: node rejected state override, retrying without it",
+			slog.Any("error", err))
+		resp, err = client.Request(ctx, "eth_estimateGas", req, blockTag)
+	}
 	if err != nil {
 		return 0, fmt.Errorf("eth_estimateGas failed: %w", err)
 	}
@@ -206,3 +221,31 @@ func EstimateGas(
 
 	return gas, nil
 }
+
+// stateOverrideUnsupportedMessages are error messages nodes return when they
+// don't accept a third eth_estimateGas parameter.
+var stateOverrideUnsupportedMessages = []string{
+	"too many arguments", // geth: "too many arguments, want at most 2"
+	"too many params",
+	"state override not supported",
+	"state overrides are not supported",
+	"stateoverride is not supported",
+}
+
+// isStateOverrideUnsupported reports whether err indicates that the node
+// rejected the state override parameter of eth_estimateGas, as opposed to the
+// estimation itself failing. Other invalid params errors (-32602), such as a
+// malformed override, are not retried.
+func isStateOverrideUnsupported(err error) bool {
+	rpcErr, ok := transport.AsRPCError(err)
+	if !ok {
+		return false
+	}
+	lower := strings.ToLower(rpcErr.Message)
+	for _, msg := range stateOverrideUnsupportedMessages {
+		if strings.Contains(lower, msg) {
+			return true
+		}
+	}
+	return false
+}
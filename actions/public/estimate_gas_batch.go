@@ -0,0 +1,93 @@
+package public
+
+import (
+	"context"
+	"sync"
+)
+
+// EstimateGasBatchParameters contains the parameters for the EstimateGasBatch action.
+type EstimateGasBatchParameters struct {
+	// Requests are the independent transactions to estimate.
+	Requests []EstimateGasParameters
+
+	// MaxConcurrent limits the number of eth_estimateGas requests in flight.
+	// With a batching transport, concurrent requests are coalesced into
+	// JSON-RPC batches. Default is 10.
+	MaxConcurrent int
+}
+
+// EstimateGasBatchResult is the outcome of a single estimate in a batch.
+type EstimateGasBatchResult struct {
+	// Gas is the gas estimate. Zero if Error is set.
+	Gas uint64
+
+	// Error is the estimation error (e.g. an execution revert), if any.
+	Error error
+}
+
+// EstimateGasBatchReturnType is the return type for the EstimateGasBatch action.
+// Results are aligned by index with EstimateGasBatchParameters.Requests.
+type EstimateGasBatchReturnType = []EstimateGasBatchResult
+
+// EstimateGasBatch estimates gas for many independent transactions with
+// bounded fan-out. A failing estimate does not affect the others; its error is
+// reported in the corresponding result.
+//
+// An error is only returned if the context is done before all estimates complete.
+//
+// Example:
+//
+//	results, err := public.EstimateGasBatch(ctx, client, public.EstimateGasBatchParameters{
+//	    Requests: []public.EstimateGasParameters{
+//	        {Account: &from, To: &recipientA, Value: amount},
+//	        {Account: &from, To: &recipientB, Value: amount},
+//	    },
+//	})
+//	for i, r := range results {
+//	    if r.Error != nil {
+//	        // request i would revert
+//	    }
+//	}
+func EstimateGasBatch(
+	ctx context.Context,
+	client Client,
+	params EstimateGasBatchParameters,
+) (EstimateGasBatchReturnType, error) {
+	results := make([]EstimateGasBatchResult, len(params.Requests))
+	if len(params.Requests) == 0 {
+		return results, nil
+	}
+
+	maxConcurrent := params.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = 10
+	}
+	if maxConcurrent > len(params.Requests) {
+		maxConcurrent = len(params.Requests)
+	}
+
+	jobs := make(chan int, len(params.Requests))
+	for i := range params.Requests {
+		jobs <- i
+	}
+	close(jobs)
+
+	var wg sync.WaitGroup
+	wg.Add(maxConcurrent)
+	for w := 0; w < maxConcurrent; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				gas, err := EstimateGas(ctx, client, params.Requests[i])
+				results[i] = EstimateGasBatchResult{Gas: gas, Error: err}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,93 @@
+package public
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// FeeCacheClient is implemented by clients that cache fee data.
+//
+// When FeeCacheTime returns a positive duration, GetGasPrice and
+// EstimateFeesPerGas reuse results for that long. The cache is keyed by
+// client UID and is dropped whenever WatchBlocks sees a new block.
+type FeeCacheClient interface {
+	FeeCacheTime() time.Duration
+}
+
+// feeCache holds fee results per client. Like the block number cache,
+// feeCacheGen is bumped on invalidation so that a request started before
+// InvalidateFeeCache does not repopulate the cache with a stale result.
+var (
+	feeCacheMu   sync.RWMutex
+	feeCacheData = make(map[string]map[string]cachedFee)
+	feeCacheGen  = make(map[string]uint64)
+)
+
+type cachedFee struct {
+	value     any
+	expiresAt time.Time
+}
+
+// feeCacheTime returns the client's fee cache time, or 0 if fee caching is
+// disabled.
+func feeCacheTime(client Client) time.Duration {
+	if c, ok := client.(FeeCacheClient); ok {
+		return c.FeeCacheTime()
+	}
+	return 0
+}
+
+// getCachedFee returns the cached value for key and the cache generation to
+// pass to setCachedFee.
+func getCachedFee(client Client, key string) (any, uint64, bool) {
+	uid := client.UID()
+
+	feeCacheMu.RLock()
+	defer feeCacheMu.RUnlock()
+	gen := feeCacheGen[uid]
+	if cached, ok := feeCacheData[uid][key]; ok && time.Now().Before(cached.expiresAt) {
+		return cached.value, gen, true
+	}
+	return nil, gen, false
+}
+
+// setCachedFee stores value under key, unless the cache was invalidated
+// since gen was read.
+func setCachedFee(client Client, key string, gen uint64, value any, cacheTime time.Duration) {
+	uid := client.UID()
+
+	feeCacheMu.Lock()
+	defer feeCacheMu.Unlock()
+	if feeCacheGen[uid] != gen {
+		return
+	}
+	if feeCacheData[uid] == nil {
+		feeCacheData[uid] = make(map[string]cachedFee)
+	}
+	feeCacheData[uid][key] = cachedFee{value: value, expiresAt: time.Now().Add(cacheTime)}
+}
+
+// InvalidateFeeCache drops the cached fee data for the client, so the next
+// GetGasPrice or EstimateFeesPerGas call fetches fresh values.
+func InvalidateFeeCache(client Client) {
+	uid := client.UID()
+
+	feeCacheMu.Lock()
+	delete(feeCacheData, uid)
+	feeCacheGen[uid]++
+	feeCacheMu.Unlock()
+}
+
+func estimateFeesPerGasCacheKey(feeType FeeValuesType, baseFeeMultiplier float64) string {
+	return fmt.Sprintf("feesPerGas.%s.%g", feeType, baseFeeMultiplier)
+}
+
+// copyBigInt returns a copy of n, so cached values cannot be mutated by callers.
+func copyBigInt(n *big.Int) *big.Int {
+	if n == nil {
+		return nil
+	}
+	return new(big.Int).Set(n)
+}
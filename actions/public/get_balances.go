@@ -0,0 +1,146 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/concurrency"
+)
+
+// multicall3GetEthBalanceABI is multicall3's getEthBalance function, which
+// returns the native balance of any address.
+var multicall3GetEthBalanceABI = abi.MustParse([]byte(`[{"inputs":[{"name":"addr","type":"address"}],"name":"getEthBalance","outputs":[{"name":"balance","type":"uint256"}],"stateMutability":"view","type":"function"}]`))
+
+// GetBalancesParameters contains the parameters for the GetBalances action.
+type GetBalancesParameters struct {
+	// Addresses are the addresses to get the balances of.
+	Addresses []common.Address
+
+	// BlockNumber is the block number to get the balances at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to get the balances at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	BlockTag BlockTag
+
+	// MulticallAddress overrides the chain's multicall3 contract address.
+	MulticallAddress *common.Address
+
+	// MaxConcurrentRequests limits the number of concurrent eth_getBalance
+	// requests when balances are read individually.
+	// Default: 10
+	MaxConcurrentRequests int
+}
+
+// GetBalancesReturnType is the return type for the GetBalances action.
+// Balances are in wei and ordered like GetBalancesParameters.Addresses.
+type GetBalancesReturnType = []*big.Int
+
+// GetBalances returns the balances of several addresses in wei, ordered like
+// the input addresses.
+//
+// When multicall3 is available (MulticallAddress is set, or the client's
+// chain has a multicall3 contract at the requested block), the balances are
+// read through multicall3's getEthBalance in as few eth_calls as possible.
+// Otherwise, or if the multicall fails, each balance is fetched with
+// eth_getBalance, running at most MaxConcurrentRequests requests at a time.
+//
+// JSON-RPC Methods:
+//   - eth_call to multicall3 getEthBalance (batched)
+//   - eth_getBalance (fallback)
+//
+// Example:
+//
+//	balances, err := public.GetBalances(ctx, client, public.GetBalancesParameters{
+//	    Addresses: []common.Address{alice, bob, carol},
+//	})
+func GetBalances(ctx context.Context, client Client, params GetBalancesParameters) (GetBalancesReturnType, error) {
+	if len(params.Addresses) == 0 {
+		return GetBalancesReturnType{}, nil
+	}
+
+	multicallAddress, err := resolveMulticallAddress(client, MulticallParameters{
+		MulticallAddress: params.MulticallAddress,
+		BlockNumber:      params.BlockNumber,
+	})
+	if err == nil {
+		balances, err := getBalancesMulticall(ctx, client, *multicallAddress, params)
+		if err == nil {
+			return balances, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+
+	return getBalancesConcurrent(ctx, client, params)
+}
+
+// getBalancesMulticall reads every balance through multicall3's getEthBalance.
+func getBalancesMulticall(ctx context.Context, client Client, multicallAddress common.Address, params GetBalancesParameters) (GetBalancesReturnType, error) {
+	contracts := make([]MulticallContract, len(params.Addresses))
+	for i, addr := range params.Addresses {
+		contracts[i] = MulticallContract{
+			Address:      multicallAddress,
+			ABI:          multicall3GetEthBalanceABI,
+			FunctionName: "getEthBalance",
+			Args:         []any{addr},
+		}
+	}
+
+	allowFailure := false
+	results, err := Multicall(ctx, client, MulticallParameters{
+		Contracts:        contracts,
+		AllowFailure:     &allowFailure,
+		MulticallAddress: &multicallAddress,
+		BlockNumber:      params.BlockNumber,
+		BlockTag:         params.BlockTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(GetBalancesReturnType, len(results))
+	for i, result := range results {
+		balance, ok := result.Result.(*big.Int)
+		if !ok {
+			return nil, fmt.Errorf("getEthBalance returned %T for %s", result.Result, params.Addresses[i].Hex())
+		}
+		balances[i] = balance
+	}
+	return balances, nil
+}
+
+// getBalancesConcurrent fetches each balance with eth_getBalance, running at
+// most MaxConcurrentRequests requests at a time.
+func getBalancesConcurrent(ctx context.Context, client Client, params GetBalancesParameters) (GetBalancesReturnType, error) {
+	balances := make(GetBalancesReturnType, len(params.Addresses))
+
+	g, ctx := concurrency.BoundedGroup(ctx, params.MaxConcurrentRequests)
+	for i, addr := range params.Addresses {
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			balance, err := GetBalance(ctx, client, GetBalanceParameters{
+				Address:     addr,
+				BlockNumber: params.BlockNumber,
+				BlockTag:    params.BlockTag,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get balance of %s: %w", addr.Hex(), err)
+			}
+			balances[i] = balance
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return balances, nil
+}
@@ -6,17 +6,42 @@ import (
 	"math/big"
 
 	json "github.com/goccy/go-json"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
 )
 
-// GetBlobBaseFeeReturnType is the return type for the GetTransactionCount action.
-// It represents the tx count in wei.
+// GetBlobBaseFeeReturnType is the return type for the GetBlobBaseFee action.
+// It represents the blob base fee in wei.
 type GetBlobBaseFeeReturnType = *big.Int
 
+// EIP-4844 blob fee parameters (Cancun).
+var (
+	minBaseFeePerBlobGas      = big.NewInt(1)
+	blobBaseFeeUpdateFraction = big.NewInt(3338477)
+)
+
+// GetBlobBaseFee returns the base fee per blob gas (in wei).
+//
+// This is equivalent to viem's `getBlobBaseFee` action.
+//
+// If the node does not support `eth_blobBaseFee`, the fee is derived from
+// the latest block's excessBlobGas using the EIP-4844 formula.
+//
+// JSON-RPC Methods:
+//   - eth_blobBaseFee (preferred)
+//   - eth_getBlockByNumber (fallback)
+//
+// Example:
+//
+//	blobBaseFee, err := public.GetBlobBaseFee(ctx, client)
 func GetBlobBaseFee(ctx context.Context, client Client) (GetBlobBaseFeeReturnType, error) {
 	// Execute the request
 	resp, err := client.Request(ctx, "eth_blobBaseFee")
 	if err != nil {
-		return nil, fmt.Errorf("eth_blobBaseFee failed: %w", err)
+		if !transport.IsMethodNotSupported(err) {
+			return nil, fmt.Errorf("eth_blobBaseFee failed: %w", err)
+		}
+		return getBlobBaseFeeFromBlock(ctx, client)
 	}
 
 	var blobFeeHex string
@@ -24,11 +49,51 @@ func GetBlobBaseFee(ctx context.Context, client Client) (GetBlobBaseFeeReturnTyp
 		return nil, fmt.Errorf("failed to unmarshal blob base fee: %w", unmarshalErr)
 	}
 
-	// Parse the tx count
+	// Parse the blob base fee
 	blobFee, err := parseHexBigInt(blobFeeHex)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parseblob base fee: %w", err)
+		return nil, fmt.Errorf("failed to parse blob base fee: %w", err)
 	}
 
 	return blobFee, nil
 }
+
+// getBlobBaseFeeFromBlock computes the blob base fee from the latest block's
+// excessBlobGas.
+func getBlobBaseFeeFromBlock(ctx context.Context, client Client) (*big.Int, error) {
+	block, err := GetBlock(ctx, client, GetBlockParameters{
+		BlockTag: BlockTagLatest,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch latest block: %w", err)
+	}
+
+	if block.ExcessBlobGas == nil {
+		return nil, fmt.Errorf("EIP-4844 not supported: missing excessBlobGas on block")
+	}
+
+	return CalcBlobBaseFee(*block.ExcessBlobGas), nil
+}
+
+// CalcBlobBaseFee computes the base fee per blob gas for the given excess
+// blob gas, as specified by EIP-4844:
+//
+//	fake_exponential(MIN_BASE_FEE_PER_BLOB_GAS, excessBlobGas, BLOB_BASE_FEE_UPDATE_FRACTION)
+func CalcBlobBaseFee(excessBlobGas uint64) *big.Int {
+	return fakeExponential(minBaseFeePerBlobGas, new(big.Int).SetUint64(excessBlobGas), blobBaseFeeUpdateFraction)
+}
+
+// fakeExponential approximates factor * e ** (numerator / denominator) using
+// Taylor expansion.
+func fakeExponential(factor, numerator, denominator *big.Int) *big.Int {
+	output := new(big.Int)
+	accum := new(big.Int).Mul(factor, denominator)
+	for i := int64(1); accum.Sign() > 0; i++ {
+		output.Add(output, accum)
+
+		accum.Mul(accum, numerator)
+		accum.Div(accum, denominator)
+		accum.Div(accum, big.NewInt(i))
+	}
+	return output.Div(output, denominator)
+}
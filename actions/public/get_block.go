@@ -28,7 +28,8 @@ type GetBlockParameters struct {
 	BlockTag BlockTag
 
 	// IncludeTransactions indicates whether to include full transaction objects
-	// in the response. If false, only transaction hashes are included.
+	// in the response. If true, the decoded transactions are returned in
+	// Block.FullTransactions; Block.Transactions holds the hashes either way.
 	// Default: false
 	IncludeTransactions bool
 }
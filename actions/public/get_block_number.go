@@ -17,15 +17,24 @@ type GetBlockNumberParameters struct {
 	// CacheTime is the time (in duration) that cached block number will remain in memory.
 	// If nil, uses the client's cache time.
 	CacheTime *time.Duration
+
+	// ForceRefresh bypasses any cached block number and fetches a fresh one.
+	// The fresh result is still written to the cache.
+	ForceRefresh bool
 }
 
 // GetBlockNumberReturnType is the return type for the GetBlockNumber action.
 type GetBlockNumberReturnType = uint64
 
 // blockNumberCache is a simple cache for block numbers.
+//
+// blockNumberCacheGen is bumped on invalidation so that a request started
+// before InvalidateBlockNumberCache does not repopulate the cache with a
+// stale result when it completes.
 var (
 	blockNumberCacheMu   sync.RWMutex
 	blockNumberCacheData = make(map[string]cachedBlockNumber)
+	blockNumberCacheGen  = make(map[string]uint64)
 )
 
 type cachedBlockNumber struct {
@@ -51,15 +60,16 @@ func GetBlockNumber(ctx context.Context, client Client, params GetBlockNumberPar
 	}
 
 	// Check cache
-	cacheKey := fmt.Sprintf("blockNumber.%s", client.UID())
-	if cacheTime > 0 {
-		blockNumberCacheMu.RLock()
+	cacheKey := blockNumberCacheKey(client)
+	blockNumberCacheMu.RLock()
+	gen := blockNumberCacheGen[cacheKey]
+	if cacheTime > 0 && !params.ForceRefresh {
 		if cached, ok := blockNumberCacheData[cacheKey]; ok && time.Now().Before(cached.expiresAt) {
 			blockNumberCacheMu.RUnlock()
 			return cached.blockNumber, nil
 		}
-		blockNumberCacheMu.RUnlock()
 	}
+	blockNumberCacheMu.RUnlock()
 
 	// Execute the request
 	resp, err := client.Request(ctx, "eth_blockNumber")
@@ -67,6 +77,11 @@ func GetBlockNumber(ctx context.Context, client Client, params GetBlockNumberPar
 		return 0, fmt.Errorf("eth_blockNumber failed: %w", err)
 	}
 
+	// Don't cache a result that arrived after the caller gave up
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return 0, ctxErr
+	}
+
 	var hexBlockNumber string
 	if unmarshalErr := json.Unmarshal(resp.Result, &hexBlockNumber); unmarshalErr != nil {
 		return 0, fmt.Errorf("failed to unmarshal block number: %w", unmarshalErr)
@@ -78,12 +93,14 @@ func GetBlockNumber(ctx context.Context, client Client, params GetBlockNumberPar
 		return 0, fmt.Errorf("failed to parse block number: %w", err)
 	}
 
-	// Cache the result
+	// Cache the result, unless the cache was invalidated in the meantime
 	if cacheTime > 0 {
 		blockNumberCacheMu.Lock()
-		blockNumberCacheData[cacheKey] = cachedBlockNumber{
-			blockNumber: blockNumber,
-			expiresAt:   time.Now().Add(cacheTime),
+		if blockNumberCacheGen[cacheKey] == gen {
+			blockNumberCacheData[cacheKey] = cachedBlockNumber{
+				blockNumber: blockNumber,
+				expiresAt:   time.Now().Add(cacheTime),
+			}
 		}
 		blockNumberCacheMu.Unlock()
 	}
@@ -91,6 +108,22 @@ func GetBlockNumber(ctx context.Context, client Client, params GetBlockNumberPar
 	return blockNumber, nil
 }
 
+// InvalidateBlockNumberCache drops the cached block number for the client, so
+// the next GetBlockNumber call fetches a fresh one. In-flight requests started
+// before the invalidation do not repopulate the cache.
+func InvalidateBlockNumberCache(client Client) {
+	cacheKey := blockNumberCacheKey(client)
+
+	blockNumberCacheMu.Lock()
+	delete(blockNumberCacheData, cacheKey)
+	blockNumberCacheGen[cacheKey]++
+	blockNumberCacheMu.Unlock()
+}
+
+func blockNumberCacheKey(client Client) string {
+	return fmt.Sprintf("blockNumber.%s", client.UID())
+}
+
 // parseHexUint64 parses a hex string to uint64.
 func parseHexUint64(hexStr string) (uint64, error) {
 	hexStr = strings.TrimPrefix(hexStr, "0x")
@@ -0,0 +1,59 @@
+package public
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GetBytecodeParameters contains the parameters for the GetBytecode action.
+// This mirrors viem's GetBytecodeParameters type.
+type GetBytecodeParameters struct {
+	// Address is the contract address to retrieve bytecode for.
+	Address common.Address
+
+	// BlockNumber is the block number to get the bytecode at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to get the bytecode at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+}
+
+// GetBytecodeReturnType is the return type for the GetBytecode action.
+// Nil means no contract is deployed at the address.
+type GetBytecodeReturnType = []byte
+
+// GetBytecode retrieves the bytecode at an address, returning nil when the
+// address has no code.
+//
+// This is equivalent to viem's `getBytecode` action, which returns undefined
+// for accounts without code. Unlike GetCode, callers can use `code == nil`
+// to check whether a contract exists at the given block.
+//
+// JSON-RPC Method: eth_getCode
+//
+// Example:
+//
+//	code, err := public.GetBytecode(ctx, client, public.GetBytecodeParameters{
+//	    Address: common.HexToAddress("0x..."),
+//	})
+//	if code == nil {
+//	    // No contract deployed at this address
+//	}
+func GetBytecode(ctx context.Context, client Client, params GetBytecodeParameters) (GetBytecodeReturnType, error) {
+	code, err := GetCode(ctx, client, GetCodeParameters{
+		Address:     params.Address,
+		BlockNumber: params.BlockNumber,
+		BlockTag:    params.BlockTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(code) == 0 {
+		return nil, nil
+	}
+	return code, nil
+}
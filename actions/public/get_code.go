@@ -26,7 +26,7 @@ type GetCodeParameters struct {
 }
 
 // GetCodeReturnType is the return type for the GetCode action.
-// It represents the contract bytecode. Nil means no code (empty account).
+// It represents the contract bytecode. An empty (non-nil) slice means no code.
 type GetCodeReturnType = []byte
 
 // GetCode retrieves the bytecode at an address.
@@ -40,9 +40,11 @@ type GetCodeReturnType = []byte
 //	code, err := public.GetCode(ctx, client, public.GetCodeParameters{
 //	    Address: common.HexToAddress("0x..."),
 //	})
-//	if code == nil {
+//	if len(code) == 0 {
 //	    // No contract deployed at this address
 //	}
+//
+// Use GetBytecode when a nil result should signal "no contract".
 func GetCode(ctx context.Context, client Client, params GetCodeParameters) (GetCodeReturnType, error) {
 	// Determine block tag/number
 	blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
@@ -60,7 +62,7 @@ func GetCode(ctx context.Context, client Client, params GetCodeParameters) (GetC
 
 	// "0x" means no code (empty account)
 	if hexCode == "" || hexCode == "0x" {
-		return nil, nil
+		return []byte{}, nil
 	}
 
 	// Decode hex to bytes
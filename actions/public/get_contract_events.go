@@ -26,6 +26,8 @@ type GetContractEventsParameters struct {
 
 	// Args filters logs by indexed event parameters.
 	// Provide indexed args in order (use nil for "any" match).
+	// Indexed string and bytes values are matched by their keccak256 hash;
+	// pass the value and it is hashed, or pass the common.Hash directly.
 	Args []any
 
 	// FromBlock is the block number to start filtering from.
@@ -62,6 +64,11 @@ type ContractEventLog struct {
 
 	// DecodedArgs contains the decoded event arguments as a map.
 	DecodedArgs map[string]any `json:"args,omitempty"`
+
+	// HashedArgs marks the indexed string, bytes, array and tuple args. Their
+	// DecodedArgs entry is the common.Hash topic (the keccak256 hash of the
+	// value), since logs do not contain the value itself.
+	HashedArgs map[string]bool `json:"-"`
 }
 
 // GetContractEventsReturnType is the return type for the GetContractEvents action.
@@ -187,7 +194,7 @@ func GetContractEvents(ctx context.Context, client Client, params GetContractEve
 		}
 
 		// Decode the event log
-		decodedArgs, decodeErr := decodeEventLog(parsedABI, matchedEvent.Name, log)
+		decoded, decodeErr := decodeEventLog(parsedABI, matchedEvent.Name, log)
 		if decodeErr != nil {
 			if params.Strict {
 				continue
@@ -203,7 +210,8 @@ func GetContractEvents(ctx context.Context, client Client, params GetContractEve
 		results = append(results, ContractEventLog{
 			Log:         log,
 			EventName:   matchedEvent.Name,
-			DecodedArgs: decodedArgs,
+			DecodedArgs: decoded.Args,
+			HashedArgs:  decoded.HashedArgs,
 		})
 	}
 
@@ -258,7 +266,7 @@ func encodeEventTopicsForFilter(parsedABI *abi.ABI, eventName string, args []any
 		}
 
 		// Encode the topic
-		topic, encodeErr := encodeTopicValue(arg, input.Type)
+		topic, encodeErr := encodeTopicValue(arg, input)
 		if encodeErr != nil {
 			return nil, fmt.Errorf("failed to encode arg %d: %w", argIdx-1, encodeErr)
 		}
@@ -269,7 +277,17 @@ func encodeEventTopicsForFilter(parsedABI *abi.ABI, eventName string, args []any
 }
 
 // encodeTopicValue encodes a single value as a topic.
-func encodeTopicValue(value any, typeName string) (string, error) {
+func encodeTopicValue(value any, input abi.Parameter) (string, error) {
+	typeName := input.Type
+	if typeName == "string" || typeName == "bytes" {
+		// Indexed dynamic values are stored as their hash
+		topic, err := encodeIndexedTopic(input, value)
+		if err != nil {
+			return "", err
+		}
+		return topic.Hex(), nil
+	}
+
 	switch v := value.(type) {
 	case common.Address:
 		// Pad address to 32 bytes
@@ -293,8 +311,7 @@ func encodeTopicValue(value any, typeName string) (string, error) {
 			copy(topic[12:], addr.Bytes())
 			return common.BytesToHash(topic[:]).Hex(), nil
 		}
-		// For string type, would need to hash - not typically used in filters
-		return "", fmt.Errorf("string topics require hashing, use hash directly")
+		return "", fmt.Errorf("unsupported string topic for %s parameter", typeName)
 
 	case common.Hash:
 		return v.Hex(), nil
@@ -314,7 +331,7 @@ func encodeTopicValue(value any, typeName string) (string, error) {
 }
 
 // decodeEventLog decodes a formatted log using the ABI.
-func decodeEventLog(parsedABI *abi.ABI, eventName string, log formatters.Log) (map[string]any, error) {
+func decodeEventLog(parsedABI *abi.ABI, eventName string, log formatters.Log) (*abi.DecodedEventLog, error) {
 	// Convert topics from strings to common.Hash
 	topics := make([]common.Hash, len(log.Topics))
 	for i, t := range log.Topics {
@@ -325,10 +342,5 @@ func decodeEventLog(parsedABI *abi.ABI, eventName string, log formatters.Log) (m
 	data := common.FromHex(log.Data)
 
 	// Use the ABI's DecodeEventLogByName
-	decoded, err := parsedABI.DecodeEventLogByName(eventName, topics, data)
-	if err != nil {
-		return nil, err
-	}
-
-	return decoded.Args, nil
+	return parsedABI.DecodeEventLogByName(eventName, topics, data)
 }
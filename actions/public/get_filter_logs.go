@@ -191,7 +191,7 @@ func parseFilterLogs(logs []formatters.Log, parsedABI *abi.ABI, eventName string
 		}
 
 		// Decode the event log
-		decodedArgs, decodeErr := decodeEventLog(parsedABI, matchedEvent.Name, log)
+		decoded, decodeErr := decodeEventLog(parsedABI, matchedEvent.Name, log)
 		if decodeErr != nil {
 			if strict {
 				continue
@@ -206,7 +206,8 @@ func parseFilterLogs(logs []formatters.Log, parsedABI *abi.ABI, eventName string
 		results = append(results, ContractEventLog{
 			Log:         log,
 			EventName:   matchedEvent.Name,
-			DecodedArgs: decodedArgs,
+			DecodedArgs: decoded.Args,
+			HashedArgs:  decoded.HashedArgs,
 		})
 	}
 
@@ -18,11 +18,21 @@ type GetGasPriceReturnType = *big.Int
 //
 // JSON-RPC Method: eth_gasPrice
 //
+// If the client implements FeeCacheClient, the result is cached for its
+// FeeCacheTime.
+//
 // Example:
 //
 //	gasPrice, err := public.GetGasPrice(ctx, client)
 //	// gasPrice is in wei, use formatGwei/formatEther to convert
 func GetGasPrice(ctx context.Context, client Client) (GetGasPriceReturnType, error) {
+	// Check cache
+	cacheTime := feeCacheTime(client)
+	cached, gen, ok := getCachedFee(client, "gasPrice")
+	if cacheTime > 0 && ok {
+		return copyBigInt(cached.(*big.Int)), nil
+	}
+
 	// Execute the request
 	resp, err := client.Request(ctx, "eth_gasPrice")
 	if err != nil {
@@ -40,5 +50,9 @@ func GetGasPrice(ctx context.Context, client Client) (GetGasPriceReturnType, err
 		return nil, fmt.Errorf("failed to parse gas price: %w", parseErr)
 	}
 
+	if cacheTime > 0 {
+		setCachedFee(client, "gasPrice", gen, copyBigInt(gasPrice), cacheTime)
+	}
+
 	return gasPrice, nil
 }
@@ -20,7 +20,8 @@ type GetLogsParameters struct {
 	Address any // common.Address or []common.Address
 
 	// Topics is the indexed event topics to filter.
-	// Each topic can be a single value or an array of values (OR condition).
+	// Each topic can be nil (any value), a single value or an array of
+	// values (OR condition), e.g. []any{transferTopic, nil, []common.Hash{a, b}}.
 	Topics []any
 
 	// FromBlock is the block number to start filtering from.
@@ -84,28 +85,7 @@ func GetLogs(ctx context.Context, client Client, params GetLogsParameters) (GetL
 	filterParams := rpcGetLogsParams{}
 
 	// Handle address (single or array)
-	if params.Address != nil {
-		switch addr := params.Address.(type) {
-		case common.Address:
-			filterParams.Address = addr.Hex()
-		case *common.Address:
-			if addr != nil {
-				filterParams.Address = addr.Hex()
-			}
-		case []common.Address:
-			if len(addr) > 0 {
-				addrs := make([]string, len(addr))
-				for i, a := range addr {
-					addrs[i] = a.Hex()
-				}
-				filterParams.Address = addrs
-			}
-		case string:
-			filterParams.Address = addr
-		case []string:
-			filterParams.Address = addr
-		}
-	}
+	filterParams.Address = formatLogAddress(params.Address)
 
 	// Handle topics
 	if len(params.Topics) > 0 {
@@ -182,3 +162,42 @@ type Event struct {
 	Signature string
 	Topic     common.Hash
 }
+
+// formatLogAddress formats a log filter address for eth_getLogs,
+// eth_newFilter and eth_subscribe. It accepts a common.Address,
+// *common.Address, []common.Address, string or []string. A single address is
+// sent as a string and several as an array; nil and empty lists return nil,
+// which matches logs from any address.
+func formatLogAddress(address any) any {
+	var addrs []string
+	switch addr := address.(type) {
+	case common.Address:
+		return addr.Hex()
+	case *common.Address:
+		if addr == nil {
+			return nil
+		}
+		return addr.Hex()
+	case string:
+		if addr == "" {
+			return nil
+		}
+		return addr
+	case []common.Address:
+		addrs = make([]string, len(addr))
+		for i, a := range addr {
+			addrs[i] = a.Hex()
+		}
+	case []string:
+		addrs = addr
+	}
+
+	switch len(addrs) {
+	case 0:
+		return nil
+	case 1:
+		return addrs[0]
+	default:
+		return addrs
+	}
+}
@@ -2,14 +2,17 @@ package public
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 )
 
@@ -32,9 +35,16 @@ type GetProofParameters struct {
 	BlockTag BlockTag
 }
 
+// AccountProof is a decoded EIP-1186 account proof. It can be verified
+// against a state root with proof.VerifyAccountProof.
+type AccountProof = types.AccountProof
+
+// StorageProofItem is a decoded proof for a single storage slot.
+type StorageProofItem = types.StorageProofItem
+
 // GetProofReturnType is the return type for the GetProof action.
-// It represents an account proof with formatted numeric fields.
-type GetProofReturnType = formatters.Proof
+// It is the account proof with every hex field decoded.
+type GetProofReturnType = AccountProof
 
 // GetProofError is returned when getProof fails.
 type GetProofError struct {
@@ -62,6 +72,8 @@ func (e *GetProofError) Unwrap() error {
 //	    Address:     common.HexToAddress("0x..."),
 //	    StorageKeys: []common.Hash{common.HexToHash("0x0")},
 //	})
+//
+// The proof can be checked against a block's state root with proof.VerifyAccountProof.
 func GetProof(ctx context.Context, client Client, params GetProofParameters) (GetProofReturnType, error) {
 	// Determine block tag/number
 	blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
@@ -69,78 +81,119 @@ func GetProof(ctx context.Context, client Client, params GetProofParameters) (Ge
 	// Execute the request
 	resp, err := client.Request(ctx, "eth_getProof", params.Address.Hex(), params.StorageKeys, blockTag)
 	if err != nil {
-		return formatters.Proof{}, &GetProofError{Cause: err}
+		return AccountProof{}, &GetProofError{Cause: err}
 	}
 
 	var rpcProof formatters.RpcProof
 	if unmarshalErr := json.Unmarshal(resp.Result, &rpcProof); unmarshalErr != nil {
-		return formatters.Proof{}, fmt.Errorf("failed to unmarshal proof: %w", unmarshalErr)
+		return AccountProof{}, fmt.Errorf("failed to unmarshal proof: %w", unmarshalErr)
 	}
 
 	// Format RPC proof into typed Proof (similar to viem's formatProof).
 	proof, formatErr := formatProof(rpcProof)
 	if formatErr != nil {
-		return formatters.Proof{}, formatErr
+		return AccountProof{}, formatErr
 	}
 
 	return proof, nil
 }
 
-// formatProof formats an RpcProof into a Proof by decoding numeric fields.
-func formatProof(rpc formatters.RpcProof) (formatters.Proof, error) {
-	var (
-		balance *big.Int
-		nonce   *int
-		err     error
-	)
+// formatProof decodes an RpcProof into an AccountProof.
+// Every hex field is decoded strictly so malformed proofs are rejected up front.
+func formatProof(rpc formatters.RpcProof) (AccountProof, error) {
+	proof := AccountProof{
+		Address: common.HexToAddress(rpc.Address),
+		Balance: new(big.Int),
+	}
+	var err error
 
-	// Decode balance (hex string) to *big.Int
 	if rpc.Balance != "" {
-		var b *big.Int
-		b, err = hexutil.DecodeBig(rpc.Balance)
-		if err != nil {
-			return formatters.Proof{}, fmt.Errorf("invalid balance in proof: %w", err)
+		if proof.Balance, err = hexutil.DecodeBig(rpc.Balance); err != nil {
+			return AccountProof{}, fmt.Errorf("invalid balance in proof: %w", err)
 		}
-		balance = b
 	}
-
-	// Decode nonce (hex string) to *int
 	if rpc.Nonce != "" {
-		var n uint64
-		n, err = hexutil.DecodeUint64(rpc.Nonce)
-		if err != nil {
-			return formatters.Proof{}, fmt.Errorf("invalid nonce in proof: %w", err)
+		if proof.Nonce, err = hexutil.DecodeUint64(rpc.Nonce); err != nil {
+			return AccountProof{}, fmt.Errorf("invalid nonce in proof: %w", err)
 		}
-		tmp := int(n)
-		nonce = &tmp
+	}
+	if proof.CodeHash, err = decodeProofHash(rpc.CodeHash); err != nil {
+		return AccountProof{}, fmt.Errorf("invalid codeHash in proof: %w", err)
+	}
+	if proof.StorageHash, err = decodeProofHash(rpc.StorageHash); err != nil {
+		return AccountProof{}, fmt.Errorf("invalid storageHash in proof: %w", err)
+	}
+	if proof.AccountProof, err = decodeProofNodes(rpc.AccountProof); err != nil {
+		return AccountProof{}, fmt.Errorf("invalid accountProof: %w", err)
 	}
 
-	// Decode storage proofs
-	storageProofs := make([]formatters.StorageProof, 0, len(rpc.StorageProof))
+	proof.StorageProof = make([]StorageProofItem, 0, len(rpc.StorageProof))
 	for _, sp := range rpc.StorageProof {
-		var value *big.Int
+		item := StorageProofItem{Value: new(big.Int)}
+
+		if item.Key, err = decodeProofKey(sp.Key); err != nil {
+			return AccountProof{}, fmt.Errorf("invalid storage key in proof: %w", err)
+		}
+
 		if sp.Value != "" {
-			v, decErr := hexutil.DecodeBig(sp.Value)
-			if decErr != nil {
-				return formatters.Proof{}, fmt.Errorf("invalid storage value in proof: %w", decErr)
+			if item.Value, err = hexutil.DecodeBig(sp.Value); err != nil {
+				return AccountProof{}, fmt.Errorf("invalid storage value in proof: %w", err)
 			}
-			value = v
+		}
+		if item.Proof, err = decodeProofNodes(sp.Proof); err != nil {
+			return AccountProof{}, fmt.Errorf("invalid storage proof for key %s: %w", sp.Key, err)
 		}
 
-		storageProofs = append(storageProofs, formatters.StorageProof{
-			Key:   sp.Key,
-			Proof: sp.Proof,
-			Value: value,
-		})
-	}
-
-	return formatters.Proof{
-		Address:      rpc.Address,
-		AccountProof: rpc.AccountProof,
-		Balance:      balance,
-		CodeHash:     rpc.CodeHash,
-		Nonce:        nonce,
-		StorageHash:  rpc.StorageHash,
-		StorageProof: storageProofs,
-	}, nil
+		proof.StorageProof = append(proof.StorageProof, item)
+	}
+
+	return proof, nil
+}
+
+// decodeProofHash decodes a 32-byte hex hash. An empty string decodes to the zero hash.
+func decodeProofHash(s string) (common.Hash, error) {
+	if s == "" {
+		return common.Hash{}, nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if len(b) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("expected %d bytes, got %d", common.HashLength, len(b))
+	}
+	return common.BytesToHash(b), nil
+}
+
+// decodeProofKey decodes a storage key. Nodes echo keys back as requested, so
+// both short ("0x0") and zero-padded 32-byte forms are accepted.
+func decodeProofKey(s string) (common.Hash, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return common.Hash{}, fmt.Errorf("missing 0x prefix: %q", s)
+	}
+	digits := s[2:]
+	if len(digits) == 0 || len(digits) > 2*common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid length: %q", s)
+	}
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(b), nil
+}
+
+// decodeProofNodes decodes a list of hex-encoded RLP trie nodes.
+func decodeProofNodes(nodes []string) ([][]byte, error) {
+	decoded := make([][]byte, len(nodes))
+	for i, node := range nodes {
+		b, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, fmt.Errorf("node %d: %w", i, err)
+		}
+		decoded[i] = b
+	}
+	return decoded, nil
 }
@@ -0,0 +1,160 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/concurrency"
+)
+
+// storageReaderBytecode is runtime code that SLOADs every 32-byte word of
+// calldata and returns the values in order:
+//
+//	i = 0
+//	while i < calldatasize: mstore(i, sload(calldataload(i))); i += 32
+//	return(0, calldatasize)
+//
+// It is placed at the target address with a state override, so it reads
+// the target's own storage.
+var storageReaderBytecode = common.FromHex("0x60005b8036111560155780355481526020016002565b366000f3")
+
+// GetStorageAtManyParameters contains the parameters for the GetStorageAtMany action.
+type GetStorageAtManyParameters struct {
+	// Address is the contract/account address to read storage from.
+	Address common.Address
+
+	// Slots are the 32-byte storage slot keys to read.
+	Slots []common.Hash
+
+	// BlockNumber is the block number to read the storage at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to read the storage at (e.g., "latest", "pending").
+	// Mutually exclusive with BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+
+	// Batch reads all slots in a single eth_call by overriding the code at
+	// Address with a storage reader. If the node rejects the call (e.g. it
+	// does not support state overrides), the slots are read individually.
+	// Default: true
+	Batch *bool
+
+	// MaxConcurrentRequests limits the number of concurrent eth_getStorageAt
+	// requests when slots are read individually.
+	// Default: 10
+	MaxConcurrentRequests int
+}
+
+// GetStorageAtManyReturnType is the return type for the GetStorageAtMany action.
+// Values are ordered like GetStorageAtManyParameters.Slots.
+type GetStorageAtManyReturnType = [][]byte
+
+// GetStorageAtMany returns the values of several storage slots at a given
+// address, ordered like the input slots.
+//
+// By default all slots are read in one eth_call: the code at Address is
+// overridden with a small storage reader that returns each requested slot.
+// Nodes that do not support state overrides fall back to concurrent
+// eth_getStorageAt requests, bounded by MaxConcurrentRequests.
+//
+// JSON-RPC Methods:
+//   - eth_call with a state override (batched)
+//   - eth_getStorageAt (fallback)
+//
+// Example:
+//
+//	values, err := public.GetStorageAtMany(ctx, client, public.GetStorageAtManyParameters{
+//	    Address: common.HexToAddress("0x..."),
+//	    Slots:   []common.Hash{common.HexToHash("0x0"), common.HexToHash("0x1")},
+//	})
+func GetStorageAtMany(ctx context.Context, client Client, params GetStorageAtManyParameters) (GetStorageAtManyReturnType, error) {
+	if len(params.Slots) == 0 {
+		return GetStorageAtManyReturnType{}, nil
+	}
+
+	if params.Batch == nil || *params.Batch {
+		values, err := getStorageAtBatched(ctx, client, params)
+		if err == nil {
+			return values, nil
+		}
+		if ctx.Err() != nil {
+			return nil, err
+		}
+	}
+
+	return getStorageAtConcurrent(ctx, client, params)
+}
+
+// getStorageAtBatched reads all slots with a single eth_call against the
+// storage reader code override.
+func getStorageAtBatched(ctx context.Context, client Client, params GetStorageAtManyParameters) (GetStorageAtManyReturnType, error) {
+	data := make([]byte, 0, len(params.Slots)*32)
+	for _, slot := range params.Slots {
+		data = append(data, slot.Bytes()...)
+	}
+
+	batch := false
+	to := params.Address
+	result, err := Call(ctx, client, CallParameters{
+		To:          &to,
+		Data:        data,
+		BlockNumber: params.BlockNumber,
+		BlockTag:    params.BlockTag,
+		Batch:       &batch,
+		StateOverride: types.StateOverride{
+			params.Address: {Code: storageReaderBytecode},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Data) != len(data) {
+		return nil, fmt.Errorf("storage reader returned %d bytes, expected %d", len(result.Data), len(data))
+	}
+
+	values := make(GetStorageAtManyReturnType, len(params.Slots))
+	for i := range values {
+		values[i] = result.Data[i*32 : (i+1)*32]
+	}
+	return values, nil
+}
+
+// getStorageAtConcurrent reads each slot with eth_getStorageAt, running at
+// most MaxConcurrentRequests requests at a time.
+func getStorageAtConcurrent(ctx context.Context, client Client, params GetStorageAtManyParameters) (GetStorageAtManyReturnType, error) {
+	blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
+	values := make(GetStorageAtManyReturnType, len(params.Slots))
+
+	g, ctx := concurrency.BoundedGroup(ctx, params.MaxConcurrentRequests)
+	for i, slot := range params.Slots {
+		g.Go(func() error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			resp, err := client.Request(ctx, "eth_getStorageAt", params.Address.Hex(), slot.Hex(), blockTag)
+			if err != nil {
+				return fmt.Errorf("eth_getStorageAt failed for slot %s: %w", slot.Hex(), err)
+			}
+
+			var hexValue string
+			if err := json.Unmarshal(resp.Result, &hexValue); err != nil {
+				return fmt.Errorf("failed to unmarshal storage value: %w", err)
+			}
+			if hexValue != "" && hexValue != "0x" {
+				values[i] = common.FromHex(hexValue)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
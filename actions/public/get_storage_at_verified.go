@@ -0,0 +1,120 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/utils/proof"
+)
+
+// GetStorageAtVerifiedParameters contains the parameters for the
+// GetStorageAtVerified action.
+type GetStorageAtVerifiedParameters struct {
+	// Address is the contract/account address to read storage from.
+	Address common.Address
+
+	// Slot is the 32-byte storage slot key.
+	Slot common.Hash
+
+	// BlockNumber is the block number to read the storage at.
+	// Mutually exclusive with BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the block tag to read the storage at (e.g., "latest", "finalized").
+	// Mutually exclusive with BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+}
+
+// GetStorageAtVerifiedReturnType is the return type for the GetStorageAtVerified
+// action. It is the 32-byte storage value.
+type GetStorageAtVerifiedReturnType = []byte
+
+// ProofVerificationError is returned by GetStorageAtVerified when the proof
+// returned by the node does not verify against the block's state root.
+type ProofVerificationError struct {
+	Address     common.Address
+	Slot        common.Hash
+	BlockNumber uint64
+	StateRoot   common.Hash
+	Cause       error
+}
+
+func (e *ProofVerificationError) Error() string {
+	return fmt.Sprintf("proof verification failed for slot %s of %s at block %d: %v",
+		e.Slot.Hex(), e.Address.Hex(), e.BlockNumber, e.Cause)
+}
+
+func (e *ProofVerificationError) Unwrap() error {
+	return e.Cause
+}
+
+// GetStorageAtVerified returns the value of a storage slot after verifying
+// it against the block's state root, so the value does not have to be
+// trusted to the node.
+//
+// The block is fetched first and the proof is requested at its number, so a
+// tag such as "latest" cannot resolve to different blocks for the two
+// requests. If the proof does not verify, a *ProofVerificationError wrapping
+// the proof package's error is returned.
+//
+// JSON-RPC Methods:
+//   - eth_getBlockByNumber (or eth_getBlockByHash)
+//   - eth_getProof
+//
+// Example:
+//
+//	value, err := public.GetStorageAtVerified(ctx, client, public.GetStorageAtVerifiedParameters{
+//	    Address:  common.HexToAddress("0x..."),
+//	    Slot:     common.HexToHash("0x0"),
+//	    BlockTag: public.BlockTagFinalized,
+//	})
+func GetStorageAtVerified(ctx context.Context, client Client, params GetStorageAtVerifiedParameters) (GetStorageAtVerifiedReturnType, error) {
+	block, err := GetBlock(ctx, client, GetBlockParameters{
+		BlockNumber: params.BlockNumber,
+		BlockTag:    params.BlockTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	blockNumber := block.Number
+	p, err := GetProof(ctx, client, GetProofParameters{
+		Address:     params.Address,
+		StorageKeys: []common.Hash{params.Slot},
+		BlockNumber: &blockNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	verificationError := func(cause error) error {
+		return &ProofVerificationError{
+			Address:     params.Address,
+			Slot:        params.Slot,
+			BlockNumber: blockNumber,
+			StateRoot:   block.StateRoot,
+			Cause:       cause,
+		}
+	}
+
+	// The proof is only meaningful for the account and slot that were asked for.
+	if p.Address != params.Address {
+		return nil, verificationError(fmt.Errorf("proof is for address %s", p.Address.Hex()))
+	}
+	if len(p.StorageProof) != 1 || p.StorageProof[0].Key != params.Slot {
+		return nil, verificationError(fmt.Errorf("proof does not cover the requested slot"))
+	}
+
+	if err := proof.VerifyAccountProof(block.StateRoot, &p); err != nil {
+		return nil, verificationError(err)
+	}
+
+	value := p.StorageProof[0].Value
+	if value == nil {
+		return make([]byte, common.HashLength), nil
+	}
+	return common.BigToHash(value).Bytes(), nil
+}
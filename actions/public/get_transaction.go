@@ -3,12 +3,13 @@ package public
 import (
 	"context"
 	"fmt"
-	"math/big"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/types"
 )
 
 // GetTransactionParameters contains the parameters for the GetTransaction action.
@@ -41,161 +42,22 @@ type GetTransactionParameters struct {
 }
 
 // TransactionResponse represents a transaction as returned by the JSON-RPC API.
-type TransactionResponse struct {
-	// BlockHash is the hash of the block containing this transaction.
-	// Null when pending.
-	BlockHash *common.Hash `json:"blockHash"`
-
-	// BlockNumber is the number of the block containing this transaction.
-	// Null when pending.
-	BlockNumber *uint64 `json:"blockNumber"`
-
-	// From is the sender address.
-	From common.Address `json:"from"`
-
-	// Gas is the gas provided by the sender.
-	Gas uint64 `json:"gas"`
-
-	// GasPrice is the gas price in wei. Null for EIP-1559 transactions.
-	GasPrice *big.Int `json:"gasPrice"`
-
-	// MaxFeePerGas is the max fee per gas (EIP-1559).
-	MaxFeePerGas *big.Int `json:"maxFeePerGas"`
-
-	// MaxPriorityFeePerGas is the max priority fee per gas (EIP-1559).
-	MaxPriorityFeePerGas *big.Int `json:"maxPriorityFeePerGas"`
-
-	// Hash is the transaction hash.
-	Hash common.Hash `json:"hash"`
-
-	// Input is the data sent along with the transaction.
-	Input []byte `json:"input"`
-
-	// Nonce is the number of transactions made by the sender prior to this one.
-	Nonce uint64 `json:"nonce"`
-
-	// To is the receiver address. Null for contract creation.
-	To *common.Address `json:"to"`
-
-	// TransactionIndex is the index of this transaction in the block.
-	// Null when pending.
-	TransactionIndex *uint64 `json:"transactionIndex"`
-
-	// Value is the value transferred in wei.
-	Value *big.Int `json:"value"`
-
-	// Type is the EIP-2718 transaction type.
-	Type uint8 `json:"type"`
-
-	// ChainID is the chain ID (EIP-155).
-	ChainID *big.Int `json:"chainId"`
-
-	// V is the ECDSA recovery id.
-	V *big.Int `json:"v"`
-
-	// R is the ECDSA signature r.
-	R *big.Int `json:"r"`
-
-	// S is the ECDSA signature s.
-	S *big.Int `json:"s"`
-
-	// AccessList is the EIP-2930 access list.
-	AccessList []AccessTuple `json:"accessList,omitempty"`
-
-	// MaxFeePerBlobGas is the max fee per blob gas (EIP-4844).
-	MaxFeePerBlobGas *big.Int `json:"maxFeePerBlobGas,omitempty"`
-
-	// BlobVersionedHashes are the blob versioned hashes (EIP-4844).
-	BlobVersionedHashes []common.Hash `json:"blobVersionedHashes,omitempty"`
-}
+//
+// It has the same fields as types.TransactionResponse, so the decoded
+// transactions of a block convert directly:
+//
+//	tx := (*public.TransactionResponse)(&block.FullTransactions[0])
+type TransactionResponse types.TransactionResponse
 
 // AccessTuple represents an access list entry.
-type AccessTuple struct {
-	Address     common.Address `json:"address"`
-	StorageKeys []common.Hash  `json:"storageKeys"`
-}
+type AccessTuple = types.AccessTuple
 
 // GetTransactionReturnType is the return type for the GetTransaction action.
 type GetTransactionReturnType = *TransactionResponse
 
 // UnmarshalJSON implements json.Unmarshaler for TransactionResponse.
 func (t *TransactionResponse) UnmarshalJSON(input []byte) error {
-	type txJSON struct {
-		BlockHash            *common.Hash    `json:"blockHash"`
-		BlockNumber          *hexutil.Uint64 `json:"blockNumber"`
-		From                 common.Address  `json:"from"`
-		Gas                  hexutil.Uint64  `json:"gas"`
-		GasPrice             *hexutil.Big    `json:"gasPrice"`
-		MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas"`
-		MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas"`
-		Hash                 common.Hash     `json:"hash"`
-		Input                hexutil.Bytes   `json:"input"`
-		Nonce                hexutil.Uint64  `json:"nonce"`
-		To                   *common.Address `json:"to"`
-		TransactionIndex     *hexutil.Uint64 `json:"transactionIndex"`
-		Value                *hexutil.Big    `json:"value"`
-		Type                 hexutil.Uint64  `json:"type"`
-		ChainID              *hexutil.Big    `json:"chainId"`
-		V                    *hexutil.Big    `json:"v"`
-		R                    *hexutil.Big    `json:"r"`
-		S                    *hexutil.Big    `json:"s"`
-		AccessList           []AccessTuple   `json:"accessList"`
-		MaxFeePerBlobGas     *hexutil.Big    `json:"maxFeePerBlobGas"`
-		BlobVersionedHashes  []common.Hash   `json:"blobVersionedHashes"`
-	}
-
-	var dec txJSON
-	if err := json.Unmarshal(input, &dec); err != nil {
-		return err
-	}
-
-	t.BlockHash = dec.BlockHash
-	if dec.BlockNumber != nil {
-		bn := uint64(*dec.BlockNumber)
-		t.BlockNumber = &bn
-	}
-	t.From = dec.From
-	t.Gas = uint64(dec.Gas)
-	if dec.GasPrice != nil {
-		t.GasPrice = (*big.Int)(dec.GasPrice)
-	}
-	if dec.MaxFeePerGas != nil {
-		t.MaxFeePerGas = (*big.Int)(dec.MaxFeePerGas)
-	}
-	if dec.MaxPriorityFeePerGas != nil {
-		t.MaxPriorityFeePerGas = (*big.Int)(dec.MaxPriorityFeePerGas)
-	}
-	t.Hash = dec.Hash
-	t.Input = dec.Input
-	t.Nonce = uint64(dec.Nonce)
-	t.To = dec.To
-	if dec.TransactionIndex != nil {
-		ti := uint64(*dec.TransactionIndex)
-		t.TransactionIndex = &ti
-	}
-	if dec.Value != nil {
-		t.Value = (*big.Int)(dec.Value)
-	}
-	t.Type = uint8(dec.Type)
-	if dec.ChainID != nil {
-		t.ChainID = (*big.Int)(dec.ChainID)
-	}
-	if dec.V != nil {
-		t.V = (*big.Int)(dec.V)
-	}
-	if dec.R != nil {
-		t.R = (*big.Int)(dec.R)
-	}
-	if dec.S != nil {
-		t.S = (*big.Int)(dec.S)
-	}
-	t.AccessList = dec.AccessList
-	if dec.MaxFeePerBlobGas != nil {
-		t.MaxFeePerBlobGas = (*big.Int)(dec.MaxFeePerBlobGas)
-	}
-	t.BlobVersionedHashes = dec.BlobVersionedHashes
-
-	return nil
+	return (*types.TransactionResponse)(t).UnmarshalJSON(input)
 }
 
 // GetTransaction returns information about a transaction given a hash or block identifier.
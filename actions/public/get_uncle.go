@@ -0,0 +1,163 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// GetUncleParameters contains the parameters for the GetUncle action.
+type GetUncleParameters struct {
+	// BlockHash is the hash of the block containing the uncle.
+	// Mutually exclusive with BlockNumber and BlockTag.
+	BlockHash *common.Hash
+
+	// BlockNumber is the number of the block containing the uncle.
+	// Mutually exclusive with BlockHash and BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the tag of the block containing the uncle.
+	// Mutually exclusive with BlockHash and BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+
+	// UncleIndex is the position of the uncle in the block's uncle list.
+	UncleIndex uint64
+}
+
+// GetUncleReturnType is the return type for the GetUncle action.
+// Uncles are returned as block headers; Transactions is always empty.
+type GetUncleReturnType = *types.Block
+
+// GetUncle returns the uncle (ommer) block at an index within a block.
+//
+// JSON-RPC Methods:
+//   - eth_getUncleByBlockHashAndIndex for blockHash
+//   - eth_getUncleByBlockNumberAndIndex for blockNumber & blockTag
+//
+// Returns an *UncleIndexOutOfRangeError if the block has fewer uncles than
+// UncleIndex + 1, or a *BlockNotFoundError if the block does not exist.
+//
+// Example:
+//
+//	blockNum := uint64(12345)
+//	uncle, err := public.GetUncle(ctx, client, public.GetUncleParameters{
+//	    BlockNumber: &blockNum,
+//	    UncleIndex:  0,
+//	})
+func GetUncle(ctx context.Context, client Client, params GetUncleParameters) (GetUncleReturnType, error) {
+	index := hexutil.EncodeUint64(params.UncleIndex)
+
+	var result json.RawMessage
+	if params.BlockHash != nil {
+		resp, err := client.Request(ctx, "eth_getUncleByBlockHashAndIndex", params.BlockHash.Hex(), index)
+		if err != nil {
+			return nil, fmt.Errorf("eth_getUncleByBlockHashAndIndex failed: %w", err)
+		}
+		result = resp.Result
+	} else {
+		blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
+		resp, err := client.Request(ctx, "eth_getUncleByBlockNumberAndIndex", blockTag, index)
+		if err != nil {
+			return nil, fmt.Errorf("eth_getUncleByBlockNumberAndIndex failed: %w", err)
+		}
+		result = resp.Result
+	}
+
+	// A null result means either the block or the uncle index doesn't exist
+	if result == nil || string(result) == "null" {
+		count, err := GetUncleCount(ctx, client, GetUncleCountParameters{
+			BlockHash:   params.BlockHash,
+			BlockNumber: params.BlockNumber,
+			BlockTag:    params.BlockTag,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if params.UncleIndex < count {
+			return nil, fmt.Errorf("uncle at index %d not found", params.UncleIndex)
+		}
+		return nil, &UncleIndexOutOfRangeError{
+			BlockHash:   params.BlockHash,
+			BlockNumber: params.BlockNumber,
+			Index:       params.UncleIndex,
+			Count:       count,
+		}
+	}
+
+	var uncle types.Block
+	if err := json.Unmarshal(result, &uncle); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal uncle: %w", err)
+	}
+
+	return &uncle, nil
+}
+
+// GetUncleCountParameters contains the parameters for the GetUncleCount action.
+type GetUncleCountParameters struct {
+	// BlockHash is the hash of the block.
+	// Mutually exclusive with BlockNumber and BlockTag.
+	BlockHash *common.Hash
+
+	// BlockNumber is the number of the block.
+	// Mutually exclusive with BlockHash and BlockTag.
+	BlockNumber *uint64
+
+	// BlockTag is the tag of the block.
+	// Mutually exclusive with BlockHash and BlockNumber.
+	// Default: "latest"
+	BlockTag BlockTag
+}
+
+// GetUncleCountReturnType is the return type for the GetUncleCount action.
+type GetUncleCountReturnType = uint64
+
+// GetUncleCount returns the number of uncles in a block.
+//
+// JSON-RPC Methods:
+//   - eth_getUncleCountByBlockHash for blockHash
+//   - eth_getUncleCountByBlockNumber for blockNumber & blockTag
+//
+// Returns a *BlockNotFoundError if the block does not exist.
+func GetUncleCount(ctx context.Context, client Client, params GetUncleCountParameters) (GetUncleCountReturnType, error) {
+	var result json.RawMessage
+	if params.BlockHash != nil {
+		resp, err := client.Request(ctx, "eth_getUncleCountByBlockHash", params.BlockHash.Hex())
+		if err != nil {
+			return 0, fmt.Errorf("eth_getUncleCountByBlockHash failed: %w", err)
+		}
+		result = resp.Result
+	} else {
+		blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
+		resp, err := client.Request(ctx, "eth_getUncleCountByBlockNumber", blockTag)
+		if err != nil {
+			return 0, fmt.Errorf("eth_getUncleCountByBlockNumber failed: %w", err)
+		}
+		result = resp.Result
+	}
+
+	if result == nil || string(result) == "null" {
+		return 0, &BlockNotFoundError{
+			BlockHash:   params.BlockHash,
+			BlockNumber: params.BlockNumber,
+		}
+	}
+
+	var countHex string
+	if err := json.Unmarshal(result, &countHex); err != nil {
+		return 0, fmt.Errorf("failed to unmarshal uncle count: %w", err)
+	}
+
+	count, err := parseHexUint64(countHex)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse uncle count: %w", err)
+	}
+
+	return count, nil
+}
@@ -2,9 +2,13 @@ package public
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"runtime"
+	"strings"
 	"sync"
+	"time"
 
 	json "github.com/goccy/go-json"
 
@@ -13,7 +17,11 @@ import (
 
 	"github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/constants"
+	"github.com/ChefBingbong/viem-go/types"
+	blockoverride "github.com/ChefBingbong/viem-go/utils/block_override"
+	"github.com/ChefBingbong/viem-go/utils/concurrency"
 	"github.com/ChefBingbong/viem-go/utils/deployless"
+	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
 )
 
 // Cached aggregate3 selector - parsed once
@@ -29,13 +37,19 @@ func getAggregate3Selector() []byte {
 	return aggregate3Selector
 }
 
+// aggregate3ValueSelector is the aggregate3Value selector (0x174dea71).
+var aggregate3ValueSelector = common.FromHex(constants.Aggregate3ValueSignature)
+
+// tryBlockAndAggregateSelector is the tryBlockAndAggregate selector (0x399542e9).
+var tryBlockAndAggregateSelector = common.FromHex(constants.TryBlockAndAggregateSignature)
+
 // MulticallContract defines a contract call for multicall.
 // This mirrors viem's ContractFunctionParameters type.
 type MulticallContract struct {
 	// Address is the contract address to call.
 	Address common.Address
 
-	// ABI is the contract ABI as JSON bytes, string, or *abi.ABI.
+	// ABI is the contract ABI. If nil, MulticallParameters.ABI is used.
 	ABI *abi.ABI
 
 	// FunctionName is the name of the function to call.
@@ -43,6 +57,11 @@ type MulticallContract struct {
 
 	// Args are the function arguments.
 	Args []any
+
+	// Value is the optional msg.value to send with the call. When any call in
+	// a chunk has a value, the chunk is sent via aggregate3Value with the
+	// summed value attached to the eth_call.
+	Value *big.Int
 }
 
 // MulticallParameters contains the parameters for the Multicall action.
@@ -51,9 +70,14 @@ type MulticallParameters struct {
 	// Contracts is the list of contract calls to execute.
 	Contracts []MulticallContract
 
+	// ABI is the default ABI for contracts that do not set their own.
+	// A per-contract ABI always takes precedence.
+	ABI *abi.ABI
+
 	// AllowFailure determines whether to continue if individual calls fail.
 	// If true, failed calls will be marked with status "failure" but won't
-	// stop the entire multicall. Default is true.
+	// stop the entire multicall. If false, a *MulticallAggregateError listing
+	// every failed call is returned instead of results. Default is true.
 	AllowFailure *bool
 
 	// BatchSize is the maximum size in bytes for each batch of calls.
@@ -65,6 +89,14 @@ type MulticallParameters struct {
 	// This allows multicall on chains without a deployed multicall3 contract.
 	Deployless bool
 
+	// DeploylessBytecode overrides the multicall3-compatible contract code
+	// executed by deployless multicalls, for chains or forks that need a
+	// custom implementation. It must expose aggregate3 (and aggregate3Value
+	// or tryBlockAndAggregate if those are used). Defaults to
+	// constants.Multicall3Bytecode. Calls with custom bytecode are never
+	// merged with other callers' calls.
+	DeploylessBytecode []byte
+
 	ShouldBatch bool
 
 	// MulticallAddress overrides the default multicall3 contract address.
@@ -79,9 +111,26 @@ type MulticallParameters struct {
 	BlockTag BlockTag
 
 	// MaxConcurrentChunks limits the number of concurrent chunk executions.
-	// This prevents overwhelming RPC endpoints. Default is 4.
-	// Set to 0 or negative for unlimited concurrency.
+	// This prevents overwhelming RPC endpoints.
+	// Default: 10
 	MaxConcurrentChunks int
+
+	// StateOverride contains state overrides applied to every chunk's eth_call.
+	StateOverride types.StateOverride
+
+	// BlockOverrides contains block-level overrides applied to every chunk's eth_call.
+	BlockOverrides *types.BlockOverrides
+
+	// ReturnBlockNumber executes the calls via tryBlockAndAggregate and sets
+	// MulticallResult.BlockNumber to the block they executed against. When
+	// the calls span several chunks, the remaining chunks are pinned to the
+	// first chunk's block. Calls with a Value are not supported.
+	ReturnBlockNumber bool
+
+	// Timeout bounds each chunk's eth_call independently of ctx. A chunk
+	// that times out fails with a *CallTimeoutError.
+	// Default: no timeout beyond ctx.
+	Timeout time.Duration
 }
 
 // MulticallResult represents the result of a single contract call in a multicall.
@@ -94,10 +143,28 @@ type MulticallResult struct {
 
 	// Error contains the error if Status is "failure".
 	Error error
+
+	// BlockNumber is the block the batch executed against. It is only set
+	// when MulticallParameters.ReturnBlockNumber is true, and is the same
+	// for every result of a call.
+	BlockNumber *uint64
+}
+
+// MulticallResults is a slice of multicall results with typed decoding helpers.
+// It is assignable to and from []MulticallResult.
+type MulticallResults []MulticallResult
+
+// BlockNumber returns the block the batch executed against, or false if the
+// multicall was not made with ReturnBlockNumber.
+func (r MulticallResults) BlockNumber() (uint64, bool) {
+	if len(r) == 0 || r[0].BlockNumber == nil {
+		return 0, false
+	}
+	return *r[0].BlockNumber, true
 }
 
 // MulticallReturnType is the return type for the Multicall action.
-type MulticallReturnType = []MulticallResult
+type MulticallReturnType = MulticallResults
 
 // Call3 represents a single call in the aggregate3 function.
 // The struct tags match the Multicall3 ABI parameter names.
@@ -105,6 +172,10 @@ type Call3 struct {
 	Target       common.Address `abi:"target"`
 	AllowFailure bool           `abi:"allowFailure"`
 	CallData     []byte         `abi:"callData"`
+
+	// Value is the msg.value forwarded to the call. It is only encoded when
+	// a chunk contains at least one call with a value (aggregate3Value).
+	Value *big.Int `abi:"value"`
 }
 
 // aggregate3Result represents the result from aggregate3.
@@ -149,12 +220,6 @@ type decodeResult struct {
 	result MulticallResult
 }
 
-// chunkJob represents a chunk to execute.
-type chunkJob struct {
-	chunkIndex int
-	chunk      []Call3
-}
-
 // getNumWorkers returns the number of workers to use based on job count.
 // Uses GOMAXPROCS but caps at job count to avoid idle workers.
 func getNumWorkers(numJobs int) int {
@@ -195,8 +260,15 @@ func getNumWorkers(numJobs int) int {
 //	    },
 //	})
 func Multicall(ctx context.Context, client Client, params MulticallParameters) (MulticallReturnType, error) {
-	// Check if client has multicall batch aggregation enabled
-	if params.ShouldBatch {
+	contracts, err := resolveMulticallContracts(params)
+	if err != nil {
+		return nil, err
+	}
+	params.Contracts = contracts
+
+	// Check if client has multicall batch aggregation enabled.
+	// Calls with overrides are never merged with other callers' contracts.
+	if params.ShouldBatch && !hasMulticallOverrides(params) && !params.ReturnBlockNumber {
 		if batch := client.Batch(); batch != nil && batch.Multicall != nil {
 			batcher := getMulticallBatcher(client, batch.Multicall)
 			if batcher != nil {
@@ -216,7 +288,13 @@ func Multicall(ctx context.Context, client Client, params MulticallParameters) (
 // Use this instead of Multicall when you know multiple goroutines will call it
 // concurrently (e.g., resolving N tokens in parallel).
 func MulticallConcurrent(ctx context.Context, client Client, params MulticallParameters) (MulticallReturnType, error) {
-	if batch := client.Batch(); batch != nil && batch.Multicall != nil {
+	contracts, err := resolveMulticallContracts(params)
+	if err != nil {
+		return nil, err
+	}
+	params.Contracts = contracts
+
+	if batch := client.Batch(); batch != nil && batch.Multicall != nil && !hasMulticallOverrides(params) && !params.ReturnBlockNumber {
 		batcher := getMulticallBatcher(client, batch.Multicall)
 		if batcher != nil {
 			return batcher.ScheduleConcurrent(ctx, params)
@@ -241,9 +319,8 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 		batchSize = 8192
 	}
 
-	maxConcurrent := params.MaxConcurrentChunks
-	if maxConcurrent <= 0 {
-		maxConcurrent = 10
+	if params.Deployless && params.DeploylessBytecode != nil && len(params.DeploylessBytecode) == 0 {
+		return nil, ErrEmptyDeploylessBytecode
 	}
 
 	// Resolve multicall address
@@ -269,9 +346,9 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 			callData, encodeErr := contract.ABI.EncodeFunctionData(contract.FunctionName, contract.Args...)
 			if encodeErr != nil {
 				encodeErrors[i] = fmt.Errorf("failed to encode call for %q: %w", contract.FunctionName, encodeErr)
-				encodedCalls[i] = Call3{Target: contract.Address, AllowFailure: true}
+				encodedCalls[i] = Call3{Target: contract.Address, AllowFailure: true, Value: contract.Value}
 			} else {
-				encodedCalls[i] = Call3{Target: contract.Address, AllowFailure: true, CallData: callData}
+				encodedCalls[i] = Call3{Target: contract.Address, AllowFailure: true, CallData: callData, Value: contract.Value}
 			}
 		}
 	} else {
@@ -293,14 +370,14 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 					if encodeErr != nil {
 						encodeResults <- encodeResult{
 							index:     job.index,
-							call:      Call3{Target: job.contract.Address, AllowFailure: true},
+							call:      Call3{Target: job.contract.Address, AllowFailure: true, Value: job.contract.Value},
 							parsedABI: parsedABI,
 							err:       fmt.Errorf("failed to encode call for %q: %w", job.contract.FunctionName, encodeErr),
 						}
 					} else {
 						encodeResults <- encodeResult{
 							index:     job.index,
-							call:      Call3{Target: job.contract.Address, AllowFailure: true, CallData: callData},
+							call:      Call3{Target: job.contract.Address, AllowFailure: true, CallData: callData, Value: job.contract.Value},
 							parsedABI: parsedABI,
 						}
 					}
@@ -330,59 +407,29 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 	// ============================================================
 	// PHASE 2: Chunk Calls and Execute with Workers
 	// ============================================================
-	chunkedCalls := chunkCalls(encodedCalls, batchSize)
-	numChunks := len(chunkedCalls)
-	chunkResults := make([]*chunkResult, numChunks)
-
-	if numChunks == 1 {
-		// Single chunk - no need for workers
-		result, execErr := executeChunk(ctx, client, chunkedCalls[0], multicallAddress, params)
-		chunkResults[0] = &chunkResult{Results: result, Err: execErr}
-	} else {
-		// Use worker pool for parallel RPC execution
-		chunkJobs := make(chan chunkJob, numChunks)
-		chunkResultsChan := make(chan struct {
-			index  int
-			result *chunkResult
-		}, numChunks)
-
-		numChunkWorkers := maxConcurrent
-		if numChunkWorkers > numChunks {
-			numChunkWorkers = numChunks
+	if params.ReturnBlockNumber {
+		if totalValue, valueErr := sumCall3Values(encodedCalls); valueErr != nil {
+			return nil, valueErr
+		} else if totalValue != nil {
+			return nil, fmt.Errorf("multicall: ReturnBlockNumber does not support calls with a value")
 		}
+	}
 
-		var chunkWg sync.WaitGroup
-		chunkWg.Add(numChunkWorkers)
-
-		// Start RPC execution workers
-		for w := 0; w < numChunkWorkers; w++ {
-			go func() {
-				defer chunkWg.Done()
-				for job := range chunkJobs {
-					result, execErr := executeChunk(ctx, client, job.chunk, multicallAddress, params)
-					chunkResultsChan <- struct {
-						index  int
-						result *chunkResult
-					}{job.chunkIndex, &chunkResult{Results: result, Err: execErr}}
-				}
-			}()
-		}
-
-		// Send chunk jobs
-		for i, chunk := range chunkedCalls {
-			chunkJobs <- chunkJob{chunkIndex: i, chunk: chunk}
-		}
-		close(chunkJobs)
-
-		// Collect results
-		go func() {
-			chunkWg.Wait()
-			close(chunkResultsChan)
-		}()
+	chunkedCalls := chunkCalls(encodedCalls, batchSize)
 
-		for res := range chunkResultsChan {
-			chunkResults[res.index] = res.result
+	var (
+		chunkResults []*chunkResult
+		blockNumber  *uint64
+	)
+	if params.ReturnBlockNumber {
+		blockNumber, chunkResults, err = executeChunksAtBlock(ctx, client, chunkedCalls, multicallAddress, params)
+		if err != nil {
+			return nil, err
 		}
+	} else {
+		chunkResults = runChunks(ctx, chunkedCalls, params.MaxConcurrentChunks, func(chunk []Call3) ([]aggregate3Result, error) {
+			return executeChunk(ctx, client, chunk, multicallAddress, params)
+		})
 	}
 
 	// ============================================================
@@ -471,12 +518,16 @@ func multicallDirect(ctx context.Context, client Client, params MulticallParamet
 		}
 	}
 
-	// Check for early failure if allowFailure is false
+	if blockNumber != nil {
+		for i := range results {
+			results[i].BlockNumber = blockNumber
+		}
+	}
+
+	// In strict mode, report every failed call together
 	if !allowFailure {
-		for _, r := range results {
-			if r.Status == "failure" {
-				return nil, r.Error
-			}
+		if aggErr := collectMulticallFailures(results); aggErr != nil {
+			return nil, aggErr
 		}
 	}
 
@@ -565,14 +616,102 @@ func chunkCalls(calls []Call3, batchSize int) [][]Call3 {
 	return chunks
 }
 
+// runChunks executes every chunk with exec, running at most maxConcurrent
+// chunks at a time. Results are ordered like chunks.
+func runChunks(ctx context.Context, chunks [][]Call3, maxConcurrent int, exec func([]Call3) ([]aggregate3Result, error)) []*chunkResult {
+	chunkResults := make([]*chunkResult, len(chunks))
+
+	if len(chunks) == 1 {
+		// Single chunk - no need for goroutines
+		result, execErr := exec(chunks[0])
+		chunkResults[0] = &chunkResult{Results: result, Err: execErr}
+		return chunkResults
+	}
+
+	// Chunk errors are reported per chunk, so the group never fails
+	g, _ := concurrency.BoundedGroup(ctx, maxConcurrent)
+	for i, chunk := range chunks {
+		g.Go(func() error {
+			result, execErr := exec(chunk)
+			chunkResults[i] = &chunkResult{Results: result, Err: execErr}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	return chunkResults
+}
+
+// executeChunksAtBlock executes the chunks via tryBlockAndAggregate. The
+// first chunk runs alone to learn the block number; the remaining chunks are
+// then pinned to that block so all results come from the same state.
+func executeChunksAtBlock(ctx context.Context, client Client, chunks [][]Call3, multicallAddress *common.Address, params MulticallParameters) (*uint64, []*chunkResult, error) {
+	blockNumber, first, err := executeTryBlockAndAggregate(ctx, client, chunks[0], multicallAddress, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pinned := params
+	pinned.BlockNumber = &blockNumber
+	pinned.BlockTag = ""
+
+	chunkResults := []*chunkResult{{Results: first}}
+	if len(chunks) > 1 {
+		rest := runChunks(ctx, chunks[1:], params.MaxConcurrentChunks, func(chunk []Call3) ([]aggregate3Result, error) {
+			_, results, execErr := executeTryBlockAndAggregate(ctx, client, chunk, multicallAddress, pinned)
+			return results, execErr
+		})
+		chunkResults = append(chunkResults, rest...)
+	}
+	return &blockNumber, chunkResults, nil
+}
+
+// executeTryBlockAndAggregate executes a single chunk of calls via
+// multicall3's tryBlockAndAggregate, returning the block number alongside
+// the results. requireSuccess is always false; failures are handled like
+// aggregate3 results.
+func executeTryBlockAndAggregate(ctx context.Context, client Client, calls []Call3, multicallAddress *common.Address, params MulticallParameters) (uint64, []aggregate3Result, error) {
+	encoded := encodeTryBlockAndAggregateFast(false, calls)
+	calldata := make([]byte, len(tryBlockAndAggregateSelector)+len(encoded))
+	copy(calldata, tryBlockAndAggregateSelector)
+	copy(calldata[len(tryBlockAndAggregateSelector):], encoded)
+
+	resultData, err := multicallEthCall(ctx, client, calldata, nil, multicallAddress, params)
+	if err != nil {
+		return 0, nil, err
+	}
+	return decodeTryBlockAndAggregateFast(resultData)
+}
+
 // executeChunk executes a single chunk of calls via multicall3.
 func executeChunk(ctx context.Context, client Client, calls []Call3, multicallAddress *common.Address, params MulticallParameters) ([]aggregate3Result, error) {
-	// Encode aggregate3 call
-	calldata, err := encodeAggregate3(calls)
+	// Encode aggregate3 call, switching to aggregate3Value for payable chunks
+	totalValue, err := sumCall3Values(calls)
 	if err != nil {
-		return nil, fmt.Errorf("failed to encode aggregate3: %w", err)
+		return nil, err
 	}
 
+	var calldata []byte
+	if totalValue != nil {
+		calldata = encodeAggregate3Value(calls)
+	} else {
+		calldata, err = encodeAggregate3(calls)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode aggregate3: %w", err)
+		}
+	}
+
+	resultData, err := multicallEthCall(ctx, client, calldata, totalValue, multicallAddress, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Decode aggregate3 result
+	return decodeAggregate3Result(resultData)
+}
+
+// multicallEthCall sends multicall3 calldata via eth_call, either to
+// multicallAddress or deployless, and returns the raw result.
+func multicallEthCall(ctx context.Context, client Client, calldata []byte, totalValue *big.Int, multicallAddress *common.Address, params MulticallParameters) ([]byte, error) {
 	// Build call request
 	blockTag := resolveBlockTag(client, params.BlockNumber, params.BlockTag)
 
@@ -581,10 +720,11 @@ func executeChunk(ctx context.Context, client Client, calls []Call3, multicallAd
 
 	if params.Deployless || multicallAddress == nil {
 		// Deployless multicall - wrap in deployless bytecode
-		deploylessData, deploylessErr := deployless.ToDeploylessCallViaBytecodeData(
-			common.FromHex(constants.Multicall3Bytecode),
-			calldata,
-		)
+		bytecode := params.DeploylessBytecode
+		if len(bytecode) == 0 {
+			bytecode = common.FromHex(constants.Multicall3Bytecode)
+		}
+		deploylessData, deploylessErr := deployless.ToDeploylessCallViaBytecodeData(bytecode, calldata)
 		if deploylessErr != nil {
 			return nil, fmt.Errorf("failed to encode deployless multicall: %w", deploylessErr)
 		}
@@ -596,11 +736,38 @@ func executeChunk(ctx context.Context, client Client, calls []Call3, multicallAd
 		}
 	}
 
+	if totalValue != nil {
+		req.Value = hexutil.EncodeBig(totalValue)
+	}
+
 	rpcParams = []any{req, blockTag}
 
+	// Append overrides, mirroring the eth_call params layout used by Call
+	rpcBlockOverrides := blockoverride.SerializeBlockOverrides(params.BlockOverrides)
+	rpcStateOverride, err := stateoverride.SerializeStateOverride(params.StateOverride)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize state override: %w", err)
+	}
+	if rpcStateOverride != nil && rpcBlockOverrides != nil {
+		rpcParams = append(rpcParams, rpcStateOverride, rpcBlockOverrides)
+	} else if rpcStateOverride != nil {
+		rpcParams = append(rpcParams, rpcStateOverride)
+	} else if rpcBlockOverrides != nil {
+		rpcParams = append(rpcParams, map[string]any{}, rpcBlockOverrides)
+	}
+
 	// Execute call
-	resp, requestErr := client.Request(ctx, "eth_call", rpcParams...)
+	callCtx := ctx
+	if params.Timeout > 0 {
+		var cancel context.CancelFunc
+		callCtx, cancel = context.WithTimeout(ctx, params.Timeout)
+		defer cancel()
+	}
+	resp, requestErr := client.Request(callCtx, "eth_call", rpcParams...)
 	if requestErr != nil {
+		if params.Timeout > 0 && ctx.Err() == nil && callCtx.Err() == context.DeadlineExceeded {
+			return nil, newCallTimeoutError(requestErr, params.Timeout, multicallAddress)
+		}
 		return nil, fmt.Errorf("eth_call failed: %w", requestErr)
 	}
 
@@ -609,9 +776,7 @@ func executeChunk(ctx context.Context, client Client, calls []Call3, multicallAd
 		return nil, fmt.Errorf("failed to unmarshal result: %w", unmarshalErr)
 	}
 
-	// Decode aggregate3 result
-	resultData := common.FromHex(hexResult)
-	return decodeAggregate3Result(resultData)
+	return common.FromHex(hexResult), nil
 }
 
 // encodeAggregate3 encodes calls for the aggregate3 function.
@@ -630,6 +795,35 @@ func encodeAggregate3(calls []Call3) ([]byte, error) {
 	return result, nil
 }
 
+// encodeAggregate3Value encodes calls for the aggregate3Value function.
+func encodeAggregate3Value(calls []Call3) []byte {
+	encoded := encodeAggregate3ValueFast(calls)
+
+	result := make([]byte, len(aggregate3ValueSelector)+len(encoded))
+	copy(result, aggregate3ValueSelector)
+	copy(result[len(aggregate3ValueSelector):], encoded)
+	return result
+}
+
+// sumCall3Values returns the total msg.value of the calls, or nil when no
+// call carries a non-zero value and plain aggregate3 can be used.
+func sumCall3Values(calls []Call3) (*big.Int, error) {
+	var total *big.Int
+	for _, c := range calls {
+		if c.Value == nil || c.Value.Sign() == 0 {
+			continue
+		}
+		if c.Value.Sign() < 0 || c.Value.BitLen() > 256 {
+			return nil, fmt.Errorf("multicall: invalid value %s for call to %s", c.Value, c.Target.Hex())
+		}
+		if total == nil {
+			total = new(big.Int)
+		}
+		total.Add(total, c.Value)
+	}
+	return total, nil
+}
+
 // decodeAggregate3Result decodes the result from aggregate3.
 // Uses a hand-rolled ABI decoder that reads directly from bytes -- zero reflect,
 // zero big.Int allocations, direct byte slicing. This is ~50-100x faster than
@@ -638,6 +832,34 @@ func decodeAggregate3Result(data []byte) ([]aggregate3Result, error) {
 	return decodeAggregate3Fast(data)
 }
 
+// hasMulticallOverrides reports whether the multicall carries state or block
+// overrides or custom deployless bytecode, which must not leak into calls
+// aggregated from other goroutines.
+func hasMulticallOverrides(params MulticallParameters) bool {
+	return len(params.StateOverride) > 0 || params.BlockOverrides != nil || params.DeploylessBytecode != nil
+}
+
+// resolveMulticallContracts applies the default ABI to contracts that omit one.
+// The caller's slice is only copied when a default actually has to be filled in.
+func resolveMulticallContracts(params MulticallParameters) ([]MulticallContract, error) {
+	contracts := params.Contracts
+	copied := false
+	for i, contract := range contracts {
+		if contract.ABI != nil {
+			continue
+		}
+		if params.ABI == nil {
+			return nil, &MulticallABINotFoundError{Index: i, FunctionName: contract.FunctionName}
+		}
+		if !copied {
+			contracts = append([]MulticallContract(nil), contracts...)
+			copied = true
+		}
+		contracts[i].ABI = params.ABI
+	}
+	return contracts, nil
+}
+
 // resolveMulticallAddress determines the multicall3 contract address.
 func resolveMulticallAddress(client Client, params MulticallParameters) (*common.Address, error) {
 	// Use provided address if specified
@@ -691,9 +913,82 @@ func parseABIParam(abiParam any) (*abi.ABI, error) {
 	}
 }
 
+// ErrEmptyDeploylessBytecode is returned when a deployless multicall sets
+// DeploylessBytecode to an empty, non-nil slice.
+var ErrEmptyDeploylessBytecode = errors.New("multicall: DeploylessBytecode is empty")
+
 // AbiDecodingZeroDataError is returned when trying to decode zero data.
 type AbiDecodingZeroDataError struct{}
 
 func (e *AbiDecodingZeroDataError) Error() string {
 	return "cannot decode zero data (0x) - the function may have reverted"
 }
+
+// MulticallABINotFoundError is returned when a contract has no ABI and
+// MulticallParameters.ABI is not set.
+type MulticallABINotFoundError struct {
+	Index        int
+	FunctionName string
+}
+
+func (e *MulticallABINotFoundError) Error() string {
+	return fmt.Sprintf("multicall: no ABI for contract %d (%q); set MulticallContract.ABI or MulticallParameters.ABI", e.Index, e.FunctionName)
+}
+
+// collectMulticallFailures returns a *MulticallAggregateError covering every
+// failed result, or nil if all calls succeeded.
+func collectMulticallFailures(results []MulticallResult) *MulticallAggregateError {
+	var failures []MulticallFailure
+	for i, r := range results {
+		if r.Status == "failure" {
+			failures = append(failures, MulticallFailure{Index: i, Err: r.Error})
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	return &MulticallAggregateError{Total: len(results), Failures: failures}
+}
+
+// MulticallFailure is a single failed call within a strict multicall.
+type MulticallFailure struct {
+	// Index is the position of the call in MulticallParameters.Contracts.
+	Index int
+
+	// Err is the decoded error for the call.
+	Err error
+}
+
+// MulticallAggregateError is returned when AllowFailure is false and one or
+// more calls fail. It lists every failed call rather than only the first.
+type MulticallAggregateError struct {
+	// Total is the number of calls in the multicall.
+	Total int
+
+	// Failures holds each failed call in index order.
+	Failures []MulticallFailure
+}
+
+func (e *MulticallAggregateError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "multicall: %d of %d calls failed: ", len(e.Failures), e.Total)
+	for i, f := range e.Failures {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "[%d]: %v", f.Index, f.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the individual call errors so errors.Is and errors.As
+// can match any of them.
+func (e *MulticallAggregateError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failures))
+	for _, f := range e.Failures {
+		if f.Err != nil {
+			errs = append(errs, f.Err)
+		}
+	}
+	return errs
+}
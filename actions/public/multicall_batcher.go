@@ -267,11 +267,8 @@ func (b *MulticallBatcher) flushLocked() {
 
 				// If the original caller had AllowFailure=false, check for failures
 				if p.entry.params.AllowFailure != nil && !*p.entry.params.AllowFailure {
-					for _, cr := range callerResults {
-						if cr.Status == "failure" {
-							result.err = cr.Error
-							break
-						}
+					if aggErr := collectMulticallFailures(callerResults); aggErr != nil {
+						result.err = aggErr
 					}
 				}
 
@@ -3,6 +3,8 @@ package public
 import (
 	"encoding/binary"
 	"fmt"
+
+	"github.com/ChefBingbong/viem-go/abi"
 )
 
 // Hand-rolled ABI encoder/decoder for the multicall3 aggregate3 function.
@@ -14,7 +16,10 @@ import (
 //
 // The ABI layouts are fixed and known at compile time:
 //   Encode: aggregate3(tuple(address,bool,bytes)[])
+//           aggregate3Value(tuple(address,bool,uint256,bytes)[])
+//           tryBlockAndAggregate(bool,tuple(address,bytes)[])
 //   Decode: returns tuple(bool,bytes)[]
+//           tryBlockAndAggregate returns (uint256,bytes32,tuple(bool,bytes)[])
 
 // pad32 rounds n up to the nearest multiple of 32.
 func pad32(n int) int {
@@ -27,10 +32,30 @@ func writeUint256(buf []byte, off int, v uint64) {
 	binary.BigEndian.PutUint64(buf[off+24:off+32], v)
 }
 
-// readUint256AsInt reads a big-endian uint256 at buf[off:off+32] as an int.
-// Only reads the low 8 bytes since aggregate3 array sizes/offsets fit in uint64.
-func readUint256AsInt(buf []byte, off int) int {
-	return int(binary.BigEndian.Uint64(buf[off+24 : off+32]))
+// readSize reads the big-endian uint256 at buf[off:off+32] as an offset or
+// length. Return data comes from the node, so the word is bounds-checked: an
+// offset or length can never exceed len(buf), which also keeps the sums
+// callers build from it from overflowing.
+func readSize(buf []byte, off int) (int, error) {
+	if off < 0 || off > len(buf)-32 {
+		return 0, decodingError("word at %d out of bounds (data len %d)", off, len(buf))
+	}
+	word := buf[off : off+32]
+	for _, b := range word[:24] {
+		if b != 0 {
+			return 0, decodingError("value at %d exceeds data length %d", off, len(buf))
+		}
+	}
+	v := binary.BigEndian.Uint64(word[24:])
+	if v > uint64(len(buf)) {
+		return 0, decodingError("value %d at %d exceeds data length %d", v, off, len(buf))
+	}
+	return int(v), nil
+}
+
+// decodingError returns an *abi.AbiDecodingError for malformed return data.
+func decodingError(format string, args ...any) error {
+	return &abi.AbiDecodingError{Message: fmt.Sprintf(format, args...)}
 }
 
 // encodeAggregate3Fast encodes Call3 structs directly to ABI-encoded bytes.
@@ -53,6 +78,26 @@ func readUint256AsInt(buf []byte, off int) int {
 //	[callData length]             (32 bytes)
 //	[callData right-padded to 32] (ceil32 bytes)
 func encodeAggregate3Fast(calls []Call3) []byte {
+	return encodeCall3Array(calls, false)
+}
+
+// encodeAggregate3ValueFast encodes Call3 structs as aggregate3Value's
+// tuple(address target, bool allowFailure, uint256 value, bytes callData)[].
+//
+// The layout matches encodeAggregate3Fast with an extra value head word,
+// so the offset to callData becomes 128 (4*32).
+func encodeAggregate3ValueFast(calls []Call3) []byte {
+	return encodeCall3Array(calls, true)
+}
+
+// encodeCall3Array encodes calls as a tuple array, optionally including the
+// uint256 value word required by aggregate3Value.
+func encodeCall3Array(calls []Call3, withValue bool) []byte {
+	headSize := 96
+	if withValue {
+		headSize = 128
+	}
+
 	n := len(calls)
 	if n == 0 {
 		buf := make([]byte, 64)
@@ -64,8 +109,8 @@ func encodeAggregate3Fast(calls []Call3) []byte {
 	tupleSizes := make([]int, n)
 	totalTupleData := 0
 	for i, c := range calls {
-		// head(3*32) + callData length(32) + padded callData
-		sz := 128 + pad32(len(c.CallData))
+		// head + callData length(32) + padded callData
+		sz := headSize + 32 + pad32(len(c.CallData))
 		tupleSizes[i] = sz
 		totalTupleData += sz
 	}
@@ -99,8 +144,16 @@ func encodeAggregate3Fast(calls []Call3) []byte {
 		}
 		pos += 32
 
-		// offset to callData = 96 (always 3 head words * 32)
-		writeUint256(buf, pos, 96)
+		// value (uint256, aggregate3Value only)
+		if withValue {
+			if c.Value != nil {
+				c.Value.FillBytes(buf[pos : pos+32])
+			}
+			pos += 32
+		}
+
+		// offset to callData, pointing just past the head words
+		writeUint256(buf, pos, uint64(headSize))
 		pos += 32
 
 		// callData length
@@ -117,6 +170,75 @@ func encodeAggregate3Fast(calls []Call3) []byte {
 	return buf
 }
 
+// encodeTryBlockAndAggregateFast encodes the arguments of
+// tryBlockAndAggregate(bool requireSuccess, tuple(address target, bytes callData)[]).
+// Call3.AllowFailure and Call3.Value are not part of this layout.
+//
+//	[requireSuccess as uint256]                     (32 bytes)
+//	[offset to array = 64]                          (32 bytes)
+//	[array length = N]                              (32 bytes)
+//	[offset to tuple[0], tuple[1], ... tuple[N-1]]  (N * 32 bytes)
+//	[tuple data]                                    (variable)
+//
+// Each tuple (address, bytes):
+//
+//	[address left-padded to 32]   (32 bytes)
+//	[offset to bytes = 64]        (32 bytes)
+//	[callData length]             (32 bytes)
+//	[callData right-padded to 32] (ceil32 bytes)
+func encodeTryBlockAndAggregateFast(requireSuccess bool, calls []Call3) []byte {
+	const headSize = 64
+	n := len(calls)
+
+	totalTupleData := 0
+	for _, c := range calls {
+		totalTupleData += headSize + 32 + pad32(len(c.CallData))
+	}
+
+	buf := make([]byte, 96+n*32+totalTupleData)
+	if requireSuccess {
+		buf[31] = 1
+	}
+	writeUint256(buf, 32, 64)
+	writeUint256(buf, 64, uint64(n))
+
+	tupleOffset := n * 32
+	for i, c := range calls {
+		writeUint256(buf, 96+i*32, uint64(tupleOffset))
+		tupleOffset += headSize + 32 + pad32(len(c.CallData))
+	}
+
+	pos := 96 + n*32
+	for _, c := range calls {
+		copy(buf[pos+12:pos+32], c.Target[:])
+		writeUint256(buf, pos+32, headSize)
+		writeUint256(buf, pos+64, uint64(len(c.CallData)))
+		copy(buf[pos+96:], c.CallData)
+		pos += headSize + 32 + pad32(len(c.CallData))
+	}
+
+	return buf
+}
+
+// decodeTryBlockAndAggregateFast decodes tryBlockAndAggregate return data:
+// (uint256 blockNumber, bytes32 blockHash, tuple(bool,bytes)[] returnData).
+// The result array has the same layout as aggregate3's.
+func decodeTryBlockAndAggregateFast(data []byte) (uint64, []aggregate3Result, error) {
+	if len(data) < 128 {
+		return 0, nil, decodingError("tryBlockAndAggregate result too short: %d bytes", len(data))
+	}
+	blockNumber := binary.BigEndian.Uint64(data[24:32])
+	offset, err := readSize(data, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	results, err := decodeResultArray(data, offset)
+	if err != nil {
+		return 0, nil, err
+	}
+	return blockNumber, results, nil
+}
+
 // decodeAggregate3Fast decodes aggregate3 return data directly from ABI bytes.
 // Zero reflection, zero big.Int allocations, direct byte slicing.
 //
@@ -134,57 +256,71 @@ func encodeAggregate3Fast(calls []Call3) []byte {
 //	[offset to bytes = 64]          (32 bytes)  -- always 2*32
 //	[returnData length]             (32 bytes)
 //	[returnData right-padded to 32] (ceil32 bytes)
+//
+// Every offset and length is bounds-checked against the data, so malformed
+// data from a buggy or hostile node yields an *abi.AbiDecodingError rather
+// than a panic.
 func decodeAggregate3Fast(data []byte) ([]aggregate3Result, error) {
 	if len(data) < 64 {
-		return nil, fmt.Errorf("aggregate3 result too short: %d bytes", len(data))
+		return nil, decodingError("aggregate3 result too short: %d bytes", len(data))
 	}
 
 	// Read outer offset (should be 32)
-	offset := readUint256AsInt(data, 0)
-	if offset < 0 || offset+32 > len(data) {
-		return nil, fmt.Errorf("aggregate3: invalid array offset %d (data len %d)", offset, len(data))
+	offset, err := readSize(data, 0)
+	if err != nil {
+		return nil, err
 	}
+	return decodeResultArray(data, offset)
+}
 
+// decodeResultArray decodes the tuple(bool,bytes)[] whose length word is at
+// data[offset:].
+func decodeResultArray(data []byte, offset int) ([]aggregate3Result, error) {
 	// Read array length
-	n := readUint256AsInt(data, offset)
-	if n < 0 || n > 1000000 {
-		return nil, fmt.Errorf("aggregate3: invalid array length %d", n)
+	n, err := readSize(data, offset)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate3: invalid array: %w", err)
 	}
 	if n == 0 {
 		return []aggregate3Result{}, nil
 	}
 
-	// Offsets area starts right after the length word
+	// Offsets area starts right after the length word. Each element needs at
+	// least its 32-byte offset, so n cannot exceed the remaining words.
 	offsetsStart := offset + 32
-	if offsetsStart+n*32 > len(data) {
-		return nil, fmt.Errorf("aggregate3: data too short for %d tuple offsets", n)
+	if n > (len(data)-offsetsStart)/32 {
+		return nil, decodingError("aggregate3: data too short for %d tuple offsets", n)
 	}
 
 	results := make([]aggregate3Result, n)
 	for i := 0; i < n; i++ {
 		// Read offset to this tuple (relative to offsetsStart)
-		tupleRel := readUint256AsInt(data, offsetsStart+i*32)
+		tupleRel, err := readSize(data, offsetsStart+i*32)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate3: tuple %d: %w", i, err)
+		}
 		tupleStart := offsetsStart + tupleRel
-
-		if tupleStart+64 > len(data) {
-			return nil, fmt.Errorf("aggregate3: tuple %d start out of bounds (offset %d, data len %d)", i, tupleStart, len(data))
+		if tupleStart > len(data)-64 {
+			return nil, decodingError("aggregate3: tuple %d start out of bounds (offset %d, data len %d)", i, tupleStart, len(data))
 		}
 
 		// success = bool at tupleStart (check byte 31 of the uint256 word)
 		results[i].Success = data[tupleStart+31] == 1
 
 		// Read offset to returnData (relative to tupleStart, should be 64 for (bool,bytes))
-		rdOffset := readUint256AsInt(data, tupleStart+32)
-		rdStart := tupleStart + rdOffset
-
-		if rdStart+32 > len(data) {
-			return nil, fmt.Errorf("aggregate3: returnData offset out of bounds for tuple %d", i)
+		rdOffset, err := readSize(data, tupleStart+32)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate3: tuple %d returnData offset: %w", i, err)
 		}
+		rdStart := tupleStart + rdOffset
 
 		// returnData length
-		rdLen := readUint256AsInt(data, rdStart)
-		if rdLen < 0 || rdStart+32+rdLen > len(data) {
-			return nil, fmt.Errorf("aggregate3: returnData out of bounds for tuple %d (len %d, available %d)", i, rdLen, len(data)-rdStart-32)
+		rdLen, err := readSize(data, rdStart)
+		if err != nil {
+			return nil, fmt.Errorf("aggregate3: tuple %d returnData: %w", i, err)
+		}
+		if rdLen > len(data)-rdStart-32 {
+			return nil, decodingError("aggregate3: returnData out of bounds for tuple %d (len %d, available %d)", i, rdLen, len(data)-rdStart-32)
 		}
 
 		// Extract returnData (copy to own slice to avoid holding the entire response buffer)
@@ -0,0 +1,131 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// TypedMulticallResult is a MulticallResult whose Result has been converted to T.
+type TypedMulticallResult[T any] struct {
+	// Status is either "success" or "failure".
+	Status string
+
+	// Result contains the typed return value if Status is "success".
+	Result T
+
+	// Error contains the error if Status is "failure".
+	Error error
+}
+
+// MulticallTyped executes a multicall and converts each result to T.
+//
+// All contracts are expected to return the same shape. Single return values
+// are assigned directly (e.g. T = *big.Int for balanceOf), while functions
+// with multiple outputs fill the exported fields of a struct T in order.
+// A result that cannot be converted to T is reported as a failure entry,
+// following the same AllowFailure semantics as Multicall.
+//
+// Example:
+//
+//	balances, err := public.MulticallTyped[*big.Int](ctx, client, public.MulticallParameters{
+//	    Contracts: []public.MulticallContract{
+//	        {Address: token, ABI: erc20ABI, FunctionName: "balanceOf", Args: []any{alice}},
+//	        {Address: token, ABI: erc20ABI, FunctionName: "balanceOf", Args: []any{bob}},
+//	    },
+//	})
+func MulticallTyped[T any](ctx context.Context, client Client, params MulticallParameters) ([]TypedMulticallResult[T], error) {
+	results, err := Multicall(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	allowFailure := params.AllowFailure == nil || *params.AllowFailure
+
+	typed := make([]TypedMulticallResult[T], len(results))
+	for i := range results {
+		var value T
+		if err := results.DecodeAs(i, &value); err != nil {
+			if !allowFailure {
+				return nil, err
+			}
+			typed[i] = TypedMulticallResult[T]{Status: "failure", Error: err}
+			continue
+		}
+		typed[i] = TypedMulticallResult[T]{Status: "success", Result: value}
+	}
+
+	return typed, nil
+}
+
+// DecodeAs converts the result at index i into out, which must be a non-nil pointer.
+//
+// If the call failed, its error is returned. Values assignable to the
+// pointed-to type are set directly; multi-output results ([]any) are
+// copied into the exported fields of a struct in declaration order.
+func (r MulticallResults) DecodeAs(i int, out any) error {
+	if i < 0 || i >= len(r) {
+		return fmt.Errorf("multicall result index %d out of range [0, %d)", i, len(r))
+	}
+	result := r[i]
+	if result.Status != "success" {
+		if result.Error != nil {
+			return result.Error
+		}
+		return fmt.Errorf("multicall result %d has status %q", i, result.Status)
+	}
+
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return fmt.Errorf("output must be a non-nil pointer, got %T", out)
+	}
+	if err := assignDecoded(rv.Elem(), result.Result); err != nil {
+		return fmt.Errorf("multicall result %d: %w", i, err)
+	}
+	return nil
+}
+
+// assignDecoded sets dst from a decoded ABI value.
+func assignDecoded(dst reflect.Value, value any) error {
+	if value == nil {
+		return fmt.Errorf("cannot assign nil result to %s", dst.Type())
+	}
+
+	src := reflect.ValueOf(value)
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	// Multi-output results are returned as []any; spread them over struct fields.
+	if values, ok := value.([]any); ok && dst.Kind() == reflect.Struct {
+		fields := exportedFieldIndexes(dst.Type())
+		if len(fields) != len(values) {
+			return fmt.Errorf("cannot decode %d outputs into %s with %d exported fields", len(values), dst.Type(), len(fields))
+		}
+		for j, idx := range fields {
+			if err := assignDecoded(dst.Field(idx), values[j]); err != nil {
+				return fmt.Errorf("field %s: %w", dst.Type().Field(idx).Name, err)
+			}
+		}
+		return nil
+	}
+
+	if src.Type().ConvertibleTo(dst.Type()) && src.Kind() == dst.Kind() {
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+
+	return fmt.Errorf("cannot assign %s to %s", src.Type(), dst.Type())
+}
+
+// exportedFieldIndexes returns the indexes of the exported fields of a struct type.
+func exportedFieldIndexes(t reflect.Type) []int {
+	indexes := make([]int, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,112 @@
+package public
+
+import (
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// DecodedLog is a receipt log together with its decoded event, if any.
+type DecodedLog struct {
+	types.Log
+
+	// EventName is the decoded event name. Empty if the log was not decoded.
+	EventName string
+
+	// Args contains the decoded event arguments keyed by parameter name.
+	// Nil if the log was not decoded.
+	Args map[string]any
+
+	// HashedArgs marks indexed string, bytes, array and tuple args, whose
+	// Args entry is the keccak256 topic hash rather than the value.
+	HashedArgs map[string]bool
+
+	// AbiIndex is the index of the ABI (in the abis passed to
+	// ParseReceiptLogs) that decoded the log, or -1 if none did.
+	AbiIndex int
+}
+
+// ParseReceiptLogs decodes the logs of a transaction receipt against the
+// given ABIs.
+//
+// Each log is matched by topic0 against the events of each ABI in order; the
+// first ABI that decodes it wins. Logs that no ABI decodes are returned with
+// nil Args and AbiIndex -1, so the result always has one entry per receipt
+// log, in order.
+//
+// Example:
+//
+//	receipt, _ := public.WaitForTransactionReceipt(ctx, client, params)
+//	logs, err := public.ParseReceiptLogs(receipt, erc20ABI)
+//	for _, log := range logs {
+//	    if log.EventName == "Transfer" {
+//	        fmt.Println(log.Args["from"], log.Args["to"], log.Args["value"])
+//	    }
+//	}
+func ParseReceiptLogs(receipt *types.Receipt, abis ...*abi.ABI) ([]DecodedLog, error) {
+	if receipt == nil {
+		return nil, fmt.Errorf("receipt is nil")
+	}
+	for i, contractABI := range abis {
+		if contractABI == nil {
+			return nil, fmt.Errorf("abi at index %d is nil", i)
+		}
+	}
+
+	logs := make([]DecodedLog, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = decodeReceiptLog(log, abis)
+	}
+
+	return logs, nil
+}
+
+// decodeReceiptLog decodes log with the first ABI that has a matching event.
+func decodeReceiptLog(log types.Log, abis []*abi.ABI) DecodedLog {
+	decoded := DecodedLog{Log: log, AbiIndex: -1}
+	if len(log.Topics) == 0 {
+		return decoded
+	}
+
+	for i, contractABI := range abis {
+		event, err := contractABI.GetEventByTopic(log.Topics[0])
+		if err != nil {
+			continue
+		}
+		// The same topic0 can belong to events with a different indexed
+		// layout (e.g. ERC-20 and ERC-721 Transfer), so a log that does not
+		// fit the event falls through to the next ABI.
+		if !logMatchesEventLayout(log, event) {
+			continue
+		}
+		result, err := contractABI.DecodeEventLogByName(event.Name, log.Topics, log.Data)
+		if err != nil {
+			continue
+		}
+		decoded.EventName = result.EventName
+		decoded.Args = result.Args
+		decoded.HashedArgs = result.HashedArgs
+		decoded.AbiIndex = i
+		return decoded
+	}
+
+	return decoded
+}
+
+// logMatchesEventLayout reports whether log has exactly one topic per indexed
+// input of event (plus topic0), and data if event has non-indexed inputs.
+func logMatchesEventLayout(log types.Log, event *abi.Event) bool {
+	indexed, nonIndexed := 0, 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed++
+		} else {
+			nonIndexed++
+		}
+	}
+	if len(log.Topics) != indexed+1 {
+		return false
+	}
+	return nonIndexed == 0 || len(log.Data) > 0
+}
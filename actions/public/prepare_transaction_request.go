@@ -0,0 +1,310 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
+)
+
+// PrepareParameters is the default set of fields PrepareTransactionRequest fills.
+var PrepareParameters = []string{
+	"chainId",
+	"fees",
+	"gas",
+	"nonce",
+	"type",
+}
+
+// PrepareTransactionRequestParameters contains the parameters for the
+// PrepareTransactionRequest action. Any nil field that can be derived from the
+// network is filled in; supplied values are always preserved.
+type PrepareTransactionRequestParameters struct {
+	// Parameters is the list of fields to fill. Defaults to PrepareParameters:
+	// ["chainId", "fees", "gas", "nonce", "type"]. Unknown names are ignored.
+	Parameters []string
+
+	// Account is the sender address (msg.sender). Required to fill the nonce.
+	Account *common.Address
+
+	// To is the recipient address. If nil, this is treated as a deployment.
+	To *common.Address
+
+	// Data is the calldata to send.
+	Data []byte
+
+	// Value is the amount of wei to send.
+	Value *big.Int
+
+	// Nonce is the transaction nonce. If nil, fetched with eth_getTransactionCount("pending").
+	Nonce *uint64
+
+	// Gas is the gas limit. If nil, estimated with eth_estimateGas.
+	Gas *uint64
+
+	// GasPrice is the legacy gas price. If nil on a legacy transaction, estimated.
+	GasPrice *big.Int
+
+	// MaxFeePerGas is the max fee per gas (EIP-1559). If nil on a non-legacy transaction, estimated.
+	MaxFeePerGas *big.Int
+
+	// MaxPriorityFeePerGas is the max priority fee per gas (EIP-1559). If nil on a non-legacy transaction, estimated.
+	MaxPriorityFeePerGas *big.Int
+
+	// MaxFeePerBlobGas is the max fee per blob gas (EIP-4844).
+	MaxFeePerBlobGas *big.Int
+
+	// AccessList is the EIP-2930 access list.
+	AccessList types.AccessList
+
+	// AuthorizationList is the EIP-7702 authorization list.
+	AuthorizationList []types.SignedAuthorization
+
+	// Blobs is the EIP-4844 blob data.
+	Blobs [][]byte
+
+	// BlobVersionedHashes is the EIP-4844 blob versioned hashes.
+	BlobVersionedHashes []common.Hash
+
+	// ChainID is the chain ID. If nil, taken from the client chain or eth_chainId.
+	ChainID *int64
+
+	// Type is the transaction type. If empty, inferred from the supplied fields,
+	// falling back to EIP-1559 when the chain supports it and legacy otherwise.
+	Type transaction.TransactionType
+}
+
+// PrepareTransactionRequestReturnType is the return type for the PrepareTransactionRequest action.
+// It is the request with every fillable field populated.
+type PrepareTransactionRequestReturnType = *PrepareTransactionRequestParameters
+
+// PrepareTransactionRequest fills in the fields required to sign a transaction
+// without relying on eth_fillTransaction, which most public nodes reject.
+//
+// This mirrors viem's `prepareTransactionRequest` action for address-only accounts:
+//   - nonce via eth_getTransactionCount at the "pending" tag
+//   - chainId from the client chain (or eth_chainId)
+//   - type inferred from the supplied fee fields, or from the latest block's baseFeePerGas
+//   - fees via EstimateFeesPerGas
+//   - gas via eth_estimateGas
+//
+// Example:
+//
+//	prepared, err := public.PrepareTransactionRequest(ctx, client, public.PrepareTransactionRequestParameters{
+//	    Account: &sender,
+//	    To:      &recipient,
+//	    Value:   big.NewInt(1),
+//	})
+func PrepareTransactionRequest(
+	ctx context.Context,
+	client Client,
+	params PrepareTransactionRequestParameters,
+) (PrepareTransactionRequestReturnType, error) {
+	parameters := params.Parameters
+	if len(parameters) == 0 {
+		parameters = PrepareParameters
+	}
+
+	// An explicit type must agree with the fields
+	if _, err := transaction.GetTransactionType(PreparedToTransaction(&params)); errors.Is(err, transaction.ErrConflictingTransactionFields) {
+		return nil, err
+	}
+
+	// ---------- Fill nonce ----------
+	if slices.Contains(parameters, "nonce") && params.Nonce == nil && params.Account != nil {
+		nonce, err := GetTransactionCount(ctx, client, GetTransactionCountParameters{
+			Address:  *params.Account,
+			BlockTag: BlockTagPending,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get nonce: %w", err)
+		}
+		params.Nonce = &nonce
+	}
+
+	// ---------- Fill chainId ----------
+	if slices.Contains(parameters, "chainId") && params.ChainID == nil {
+		if ch := client.Chain(); ch != nil {
+			chainID := ch.ID
+			params.ChainID = &chainID
+		} else {
+			id, err := GetChainID(ctx, client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get chain ID: %w", err)
+			}
+			chainID := int64(id)
+			params.ChainID = &chainID
+		}
+	}
+
+	// ---------- Fill type ----------
+	if (slices.Contains(parameters, "fees") || slices.Contains(parameters, "type")) && params.Type == "" {
+		if txType, err := transaction.GetTransactionType(PreparedToTransaction(&params)); err == nil {
+			params.Type = txType
+		} else {
+			// Nothing to infer from; use the network. A baseFeePerGas on the
+			// latest block means the chain supports EIP-1559.
+			block, blockErr := GetBlock(ctx, client, GetBlockParameters{
+				BlockTag: BlockTagLatest,
+			})
+			if blockErr != nil {
+				return nil, fmt.Errorf("failed to get latest block: %w", blockErr)
+			}
+			if block.BaseFeePerGas != nil {
+				params.Type = transaction.TransactionTypeEIP1559
+			} else {
+				params.Type = transaction.TransactionTypeLegacy
+			}
+		}
+	}
+
+	// ---------- Fill fees ----------
+	if slices.Contains(parameters, "fees") {
+		if params.Type == transaction.TransactionTypeLegacy || params.Type == transaction.TransactionTypeEIP2930 {
+			if params.MaxFeePerGas != nil || params.MaxPriorityFeePerGas != nil {
+				return nil, transaction.ErrMaxFeePerGasNotAllowed
+			}
+			if params.GasPrice == nil {
+				fees, err := EstimateFeesPerGas(ctx, client, EstimateFeesPerGasParameters{
+					Type: FeeValuesTypeLegacy,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to estimate gas price: %w", err)
+				}
+				params.GasPrice = fees.GasPrice
+			}
+		} else if params.MaxFeePerGas == nil || params.MaxPriorityFeePerGas == nil {
+			fees, err := EstimateFeesPerGas(ctx, client, EstimateFeesPerGasParameters{
+				Type: FeeValuesTypeEIP1559,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to estimate fees: %w", err)
+			}
+
+			if params.MaxPriorityFeePerGas == nil && params.MaxFeePerGas != nil &&
+				params.MaxFeePerGas.Cmp(fees.MaxPriorityFeePerGas) < 0 {
+				return nil, fmt.Errorf(
+					"maxFeePerGas (%s) cannot be lower than maxPriorityFeePerGas (%s)",
+					params.MaxFeePerGas.String(), fees.MaxPriorityFeePerGas.String(),
+				)
+			}
+
+			if params.MaxPriorityFeePerGas == nil {
+				params.MaxPriorityFeePerGas = fees.MaxPriorityFeePerGas
+			}
+			if params.MaxFeePerGas == nil {
+				params.MaxFeePerGas = fees.MaxFeePerGas
+			}
+		}
+	}
+
+	// ---------- Fill gas ----------
+	if slices.Contains(parameters, "gas") && params.Gas == nil {
+		gas, err := EstimateGas(ctx, client, EstimateGasParameters{
+			Account:              params.Account,
+			To:                   params.To,
+			Data:                 params.Data,
+			Value:                params.Value,
+			Nonce:                params.Nonce,
+			GasPrice:             params.GasPrice,
+			MaxFeePerGas:         params.MaxFeePerGas,
+			MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+			MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+			AccessList:           params.AccessList,
+			BlobVersionedHashes:  params.BlobVersionedHashes,
+			Blobs:                params.Blobs,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas: %w", err)
+		}
+		params.Gas = &gas
+	}
+
+	return &params, nil
+}
+
+// PreparedToTransaction converts a PrepareTransactionRequestReturnType (from
+// PrepareTransactionRequest) into a transaction.Transaction, e.g. to serialize it.
+func PreparedToTransaction(params *PrepareTransactionRequestParameters) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Type:                 params.Type,
+		Value:                params.Value,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+	}
+
+	if params.ChainID != nil {
+		tx.ChainId = int(*params.ChainID)
+	}
+	if params.Nonce != nil {
+		tx.Nonce = int(*params.Nonce)
+	}
+	if params.Gas != nil {
+		tx.Gas = new(big.Int).SetUint64(*params.Gas)
+	}
+	if params.To != nil {
+		tx.To = params.To.Hex()
+	}
+	if len(params.Data) > 0 {
+		tx.Data = hexutil.Encode(params.Data)
+	}
+
+	if len(params.AccessList) > 0 {
+		al := make(transaction.AccessList, len(params.AccessList))
+		for i, item := range params.AccessList {
+			keys := make([]string, len(item.StorageKeys))
+			for j, key := range item.StorageKeys {
+				keys[j] = key.Hex()
+			}
+			al[i] = transaction.AccessListItem{
+				Address:     item.Address.Hex(),
+				StorageKeys: keys,
+			}
+		}
+		tx.AccessList = al
+	}
+
+	if len(params.BlobVersionedHashes) > 0 {
+		hashes := make([]string, len(params.BlobVersionedHashes))
+		for i, h := range params.BlobVersionedHashes {
+			hashes[i] = h.Hex()
+		}
+		tx.BlobVersionedHashes = hashes
+	}
+
+	if len(params.Blobs) > 0 {
+		blobs := make([]string, len(params.Blobs))
+		for i, b := range params.Blobs {
+			blobs[i] = hexutil.Encode(b)
+		}
+		tx.Blobs = blobs
+	}
+
+	if len(params.AuthorizationList) > 0 {
+		auths := make([]transaction.SignedAuthorization, len(params.AuthorizationList))
+		for i, a := range params.AuthorizationList {
+			auths[i] = transaction.SignedAuthorization{
+				Authorization: transaction.Authorization{
+					Address: a.Address,
+					ChainId: a.ChainId,
+					Nonce:   a.Nonce,
+				},
+				R:       a.R,
+				S:       a.S,
+				YParity: a.YParity,
+			}
+		}
+		tx.AuthorizationList = auths
+	}
+
+	return tx
+}
@@ -139,6 +139,16 @@ type simulateAccessListItem struct {
 	StorageKeys []string `json:"storageKeys"`
 }
 
+// simulateAuthorizationItem is the RPC format for an EIP-7702 authorization in simulation.
+type simulateAuthorizationItem struct {
+	Address string `json:"address"`
+	ChainID string `json:"chainId"`
+	Nonce   string `json:"nonce"`
+	R       string `json:"r"`
+	S       string `json:"s"`
+	YParity string `json:"yParity"`
+}
+
 // rpcSimulateCall is the RPC format for a simulation call.
 type rpcSimulateCall struct {
 	From                 string                   `json:"from,omitempty"`
@@ -151,6 +161,8 @@ type rpcSimulateCall struct {
 	MaxPriorityFeePerGas string                   `json:"maxPriorityFeePerGas,omitempty"`
 	Nonce                string                   `json:"nonce,omitempty"`
 	AccessList           []simulateAccessListItem `json:"accessList,omitempty"`
+
+	AuthorizationList []simulateAuthorizationItem `json:"authorizationList,omitempty"`
 }
 
 // rpcBlockStateCall is the RPC format for a block state call.
@@ -279,6 +291,20 @@ func SimulateBlocks(ctx context.Context, client Client, params SimulateBlocksPar
 				}
 				rpcCall.AccessList = rpcAccessList
 			}
+			if len(call.AuthorizationList) > 0 {
+				rpcAuthList := make([]simulateAuthorizationItem, len(call.AuthorizationList))
+				for i, auth := range call.AuthorizationList {
+					rpcAuthList[i] = simulateAuthorizationItem{
+						Address: auth.Address,
+						ChainID: hexutil.EncodeUint64(uint64(auth.ChainId)),
+						Nonce:   hexutil.EncodeUint64(uint64(auth.Nonce)),
+						R:       auth.R,
+						S:       auth.S,
+						YParity: hexutil.EncodeUint64(uint64(auth.YParity)),
+					}
+				}
+				rpcCall.AuthorizationList = rpcAuthList
+			}
 
 			calls = append(calls, rpcCall)
 		}
@@ -348,8 +374,11 @@ func SimulateBlocks(ctx context.Context, client Client, params SimulateBlocksPar
 			// Parse gas used
 			var gasUsed *big.Int
 			if rpcCall.GasUsed != "" {
-				gasUsed = new(big.Int)
-				gasUsed.SetString(rpcCall.GasUsed[2:], 16)
+				parsed, err := parseHexBigInt(rpcCall.GasUsed)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse gasUsed for call %d in block %d: %w", j, i, err)
+				}
+				gasUsed = parsed
 			}
 
 			// Format logs
@@ -0,0 +1,197 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/utils/concurrency"
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+)
+
+// DefaultStreamLogsChunkSize is the number of blocks requested per
+// eth_getLogs call when StreamLogsParameters.ChunkSize is zero.
+const DefaultStreamLogsChunkSize uint64 = 1000
+
+// StreamLogsParameters contains the parameters for the StreamLogs action.
+type StreamLogsParameters struct {
+	// Address is the contract address(es) to filter logs from.
+	// Can be a single address or a slice of addresses.
+	Address any // common.Address or []common.Address
+
+	// Topics is the indexed event topics to filter.
+	// Each topic can be nil (any value), a single value or an array of
+	// values (OR condition), e.g. []any{transferTopic, nil, []common.Hash{a, b}}.
+	Topics []any
+
+	// FromBlock is the first block to scan.
+	FromBlock uint64
+
+	// ToBlock is the last block to scan (inclusive).
+	// If nil, the latest block number at the time of the call is used.
+	ToBlock *uint64
+
+	// ChunkSize is the number of blocks requested per eth_getLogs call.
+	// Default: DefaultStreamLogsChunkSize
+	ChunkSize uint64
+
+	// MaxConcurrentChunks limits the number of chunks fetched (or waiting to
+	// be received) at once.
+	// Default: 10
+	MaxConcurrentChunks int
+}
+
+// LogOrError is a single item sent on the channel returned by StreamLogs.
+// Exactly one of Log or Error is meaningful.
+type LogOrError struct {
+	// Log is the streamed log.
+	Log formatters.Log
+
+	// Error is the error that ended the stream.
+	Error error
+}
+
+// StreamLogs scans a block range for logs in ChunkSize windows and sends
+// them on the returned channel in block order. Up to MaxConcurrentChunks
+// windows are fetched ahead of the receiver, so memory stays bounded by
+// MaxConcurrentChunks chunks regardless of the range.
+//
+// The channel is closed after ToBlock is scanned, after an error is sent, or
+// when ctx is cancelled.
+//
+// JSON-RPC Method: eth_getLogs
+//
+// Example:
+//
+//	toBlock := uint64(19000000)
+//	for item := range public.StreamLogs(ctx, client, public.StreamLogsParameters{
+//	    Address:   contractAddress,
+//	    Topics:    []any{transferEventTopic},
+//	    FromBlock: 18000000,
+//	    ToBlock:   &toBlock,
+//	    ChunkSize: 2000,
+//	}) {
+//	    if item.Error != nil {
+//	        log.Fatal(item.Error)
+//	    }
+//	    fmt.Println(item.Log.TransactionHash)
+//	}
+func StreamLogs(ctx context.Context, client Client, params StreamLogsParameters) <-chan LogOrError {
+	out := make(chan LogOrError)
+
+	go func() {
+		defer close(out)
+
+		send := func(item LogOrError) bool {
+			select {
+			case out <- item:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		chunkSize := params.ChunkSize
+		if chunkSize == 0 {
+			chunkSize = DefaultStreamLogsChunkSize
+		}
+
+		var toBlock uint64
+		if params.ToBlock != nil {
+			toBlock = *params.ToBlock
+		} else {
+			latest, err := GetBlockNumber(ctx, client, GetBlockNumberParameters{})
+			if err != nil {
+				send(LogOrError{Error: fmt.Errorf("failed to get latest block number: %w", err)})
+				return
+			}
+			toBlock = latest
+		}
+
+		if params.FromBlock > toBlock {
+			send(LogOrError{Error: fmt.Errorf("fromBlock %d is after toBlock %d", params.FromBlock, toBlock)})
+			return
+		}
+
+		limit := params.MaxConcurrentChunks
+		if limit <= 0 {
+			limit = concurrency.DefaultLimit
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+
+		// Each chunk's result is handed over on its own channel, queued in
+		// block order. A chunk holds its group slot until the result is
+		// received, which bounds the chunks held in memory.
+		pending := make(chan chan chunkLogs, limit)
+		g, gctx := concurrency.BoundedGroup(ctx, limit)
+		go func() {
+			defer close(pending)
+			for from := params.FromBlock; gctx.Err() == nil; {
+				to := toBlock
+				if toBlock-from >= chunkSize {
+					to = from + chunkSize - 1
+				}
+
+				chunkFrom, chunkTo := from, to
+				result := make(chan chunkLogs)
+				pending <- result
+				g.Go(func() error {
+					logs, err := GetLogs(gctx, client, GetLogsParameters{
+						Address:   params.Address,
+						Topics:    params.Topics,
+						FromBlock: &chunkFrom,
+						ToBlock:   &chunkTo,
+					})
+					if err != nil {
+						err = fmt.Errorf("blocks %d-%d: %w", chunkFrom, chunkTo, err)
+					}
+					select {
+					case result <- chunkLogs{logs: logs, err: err}:
+					case <-gctx.Done():
+					}
+					return nil
+				})
+
+				if to == toBlock {
+					return
+				}
+				from = to + 1
+			}
+		}()
+		defer func() {
+			cancel()
+			for range pending {
+			}
+			_ = g.Wait()
+		}()
+
+		for result := range pending {
+			var chunk chunkLogs
+			select {
+			case chunk = <-result:
+			case <-ctx.Done():
+				return
+			}
+			if chunk.err != nil {
+				if ctx.Err() == nil {
+					send(LogOrError{Error: chunk.err})
+				}
+				return
+			}
+
+			for _, log := range chunk.logs {
+				if !send(LogOrError{Log: log}) {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// chunkLogs is the result of fetching one StreamLogs chunk.
+type chunkLogs struct {
+	logs []formatters.Log
+	err  error
+}
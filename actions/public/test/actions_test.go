@@ -3,15 +3,23 @@ package public_test
 import (
 	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -19,7 +27,9 @@ import (
 	"github.com/ChefBingbong/viem-go/actions/public"
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/constants"
 	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
 
 // mockClient implements the public.Client interface for testing.
@@ -513,9 +523,13 @@ func TestCall_WithAccessList(t *testing.T) {
 func TestCall_ErrorWrapping(t *testing.T) {
 	// Test that errors are properly wrapped in CallExecutionError
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID any `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
 		resp := map[string]any{
 			"jsonrpc": "2.0",
-			"id":      1,
+			"id":      req.ID,
 			"error": map[string]any{
 				"code":    3,
 				"message": "execution reverted",
@@ -540,6 +554,113 @@ func TestCall_ErrorWrapping(t *testing.T) {
 	// Should be wrapped in CallExecutionError
 	_, ok := err.(*public.CallExecutionError)
 	assert.True(t, ok, "expected CallExecutionError, got %T", err)
+
+	// The chain survives further wrapping and reaches the RPC error
+	wrapped := fmt.Errorf("read balance: %w", fmt.Errorf("multicall fallback: %w", err))
+	var execErr *public.CallExecutionError
+	require.True(t, errors.As(wrapped, &execErr))
+	assert.Equal(t, &to, execErr.To)
+
+	rpcErr, ok := transport.AsRPCError(wrapped)
+	require.True(t, ok)
+	assert.Equal(t, 3, rpcErr.Code)
+	assert.Equal(t, "execution reverted", rpcErr.Message)
+}
+
+func TestErrSubscriptionNotSupported_Is(t *testing.T) {
+	adapter := public.NewWatchClientAdapter(&mockClient{})
+	_, err := adapter.Subscribe(transport.NewHeadsSubscribeParams(), nil, nil)
+	require.Error(t, err)
+
+	wrapped := fmt.Errorf("watch blocks: %w", err)
+	assert.True(t, errors.Is(wrapped, public.ErrSubscriptionNotSupported))
+
+	// A copy of the sentinel carrying a cause still matches
+	withCause := &public.WatchError{
+		Message: public.ErrSubscriptionNotSupported.Message,
+		Cause:   transport.ErrProviderSubscriptionsNotSupported,
+	}
+	assert.True(t, errors.Is(fmt.Errorf("subscribe: %w", withCause), public.ErrSubscriptionNotSupported))
+	assert.True(t, errors.Is(withCause, transport.ErrProviderSubscriptionsNotSupported))
+	assert.False(t, errors.Is(&public.WatchError{Message: "polling failed"}, public.ErrSubscriptionNotSupported))
+}
+
+func TestCall_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := createTestServer(t, func(method string, params []any) any {
+		select {
+		case <-release:
+		case <-time.After(2 * time.Second):
+		}
+		return "0x"
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := createMockClient(t, server.URL)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	start := time.Now()
+	_, err := public.Call(context.Background(), client, public.CallParameters{
+		To:      &to,
+		Data:    []byte{0x01},
+		Timeout: 50 * time.Millisecond,
+	})
+	require.Error(t, err)
+	assert.Less(t, time.Since(start), time.Second)
+
+	var timeoutErr *public.CallTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 50*time.Millisecond, timeoutErr.Timeout)
+	assert.Equal(t, &to, timeoutErr.To)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.True(t, transport.IsTimeout(err))
+
+	var execErr *public.CallExecutionError
+	assert.False(t, errors.As(err, &execErr), "timeout must not look like a failed call")
+}
+
+func TestCall_TimeoutNotReached(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return "0x000000000000000000000000000000000000000000000000000000000000002a"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	result, err := public.Call(context.Background(), client, public.CallParameters{
+		To:      &to,
+		Data:    []byte{0x01},
+		Timeout: time.Second,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, byte(0x2a), result.Data[31])
+}
+
+func TestCall_ParentContextCancelIsNotTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := createTestServer(t, func(method string, params []any) any {
+		<-release
+		return "0x"
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := createMockClient(t, server.URL)
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := public.Call(ctx, client, public.CallParameters{
+		To:      &to,
+		Data:    []byte{0x01},
+		Timeout: time.Second,
+	})
+	require.Error(t, err)
+
+	var timeoutErr *public.CallTimeoutError
+	assert.False(t, errors.As(err, &timeoutErr))
 }
 
 // ============================================================================
@@ -793,6 +914,180 @@ func TestGetBlock_NotFound(t *testing.T) {
 	assert.True(t, ok, "expected BlockNotFoundError")
 }
 
+func TestGetBlock_CancunFields(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return map[string]any{
+			"number":                "0x12a05f2",
+			"hash":                  "0x00000000000000000000000000000000000000000000000000000000000000aa",
+			"timestamp":             "0x65f3c8a3",
+			"baseFeePerGas":         "0x3b9aca00",
+			"blobGasUsed":           "0x40000",
+			"excessBlobGas":         "0x4b00000",
+			"parentBeaconBlockRoot": "0x00000000000000000000000000000000000000000000000000000000000000bb",
+			"withdrawalsRoot":       "0x00000000000000000000000000000000000000000000000000000000000000cc",
+			"withdrawals": []map[string]any{
+				{
+					"index":          "0x2a9b1f0",
+					"validatorIndex": "0x10f3a5",
+					"address":        "0x1234567890123456789012345678901234567890",
+					"amount":         "0x1124e47",
+				},
+				{
+					"index":          "0x2a9b1f1",
+					"validatorIndex": "0x10f3a6",
+					"address":        "0x0000000000000000000000000000000000000001",
+					"amount":         "0x0",
+				},
+			},
+			"transactions": []string{},
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	block, err := public.GetBlock(context.Background(), client, public.GetBlockParameters{})
+	require.NoError(t, err)
+
+	require.NotNil(t, block.BlobGasUsed)
+	assert.Equal(t, uint64(0x40000), *block.BlobGasUsed)
+	require.NotNil(t, block.ExcessBlobGas)
+	assert.Equal(t, uint64(0x4b00000), *block.ExcessBlobGas)
+	require.NotNil(t, block.ParentBeaconBlockRoot)
+	assert.Equal(t, common.HexToHash("0xbb"), *block.ParentBeaconBlockRoot)
+	require.NotNil(t, block.WithdrawalsRoot)
+	assert.Equal(t, common.HexToHash("0xcc"), *block.WithdrawalsRoot)
+
+	require.Len(t, block.Withdrawals, 2)
+	assert.Equal(t, types.Withdrawal{
+		Index:          0x2a9b1f0,
+		ValidatorIndex: 0x10f3a5,
+		Address:        common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Amount:         0x1124e47,
+	}, block.Withdrawals[0])
+	assert.Equal(t, big.NewInt(0x1124e47*1_000_000_000), block.Withdrawals[0].AmountWei())
+	assert.Equal(t, uint64(0), block.Withdrawals[1].Amount)
+}
+
+func TestGetBlock_PreShanghaiHasNoWithdrawals(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return map[string]any{"number": "0x1", "transactions": []string{}}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	block, err := public.GetBlock(context.Background(), client, public.GetBlockParameters{})
+	require.NoError(t, err)
+	assert.Nil(t, block.Withdrawals)
+	assert.Nil(t, block.WithdrawalsRoot)
+	assert.Nil(t, block.ExcessBlobGas)
+}
+
+// ============================================================================
+// GetUncle Tests
+// ============================================================================
+
+// sampleUncleHeader is a representative pre-merge uncle header.
+var sampleUncleHeader = map[string]any{
+	"number":           "0x186a3",
+	"hash":             "0x4dcdfa6e4a5cc6b2d1ffa47cb6e7b2c1ee7c1c3bcb1e9ba4b1cc7b1fcf9e2b1a",
+	"parentHash":       "0x5d6fe0a9d7d5a6a3f2b6d9b2a9c1c7d1e6f3a2b1c4d5e6f7a8b9c0d1e2f3a4b5",
+	"nonce":            "0x3fbea7af642a4e20",
+	"sha3Uncles":       "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347",
+	"transactionsRoot": "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	"stateRoot":        "0x90c25f6d7fddeb31a6cc5668a6bba77adbadec705eb7aa5a51265c2d1e3bb7ac",
+	"receiptsRoot":     "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
+	"miner":            "0xbb7b8287f3f0a933474a79eae42cbca977791171",
+	"difficulty":       "0x4ea3f27bc",
+	"gasLimit":         "0x1388",
+	"gasUsed":          "0x0",
+	"timestamp":        "0x55ba4230",
+	"extraData":        "0x476574682f4c5649562f76312e302e302f6c696e75782f676f312e342e32",
+	"uncles":           []string{},
+}
+
+func TestGetUncle_ByNumber(t *testing.T) {
+	var capturedMethod string
+	var capturedParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		capturedMethod, capturedParams = method, params
+		if method == "eth_getUncleByBlockNumberAndIndex" {
+			return sampleUncleHeader
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	blockNum := uint64(100004)
+	uncle, err := public.GetUncle(context.Background(), client, public.GetUncleParameters{
+		BlockNumber: &blockNum,
+		UncleIndex:  1,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "eth_getUncleByBlockNumberAndIndex", capturedMethod)
+	assert.Equal(t, []any{"0x186a4", "0x1"}, capturedParams)
+	assert.Equal(t, uint64(100003), uncle.Number)
+	assert.Equal(t, common.HexToAddress("0xbb7b8287f3f0a933474a79eae42cbca977791171"), uncle.Miner)
+	assert.Equal(t, uint64(5000), uncle.GasLimit)
+	assert.Equal(t, big.NewInt(0x4ea3f27bc), uncle.Difficulty)
+	assert.Empty(t, uncle.Transactions)
+}
+
+func TestGetUncle_ByHash(t *testing.T) {
+	var capturedMethod string
+	server := createTestServer(t, func(method string, params []any) any {
+		capturedMethod = method
+		return sampleUncleHeader
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	hash := common.HexToHash("0x1234567890123456789012345678901234567890123456789012345678901234")
+	_, err := public.GetUncle(context.Background(), client, public.GetUncleParameters{BlockHash: &hash})
+
+	require.NoError(t, err)
+	assert.Equal(t, "eth_getUncleByBlockHashAndIndex", capturedMethod)
+}
+
+func TestGetUncle_IndexOutOfRange(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getUncleCountByBlockNumber" {
+			return "0x1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	blockNum := uint64(100004)
+	_, err := public.GetUncle(context.Background(), client, public.GetUncleParameters{
+		BlockNumber: &blockNum,
+		UncleIndex:  2,
+	})
+
+	var rangeErr *public.UncleIndexOutOfRangeError
+	require.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, uint64(2), rangeErr.Index)
+	assert.Equal(t, uint64(1), rangeErr.Count)
+}
+
+func TestGetUncle_BlockNotFound(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	hash := common.HexToHash("0x1234567890123456789012345678901234567890123456789012345678901234")
+	_, err := public.GetUncle(context.Background(), client, public.GetUncleParameters{BlockHash: &hash})
+
+	var notFound *public.BlockNotFoundError
+	require.ErrorAs(t, err, &notFound)
+}
+
 // ============================================================================
 // GetTransaction Tests
 // ============================================================================
@@ -921,6 +1216,167 @@ func TestGetTransaction_ByBlockNumberAndIndex(t *testing.T) {
 	assert.Equal(t, "eth_getTransactionByBlockNumberAndIndex", capturedMethod)
 }
 
+func TestGetTransaction_TypedFields(t *testing.T) {
+	base := func(txType string) map[string]any {
+		return map[string]any{
+			"blockHash":        "0x1234567890123456789012345678901234567890123456789012345678901234",
+			"blockNumber":      "0x10",
+			"from":             "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"gas":              "0x5208",
+			"hash":             "0xabcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+			"input":            "0x",
+			"nonce":            "0x1",
+			"to":               "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			"transactionIndex": "0x0",
+			"value":            "0x0",
+			"type":             txType,
+			"chainId":          "0x1",
+			"v":                "0x1",
+			"r":                "0x1234",
+			"s":                "0x5678",
+		}
+	}
+	accessList := []any{map[string]any{
+		"address":     "0xcccccccccccccccccccccccccccccccccccccccc",
+		"storageKeys": []any{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+	}}
+	wantAccessList := []public.AccessTuple{{
+		Address:     common.HexToAddress("0xcccccccccccccccccccccccccccccccccccccccc"),
+		StorageKeys: []common.Hash{common.HexToHash("0x01")},
+	}}
+	blobHash := common.HexToHash("0x01a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1")
+
+	tests := []struct {
+		name  string
+		tx    map[string]any
+		check func(t *testing.T, tx *public.TransactionResponse)
+	}{
+		{
+			name: "legacy",
+			tx: func() map[string]any {
+				tx := base("0x0")
+				tx["gasPrice"] = "0x3b9aca00"
+				tx["v"] = "0x25"
+				return tx
+			}(),
+			check: func(t *testing.T, tx *public.TransactionResponse) {
+				assert.Equal(t, uint8(0), tx.Type)
+				assert.Equal(t, big.NewInt(1_000_000_000), tx.GasPrice)
+				assert.Nil(t, tx.YParity)
+				assert.Nil(t, tx.AccessList)
+				assert.Nil(t, tx.MaxFeePerGas)
+				assert.Nil(t, tx.MaxFeePerBlobGas)
+				assert.Nil(t, tx.BlobVersionedHashes)
+				assert.Nil(t, tx.AuthorizationList)
+			},
+		},
+		{
+			name: "eip2930",
+			tx: func() map[string]any {
+				tx := base("0x1")
+				tx["gasPrice"] = "0x3b9aca00"
+				tx["yParity"] = "0x1"
+				tx["accessList"] = accessList
+				return tx
+			}(),
+			check: func(t *testing.T, tx *public.TransactionResponse) {
+				assert.Equal(t, uint8(1), tx.Type)
+				require.NotNil(t, tx.YParity)
+				assert.Equal(t, uint64(1), *tx.YParity)
+				assert.Equal(t, wantAccessList, tx.AccessList)
+				assert.Nil(t, tx.MaxFeePerGas)
+				assert.Nil(t, tx.AuthorizationList)
+			},
+		},
+		{
+			name: "eip1559",
+			tx: func() map[string]any {
+				tx := base("0x2")
+				tx["maxFeePerGas"] = "0x77359400"
+				tx["maxPriorityFeePerGas"] = "0x3b9aca00"
+				tx["yParity"] = "0x0"
+				tx["accessList"] = []any{}
+				return tx
+			}(),
+			check: func(t *testing.T, tx *public.TransactionResponse) {
+				assert.Equal(t, uint8(2), tx.Type)
+				assert.Equal(t, big.NewInt(2_000_000_000), tx.MaxFeePerGas)
+				assert.Equal(t, big.NewInt(1_000_000_000), tx.MaxPriorityFeePerGas)
+				assert.NotNil(t, tx.AccessList)
+				assert.Empty(t, tx.AccessList)
+				assert.Nil(t, tx.MaxFeePerBlobGas)
+				assert.Nil(t, tx.BlobVersionedHashes)
+			},
+		},
+		{
+			name: "eip4844",
+			tx: func() map[string]any {
+				tx := base("0x3")
+				tx["maxFeePerGas"] = "0x77359400"
+				tx["maxPriorityFeePerGas"] = "0x3b9aca00"
+				tx["maxFeePerBlobGas"] = "0x2540be400"
+				tx["blobVersionedHashes"] = []any{blobHash.Hex()}
+				tx["yParity"] = "0x1"
+				tx["accessList"] = accessList
+				return tx
+			}(),
+			check: func(t *testing.T, tx *public.TransactionResponse) {
+				assert.Equal(t, uint8(3), tx.Type)
+				assert.Equal(t, big.NewInt(10_000_000_000), tx.MaxFeePerBlobGas)
+				assert.Equal(t, []common.Hash{blobHash}, tx.BlobVersionedHashes)
+				assert.Equal(t, wantAccessList, tx.AccessList)
+				assert.Nil(t, tx.AuthorizationList)
+			},
+		},
+		{
+			name: "eip7702",
+			tx: func() map[string]any {
+				tx := base("0x4")
+				tx["maxFeePerGas"] = "0x77359400"
+				tx["maxPriorityFeePerGas"] = "0x3b9aca00"
+				tx["yParity"] = "0x0"
+				tx["accessList"] = []any{}
+				tx["authorizationList"] = []any{map[string]any{
+					"address": "0xdddddddddddddddddddddddddddddddddddddddd",
+					"chainId": "0x1",
+					"nonce":   "0x7",
+					"r":       "0xabc",
+					"s":       "0xdef",
+					"yParity": "0x1",
+				}}
+				return tx
+			}(),
+			check: func(t *testing.T, tx *public.TransactionResponse) {
+				assert.Equal(t, uint8(4), tx.Type)
+				assert.Nil(t, tx.MaxFeePerBlobGas)
+				assert.Equal(t, []types.SignedAuthorization{{
+					Address: common.HexToAddress("0xdddddddddddddddddddddddddddddddddddddddd").Hex(),
+					ChainId: 1,
+					Nonce:   7,
+					R:       "0xabc",
+					S:       "0xdef",
+					YParity: 1,
+				}}, tx.AuthorizationList)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := createTestServer(t, func(method string, params []any) any {
+				return tt.tx
+			})
+			defer server.Close()
+
+			client := createMockClient(t, server.URL)
+			hash := common.HexToHash("0xabcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890")
+			tx, err := public.GetTransaction(context.Background(), client, public.GetTransactionParameters{Hash: &hash})
+			require.NoError(t, err)
+			tt.check(t, tx)
+		})
+	}
+}
+
 func TestGetTransaction_NotFound(t *testing.T) {
 	server := createTestServer(t, func(method string, params []any) any {
 		return nil
@@ -956,6 +1412,91 @@ func TestGetTransaction_InvalidParams(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid parameters")
 }
 
+func TestDecodeTransactionInput_ERC20Transfer(t *testing.T) {
+	erc20, err := parseTestABI(`[
+		{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"},
+		{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"type":"bool"}],"stateMutability":"nonpayable"}
+	]`)
+	require.NoError(t, err)
+
+	// transfer(0x70997970C51812dc3A010C7d01b50e0d17dc79C8, 1e6)
+	input := common.FromHex("0xa9059cbb" +
+		"00000000000000000000000070997970c51812dc3a010c7d01b50e0d17dc79c8" +
+		"00000000000000000000000000000000000000000000000000000000000f4240")
+	tx := &public.TransactionResponse{Input: input}
+
+	name, args, err := tx.DecodeInput(erc20)
+	require.NoError(t, err)
+	assert.Equal(t, "transfer", name)
+	assert.Equal(t, common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"), args["to"])
+	assert.Equal(t, big.NewInt(1_000_000), args["amount"])
+
+	t.Run("unknown selector", func(t *testing.T) {
+		_, _, err := public.DecodeTransactionInput(erc20, common.FromHex("0x23b872dd"))
+		var notFound *public.FunctionSelectorNotFoundError
+		require.ErrorAs(t, err, &notFound)
+		assert.Equal(t, [4]byte{0x23, 0xb8, 0x72, 0xdd}, notFound.Selector)
+	})
+}
+
+func TestParseReceiptLogs_TransferAndUnknown(t *testing.T) {
+	erc20, err := parseTestABI(`[
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256"}]}
+	]`)
+	require.NoError(t, err)
+	erc721, err := parseTestABI(`[
+		{"type":"event","name":"Transfer","inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"tokenId","type":"uint256","indexed":true}]}
+	]`)
+	require.NoError(t, err)
+
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	from := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	receipt := &types.Receipt{
+		Logs: []types.Log{
+			{
+				Topics:   []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes())},
+				Data:     common.FromHex("0x00000000000000000000000000000000000000000000000000000000000f4240"),
+				LogIndex: 0,
+			},
+			{
+				Topics:   []common.Hash{common.HexToHash("0x1234"), common.BytesToHash(from.Bytes())},
+				LogIndex: 1,
+			},
+			{
+				Topics:   []common.Hash{transferTopic, common.BytesToHash(from.Bytes()), common.BytesToHash(to.Bytes()), common.BigToHash(big.NewInt(7))},
+				LogIndex: 2,
+			},
+		},
+	}
+
+	logs, err := public.ParseReceiptLogs(receipt, erc20, erc721)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+
+	assert.Equal(t, "Transfer", logs[0].EventName)
+	assert.Equal(t, 0, logs[0].AbiIndex)
+	assert.Equal(t, from, logs[0].Args["from"])
+	assert.Equal(t, to, logs[0].Args["to"])
+	assert.Equal(t, big.NewInt(1_000_000), logs[0].Args["value"])
+
+	assert.Empty(t, logs[1].EventName)
+	assert.Nil(t, logs[1].Args)
+	assert.Equal(t, -1, logs[1].AbiIndex)
+	assert.Equal(t, uint64(1), logs[1].LogIndex)
+
+	// The ERC-721 Transfer shares topic0 but not the indexed layout, so it
+	// is decoded by the second ABI.
+	assert.Equal(t, 1, logs[2].AbiIndex)
+	assert.Equal(t, big.NewInt(7), logs[2].Args["tokenId"])
+
+	t.Run("nil receipt", func(t *testing.T) {
+		_, err := public.ParseReceiptLogs(nil, erc20)
+		require.Error(t, err)
+	})
+}
+
 // ============================================================================
 // GetBlockNumber Tests
 // ============================================================================
@@ -1028,6 +1569,72 @@ func TestGetBlockNumber_WithCaching(t *testing.T) {
 	assert.Equal(t, 1, callCount)
 }
 
+func TestGetBlockNumber_ForceRefresh(t *testing.T) {
+	blockNumber := 0x10
+	callCount := 0
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_blockNumber" {
+			callCount++
+			return hexutil.EncodeUint64(uint64(blockNumber))
+		}
+		return "0x0"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-get-block-number-force-refresh"
+	client.cacheTime = 10 * time.Second
+	public.InvalidateBlockNumberCache(client)
+	ctx := context.Background()
+
+	first, err := public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x10), first)
+
+	// A new block arrives; ForceRefresh bypasses the cache...
+	blockNumber = 0x11
+	refreshed, err := public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{ForceRefresh: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x11), refreshed)
+	assert.Equal(t, 2, callCount)
+
+	// ...and warms it for subsequent calls
+	cached, err := public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x11), cached)
+	assert.Equal(t, 2, callCount)
+}
+
+func TestGetBlockNumber_InvalidateCache(t *testing.T) {
+	callCount := 0
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_blockNumber" {
+			callCount++
+			return "0x10"
+		}
+		return "0x0"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-get-block-number-invalidate"
+	client.cacheTime = 10 * time.Second
+	public.InvalidateBlockNumberCache(client)
+	ctx := context.Background()
+
+	_, err := public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{})
+	require.NoError(t, err)
+	_, err = public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, callCount)
+
+	public.InvalidateBlockNumberCache(client)
+
+	_, err = public.GetBlockNumber(ctx, client, public.GetBlockNumberParameters{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, callCount)
+}
+
 // ============================================================================
 // GetTransactionReceipt Tests
 // ============================================================================
@@ -1361,34 +1968,610 @@ func TestWaitForTransactionReceipt_Timeout(t *testing.T) {
 	assert.True(t, ok, "expected WaitForTransactionReceiptTimeoutError")
 }
 
-// ============================================================================
-// FillTransaction Tests
-// ============================================================================
+func TestWaitForTransactionReceipt_RepricedReplacement(t *testing.T) {
+	originalHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	replacementHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+
+	tx := func(hash, gasPrice string, pending bool) map[string]any {
+		m := map[string]any{
+			"from":     "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			"gas":      "0x5208",
+			"gasPrice": gasPrice,
+			"hash":     hash,
+			"input":    "0x",
+			"nonce":    "0x5",
+			"to":       "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+			"value":    "0x1",
+			"type":     "0x0",
+		}
+		if !pending {
+			m["blockNumber"] = "0x10"
+		}
+		return m
+	}
 
-func TestFillTransaction_Basic(t *testing.T) {
+	var getBlockCalls int
 	server := createTestServer(t, func(method string, params []any) any {
-		if method == "eth_fillTransaction" {
-			return map[string]any{
-				"raw": "0xf86c808504a817c80082520894bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb880de0b6b3a76400008025a0abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890a0abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
-				"tx": map[string]any{
-					"from":                 "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
-					"to":                   "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
-					"input":                "0x",
-					"value":                "0xde0b6b3a7640000",
-					"nonce":                "0x5",
-					"gas":                  "0x5208",
-					"maxFeePerGas":         "0x4a817c800",
-					"maxPriorityFeePerGas": "0x77359400",
-					"chainId":              "0x1",
-					"type":                 "0x2",
-				},
+		switch method {
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionCount":
+			return "0x6" // nonce 5 has been consumed
+		case "eth_getTransactionByHash":
+			if params[0] == originalHash {
+				return tx(originalHash, "0x1", true)
 			}
-		}
-		return nil
-	})
-	defer server.Close()
-
-	client := createMockClient(t, server.URL)
+			return tx(replacementHash, "0x2", false)
+		case "eth_getTransactionReceipt":
+			if params[0] == originalHash {
+				return nil // the original never mines
+			}
+			return map[string]any{
+				"transactionHash":   replacementHash,
+				"transactionIndex":  "0x0",
+				"blockHash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"blockNumber":       "0x10",
+				"from":              "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"to":                "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+				"cumulativeGasUsed": "0x5208",
+				"gasUsed":           "0x5208",
+				"logs":              []any{},
+				"status":            "0x1",
+				"effectiveGasPrice": "0x2",
+				"type":              "0x0",
+			}
+		case "eth_getBlockByNumber":
+			getBlockCalls++
+			return map[string]any{
+				"number":       "0x10",
+				"hash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"transactions": []any{replacementHash},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-repriced"
+	client.cacheTime = 0
+
+	var replacements []public.ReplacementInfo
+	receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+		Hash:            common.HexToHash(originalHash),
+		Timeout:         2 * time.Second,
+		PollingInterval: 20 * time.Millisecond,
+		OnReplaced: func(info public.ReplacementInfo) {
+			replacements = append(replacements, info)
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(replacementHash), receipt.TransactionHash)
+	require.Len(t, replacements, 1)
+	assert.Equal(t, public.ReplacementReasonRepriced, replacements[0].Reason)
+	assert.Equal(t, common.HexToHash(originalHash), replacements[0].ReplacedTransaction.Hash)
+	assert.Equal(t, common.HexToHash(replacementHash), replacements[0].Transaction.Hash)
+	assert.Equal(t, 1, getBlockCalls)
+}
+
+func TestWaitForTransactionReceipt_ReplacementMinedBeforeTick(t *testing.T) {
+	originalHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	replacementHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+
+	var (
+		mu             sync.Mutex
+		headCalls      int
+		searchedBlocks []string
+	)
+	server := createTestServer(t, func(method string, params []any) any {
+		mu.Lock()
+		defer mu.Unlock()
+		switch method {
+		case "eth_blockNumber":
+			// The second tick skips a block: the replacement was mined in
+			// 0x11, which no tick sees as the head.
+			headCalls++
+			if headCalls == 1 {
+				return "0x10"
+			}
+			return "0x12"
+		case "eth_getTransactionCount":
+			if headCalls == 1 {
+				return "0x5" // nonce 5 is still pending
+			}
+			return "0x6"
+		case "eth_getTransactionByHash":
+			m := map[string]any{
+				"from":     "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"gas":      "0x5208",
+				"gasPrice": "0x1",
+				"hash":     params[0],
+				"input":    "0x",
+				"nonce":    "0x5",
+				"to":       "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"value":    "0x0",
+				"type":     "0x0",
+			}
+			if params[0] == replacementHash {
+				m["blockNumber"] = "0x11"
+			}
+			return m
+		case "eth_getTransactionReceipt":
+			if params[0] == originalHash {
+				return nil
+			}
+			return map[string]any{
+				"transactionHash": replacementHash,
+				"blockNumber":     "0x11",
+				"from":            "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		case "eth_getBlockByNumber":
+			searchedBlocks = append(searchedBlocks, params[0].(string))
+			txs := []any{}
+			if params[0] == "0x11" {
+				txs = append(txs, replacementHash)
+			}
+			return map[string]any{
+				"number":       params[0],
+				"hash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"transactions": txs,
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-replacement-before-tick"
+	client.cacheTime = time.Nanosecond
+
+	var replacements []public.ReplacementInfo
+	receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+		Hash:            common.HexToHash(originalHash),
+		Timeout:         2 * time.Second,
+		PollingInterval: 20 * time.Millisecond,
+		OnReplaced: func(info public.ReplacementInfo) {
+			replacements = append(replacements, info)
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(replacementHash), receipt.TransactionHash)
+	require.Len(t, replacements, 1)
+	assert.Equal(t, public.ReplacementReasonRepriced, replacements[0].Reason)
+	assert.Equal(t, []string{"0x11"}, searchedBlocks)
+}
+
+func TestWaitForTransactionReceipt_NoReplacementSearchWhileNonceUnused(t *testing.T) {
+	hash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	var receiptCalls, getBlockCalls int
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionCount":
+			return "0x5" // nonce 5 is still pending
+		case "eth_getTransactionByHash":
+			return map[string]any{
+				"from":  "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+				"hash":  hash,
+				"input": "0x",
+				"nonce": "0x5",
+				"value": "0x0",
+			}
+		case "eth_getTransactionReceipt":
+			receiptCalls++
+			if receiptCalls < 3 {
+				return nil
+			}
+			return map[string]any{
+				"transactionHash": hash,
+				"blockNumber":     "0x10",
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		case "eth_getBlockByNumber":
+			getBlockCalls++
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-nonce-unused"
+	client.cacheTime = 0
+
+	receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+		Hash:            common.HexToHash(hash),
+		Timeout:         2 * time.Second,
+		PollingInterval: 20 * time.Millisecond,
+		OnReplaced: func(info public.ReplacementInfo) {
+			t.Fatalf("unexpected replacement: %s", info.Reason)
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(hash), receipt.TransactionHash)
+	assert.Equal(t, 0, getBlockCalls)
+}
+
+func TestWaitForTransactionReceiptByNonce_ReplacedGuess(t *testing.T) {
+	sender := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	guessHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	otherHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+	replacementHash := "0x3333333333333333333333333333333333333333333333333333333333333333"
+
+	// The head advances one block per eth_blockNumber call from 0x10; nonce 5
+	// is consumed in block 0x12 by a transaction other than the guess.
+	var head atomic.Uint64
+	head.Store(0xf)
+	var countBlocks []string
+	var getBlockCalls []string
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return fmt.Sprintf("0x%x", head.Add(1))
+		case "eth_getTransactionCount":
+			block := params[1].(string)
+			countBlocks = append(countBlocks, block)
+			n, _ := hexutil.DecodeUint64(block)
+			if n >= 0x12 {
+				return "0x6"
+			}
+			return "0x5"
+		case "eth_getBlockByNumber":
+			getBlockCalls = append(getBlockCalls, params[0].(string))
+			return map[string]any{
+				"number":       params[0],
+				"hash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"transactions": []any{otherHash, replacementHash},
+			}
+		case "eth_getTransactionByHash":
+			from, nonce := sender, "0x5"
+			if params[0] == otherHash {
+				from = "0xcccccccccccccccccccccccccccccccccccccccc"
+			}
+			return map[string]any{
+				"from":        from,
+				"hash":        params[0],
+				"input":       "0x",
+				"nonce":       nonce,
+				"value":       "0x0",
+				"blockNumber": "0x12",
+			}
+		case "eth_getTransactionReceipt":
+			if params[0] != replacementHash {
+				return nil // the guess never mines
+			}
+			return map[string]any{
+				"transactionHash": replacementHash,
+				"blockNumber":     "0x12",
+				"from":            sender,
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-by-nonce"
+	client.cacheTime = time.Nanosecond // let every poll see the new head
+
+	guess := common.HexToHash(guessHash)
+	result, err := public.WaitForTransactionReceiptByNonce(context.Background(), client, public.WaitForTransactionReceiptByNonceParameters{
+		Address:         common.HexToAddress(sender),
+		Nonce:           5,
+		Hash:            &guess,
+		Timeout:         2 * time.Second,
+		PollingInterval: 20 * time.Millisecond,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(replacementHash), result.Hash)
+	assert.Equal(t, common.HexToHash(replacementHash), result.Receipt.TransactionHash)
+	assert.Equal(t, uint64(0x12), result.Receipt.BlockNumber)
+	assert.Equal(t, []string{"0x10", "0x11", "0x12"}, countBlocks)
+	assert.Equal(t, []string{"0x12"}, getBlockCalls)
+}
+
+func TestWaitForTransactionReceiptByNonce_AlreadyConsumed(t *testing.T) {
+	sender := "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	hash := "0x3333333333333333333333333333333333333333333333333333333333333333"
+
+	// Nonce 5 was consumed in block 0x2a, long before waiting started.
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return "0x64"
+		case "eth_getTransactionCount":
+			n, _ := hexutil.DecodeUint64(params[1].(string))
+			if n >= 0x2a {
+				return "0x6"
+			}
+			return "0x5"
+		case "eth_getBlockByNumber":
+			if params[0] != "0x2a" {
+				t.Errorf("unexpected block %v", params[0])
+			}
+			return map[string]any{"number": params[0], "transactions": []any{hash}}
+		case "eth_getTransactionByHash":
+			return map[string]any{"from": sender, "hash": hash, "input": "0x", "nonce": "0x5", "value": "0x0"}
+		case "eth_getTransactionReceipt":
+			return map[string]any{"transactionHash": hash, "blockNumber": "0x2a", "from": sender, "status": "0x1", "logs": []any{}}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-by-nonce-consumed"
+	client.cacheTime = 0
+
+	result, err := public.WaitForTransactionReceiptByNonce(context.Background(), client, public.WaitForTransactionReceiptByNonceParameters{
+		Address: common.HexToAddress(sender),
+		Nonce:   5,
+		Timeout: time.Second,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, common.HexToHash(hash), result.Hash)
+	assert.Equal(t, uint64(0x2a), result.Receipt.BlockNumber)
+}
+
+func TestWaitForTransactionReceipt_ChecksOnNewHeads(t *testing.T) {
+	hash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	var mined atomic.Bool
+	methods := make(chan string, 64)
+	client := newWatchMockClientWithHandler(t, "webSocket", methods, func(method string, params []any) any {
+		if method == "eth_getTransactionReceipt" && mined.Load() {
+			return map[string]any{
+				"transactionHash": hash,
+				"blockNumber":     "0x10",
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		}
+		return nil
+	})
+
+	checkReplacement := false
+	type result struct {
+		receipt *types.Receipt
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+			Hash:             common.HexToHash(hash),
+			Timeout:          5 * time.Second,
+			PollingInterval:  time.Hour, // only a new head can trigger a check
+			CheckReplacement: &checkReplacement,
+		})
+		done <- result{receipt, err}
+	}()
+
+	<-client.subscribed
+	mined.Store(true)
+	// The first head is skipped as the initial block, the second is emitted.
+	go func() {
+		client.onData(json.RawMessage(`{"number":"0x10"}`))
+		client.onData(json.RawMessage(`{"number":"0x11"}`))
+	}()
+
+	select {
+	case res := <-done:
+		require.NoError(t, res.err)
+		assert.Equal(t, common.HexToHash(hash), res.receipt.TransactionHash)
+	case <-time.After(2 * time.Second):
+		t.Fatal("new head did not trigger a receipt check")
+	}
+
+	close(methods)
+	for method := range methods {
+		assert.NotEqual(t, "eth_blockNumber", method, "subscription mode should not poll the block number")
+	}
+}
+
+func TestWaitForTransactionReceipt_ConfirmationsFromNewHeads(t *testing.T) {
+	hash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	client := newWatchMockClientWithHandler(t, "webSocket", make(chan string, 64), func(method string, params []any) any {
+		if method == "eth_getTransactionReceipt" {
+			return map[string]any{
+				"transactionHash": hash,
+				"blockNumber":     "0x10",
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		}
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+			Hash:            common.HexToHash(hash),
+			Confirmations:   3,
+			Timeout:         5 * time.Second,
+			PollingInterval: time.Hour,
+		})
+		done <- err
+	}()
+
+	<-client.subscribed
+	client.onData(json.RawMessage(`{"number":"0x11"}`)) // 2 confirmations
+
+	select {
+	case <-done:
+		t.Fatal("resolved before reaching 3 confirmations")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	client.onData(json.RawMessage(`{"number":"0x12"}`)) // 3 confirmations
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not resolve after 3 confirmations")
+	}
+}
+
+// finalizedTestServer serves a receipt mined in block 0x10 and a finalized
+// block that advances by 4 on every request, starting at 0x8. With
+// finalizedSupported false, the finalized tag returns no block.
+func finalizedTestServer(t *testing.T, finalizedSupported bool, finalizedRequests *atomic.Int32) *httptest.Server {
+	hash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+	return createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_getTransactionReceipt":
+			return map[string]any{
+				"transactionHash": hash,
+				"blockNumber":     "0x10",
+				"status":          "0x1",
+				"logs":            []any{},
+			}
+		case "eth_blockNumber":
+			return "0x20"
+		case "eth_getBlockByNumber":
+			if params[0] != "finalized" {
+				return nil
+			}
+			n := finalizedRequests.Add(1)
+			if !finalizedSupported {
+				return nil
+			}
+			return reorgTestBlock(uint64(4+4*n), hash, hash)
+		}
+		return nil
+	})
+}
+
+func TestWaitForTransactionReceipt_WaitForFinalized(t *testing.T) {
+	var finalizedRequests atomic.Int32
+	server := finalizedTestServer(t, true, &finalizedRequests)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-finalized"
+
+	receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+		Hash:             common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111"),
+		WaitForFinalized: true,
+		Timeout:          5 * time.Second,
+		PollingInterval:  10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x10), receipt.BlockNumber)
+
+	// Finalized was at 0x8, 0xc, then 0x10: the wait resolved on the third check
+	assert.Equal(t, int32(3), finalizedRequests.Load())
+}
+
+func TestWaitForTransactionReceipt_WaitForFinalizedFallsBackToConfirmations(t *testing.T) {
+	var finalizedRequests atomic.Int32
+	server := finalizedTestServer(t, false, &finalizedRequests)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-wait-finalized-unsupported"
+
+	receipt, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+		Hash:             common.HexToHash("0x1111111111111111111111111111111111111111111111111111111111111111"),
+		WaitForFinalized: true,
+		Confirmations:    3,
+		Timeout:          5 * time.Second,
+		PollingInterval:  10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x10), receipt.BlockNumber)
+
+	// The finalized tag is only tried once; confirmations are counted after
+	assert.Equal(t, int32(1), finalizedRequests.Load())
+}
+
+func TestWaitForTransactionReceipt_FallsBackToPollingOnSubscriptionError(t *testing.T) {
+	hash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	var mined atomic.Bool
+	methods := make(chan string, 64)
+	client := newWatchMockClientWithHandler(t, "webSocket", methods, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionReceipt":
+			if mined.Load() {
+				return map[string]any{
+					"transactionHash": hash,
+					"blockNumber":     "0x10",
+					"status":          "0x1",
+					"logs":            []any{},
+				}
+			}
+		}
+		return nil
+	})
+	client.cacheTime = 0
+
+	checkReplacement := false
+	done := make(chan error, 1)
+	go func() {
+		_, err := public.WaitForTransactionReceipt(context.Background(), client, public.WaitForTransactionReceiptParameters{
+			Hash:             common.HexToHash(hash),
+			Timeout:          5 * time.Second,
+			PollingInterval:  20 * time.Millisecond,
+			CheckReplacement: &checkReplacement,
+		})
+		done <- err
+	}()
+
+	<-client.subscribed
+	mined.Store(true)
+	client.onError(fmt.Errorf("connection closed"))
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(2 * time.Second):
+		t.Fatal("did not fall back to polling")
+	}
+}
+
+// ============================================================================
+// FillTransaction Tests
+// ============================================================================
+
+func TestFillTransaction_Basic(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_fillTransaction" {
+			return map[string]any{
+				"raw": "0xf86c808504a817c80082520894bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb880de0b6b3a76400008025a0abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890a0abcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				"tx": map[string]any{
+					"from":                 "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+					"to":                   "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb",
+					"input":                "0x",
+					"value":                "0xde0b6b3a7640000",
+					"nonce":                "0x5",
+					"gas":                  "0x5208",
+					"maxFeePerGas":         "0x4a817c800",
+					"maxPriorityFeePerGas": "0x77359400",
+					"chainId":              "0x1",
+					"type":                 "0x2",
+				},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
 	ctx := context.Background()
 
 	from := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
@@ -1492,51 +2675,227 @@ func TestFillTransaction_InvalidBaseFeeMultiplier(t *testing.T) {
 }
 
 // ============================================================================
-// GetCode Tests
+// PrepareTransactionRequest Tests
 // ============================================================================
 
-func TestGetCode_Basic(t *testing.T) {
-	server := createTestServer(t, func(method string, params []any) any {
-		if method == "eth_getCode" {
-			// Simple bytecode
-			return "0x6001600101"
+func createPrepareTransactionServer(t *testing.T, calls map[string]int) *httptest.Server {
+	return createTestServer(t, func(method string, params []any) any {
+		calls[method]++
+		switch method {
+		case "eth_getTransactionCount":
+			return "0x7"
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getBlockByNumber":
+			return map[string]any{
+				"number":        "0x10",
+				"baseFeePerGas": "0x3b9aca00", // 1 gwei
+			}
+		case "eth_maxPriorityFeePerGas":
+			return "0x5f5e100" // 0.1 gwei
+		case "eth_gasPrice":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			return "0x5208"
 		}
-		return "0x"
+		return nil
 	})
+}
+
+func TestPrepareTransactionRequest_FillsAllFields(t *testing.T) {
+	calls := map[string]int{}
+	server := createPrepareTransactionServer(t, calls)
 	defer server.Close()
 
 	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1}
 	ctx := context.Background()
 
-	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
-	code, err := public.GetCode(ctx, client, public.GetCodeParameters{
-		Address: addr,
-	})
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
 
+	prepared, err := public.PrepareTransactionRequest(ctx, client, public.PrepareTransactionRequestParameters{
+		Account: &account,
+		To:      &to,
+		Value:   big.NewInt(1),
+	})
 	require.NoError(t, err)
-	require.NotNil(t, code)
-	assert.Equal(t, common.FromHex("0x6001600101"), code)
+
+	require.NotNil(t, prepared.Nonce)
+	assert.Equal(t, uint64(7), *prepared.Nonce)
+	require.NotNil(t, prepared.ChainID)
+	assert.Equal(t, int64(1), *prepared.ChainID)
+	assert.Equal(t, "eip1559", string(prepared.Type))
+	require.NotNil(t, prepared.MaxPriorityFeePerGas)
+	assert.Equal(t, big.NewInt(100_000_000), prepared.MaxPriorityFeePerGas)
+	require.NotNil(t, prepared.MaxFeePerGas)
+	assert.Equal(t, big.NewInt(1_300_000_000), prepared.MaxFeePerGas) // 1.2 * base + priority
+	assert.Nil(t, prepared.GasPrice)
+	require.NotNil(t, prepared.Gas)
+	assert.Equal(t, uint64(21000), *prepared.Gas)
+
+	// chainId comes from the client chain, not the network
+	assert.Equal(t, 0, calls["eth_chainId"])
 }
 
-func TestGetCode_Empty(t *testing.T) {
-	server := createTestServer(t, func(method string, params []any) any {
-		if method == "eth_getCode" {
-			return "0x"
-		}
-		return "0x"
-	})
+func TestPrepareTransactionRequest_PreservesSuppliedValues(t *testing.T) {
+	calls := map[string]int{}
+	server := createPrepareTransactionServer(t, calls)
 	defer server.Close()
 
 	client := createMockClient(t, server.URL)
 	ctx := context.Background()
 
-	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
-	code, err := public.GetCode(ctx, client, public.GetCodeParameters{
-		Address: addr,
-	})
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	nonce := uint64(42)
+	gas := uint64(100000)
+	chainID := int64(10)
+	gasPrice := big.NewInt(5_000_000_000)
 
-	require.NoError(t, err)
-	assert.Nil(t, code)
+	prepared, err := public.PrepareTransactionRequest(ctx, client, public.PrepareTransactionRequestParameters{
+		Account:  &account,
+		To:       &to,
+		Nonce:    &nonce,
+		Gas:      &gas,
+		ChainID:  &chainID,
+		GasPrice: gasPrice,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(42), *prepared.Nonce)
+	assert.Equal(t, uint64(100000), *prepared.Gas)
+	assert.Equal(t, int64(10), *prepared.ChainID)
+	assert.Equal(t, gasPrice, prepared.GasPrice)
+	assert.Equal(t, "legacy", string(prepared.Type))
+	assert.Nil(t, prepared.MaxFeePerGas)
+
+	assert.Equal(t, 0, calls["eth_getTransactionCount"])
+	assert.Equal(t, 0, calls["eth_estimateGas"])
+	assert.Equal(t, 0, calls["eth_chainId"])
+	assert.Equal(t, 0, calls["eth_getBlockByNumber"])
+}
+
+func TestPrepareTransactionRequest_LegacyChain(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x38"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10"} // no baseFeePerGas
+		case "eth_gasPrice":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			return "0x5208"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	prepared, err := public.PrepareTransactionRequest(ctx, client, public.PrepareTransactionRequestParameters{
+		To: &to,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "legacy", string(prepared.Type))
+	assert.Equal(t, int64(56), *prepared.ChainID)
+	assert.Equal(t, big.NewInt(1_200_000_000), prepared.GasPrice)
+	assert.Nil(t, prepared.MaxFeePerGas)
+	assert.Nil(t, prepared.Nonce) // no account to fetch a nonce for
+}
+
+func TestPrepareTransactionRequest_OnlyRequestedParameters(t *testing.T) {
+	calls := map[string]int{}
+	server := createPrepareTransactionServer(t, calls)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	prepared, err := public.PrepareTransactionRequest(ctx, client, public.PrepareTransactionRequestParameters{
+		Parameters: []string{"gas"},
+		Account:    &account,
+		To:         &to,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(21000), *prepared.Gas)
+	assert.Nil(t, prepared.Nonce)
+	assert.Nil(t, prepared.ChainID)
+	assert.Empty(t, prepared.Type)
+	assert.Equal(t, map[string]int{"eth_estimateGas": 1}, calls)
+}
+
+func TestPrepareTransactionRequest_ConflictingType(t *testing.T) {
+	calls := map[string]int{}
+	server := createPrepareTransactionServer(t, calls)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	_, err := public.PrepareTransactionRequest(context.Background(), client, public.PrepareTransactionRequestParameters{
+		To:           &to,
+		Type:         transaction.TransactionTypeLegacy,
+		MaxFeePerGas: big.NewInt(1),
+	})
+	require.ErrorIs(t, err, transaction.ErrConflictingTransactionFields)
+	assert.Empty(t, calls)
+}
+
+// ============================================================================
+// GetCode Tests
+// ============================================================================
+
+func TestGetCode_Basic(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getCode" {
+			// Simple bytecode
+			return "0x6001600101"
+		}
+		return "0x"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	code, err := public.GetCode(ctx, client, public.GetCodeParameters{
+		Address: addr,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, code)
+	assert.Equal(t, common.FromHex("0x6001600101"), code)
+}
+
+func TestGetCode_Empty(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getCode" {
+			return "0x"
+		}
+		return "0x"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	code, err := public.GetCode(ctx, client, public.GetCodeParameters{
+		Address: addr,
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, code)
+	assert.Empty(t, code)
 }
 
 func TestGetCode_WithBlockNumber(t *testing.T) {
@@ -1567,6 +2926,61 @@ func TestGetCode_WithBlockNumber(t *testing.T) {
 	assert.Equal(t, "0x64", capturedParams[1]) // 100 in hex
 }
 
+// ============================================================================
+// GetBytecode Tests
+// ============================================================================
+
+func TestGetBytecode(t *testing.T) {
+	eoa := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	contract := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	deployedAt := uint64(100)
+
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "eth_getCode" || common.HexToAddress(params[0].(string)) != contract {
+			return "0x"
+		}
+		if tag := params[1].(string); tag != "latest" {
+			if n, err := hexutil.DecodeUint64(tag); err == nil && n < deployedAt {
+				return "0x"
+			}
+		}
+		return "0x6001600101"
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	t.Run("eoa returns nil", func(t *testing.T) {
+		code, err := public.GetBytecode(ctx, client, public.GetBytecodeParameters{Address: eoa})
+		require.NoError(t, err)
+		assert.Nil(t, code)
+	})
+
+	t.Run("contract returns code", func(t *testing.T) {
+		code, err := public.GetBytecode(ctx, client, public.GetBytecodeParameters{Address: contract})
+		require.NoError(t, err)
+		assert.Equal(t, common.FromHex("0x6001600101"), code)
+	})
+
+	t.Run("before deployment returns nil", func(t *testing.T) {
+		before := deployedAt - 1
+		code, err := public.GetBytecode(ctx, client, public.GetBytecodeParameters{
+			Address:     contract,
+			BlockNumber: &before,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, code)
+
+		code, err = public.GetBytecode(ctx, client, public.GetBytecodeParameters{
+			Address:     contract,
+			BlockNumber: &deployedAt,
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, code)
+	})
+}
+
 // ============================================================================
 // GetStorageAt Tests
 // ============================================================================
@@ -1651,6 +3065,211 @@ func TestGetStorageAt_WithBlockTag(t *testing.T) {
 	assert.Equal(t, "safe", capturedParams[2])
 }
 
+// ============================================================================
+// GetStorageAtMany Tests
+// ============================================================================
+
+// storageManySlots are the slots read by the GetStorageAtMany tests; slot i
+// holds the value i+1.
+var storageManySlots = []common.Hash{
+	common.HexToHash("0x4"),
+	common.HexToHash("0x0"),
+	common.HexToHash("0x3"),
+	common.HexToHash("0x1"),
+	common.HexToHash("0x2"),
+}
+
+// storageManyServer serves eth_getStorageAt and an eth_call against the
+// storage reader override, where slot n holds n+1. If callErr is set,
+// eth_call fails with it instead.
+func storageManyServer(t *testing.T, calls map[string]int, mu *sync.Mutex, callErr map[string]any) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		mu.Lock()
+		calls[req.Method]++
+		mu.Unlock()
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_call":
+			if callErr != nil {
+				resp["error"] = callErr
+				break
+			}
+			// The target's code is overridden with the storage reader.
+			overrides := req.Params[2].(map[string]any)
+			require.Contains(t, overrides, "0x1234567890123456789012345678901234567890")
+
+			data := common.FromHex(req.Params[0].(map[string]any)["data"].(string))
+			out := make([]byte, 0, len(data))
+			for i := 0; i < len(data); i += 32 {
+				slot := new(big.Int).SetBytes(data[i : i+32])
+				out = append(out, common.BigToHash(new(big.Int).Add(slot, big.NewInt(1))).Bytes()...)
+			}
+			resp["result"] = hexutil.Encode(out)
+		case "eth_getStorageAt":
+			slot := new(big.Int).SetBytes(common.FromHex(req.Params[1].(string)))
+			resp["result"] = common.BigToHash(new(big.Int).Add(slot, big.NewInt(1))).Hex()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func assertStorageManyValues(t *testing.T, values [][]byte) {
+	t.Helper()
+	require.Len(t, values, len(storageManySlots))
+	for i, slot := range storageManySlots {
+		want := new(big.Int).Add(slot.Big(), big.NewInt(1))
+		assert.Equal(t, want, new(big.Int).SetBytes(values[i]), "slot %s", slot.Hex())
+	}
+}
+
+func TestGetStorageAtMany_Batched(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := storageManyServer(t, calls, &mu, nil)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	values, err := public.GetStorageAtMany(context.Background(), client, public.GetStorageAtManyParameters{
+		Address: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Slots:   storageManySlots,
+	})
+	require.NoError(t, err)
+	assertStorageManyValues(t, values)
+
+	// A single round trip.
+	assert.Equal(t, map[string]int{"eth_call": 1}, calls)
+}
+
+func TestGetStorageAtMany_FallsBackWithoutStateOverrides(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := storageManyServer(t, calls, &mu, map[string]any{
+		"code":    -32602,
+		"message": "invalid argument 2: state override is not supported",
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	values, err := public.GetStorageAtMany(context.Background(), client, public.GetStorageAtManyParameters{
+		Address:               common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Slots:                 storageManySlots,
+		MaxConcurrentRequests: 2,
+	})
+	require.NoError(t, err)
+	assertStorageManyValues(t, values)
+	assert.Equal(t, map[string]int{"eth_call": 1, "eth_getStorageAt": 5}, calls)
+}
+
+func TestGetStorageAtMany_BatchDisabled(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	server := storageManyServer(t, calls, &mu, nil)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	batch := false
+	values, err := public.GetStorageAtMany(context.Background(), client, public.GetStorageAtManyParameters{
+		Address: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Slots:   storageManySlots,
+		Batch:   &batch,
+	})
+	require.NoError(t, err)
+	assertStorageManyValues(t, values)
+	assert.Equal(t, map[string]int{"eth_getStorageAt": 5}, calls)
+}
+
+// ============================================================================
+// GetBalances Tests
+// ============================================================================
+
+var getBalancesAddresses = []common.Address{
+	common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+	common.HexToAddress("0x00000000000000000000000000000000000000a2"),
+	common.HexToAddress("0x00000000000000000000000000000000000000a3"),
+}
+
+// getBalancesBalance is the balance the test servers report for addr: its
+// last byte in ether.
+func getBalancesBalance(addr common.Address) *big.Int {
+	return new(big.Int).Mul(big.NewInt(int64(addr[19])), big.NewInt(1e18))
+}
+
+func TestGetBalances_Multicall(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+	var calldata string
+	server := createTestServer(t, func(method string, params []any) any {
+		mu.Lock()
+		calls[method]++
+		mu.Unlock()
+		if method != "eth_call" {
+			return nil
+		}
+		calldata = params[0].(map[string]any)["data"].(string)
+		results := make([]multicallReturn, len(getBalancesAddresses))
+		for i, addr := range getBalancesAddresses {
+			results[i] = multicallReturn{success: true, returnData: common.LeftPadBytes(getBalancesBalance(addr).Bytes(), 32)}
+		}
+		return encodeAggregate3Response(results)
+	})
+	defer server.Close()
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	client := createMockClient(t, server.URL)
+	client.chain = &chain.Chain{ID: 1, Contracts: &chain.ChainContracts{
+		Multicall3: &chain.ChainContract{Address: multicallAddress},
+	}}
+
+	balances, err := public.GetBalances(context.Background(), client, public.GetBalancesParameters{
+		Addresses: getBalancesAddresses,
+	})
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	for i, addr := range getBalancesAddresses {
+		assert.Equal(t, getBalancesBalance(addr), balances[i])
+		// getEthBalance(addr) on multicall3 itself
+		assert.Contains(t, calldata, "4d2301cc"+strings.ToLower(common.BytesToHash(addr.Bytes()).Hex()[2:]))
+	}
+	assert.Equal(t, map[string]int{"eth_call": 1}, calls)
+}
+
+func TestGetBalances_ConcurrentFallback(t *testing.T) {
+	var counter inFlightCounter
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_getBalance", method)
+		return counter.track(func() any {
+			return hexutil.EncodeBig(getBalancesBalance(common.HexToAddress(params[0].(string))))
+		})
+	})
+	defer server.Close()
+
+	// No chain, so multicall3 is unavailable
+	client := createMockClient(t, server.URL)
+
+	balances, err := public.GetBalances(context.Background(), client, public.GetBalancesParameters{
+		Addresses:             getBalancesAddresses,
+		MaxConcurrentRequests: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, balances, 3)
+	for i, addr := range getBalancesAddresses {
+		assert.Equal(t, getBalancesBalance(addr), balances[i])
+	}
+	assert.LessOrEqual(t, counter.Peak(), 2)
+}
+
 // ============================================================================
 // GetProof Tests
 // ============================================================================
@@ -1660,16 +3279,16 @@ func TestGetProof_Basic(t *testing.T) {
 		if method == "eth_getProof" {
 			return map[string]any{
 				"address":      "0x1234567890123456789012345678901234567890",
-				"accountProof": []string{"0xabc"},
+				"accountProof": []string{"0x0abc"},
 				"balance":      "0xde0b6b3a7640000", // 1 ETH
-				"codeHash":     "0xcodehash",
+				"codeHash":     "0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
 				"nonce":        "0x1",
-				"storageHash":  "0xstoragehash",
+				"storageHash":  "0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421",
 				"storageProof": []any{
 					map[string]any{
 						"key":   "0x0",
 						"value": "0x2a",
-						"proof": []any{"0xdef"},
+						"proof": []any{"0x0def"},
 					},
 				},
 			}
@@ -1690,14 +3309,42 @@ func TestGetProof_Basic(t *testing.T) {
 	})
 
 	require.NoError(t, err)
-	assert.Equal(t, addr.Hex(), proof.Address)
+	assert.Equal(t, addr, proof.Address)
 	require.NotNil(t, proof.Balance)
 	assert.Equal(t, "1000000000000000000", proof.Balance.String())
+	assert.Equal(t, uint64(1), proof.Nonce)
+	assert.Equal(t, common.HexToHash("0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"), proof.CodeHash)
+	assert.Equal(t, [][]byte{{0x0a, 0xbc}}, proof.AccountProof)
 	require.Len(t, proof.StorageProof, 1)
+	assert.Equal(t, slot, proof.StorageProof[0].Key)
+	assert.Equal(t, [][]byte{{0x0d, 0xef}}, proof.StorageProof[0].Proof)
 	require.NotNil(t, proof.StorageProof[0].Value)
 	assert.Equal(t, big.NewInt(0x2a).String(), proof.StorageProof[0].Value.String())
 }
 
+func TestGetProof_InvalidHex(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getProof" {
+			return map[string]any{
+				"address":      "0x1234567890123456789012345678901234567890",
+				"accountProof": []string{"0xnothex"},
+				"balance":      "0x0",
+				"nonce":        "0x0",
+				"storageProof": []any{},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	_, err := public.GetProof(context.Background(), client, public.GetProofParameters{
+		Address: common.HexToAddress("0x1234567890123456789012345678901234567890"),
+	})
+	assert.Error(t, err)
+}
+
 // ============================================================================
 // GetChainID & GetGasPrice Tests
 // ============================================================================
@@ -1742,60 +3389,606 @@ func TestGetGasPrice_Basic(t *testing.T) {
 	assert.Equal(t, 0, gasPrice.Cmp(expected))
 }
 
-// ============================================================================
-// GetFeeHistory Tests
-// ============================================================================
+// feeCacheMockClient is a mockClient with fee caching enabled.
+type feeCacheMockClient struct {
+	*mockClient
+	feeCacheTime time.Duration
+}
 
-func TestGetFeeHistory_Basic(t *testing.T) {
+func (c *feeCacheMockClient) FeeCacheTime() time.Duration { return c.feeCacheTime }
+
+func newFeeCacheMockClient(t *testing.T, serverURL string, cacheTime time.Duration) *feeCacheMockClient {
+	mc := createMockClient(t, serverURL)
+	// The fee cache is shared per client UID, so keep each test isolated.
+	mc.uid = t.Name()
+	return &feeCacheMockClient{mockClient: mc, feeCacheTime: cacheTime}
+}
+
+func TestGetGasPrice_CachedWithinFeeCacheTime(t *testing.T) {
+	var calls atomic.Int32
 	server := createTestServer(t, func(method string, params []any) any {
-		if method == "eth_feeHistory" {
-			return map[string]any{
-				"baseFeePerGas": []any{"0x1", "0x2"},
-				"gasUsedRatio":  []any{0.5, 0.6},
-				"oldestBlock":   "0x10",
-				"reward": [][]any{
-					{"0x3", "0x4"},
-					{"0x5", "0x6"},
-				},
-			}
+		if method == "eth_gasPrice" {
+			calls.Add(1)
+			return "0x4a817c800"
 		}
 		return nil
 	})
 	defer server.Close()
 
-	client := createMockClient(t, server.URL)
+	client := newFeeCacheMockClient(t, server.URL, time.Minute)
 	ctx := context.Background()
 
-	history, err := public.GetFeeHistory(ctx, client, public.GetFeeHistoryParameters{
-		BlockCount:        2,
-		RewardPercentiles: []float64{25, 75},
-	})
-
+	first, err := public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	second, err := public.GetGasPrice(ctx, client)
 	require.NoError(t, err)
-	assert.Len(t, history.BaseFeePerGas, 2)
-	assert.Equal(t, "1", history.BaseFeePerGas[0].String())
-	assert.Equal(t, "2", history.BaseFeePerGas[1].String())
-
-	require.NotNil(t, history.OldestBlock)
-	assert.Equal(t, "16", history.OldestBlock.String())
 
-	assert.Len(t, history.GasUsedRatio, 2)
-	assert.InDelta(t, 0.5, history.GasUsedRatio[0], 1e-9)
-	assert.InDelta(t, 0.6, history.GasUsedRatio[1], 1e-9)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, 0, first.Cmp(second))
 
-	require.Len(t, history.Reward, 2)
-	require.Len(t, history.Reward[0], 2)
-	assert.Equal(t, "3", history.Reward[0][0].String())
-	assert.Equal(t, "4", history.Reward[0][1].String())
-	assert.Equal(t, "5", history.Reward[1][0].String())
-	assert.Equal(t, "6", history.Reward[1][1].String())
+	// Mutating a returned value must not affect the cache.
+	first.SetInt64(1)
+	third, err := public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20000000000), third.Int64())
 }
 
-func TestGetTransaction_Count(t *testing.T) {
+func TestGetGasPrice_FeeCacheExpiresAndInvalidates(t *testing.T) {
+	var calls atomic.Int32
 	server := createTestServer(t, func(method string, params []any) any {
-		if method == "eth_getTransactionCount" {
-			return "0x2"
-		}
+		if method == "eth_gasPrice" {
+			calls.Add(1)
+			return "0x4a817c800"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := newFeeCacheMockClient(t, server.URL, 50*time.Millisecond)
+	ctx := context.Background()
+
+	_, err := public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+
+	public.InvalidateFeeCache(client)
+	_, err = public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+
+	time.Sleep(75 * time.Millisecond)
+	_, err = public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+}
+
+func TestGetGasPrice_NoFeeCacheByDefault(t *testing.T) {
+	var calls atomic.Int32
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_gasPrice" {
+			calls.Add(1)
+			return "0x4a817c800"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := newFeeCacheMockClient(t, server.URL, 0)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := public.GetGasPrice(ctx, client)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestEstimateFeesPerGas_CachedWithinFeeCacheTime(t *testing.T) {
+	calls := map[string]int{}
+	server := createPrepareTransactionServer(t, calls)
+	defer server.Close()
+
+	client := newFeeCacheMockClient(t, server.URL, time.Minute)
+	ctx := context.Background()
+
+	first, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{})
+	require.NoError(t, err)
+	second, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, calls["eth_getBlockByNumber"])
+	assert.Equal(t, 0, first.MaxFeePerGas.Cmp(second.MaxFeePerGas))
+	assert.Equal(t, 0, first.MaxPriorityFeePerGas.Cmp(second.MaxPriorityFeePerGas))
+
+	// A different fee type is cached separately.
+	legacy, err := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{
+		Type: public.FeeValuesTypeLegacy,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, legacy.GasPrice)
+	assert.Equal(t, 2, calls["eth_getBlockByNumber"])
+}
+
+// ============================================================================
+// GetLogs Tests
+// ============================================================================
+
+// removedLogJSON is a log the node re-sent after a reorg orphaned its block.
+const removedLogJSON = `{
+	"address": "0x1234567890123456789012345678901234567890",
+	"topics": ["0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"],
+	"data": "0x",
+	"blockNumber": "0x10",
+	"blockHash": "0x00000000000000000000000000000000000000000000000000000000000000aa",
+	"transactionHash": "0x00000000000000000000000000000000000000000000000000000000000000bb",
+	"transactionIndex": "0x0",
+	"logIndex": "0x2",
+	"removed": true
+}`
+
+func TestGetLogs_Address(t *testing.T) {
+	token0 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token1 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	tests := []struct {
+		name    string
+		address any
+		want    any
+	}{
+		{"single address", token0, token0.Hex()},
+		{"single-element slice", []common.Address{token0}, token0.Hex()},
+		{"two addresses", []common.Address{token0, token1}, []any{token0.Hex(), token1.Hex()}},
+		{"string slice", []string{token0.Hex(), token1.Hex()}, []any{token0.Hex(), token1.Hex()}},
+		{"empty slice", []common.Address{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var filter map[string]any
+			server := createTestServer(t, func(method string, params []any) any {
+				filter = params[0].(map[string]any)
+				return []any{}
+			})
+			defer server.Close()
+
+			_, err := public.GetLogs(context.Background(), createMockClient(t, server.URL), public.GetLogsParameters{Address: tt.address})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, filter["address"])
+		})
+	}
+}
+
+func TestGetLogs_RemovedLog(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getLogs" {
+			return []json.RawMessage{json.RawMessage(removedLogJSON)}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	logs, err := public.GetLogs(context.Background(), client, public.GetLogsParameters{})
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.True(t, logs[0].Removed)
+	assert.Equal(t, big.NewInt(16), logs[0].BlockNumber)
+}
+
+func TestLog_UnmarshalRemoved(t *testing.T) {
+	var log types.Log
+	require.NoError(t, json.Unmarshal([]byte(removedLogJSON), &log))
+	assert.True(t, log.Removed)
+	assert.Equal(t, uint64(16), log.BlockNumber)
+	assert.Equal(t, uint64(2), log.LogIndex)
+}
+
+// ============================================================================
+// StreamLogs Tests
+// ============================================================================
+
+// streamLogsServer answers eth_getLogs with one log per requested chunk,
+// numbered by the chunk's fromBlock, and records the requested ranges.
+func streamLogsServer(t *testing.T, ranges *[][2]string, mu *sync.Mutex) *httptest.Server {
+	return createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return "0x1d"
+		case "eth_getLogs":
+			filter := params[0].(map[string]any)
+			from, to := filter["fromBlock"].(string), filter["toBlock"].(string)
+			mu.Lock()
+			*ranges = append(*ranges, [2]string{from, to})
+			mu.Unlock()
+			return []map[string]any{{
+				"address":          "0x1234567890123456789012345678901234567890",
+				"topics":           []string{"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"},
+				"data":             "0x",
+				"blockNumber":      from,
+				"blockHash":        "0x00000000000000000000000000000000000000000000000000000000000000aa",
+				"transactionHash":  "0x00000000000000000000000000000000000000000000000000000000000000bb",
+				"transactionIndex": "0x0",
+				"logIndex":         "0x0",
+			}}
+		}
+		return nil
+	})
+}
+
+func TestStreamLogs_Chunks(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		ranges [][2]string
+	)
+	server := streamLogsServer(t, &ranges, &mu)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	toBlock := uint64(29)
+	var blocks []int64
+	for item := range public.StreamLogs(context.Background(), client, public.StreamLogsParameters{
+		FromBlock: 0,
+		ToBlock:   &toBlock,
+		ChunkSize: 10,
+	}) {
+		require.NoError(t, item.Error)
+		blocks = append(blocks, item.Log.BlockNumber.Int64())
+	}
+
+	assert.Equal(t, []int64{0, 10, 20}, blocks)
+	// Chunks are fetched concurrently, so only the delivery order is fixed.
+	assert.ElementsMatch(t, [][2]string{{"0x0", "0x9"}, {"0xa", "0x13"}, {"0x14", "0x1d"}}, ranges)
+}
+
+func TestStreamLogs_DefaultsToLatestBlock(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		ranges [][2]string
+	)
+	server := streamLogsServer(t, &ranges, &mu)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.uid = "test-stream-logs-latest"
+
+	var count int
+	for item := range public.StreamLogs(context.Background(), client, public.StreamLogsParameters{
+		FromBlock: 25,
+	}) {
+		require.NoError(t, item.Error)
+		count++
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Equal(t, [][2]string{{"0x19", "0x1d"}}, ranges)
+}
+
+func TestStreamLogs_ContextCancel(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		ranges [][2]string
+	)
+	server := streamLogsServer(t, &ranges, &mu)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	toBlock := uint64(1_000_000)
+	ch := public.StreamLogs(ctx, client, public.StreamLogsParameters{
+		ToBlock:             &toBlock,
+		ChunkSize:           10,
+		MaxConcurrentChunks: 1,
+	})
+
+	first := <-ch
+	require.NoError(t, first.Error)
+	cancel()
+
+	// The channel is closed promptly without scanning the whole range.
+	for range ch {
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Less(t, len(ranges), 5)
+}
+
+func TestStreamLogs_InvalidRange(t *testing.T) {
+	client := createMockClient(t, "http://127.0.0.1:1")
+
+	toBlock := uint64(5)
+	ch := public.StreamLogs(context.Background(), client, public.StreamLogsParameters{
+		FromBlock: 10,
+		ToBlock:   &toBlock,
+	})
+
+	item, ok := <-ch
+	require.True(t, ok)
+	assert.Error(t, item.Error)
+	_, ok = <-ch
+	assert.False(t, ok)
+}
+
+// ============================================================================
+// Bounded Concurrency Tests
+// ============================================================================
+
+// inFlightCounter records the peak number of mock requests served at once.
+type inFlightCounter struct {
+	mu       sync.Mutex
+	inFlight int
+	peak     int
+}
+
+// track counts a request as in flight while fn runs, holding it open long
+// enough for concurrent requests to overlap.
+func (c *inFlightCounter) track(fn func() any) any {
+	c.mu.Lock()
+	c.inFlight++
+	c.peak = max(c.peak, c.inFlight)
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		c.inFlight--
+		c.mu.Unlock()
+	}()
+	time.Sleep(20 * time.Millisecond)
+	return fn()
+}
+
+func (c *inFlightCounter) Peak() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.peak
+}
+
+func TestStreamLogs_MaxConcurrentChunks(t *testing.T) {
+	var counter inFlightCounter
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_getLogs", method)
+		return counter.track(func() any {
+			return []map[string]any{{
+				"address":          "0x1234567890123456789012345678901234567890",
+				"topics":           []string{},
+				"data":             "0x",
+				"blockNumber":      params[0].(map[string]any)["fromBlock"],
+				"blockHash":        "0x00000000000000000000000000000000000000000000000000000000000000aa",
+				"transactionHash":  "0x00000000000000000000000000000000000000000000000000000000000000bb",
+				"transactionIndex": "0x0",
+				"logIndex":         "0x0",
+			}}
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	toBlock := uint64(99)
+	var blocks []int64
+	for item := range public.StreamLogs(context.Background(), client, public.StreamLogsParameters{
+		ToBlock:             &toBlock,
+		ChunkSize:           10,
+		MaxConcurrentChunks: 3,
+	}) {
+		require.NoError(t, item.Error)
+		blocks = append(blocks, item.Log.BlockNumber.Int64())
+	}
+
+	assert.Equal(t, []int64{0, 10, 20, 30, 40, 50, 60, 70, 80, 90}, blocks)
+	assert.LessOrEqual(t, counter.Peak(), 3)
+	assert.Greater(t, counter.Peak(), 1)
+}
+
+func TestMulticall_MaxConcurrentChunks(t *testing.T) {
+	var counter inFlightCounter
+	server := createTestServer(t, func(method string, params []any) any {
+		return counter.track(func() any {
+			return encodeAggregate3Response([]multicallReturn{
+				{success: true, returnData: common.LeftPadBytes(big.NewInt(7).Bytes(), 32)},
+			})
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	contracts := make([]public.MulticallContract, 8)
+	for i := range contracts {
+		contracts[i] = public.MulticallContract{
+			Address:      common.HexToAddress("0x1234567890123456789012345678901234567890"),
+			FunctionName: "balanceOf",
+			Args:         []any{common.BigToAddress(big.NewInt(int64(i + 1)))},
+		}
+	}
+
+	results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts:           contracts,
+		ABI:                 erc20,
+		MulticallAddress:    &multicallAddress,
+		BatchSize:           36, // one balanceOf call per chunk
+		MaxConcurrentChunks: 3,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 8)
+	for _, r := range results {
+		assert.Equal(t, big.NewInt(7), r.Result)
+	}
+	assert.LessOrEqual(t, counter.Peak(), 3)
+	assert.Greater(t, counter.Peak(), 1)
+}
+
+func TestGetStorageAtMany_MaxConcurrentRequests(t *testing.T) {
+	var counter inFlightCounter
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_getStorageAt", method)
+		return counter.track(func() any {
+			return params[1]
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	slots := make([]common.Hash, 8)
+	for i := range slots {
+		slots[i] = common.BigToHash(big.NewInt(int64(i + 1)))
+	}
+
+	batch := false
+	values, err := public.GetStorageAtMany(context.Background(), client, public.GetStorageAtManyParameters{
+		Address:               common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		Slots:                 slots,
+		Batch:                 &batch,
+		MaxConcurrentRequests: 3,
+	})
+	require.NoError(t, err)
+	for i, slot := range slots {
+		assert.Equal(t, slot.Bytes(), values[i])
+	}
+	assert.LessOrEqual(t, counter.Peak(), 3)
+	assert.Greater(t, counter.Peak(), 1)
+}
+
+// ============================================================================
+// GetBlobBaseFee Tests
+// ============================================================================
+
+func TestGetBlobBaseFee_Basic(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_blobBaseFee" {
+			return "0x3b9aca00" // 1 gwei
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	fee, err := public.GetBlobBaseFee(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1_000_000_000), fee)
+}
+
+func TestGetBlobBaseFee_FallbackToExcessBlobGas(t *testing.T) {
+	calls := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		calls[req.Method]++
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_blobBaseFee":
+			resp["error"] = map[string]any{"code": -32601, "message": "the method eth_blobBaseFee does not exist/is not available"}
+		case "eth_getBlockByNumber":
+			resp["result"] = map[string]any{
+				"number":        "0x10",
+				"excessBlobGas": hexutil.EncodeUint64(10 * 3338477), // e^10 * MIN_BASE_FEE_PER_BLOB_GAS
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	fee, err := public.GetBlobBaseFee(context.Background(), client)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(22026), fee)
+	assert.Equal(t, 1, calls["eth_blobBaseFee"])
+	assert.Equal(t, 1, calls["eth_getBlockByNumber"])
+}
+
+func TestGetBlobBaseFee_OtherErrorsAreReturned(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID any `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"error":   map[string]any{"code": -32000, "message": "header not found"},
+		})
+	}))
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	_, err := public.GetBlobBaseFee(context.Background(), client)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "eth_blobBaseFee failed")
+}
+
+func TestCalcBlobBaseFee(t *testing.T) {
+	assert.Equal(t, big.NewInt(1), public.CalcBlobBaseFee(0))
+	assert.Equal(t, big.NewInt(22026), public.CalcBlobBaseFee(10*3338477))
+}
+
+// ============================================================================
+// GetFeeHistory Tests
+// ============================================================================
+
+func TestGetFeeHistory_Basic(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_feeHistory" {
+			return map[string]any{
+				"baseFeePerGas": []any{"0x1", "0x2"},
+				"gasUsedRatio":  []any{0.5, 0.6},
+				"oldestBlock":   "0x10",
+				"reward": [][]any{
+					{"0x3", "0x4"},
+					{"0x5", "0x6"},
+				},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	history, err := public.GetFeeHistory(ctx, client, public.GetFeeHistoryParameters{
+		BlockCount:        2,
+		RewardPercentiles: []float64{25, 75},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, history.BaseFeePerGas, 2)
+	assert.Equal(t, "1", history.BaseFeePerGas[0].String())
+	assert.Equal(t, "2", history.BaseFeePerGas[1].String())
+
+	require.NotNil(t, history.OldestBlock)
+	assert.Equal(t, "16", history.OldestBlock.String())
+
+	assert.Len(t, history.GasUsedRatio, 2)
+	assert.InDelta(t, 0.5, history.GasUsedRatio[0], 1e-9)
+	assert.InDelta(t, 0.6, history.GasUsedRatio[1], 1e-9)
+
+	require.Len(t, history.Reward, 2)
+	require.Len(t, history.Reward[0], 2)
+	assert.Equal(t, "3", history.Reward[0][0].String())
+	assert.Equal(t, "4", history.Reward[0][1].String())
+	assert.Equal(t, "5", history.Reward[1][0].String())
+	assert.Equal(t, "6", history.Reward[1][1].String())
+}
+
+func TestGetTransaction_Count(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_getTransactionCount" {
+			return "0x2"
+		}
 		return "0x0"
 	})
 	defer server.Close()
@@ -2085,9 +4278,140 @@ func TestSimulateBlocks_FailedCall(t *testing.T) {
 	assert.NotNil(t, results[0].Calls[0].Error)
 }
 
-// ============================================================================
-// SimulateCalls Tests
-// ============================================================================
+const simulateTransferTestABI = `[
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"}],
+		"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+func TestSimulateBlocks_TwoBlockTransferBundle(t *testing.T) {
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	recipient := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d4c3e2F9e")
+
+	transferTopic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	word := func(n int64) string {
+		return hexutil.Encode(common.LeftPadBytes(big.NewInt(n).Bytes(), 32))
+	}
+	transferResult := func(block string) map[string]any {
+		return map[string]any{
+			"status": "0x1", "returnData": word(1), "gasUsed": "0xb411",
+			"logs": []map[string]any{{
+				"address":     token.Hex(),
+				"topics":      []string{transferTopic, common.BytesToHash(sender.Bytes()).Hex(), common.BytesToHash(recipient.Bytes()).Hex()},
+				"data":        word(100),
+				"blockNumber": block,
+				"logIndex":    "0x0",
+			}},
+		}
+	}
+
+	var blockStateCalls []any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "eth_simulateV1" {
+			return nil
+		}
+		blockStateCalls = params[0].(map[string]any)["blockStateCalls"].([]any)
+		return []map[string]any{
+			{
+				"number": "0x65",
+				"calls": []map[string]any{
+					transferResult("0x65"),
+					{"status": "0x1", "returnData": word(100), "gasUsed": "0x2a1e"},
+				},
+			},
+			{
+				"number": "0x66",
+				"calls": []map[string]any{
+					transferResult("0x66"),
+					{"status": "0x1", "returnData": word(200), "gasUsed": "0x2a1e"},
+				},
+			},
+		}
+	})
+	defer server.Close()
+
+	erc20, err := parseTestABI(simulateTransferTestABI)
+	require.NoError(t, err)
+
+	bundle := public.SimulateBlock{
+		Calls: []public.SimulateBlockCall{
+			{From: &sender, To: &token, ABI: erc20, FunctionName: "transfer", Args: []any{recipient, big.NewInt(100)}},
+			{To: &token, ABI: erc20, FunctionName: "balanceOf", Args: []any{recipient}},
+		},
+	}
+	client := createMockClient(t, server.URL)
+	results, err := public.SimulateBlocks(context.Background(), client, public.SimulateBlocksParameters{
+		Blocks:         []public.SimulateBlock{bundle, bundle},
+		TraceTransfers: true,
+		Validation:     true,
+	})
+	require.NoError(t, err)
+
+	// Each block carries its own transfer and balance check
+	require.Len(t, blockStateCalls, 2)
+	for _, bsc := range blockStateCalls {
+		calls := bsc.(map[string]any)["calls"].([]any)
+		require.Len(t, calls, 2)
+		assert.Equal(t, sender.Hex(), calls[0].(map[string]any)["from"])
+	}
+
+	require.Len(t, results, 2)
+	for i, want := range []int64{100, 200} {
+		transfer, balance := results[i].Calls[0], results[i].Calls[1]
+		assert.Equal(t, "success", transfer.Status)
+		assert.Equal(t, true, transfer.Result)
+		assert.Equal(t, big.NewInt(0xb411), transfer.GasUsed)
+		require.Len(t, transfer.Logs, 1)
+		assert.Equal(t, transferTopic, transfer.Logs[0].Topics[0])
+
+		assert.Equal(t, big.NewInt(want), balance.Result, "balance after block %d", i)
+	}
+}
+
+func TestSimulateBlocks_AuthorizationList(t *testing.T) {
+	var call map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_simulateV1" {
+			bsc := params[0].(map[string]any)["blockStateCalls"].([]any)[0]
+			call = bsc.(map[string]any)["calls"].([]any)[0].(map[string]any)
+			return []map[string]any{{"number": "0x1", "calls": []map[string]any{{"status": "0x1", "returnData": "0x", "gasUsed": "0x0"}}}}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	client := createMockClient(t, server.URL)
+	_, err := public.SimulateBlocks(context.Background(), client, public.SimulateBlocksParameters{
+		Blocks: []public.SimulateBlock{{Calls: []public.SimulateBlockCall{{
+			To: &to,
+			AuthorizationList: []types.SignedAuthorization{{
+				Address: to.Hex(),
+				ChainId: 1,
+				Nonce:   7,
+				R:       "0x01",
+				S:       "0x02",
+				YParity: 1,
+			}},
+		}}}},
+	})
+	require.NoError(t, err)
+
+	require.Contains(t, call, "authorizationList")
+	auth := call["authorizationList"].([]any)[0].(map[string]any)
+	assert.Equal(t, "0x1", auth["chainId"])
+	assert.Equal(t, "0x7", auth["nonce"])
+	assert.Equal(t, "0x1", auth["yParity"])
+	assert.Equal(t, to.Hex(), auth["address"])
+}
+
+// ============================================================================
+// SimulateCalls Tests
+// ============================================================================
 
 func TestSimulateCalls_Basic(t *testing.T) {
 	server := createTestServer(t, func(method string, params []any) any {
@@ -2331,3 +4655,2157 @@ func TestSimulateContract_ReturnsRequest(t *testing.T) {
 func parseTestABI(jsonABI string) (*abi.ABI, error) {
 	return abi.ParseFromString(jsonABI)
 }
+
+// ============================================================================
+// EstimateGas Tests
+// ============================================================================
+
+func TestEstimateGas_StateOverride(t *testing.T) {
+	var estimateParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_estimateGas" {
+			estimateParams = params
+			return "0xc350"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	to := common.HexToAddress("0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb")
+	gas, err := public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account: &account,
+		To:      &to,
+		Value:   big.NewInt(1e18),
+		StateOverride: types.StateOverride{
+			account: {Balance: big.NewInt(2e18)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(50_000), gas)
+
+	require.Len(t, estimateParams, 3)
+	override := estimateParams[2].(map[string]any)
+	require.Contains(t, override, account.Hex())
+	assert.Equal(t, "0x1bc16d674ec80000", override[account.Hex()].(map[string]any)["balance"])
+}
+
+// loggerMockClient is a mockClient with a logger, for actions that warn
+// through LoggerClient.
+type loggerMockClient struct {
+	*mockClient
+	logger *slog.Logger
+}
+
+func (c *loggerMockClient) Logger() *slog.Logger { return c.logger }
+
+// estimateGasErrorServer answers eth_estimateGas with rpcErr when it is sent
+// a state override, and with 21000 gas otherwise. It records the number of
+// params of each request.
+func estimateGasErrorServer(t *testing.T, rpcErr map[string]any, paramCounts *[]int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any   `json:"id"`
+			Params []any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		*paramCounts = append(*paramCounts, len(req.Params))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		if len(req.Params) > 2 {
+			resp["error"] = rpcErr
+		} else {
+			resp["result"] = "0x5208"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+func TestEstimateGas_RetriesWithoutUnsupportedStateOverride(t *testing.T) {
+	var paramCounts []int
+	server := estimateGasErrorServer(t, map[string]any{"code": -32602, "message": "too many arguments, want at most 2"}, &paramCounts)
+	defer server.Close()
+
+	var logs bytes.Buffer
+	client := &loggerMockClient{
+		mockClient: createMockClient(t, server.URL),
+		logger:     slog.New(slog.NewTextHandler(&logs, nil)),
+	}
+
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	gas, err := public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account: &account,
+		To:      &account,
+		StateOverride: types.StateOverride{
+			account: {Balance: big.NewInt(1)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21_000), gas)
+	assert.Equal(t, []int{3, 2}, paramCounts)
+
+	// The retry is reported through the client's logger
+	assert.Contains(t, logs.String(), "level=WARN")
+	assert.Contains(t, logs.String(), "too many arguments")
+}
+
+func TestEstimateGas_DoesNotRetryOtherInvalidParams(t *testing.T) {
+	var paramCounts []int
+	server := estimateGasErrorServer(t, map[string]any{"code": -32602, "message": "invalid argument 2: json: cannot unmarshal hex string"}, &paramCounts)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	_, err := public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account: &account,
+		To:      &account,
+		StateOverride: types.StateOverride{
+			account: {Balance: big.NewInt(1)},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot unmarshal")
+	assert.Equal(t, []int{3}, paramCounts)
+}
+
+func TestEstimateGas_DoesNotRetryExecutionErrors(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID any `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"error":   map[string]any{"code": 3, "message": "execution reverted"},
+		})
+	}))
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	account := common.HexToAddress("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	_, err := public.EstimateGas(context.Background(), client, public.EstimateGasParameters{
+		Account: &account,
+		To:      &account,
+		StateOverride: types.StateOverride{
+			account: {Balance: big.NewInt(1)},
+		},
+	})
+	require.Error(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+// ============================================================================
+// EstimateGasBatch Tests
+// ============================================================================
+
+func TestEstimateGasBatch_AlignedWithPerRequestErrors(t *testing.T) {
+	revertTo := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any   `json:"id"`
+			Params []any `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		tx := req.Params[0].(map[string]any)
+
+		// Echo calldata back as the gas estimate so results can be matched by index.
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		if common.HexToAddress(tx["to"].(string)) == revertTo {
+			resp["error"] = map[string]any{"code": 3, "message": "execution reverted"}
+		} else {
+			resp["result"] = tx["data"]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	to := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	requests := []public.EstimateGasParameters{
+		{To: &to, Data: []byte{0x52, 0x08}},
+		{To: &revertTo, Data: []byte{0x01}},
+		{To: &to, Data: []byte{0xff, 0xff}},
+	}
+
+	results, err := public.EstimateGasBatch(ctx, client, public.EstimateGasBatchParameters{
+		Requests:      requests,
+		MaxConcurrent: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.NoError(t, results[0].Error)
+	assert.Equal(t, uint64(21000), results[0].Gas)
+
+	assert.Error(t, results[1].Error)
+	assert.Equal(t, uint64(0), results[1].Gas)
+
+	assert.NoError(t, results[2].Error)
+	assert.Equal(t, uint64(65535), results[2].Gas)
+}
+
+func TestEstimateGasBatch_Empty(t *testing.T) {
+	client := createMockClient(t, "http://localhost")
+	results, err := public.EstimateGasBatch(context.Background(), client, public.EstimateGasBatchParameters{})
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+// ============================================================================
+// Multicall Tests
+// ============================================================================
+
+// multicallReturn is a single (success, returnData) entry of an aggregate3 response.
+type multicallReturn struct {
+	success    bool
+	returnData []byte
+}
+
+// encodeAggregate3Response ABI-encodes a (bool,bytes)[] aggregate3 return value.
+func encodeAggregate3Response(results []multicallReturn) string {
+	word := func(v uint64) []byte {
+		return common.LeftPadBytes(new(big.Int).SetUint64(v).Bytes(), 32)
+	}
+
+	var tuples [][]byte
+	for _, r := range results {
+		var tuple []byte
+		if r.success {
+			tuple = append(tuple, word(1)...)
+		} else {
+			tuple = append(tuple, word(0)...)
+		}
+		tuple = append(tuple, word(64)...)
+		tuple = append(tuple, word(uint64(len(r.returnData)))...)
+		padded := make([]byte, (len(r.returnData)+31)/32*32)
+		copy(padded, r.returnData)
+		tuples = append(tuples, append(tuple, padded...))
+	}
+
+	out := append(word(32), word(uint64(len(results)))...)
+	offset := uint64(32 * len(results))
+	for _, tuple := range tuples {
+		out = append(out, word(offset)...)
+		offset += uint64(len(tuple))
+	}
+	for _, tuple := range tuples {
+		out = append(out, tuple...)
+	}
+	return hexutil.Encode(out)
+}
+
+const multicallTestERC20ABI = `[
+	{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"}],"outputs":[{"type":"uint256"}],"stateMutability":"view"},
+	{"type":"function","name":"getReserves","inputs":[],"outputs":[{"name":"reserve0","type":"uint112"},{"name":"reserve1","type":"uint112"},{"name":"blockTimestampLast","type":"uint32"}],"stateMutability":"view"}
+]`
+
+func TestMulticallTyped_BigInt(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: common.LeftPadBytes(big.NewInt(100).Bytes(), 32)},
+			{success: true, returnData: common.LeftPadBytes(big.NewInt(250).Bytes(), 32)},
+			{success: false, returnData: nil},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contracts := []public.MulticallContract{
+		{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x01")}},
+		{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x02")}},
+		{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x03")}},
+	}
+
+	results, err := public.MulticallTyped[*big.Int](context.Background(), client, public.MulticallParameters{
+		Contracts:        contracts,
+		MulticallAddress: &multicallAddress,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, "success", results[0].Status)
+	assert.Equal(t, int64(100), results[0].Result.Int64())
+	assert.Equal(t, int64(250), results[1].Result.Int64())
+	assert.Equal(t, "failure", results[2].Status)
+	assert.Error(t, results[2].Error)
+	assert.Nil(t, results[2].Result)
+}
+
+func TestMulticallResults_DecodeAsStruct(t *testing.T) {
+	reserves := make([]byte, 0, 96)
+	reserves = append(reserves, common.LeftPadBytes(big.NewInt(1000).Bytes(), 32)...)
+	reserves = append(reserves, common.LeftPadBytes(big.NewInt(2000).Bytes(), 32)...)
+	reserves = append(reserves, common.LeftPadBytes(big.NewInt(1700000000).Bytes(), 32)...)
+
+	server := createTestServer(t, func(method string, params []any) any {
+		return encodeAggregate3Response([]multicallReturn{{success: true, returnData: reserves}})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	pair, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: common.HexToAddress("0x1234567890123456789012345678901234567890"), ABI: pair, FunctionName: "getReserves"},
+		},
+		MulticallAddress: &multicallAddress,
+	})
+	require.NoError(t, err)
+
+	var out struct {
+		Reserve0           *big.Int
+		Reserve1           *big.Int
+		BlockTimestampLast uint32
+	}
+	require.NoError(t, results.DecodeAs(0, &out))
+	assert.Equal(t, int64(1000), out.Reserve0.Int64())
+	assert.Equal(t, int64(2000), out.Reserve1.Int64())
+	assert.Equal(t, uint32(1700000000), out.BlockTimestampLast)
+
+	var wrong string
+	assert.Error(t, results.DecodeAs(0, &wrong))
+	assert.Error(t, results.DecodeAs(1, &out))
+	assert.Error(t, results.DecodeAs(0, out))
+}
+
+func TestMulticall_SharedABI(t *testing.T) {
+	var calldata string
+	server := createTestServer(t, func(method string, params []any) any {
+		calldata = params[0].(map[string]any)["data"].(string)
+		balance := common.LeftPadBytes(big.NewInt(7).Bytes(), 32)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: balance},
+			{success: true, returnData: balance},
+			{success: true, returnData: balance},
+			{success: true, returnData: common.LeftPadBytes([]byte{0x01}, 32)},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+	owned, err := parseTestABI(`[{"type":"function","name":"owner","inputs":[],"outputs":[{"type":"bool"}],"stateMutability":"view"}]`)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	account := common.HexToAddress("0x01")
+	contracts := []public.MulticallContract{
+		{Address: common.HexToAddress("0x10"), FunctionName: "balanceOf", Args: []any{account}},
+		{Address: common.HexToAddress("0x11"), FunctionName: "balanceOf", Args: []any{account}},
+		{Address: common.HexToAddress("0x12"), FunctionName: "balanceOf", Args: []any{account}},
+		{Address: common.HexToAddress("0x13"), ABI: owned, FunctionName: "owner"},
+	}
+
+	results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts:        contracts,
+		ABI:              erc20,
+		MulticallAddress: &multicallAddress,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, "success", results[i].Status)
+		assert.Equal(t, big.NewInt(7), results[i].Result)
+	}
+	assert.Equal(t, "success", results[3].Status)
+	assert.Equal(t, true, results[3].Result)
+
+	// The per-contract ABI was used to encode the override call.
+	ownerCall, err := owned.EncodeFunctionData("owner")
+	require.NoError(t, err)
+	assert.Contains(t, calldata, hexutil.Encode(ownerCall)[2:])
+
+	// The caller's contracts are left untouched.
+	assert.Nil(t, contracts[0].ABI)
+}
+
+func TestMulticall_CustomChainMulticall3(t *testing.T) {
+	var to string
+	server := createTestServer(t, func(method string, params []any) any {
+		to = params[0].(map[string]any)["to"].(string)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: common.LeftPadBytes(big.NewInt(7).Bytes(), 32)},
+		})
+	})
+	defer server.Close()
+
+	multicallAddress := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	devnet, err := chain.NewChain(chain.ChainOptions{
+		ID:             31337,
+		RpcURL:         server.URL,
+		NativeCurrency: chain.ChainNativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+	}, chain.WithMulticall3(multicallAddress, 100))
+	require.NoError(t, err)
+
+	client := createMockClient(t, server.URL)
+	client.chain = devnet
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	contracts := []public.MulticallContract{
+		{Address: common.HexToAddress("0x10"), ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x01")}},
+	}
+	results, err := public.Multicall(context.Background(), client, public.MulticallParameters{Contracts: contracts})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, big.NewInt(7), results[0].Result)
+	assert.Equal(t, strings.ToLower(multicallAddress.Hex()), strings.ToLower(to))
+
+	// Blocks before the configured deployment are rejected.
+	blockNumber := uint64(50)
+	_, err = public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts:   contracts,
+		BlockNumber: &blockNumber,
+	})
+	var unsupported *public.ChainDoesNotSupportContractError
+	require.ErrorAs(t, err, &unsupported)
+	assert.Equal(t, int64(31337), unsupported.ChainID)
+}
+
+func TestMulticall_MissingABI(t *testing.T) {
+	client := createMockClient(t, "http://localhost")
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+	_, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: common.HexToAddress("0x10"), FunctionName: "balanceOf"},
+		},
+		MulticallAddress: &multicallAddress,
+	})
+	require.Error(t, err)
+	var abiErr *public.MulticallABINotFoundError
+	require.ErrorAs(t, err, &abiErr)
+	assert.Equal(t, 0, abiErr.Index)
+	assert.Contains(t, err.Error(), "balanceOf")
+}
+
+func TestMulticall_StateAndBlockOverrides(t *testing.T) {
+	var ethCallParams [][]any
+	server := createTestServer(t, func(method string, params []any) any {
+		ethCallParams = append(ethCallParams, params)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: common.LeftPadBytes(big.NewInt(1e18).Bytes(), 32)},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.batch = &types.BatchOptions{Multicall: &types.MulticallBatchOptions{}}
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	gasLimit := uint64(30_000_000)
+	base := public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x01")}},
+		},
+		StateOverride: types.StateOverride{
+			token: {Balance: big.NewInt(1e18)},
+		},
+		BlockOverrides: &types.BlockOverrides{GasLimit: &gasLimit},
+		ShouldBatch:    true,
+	}
+
+	t.Run("deployed", func(t *testing.T) {
+		ethCallParams = nil
+		params := base
+		params.MulticallAddress = &multicallAddress
+
+		results, err := public.Multicall(context.Background(), client, params)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1e18), results[0].Result)
+
+		require.Len(t, ethCallParams, 1)
+		require.Len(t, ethCallParams[0], 4)
+		assert.Equal(t, multicallAddress.Hex(), common.HexToAddress(ethCallParams[0][0].(map[string]any)["to"].(string)).Hex())
+		stateOverride := ethCallParams[0][2].(map[string]any)
+		assert.Contains(t, stateOverride, token.Hex())
+		blockOverrides := ethCallParams[0][3].(map[string]any)
+		assert.Equal(t, "0x1c9c380", blockOverrides["gasLimit"])
+	})
+
+	t.Run("deployless", func(t *testing.T) {
+		ethCallParams = nil
+		params := base
+		params.Deployless = true
+		params.BlockOverrides = nil
+
+		_, err := public.Multicall(context.Background(), client, params)
+		require.NoError(t, err)
+
+		require.Len(t, ethCallParams, 1)
+		require.Len(t, ethCallParams[0], 3)
+		assert.NotContains(t, ethCallParams[0][0].(map[string]any), "to")
+		assert.Contains(t, ethCallParams[0][2].(map[string]any), token.Hex())
+	})
+}
+
+func TestMulticall_DeploylessBytecode(t *testing.T) {
+	var callData string
+	server := createTestServer(t, func(method string, params []any) any {
+		callData = params[0].(map[string]any)["data"].(string)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: common.LeftPadBytes(big.NewInt(1e18).Bytes(), 32)},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.batch = &types.BatchOptions{Multicall: &types.MulticallBatchOptions{}}
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	params := public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{common.HexToAddress("0x01")}},
+		},
+		Deployless:  true,
+		ShouldBatch: true,
+	}
+	multicall3Code := strings.TrimPrefix(constants.Multicall3Bytecode, "0x")
+
+	t.Run("default", func(t *testing.T) {
+		_, err := public.Multicall(context.Background(), client, params)
+		require.NoError(t, err)
+		assert.Contains(t, callData, multicall3Code)
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		custom := common.FromHex("0x6080604052deadbeefcafe")
+		params := params
+		params.DeploylessBytecode = custom
+
+		results, err := public.Multicall(context.Background(), client, params)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1e18), results[0].Result)
+		assert.Contains(t, callData, strings.TrimPrefix(hexutil.Encode(custom), "0x"))
+		assert.NotContains(t, callData, multicall3Code)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		params := params
+		params.DeploylessBytecode = []byte{}
+
+		_, err := public.Multicall(context.Background(), client, params)
+		assert.ErrorIs(t, err, public.ErrEmptyDeploylessBytecode)
+	})
+}
+
+func TestMulticall_Aggregate3Value(t *testing.T) {
+	var ethCallParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		ethCallParams = params
+		ok := common.LeftPadBytes([]byte{0x01}, 32)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: ok},
+			{success: true, returnData: ok},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	vault, err := parseTestABI(`[
+		{"type":"function","name":"deposit","inputs":[],"outputs":[{"type":"bool"}],"stateMutability":"payable"},
+		{"type":"function","name":"paused","inputs":[],"outputs":[{"type":"bool"}],"stateMutability":"view"}
+	]`)
+	require.NoError(t, err)
+	multicall3, err := parseTestABI(`[{"type":"function","name":"aggregate3Value","stateMutability":"payable",
+		"inputs":[{"name":"calls","type":"tuple[]","components":[
+			{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},
+			{"name":"value","type":"uint256"},{"name":"callData","type":"bytes"}]}],
+		"outputs":[{"name":"returnData","type":"tuple[]","components":[
+			{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	target := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	t.Run("mixed batch uses aggregate3Value", func(t *testing.T) {
+		results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts: []public.MulticallContract{
+				{Address: target, ABI: vault, FunctionName: "deposit", Value: big.NewInt(1e18)},
+				{Address: target, ABI: vault, FunctionName: "paused"},
+			},
+			MulticallAddress: &multicallAddress,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, true, results[0].Result)
+		assert.Equal(t, true, results[1].Result)
+
+		req := ethCallParams[0].(map[string]any)
+		assert.Equal(t, "0xde0b6b3a7640000", req["value"])
+
+		data := common.FromHex(req["data"].(string))
+		assert.Equal(t, "0x174dea71", hexutil.Encode(data[:4]))
+
+		var calls []struct {
+			Target       common.Address
+			AllowFailure bool
+			Value        *big.Int
+			CallData     []byte
+		}
+		inputs := multicall3.GethABI().Methods["aggregate3Value"].Inputs
+		unpacked, err := inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		require.NoError(t, inputs.Copy(&calls, unpacked))
+		require.Len(t, calls, 2)
+		assert.Equal(t, big.NewInt(1e18), calls[0].Value)
+		assert.Equal(t, 0, calls[1].Value.Sign())
+		assert.Equal(t, target, calls[1].Target)
+		pausedCall, err := vault.EncodeFunctionData("paused")
+		require.NoError(t, err)
+		assert.Equal(t, pausedCall, calls[1].CallData)
+	})
+
+	t.Run("no values keeps aggregate3", func(t *testing.T) {
+		_, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts: []public.MulticallContract{
+				{Address: target, ABI: vault, FunctionName: "paused"},
+				{Address: target, ABI: vault, FunctionName: "paused"},
+			},
+			MulticallAddress: &multicallAddress,
+		})
+		require.NoError(t, err)
+
+		req := ethCallParams[0].(map[string]any)
+		assert.NotContains(t, req, "value")
+		assert.Equal(t, "0x82ad56cb", req["data"].(string)[:10])
+	})
+}
+
+func TestMulticall_StrictAggregateError(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		balance := common.LeftPadBytes(big.NewInt(5).Bytes(), 32)
+		return encodeAggregate3Response([]multicallReturn{
+			{success: true, returnData: balance},
+			{success: false, returnData: nil},
+			{success: true, returnData: balance},
+			{success: true, returnData: nil},
+			{success: true, returnData: balance},
+		})
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contracts := make([]public.MulticallContract, 5)
+	for i := range contracts {
+		contracts[i] = public.MulticallContract{
+			Address:      token,
+			FunctionName: "balanceOf",
+			Args:         []any{common.BigToAddress(big.NewInt(int64(i + 1)))},
+		}
+	}
+
+	t.Run("strict", func(t *testing.T) {
+		allowFailure := false
+		results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts:        contracts,
+			ABI:              erc20,
+			AllowFailure:     &allowFailure,
+			MulticallAddress: &multicallAddress,
+		})
+		require.Error(t, err)
+		assert.Nil(t, results)
+
+		var aggErr *public.MulticallAggregateError
+		require.ErrorAs(t, err, &aggErr)
+		assert.Equal(t, 5, aggErr.Total)
+		require.Len(t, aggErr.Failures, 2)
+		assert.Equal(t, 1, aggErr.Failures[0].Index)
+		assert.Equal(t, 3, aggErr.Failures[1].Index)
+
+		var rawErr *public.RawContractError
+		assert.ErrorAs(t, err, &rawErr)
+
+		assert.Equal(t,
+			`multicall: 2 of 5 calls failed: [1]: contract reverted, [3]: failed to decode result for "balanceOf": expected return data for function "balanceOf" but got empty`,
+			err.Error(),
+		)
+	})
+
+	t.Run("allow failure", func(t *testing.T) {
+		results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts:        contracts,
+			ABI:              erc20,
+			MulticallAddress: &multicallAddress,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 5)
+		assert.Equal(t, "failure", results[1].Status)
+		assert.Equal(t, "failure", results[3].Status)
+		assert.Equal(t, "success", results[4].Status)
+	})
+}
+
+func TestMulticall_ChunkTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := createTestServer(t, func(method string, params []any) any {
+		<-release
+		return encodeAggregate3Response(nil)
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+	results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{token}},
+		},
+		MulticallAddress: &multicallAddress,
+		Timeout:          50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "failure", results[0].Status)
+
+	var timeoutErr *public.CallTimeoutError
+	require.ErrorAs(t, results[0].Error, &timeoutErr)
+	assert.Equal(t, &multicallAddress, timeoutErr.To)
+}
+
+// encodeTryBlockAndAggregateResponse ABI-encodes a
+// (uint256,bytes32,(bool,bytes)[]) tryBlockAndAggregate return value.
+func encodeTryBlockAndAggregateResponse(blockNumber uint64, results []multicallReturn) string {
+	out := common.LeftPadBytes(new(big.Int).SetUint64(blockNumber).Bytes(), 32)
+	out = append(out, common.HexToHash("0xb10c").Bytes()...)
+	out = append(out, common.LeftPadBytes([]byte{96}, 32)...)
+	// The result array is laid out like aggregate3's, minus its head offset.
+	out = append(out, common.FromHex(encodeAggregate3Response(results))[32:]...)
+	return hexutil.Encode(out)
+}
+
+func TestMulticall_ReturnBlockNumber(t *testing.T) {
+	multicall3, err := parseTestABI(`[{"type":"function","name":"tryBlockAndAggregate","stateMutability":"payable",
+		"inputs":[{"name":"requireSuccess","type":"bool"},{"name":"calls","type":"tuple[]","components":[
+			{"name":"target","type":"address"},{"name":"callData","type":"bytes"}]}],
+		"outputs":[{"name":"blockNumber","type":"uint256"},{"name":"blockHash","type":"bytes32"},
+			{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`)
+	require.NoError(t, err)
+	inputs := multicall3.GethABI().Methods["tryBlockAndAggregate"].Inputs
+
+	var (
+		mu        sync.Mutex
+		blockTags []string
+	)
+	server := createTestServer(t, func(method string, params []any) any {
+		data := common.FromHex(params[0].(map[string]any)["data"].(string))
+		require.Equal(t, "0x399542e9", hexutil.Encode(data[:4]))
+
+		unpacked, err := inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		require.Equal(t, false, unpacked[0])
+		var calls []struct {
+			Target   common.Address
+			CallData []byte
+		}
+		require.NoError(t, inputs.Copy(&struct {
+			RequireSuccess bool
+			Calls          *[]struct {
+				Target   common.Address
+				CallData []byte
+			}
+		}{Calls: &calls}, unpacked))
+
+		mu.Lock()
+		blockTags = append(blockTags, params[1].(string))
+		mu.Unlock()
+
+		results := make([]multicallReturn, len(calls))
+		for i := range results {
+			results[i] = multicallReturn{success: true, returnData: common.LeftPadBytes(big.NewInt(int64(i+1)).Bytes(), 32)}
+		}
+		return encodeTryBlockAndAggregateResponse(12345, results)
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	require.NoError(t, err)
+
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	contracts := make([]public.MulticallContract, 3)
+	for i := range contracts {
+		contracts[i] = public.MulticallContract{
+			Address:      token,
+			FunctionName: "balanceOf",
+			Args:         []any{common.BigToAddress(big.NewInt(int64(i + 1)))},
+		}
+	}
+
+	t.Run("single chunk", func(t *testing.T) {
+		blockTags = nil
+		results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts:         contracts,
+			ABI:               erc20,
+			MulticallAddress:  &multicallAddress,
+			ReturnBlockNumber: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		blockNumber, ok := results.BlockNumber()
+		require.True(t, ok)
+		assert.Equal(t, uint64(12345), blockNumber)
+		for i, r := range results {
+			assert.Equal(t, "success", r.Status)
+			assert.Equal(t, big.NewInt(int64(i+1)), r.Result)
+			require.NotNil(t, r.BlockNumber)
+			assert.Equal(t, uint64(12345), *r.BlockNumber)
+		}
+		assert.Equal(t, []string{"latest"}, blockTags)
+	})
+
+	t.Run("later chunks are pinned to the first chunk's block", func(t *testing.T) {
+		blockTags = nil
+		results, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts:         contracts,
+			ABI:               erc20,
+			MulticallAddress:  &multicallAddress,
+			BatchSize:         36, // one balanceOf call per chunk
+			ReturnBlockNumber: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		blockNumber, ok := results.BlockNumber()
+		require.True(t, ok)
+		assert.Equal(t, uint64(12345), blockNumber)
+		assert.Equal(t, []string{"latest", "0x3039", "0x3039"}, blockTags)
+	})
+
+	t.Run("values are rejected", func(t *testing.T) {
+		_, err := public.Multicall(context.Background(), client, public.MulticallParameters{
+			Contracts: []public.MulticallContract{
+				{Address: token, ABI: erc20, FunctionName: "balanceOf", Args: []any{token}, Value: big.NewInt(1)},
+			},
+			MulticallAddress:  &multicallAddress,
+			ReturnBlockNumber: true,
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		server := createTestServer(t, func(method string, params []any) any {
+			return encodeAggregate3Response([]multicallReturn{
+				{success: true, returnData: common.LeftPadBytes(big.NewInt(1).Bytes(), 32)},
+			})
+		})
+		defer server.Close()
+
+		results, err := public.Multicall(context.Background(), createMockClient(t, server.URL), public.MulticallParameters{
+			Contracts:        contracts[:1],
+			ABI:              erc20,
+			MulticallAddress: &multicallAddress,
+		})
+		require.NoError(t, err)
+		_, ok := results.BlockNumber()
+		assert.False(t, ok)
+		assert.Nil(t, results[0].BlockNumber)
+	})
+}
+
+// ============================================================================
+// BuildEventFilter Tests
+// ============================================================================
+
+const buildEventFilterTestABI = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Named","anonymous":false,"inputs":[
+		{"name":"label","type":"string","indexed":true},
+		{"name":"id","type":"uint256","indexed":true}]}
+]`
+
+func TestBuildEventFilter_TransferTo(t *testing.T) {
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
+
+	filter, err := public.BuildEventFilter(erc20, "Transfer", map[string]any{"to": to})
+	require.NoError(t, err)
+	require.Len(t, filter.Topics, 3)
+	assert.Equal(t, []common.Hash{transferTopic}, filter.Topics[0])
+	assert.Nil(t, filter.Topics[1])
+	assert.Equal(t, []common.Hash{common.BytesToHash(to.Bytes())}, filter.Topics[2])
+
+	// OR-matching across several recipients
+	other := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	filter, err = public.BuildEventFilter(erc20, "Transfer", map[string]any{"to": []common.Address{to, other}})
+	require.NoError(t, err)
+	assert.Equal(t, []common.Hash{common.BytesToHash(to.Bytes()), common.BytesToHash(other.Bytes())}, filter.Topics[2])
+
+	// Unfiltered indexed args are trimmed from the tail
+	filter, err = public.BuildEventFilter(erc20, "Transfer", map[string]any{"from": to})
+	require.NoError(t, err)
+	require.Len(t, filter.Topics, 2)
+	assert.Equal(t, []common.Hash{common.BytesToHash(to.Bytes())}, filter.Topics[1])
+
+	filter, err = public.BuildEventFilter(erc20, "Transfer", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]common.Hash{{transferTopic}}, filter.Topics)
+}
+
+func TestBuildEventFilter_DynamicAndErrors(t *testing.T) {
+	parsed, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	filter, err := public.BuildEventFilter(parsed, "Named", map[string]any{
+		"label": "hello",
+		"id":    big.NewInt(7),
+	})
+	require.NoError(t, err)
+	require.Len(t, filter.Topics, 3)
+	assert.Equal(t, common.HexToHash("0x1c8aff950685c2ed4bc3174f3472287b56d9517b9c948127319a09a7a36deac8"), filter.Topics[1][0])
+	assert.Equal(t, common.BigToHash(big.NewInt(7)), filter.Topics[2][0])
+
+	_, err = public.BuildEventFilter(parsed, "Transfer", map[string]any{"value": big.NewInt(1)})
+	assert.Error(t, err)
+
+	_, err = public.BuildEventFilter(parsed, "Missing", nil)
+	assert.Error(t, err)
+}
+
+func TestBuildEventFilter_TopicWildcardAndOr(t *testing.T) {
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	a := common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	b := common.HexToAddress("0x00000000000000000000000000000000000000b2")
+
+	// Transfers to A or B, from anyone
+	filter, err := public.BuildEventFilter(erc20, "Transfer", map[string]any{"to": []common.Address{a, b}})
+	require.NoError(t, err)
+
+	encoded, err := json.Marshal(filter)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"topics":[
+		"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+		null,
+		["0x00000000000000000000000000000000000000000000000000000000000000a1",
+		 "0x00000000000000000000000000000000000000000000000000000000000000b2"]
+	]}`, string(encoded))
+
+	// An empty position is a wildcard too
+	filter.Topics[1] = []common.Hash{}
+	encoded, err = json.Marshal(filter)
+	require.NoError(t, err)
+	var decoded struct {
+		Topics []any `json:"topics"`
+	}
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	require.Len(t, decoded.Topics, 3)
+	assert.Nil(t, decoded.Topics[1])
+}
+
+func TestGetLogs_TopicWildcardAndOr(t *testing.T) {
+	var topics []any
+	server := createTestServer(t, func(method string, params []any) any {
+		require.Equal(t, "eth_getLogs", method)
+		topics = params[0].(map[string]any)["topics"].([]any)
+		return []any{}
+	})
+	defer server.Close()
+
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	a := common.HexToHash("0xa1")
+	b := common.HexToHash("0xb2")
+
+	_, err := public.GetLogs(context.Background(), createMockClient(t, server.URL), public.GetLogsParameters{
+		Topics: []any{transferTopic, nil, []common.Hash{a, b}, []common.Hash{}},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []any{
+		transferTopic.Hex(),
+		nil,
+		[]any{a.Hex(), b.Hex()},
+		nil,
+	}, topics)
+}
+
+func TestGetContractEvents_IndexedString(t *testing.T) {
+	parsed, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+	named, err := parsed.GetEvent("Named")
+	require.NoError(t, err)
+
+	labelHash := crypto.Keccak256Hash([]byte("hello"))
+	var topics []any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "eth_getLogs" {
+			return nil
+		}
+		topics = params[0].(map[string]any)["topics"].([]any)
+		return []any{map[string]any{
+			"address":     "0x1234567890123456789012345678901234567890",
+			"topics":      []string{named.Topic.Hex(), labelHash.Hex(), common.BigToHash(big.NewInt(7)).Hex()},
+			"data":        "0x",
+			"blockNumber": "0x1",
+			"logIndex":    "0x0",
+		}}
+	})
+	defer server.Close()
+
+	logs, err := public.GetContractEvents(context.Background(), createMockClient(t, server.URL), public.GetContractEventsParameters{
+		ABI:       parsed,
+		EventName: "Named",
+		Args:      []any{"hello"},
+	})
+	require.NoError(t, err)
+
+	// The candidate string is hashed into the filter
+	require.Len(t, topics, 2)
+	assert.Equal(t, labelHash.Hex(), topics[1])
+
+	// The decoded arg is the topic hash, flagged as such
+	require.Len(t, logs, 1)
+	assert.Equal(t, labelHash, logs[0].DecodedArgs["label"])
+	assert.True(t, logs[0].HashedArgs["label"])
+	assert.False(t, logs[0].HashedArgs["id"])
+	assert.Equal(t, big.NewInt(7), logs[0].DecodedArgs["id"])
+}
+
+// ============================================================================
+// WatchEvent Tests
+// ============================================================================
+
+// watchMockClient wraps mockClient with the WatchClient surface, letting tests
+// drive subscription data and errors directly.
+type watchMockClient struct {
+	*mockClient
+	transportType string
+	subscribed    chan struct{}
+	subscription  transport.SubscribeParams
+	onData        func(json.RawMessage)
+	onError       func(error)
+}
+
+func (c *watchMockClient) TransportType() string { return c.transportType }
+
+func (c *watchMockClient) PollingInterval() time.Duration { return 20 * time.Millisecond }
+
+func (c *watchMockClient) Subscribe(
+	params transport.SubscribeParams,
+	onData func(json.RawMessage),
+	onError func(error),
+) (*transport.Subscription, error) {
+	c.subscription = params
+	c.onData, c.onError = onData, onError
+	close(c.subscribed)
+	return &transport.Subscription{ID: "0x1", Unsubscribe: func() error { return nil }}, nil
+}
+
+func newWatchMockClient(t *testing.T, transportType string, methods chan<- string) *watchMockClient {
+	return newWatchMockClientWithHandler(t, transportType, methods, func(method string, params []any) any {
+		switch method {
+		case "eth_newFilter":
+			return "0x1"
+		case "eth_getFilterChanges":
+			return []any{}
+		}
+		return nil
+	})
+}
+
+func newWatchMockClientWithHandler(
+	t *testing.T,
+	transportType string,
+	methods chan<- string,
+	handler func(method string, params []any) any,
+) *watchMockClient {
+	server := createTestServer(t, handler)
+	t.Cleanup(server.Close)
+
+	mc := createMockClient(t, server.URL)
+	// Polling watchers are shared per client UID, so keep each test isolated.
+	mc.uid = t.Name()
+	mc.requestRecorder = func(method string, params []any) {
+		select {
+		case methods <- method:
+		default:
+		}
+	}
+	return &watchMockClient{mockClient: mc, transportType: transportType, subscribed: make(chan struct{})}
+}
+
+const watchTestLog = `{"address":"0x0000000000000000000000000000000000000001","topics":[],"data":"0x",
+	"blockNumber":"0x10","transactionHash":"0x0000000000000000000000000000000000000000000000000000000000000002",
+	"transactionIndex":"0x0","blockHash":"0x0000000000000000000000000000000000000000000000000000000000000003",
+	"logIndex":"0x0","removed":false}`
+
+func TestWatchEvent_SubscribesOverWebSocket(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "webSocket", methods)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	<-client.subscribed
+	client.onData(json.RawMessage(watchTestLog))
+
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		require.Len(t, ev.Logs, 1)
+		assert.Equal(t, big.NewInt(16), ev.Logs[0].BlockNumber)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscription log")
+	}
+	assert.Empty(t, methods, "subscription mode should not issue polling requests")
+}
+
+func TestWatchEvent_PollsOverHTTP(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "http", methods)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	select {
+	case method := <-methods:
+		assert.Equal(t, "eth_newFilter", method)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected polling requests over http")
+	}
+	select {
+	case <-client.subscribed:
+		t.Fatal("http transport should not subscribe")
+	default:
+	}
+}
+
+func TestWatchEvent_MultipleAddresses(t *testing.T) {
+	token0 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token1 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	filters := make(chan map[string]any, 1)
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 16), func(method string, params []any) any {
+		switch method {
+		case "eth_newFilter":
+			select {
+			case filters <- params[0].(map[string]any):
+			default:
+			}
+			return "0x1"
+		case "eth_getFilterChanges":
+			return []any{}
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = public.WatchEvent(ctx, client, public.WatchEventParameters{Address: []common.Address{token0, token1}})
+
+	select {
+	case filter := <-filters:
+		assert.Equal(t, []any{token0.Hex(), token1.Hex()}, filter["address"])
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected eth_newFilter")
+	}
+}
+
+func TestWatchEvent_MultipleAddressesSubscription(t *testing.T) {
+	token0 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token1 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	client := newWatchMockClient(t, "webSocket", make(chan string, 16))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_ = public.WatchEvent(ctx, client, public.WatchEventParameters{Address: []common.Address{token0, token1}})
+
+	<-client.subscribed
+	assert.Equal(t, "logs", client.subscription.Type)
+	assert.Equal(t, []string{token0.Hex(), token1.Hex()}, client.subscription.Params.(map[string]any)["address"])
+}
+
+func TestWatchEvent_PollOverride(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "webSocket", methods)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	poll := true
+	_ = public.WatchEvent(ctx, client, public.WatchEventParameters{Poll: &poll})
+
+	select {
+	case method := <-methods:
+		assert.Equal(t, "eth_newFilter", method)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected Poll to force polling")
+	}
+}
+
+func TestWatchEvent_FallsBackToPollingOnSubscriptionError(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "webSocket", methods)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	<-client.subscribed
+	client.onError(assert.AnError)
+
+	select {
+	case ev := <-events:
+		require.Error(t, ev.Error)
+		assert.ErrorIs(t, ev.Error, assert.AnError)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscription error")
+	}
+	select {
+	case method := <-methods:
+		assert.Equal(t, "eth_newFilter", method)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected polling after subscription failure")
+	}
+}
+
+func TestWatchEvent_FallbackResumesAfterLastDeliveredBlock(t *testing.T) {
+	var getLogsParams atomic.Value
+	client := newWatchMockClientWithHandler(t, "webSocket", make(chan string, 16), func(method string, params []any) any {
+		switch method {
+		case "eth_newFilter":
+			return "0x1"
+		case "eth_getFilterChanges":
+			return []any{}
+		case "eth_blockNumber":
+			return "0x14"
+		case "eth_getLogs":
+			getLogsParams.Store(params[0].(map[string]any))
+			var log map[string]any
+			require.NoError(t, json.Unmarshal([]byte(watchTestLog), &log))
+			log["blockNumber"] = "0x12"
+			return []any{log}
+		}
+		return nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	<-client.subscribed
+	client.onData(json.RawMessage(watchTestLog))
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		require.Len(t, ev.Logs, 1)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscription log")
+	}
+
+	client.onError(assert.AnError)
+	select {
+	case ev := <-events:
+		assert.ErrorIs(t, ev.Error, assert.AnError)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscription error")
+	}
+
+	// Polling picks up from the block after the last delivered log (0x10),
+	// not from the current head.
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		require.Len(t, ev.Logs, 1)
+		assert.Equal(t, big.NewInt(0x12), ev.Logs[0].BlockNumber)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for backfilled logs")
+	}
+	filter := getLogsParams.Load().(map[string]any)
+	assert.Equal(t, "0x11", filter["fromBlock"])
+	assert.Equal(t, "0x14", filter["toBlock"])
+}
+
+func TestWatchContractEvent_FallsBackToPollingOnSubscriptionError(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "webSocket", methods)
+
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchContractEvent(ctx, client, public.WatchContractEventParameters{
+		Address: common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		ABI:     erc20,
+	})
+
+	<-client.subscribed
+	client.onError(assert.AnError)
+
+	select {
+	case ev := <-events:
+		assert.ErrorIs(t, ev.Error, assert.AnError)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for subscription error")
+	}
+	select {
+	case method := <-methods:
+		assert.Equal(t, "eth_newFilter", method)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected polling after subscription failure")
+	}
+}
+
+// watchTransferLogs returns a decodable Transfer log and a Transfer log
+// whose data is missing the value, as JSON-RPC logs.
+func watchTransferLogs(t *testing.T) (valid, malformed map[string]any) {
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+	transfer, err := erc20.GetEvent("Transfer")
+	require.NoError(t, err)
+
+	newLog := func(logIndex uint64, data string) map[string]any {
+		return map[string]any{
+			"address": "0x0000000000000000000000000000000000000001",
+			"topics": []any{
+				transfer.Topic.Hex(),
+				common.BytesToHash(common.HexToAddress("0xa").Bytes()).Hex(),
+				common.BytesToHash(common.HexToAddress("0xb").Bytes()).Hex(),
+			},
+			"data":             data,
+			"blockNumber":      "0x10",
+			"transactionHash":  reorgTestHash(2),
+			"transactionIndex": "0x0",
+			"blockHash":        reorgTestHash(3),
+			"logIndex":         hexutil.EncodeUint64(logIndex),
+			"removed":          false,
+		}
+	}
+	return newLog(0, hexutil.Encode(common.LeftPadBytes(big.NewInt(7).Bytes(), 32))), newLog(1, "0x")
+}
+
+// newTransferPollingClient serves the valid and malformed Transfer logs from
+// the first eth_getFilterChanges poll.
+func newTransferPollingClient(t *testing.T) *watchMockClient {
+	valid, malformed := watchTransferLogs(t)
+	var polls atomic.Int32
+	return newWatchMockClientWithHandler(t, "http", make(chan string, 16), func(method string, params []any) any {
+		switch method {
+		case "eth_newFilter":
+			return "0x1"
+		case "eth_getFilterChanges":
+			if polls.Add(1) == 1 {
+				return []any{valid, malformed}
+			}
+			return []any{}
+		}
+		return nil
+	})
+}
+
+func TestWatchContractEvent_DecodeErrorsAreSeparated(t *testing.T) {
+	client := newTransferPollingClient(t)
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchContractEvent(ctx, client, public.WatchContractEventParameters{
+		Address:   common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		ABI:       erc20,
+		EventName: "Transfer",
+	})
+
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		require.Len(t, ev.Logs, 1)
+		args := ev.Logs[0].Args.(map[string]any)
+		assert.Equal(t, big.NewInt(7), args["value"])
+		assert.Len(t, ev.Logs[0].Topics, 3, "raw topics are kept next to the decoded args")
+
+		require.Len(t, ev.DecodeErrors, 1)
+		assert.Equal(t, "0x", ev.DecodeErrors[0].Log.Data)
+		assert.Equal(t, 1, *ev.DecodeErrors[0].Log.LogIndex)
+		assert.Error(t, ev.DecodeErrors[0].Cause)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for logs")
+	}
+
+	// The stream keeps running after a decode error.
+	select {
+	case ev, ok := <-events:
+		if ok {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+		t.Fatal("stream ended after a non-strict decode error")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWatchContractEvent_StrictEndsStreamOnDecodeError(t *testing.T) {
+	client := newTransferPollingClient(t)
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchContractEvent(ctx, client, public.WatchContractEventParameters{
+		Address:   common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		ABI:       erc20,
+		EventName: "Transfer",
+		Strict:    true,
+	})
+
+	var received []public.WatchContractEventEvent
+	timeout := time.After(2 * time.Second)
+	for done := false; !done; {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				done = true
+				break
+			}
+			received = append(received, ev)
+		case <-timeout:
+			t.Fatal("strict stream did not end")
+		}
+	}
+
+	require.Len(t, received, 2)
+	require.Len(t, received[0].Logs, 1)
+	assert.Empty(t, received[0].DecodeErrors)
+
+	var decodeErr *public.LogDecodeError
+	require.ErrorAs(t, received[1].Error, &decodeErr)
+	assert.Equal(t, "0x", decodeErr.Log.Data)
+}
+
+func TestWatchContractEvent_StrictSubscriptionDoesNotFallBack(t *testing.T) {
+	methods := make(chan string, 16)
+	client := newWatchMockClient(t, "webSocket", methods)
+	erc20, err := parseTestABI(buildEventFilterTestABI)
+	require.NoError(t, err)
+	valid, malformed := watchTransferLogs(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchContractEvent(ctx, client, public.WatchContractEventParameters{
+		Address:   common.HexToAddress("0x0000000000000000000000000000000000000001"),
+		ABI:       erc20,
+		EventName: "Transfer",
+		Strict:    true,
+	})
+
+	<-client.subscribed
+	for _, log := range []map[string]any{valid, malformed} {
+		data, err := json.Marshal(log)
+		require.NoError(t, err)
+		client.onData(data)
+	}
+
+	var errs []error
+	timeout := time.After(2 * time.Second)
+	for done := false; !done; {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				done = true
+				break
+			}
+			if ev.Error != nil {
+				errs = append(errs, ev.Error)
+			}
+		case <-timeout:
+			t.Fatal("strict subscription did not end")
+		}
+	}
+
+	require.Len(t, errs, 1)
+	var decodeErr *public.LogDecodeError
+	assert.ErrorAs(t, errs[0], &decodeErr)
+	assert.Empty(t, methods, "a strict decode failure must not fall back to polling")
+}
+
+// ============================================================================
+// Reorg Tests
+// ============================================================================
+
+func reorgTestHash(n int) string {
+	return fmt.Sprintf("0x%064x", n)
+}
+
+func reorgTestBlock(number uint64, hash, parentHash string) map[string]any {
+	return map[string]any{
+		"number":       hexutil.EncodeUint64(number),
+		"hash":         hash,
+		"parentHash":   parentHash,
+		"miner":        "0x0000000000000000000000000000000000000000",
+		"difficulty":   "0x0",
+		"gasLimit":     "0x1c9c380",
+		"gasUsed":      "0x0",
+		"timestamp":    "0x60000000",
+		"transactions": []string{},
+		"uncles":       []string{},
+	}
+}
+
+// reorgTestChain serves blocks from a chain whose tip at 0x11 is replaced
+// once reorg is called.
+type reorgTestChain struct {
+	mu      sync.Mutex
+	reorged bool
+}
+
+func (c *reorgTestChain) reorg() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reorged = true
+}
+
+func (c *reorgTestChain) blocks() (head uint64, byNumber map[uint64]map[string]any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	byNumber = map[uint64]map[string]any{
+		0x10: reorgTestBlock(0x10, reorgTestHash(0xa), reorgTestHash(0x9)),
+		0x11: reorgTestBlock(0x11, reorgTestHash(0xb), reorgTestHash(0xa)),
+	}
+	if !c.reorged {
+		return 0x11, byNumber
+	}
+	byNumber[0x11] = reorgTestBlock(0x11, reorgTestHash(0xbb), reorgTestHash(0xa))
+	byNumber[0x12] = reorgTestBlock(0x12, reorgTestHash(0xc), reorgTestHash(0xbb))
+	return 0x12, byNumber
+}
+
+func (c *reorgTestChain) handle(method string, params []any) any {
+	head, byNumber := c.blocks()
+	switch method {
+	case "eth_getBlockByNumber":
+		if tag, _ := params[0].(string); tag != "latest" {
+			n, err := hexutil.DecodeUint64(tag)
+			if err != nil {
+				return nil
+			}
+			return byNumber[n]
+		}
+		return byNumber[head]
+	case "eth_getLogs":
+		return []any{map[string]any{
+			"address":          "0x0000000000000000000000000000000000000001",
+			"topics":           []string{},
+			"data":             "0x",
+			"blockNumber":      "0x11",
+			"blockHash":        byNumber[0x11]["hash"],
+			"transactionHash":  reorgTestHash(0xf),
+			"transactionIndex": "0x0",
+			"logIndex":         "0x0",
+			"removed":          false,
+		}}
+	}
+	return nil
+}
+
+// blockNumberSequence serves eth_blockNumber from heads, repeating the last
+// head once they run out.
+func blockNumberSequence(heads ...uint64) func(method string, params []any) any {
+	var mu sync.Mutex
+	return func(method string, params []any) any {
+		if method != "eth_blockNumber" {
+			return nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		head := heads[0]
+		if len(heads) > 1 {
+			heads = heads[1:]
+		}
+		return hexutil.EncodeUint64(head)
+	}
+}
+
+func receiveBlockNumbers(t *testing.T, events <-chan public.WatchBlockNumberEvent, n int) []uint64 {
+	t.Helper()
+	var numbers []uint64
+	for len(numbers) < n {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			numbers = append(numbers, ev.BlockNumber)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out after block numbers %v", numbers)
+		}
+	}
+	return numbers
+}
+
+func TestWatchBlockNumber_EmitMissed(t *testing.T) {
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), blockNumberSequence(10, 14))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlockNumber(ctx, client, public.WatchBlockNumberParameters{
+		EmitOnBegin: true,
+		EmitMissed:  true,
+	})
+
+	assert.Equal(t, []uint64{10, 11, 12, 13, 14}, receiveBlockNumbers(t, events, 5))
+}
+
+func TestWatchBlockNumber_EmitMissedMaxBackfill(t *testing.T) {
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), blockNumberSequence(10, 30))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlockNumber(ctx, client, public.WatchBlockNumberParameters{
+		EmitOnBegin: true,
+		EmitMissed:  true,
+		MaxBackfill: 3,
+	})
+
+	assert.Equal(t, []uint64{10, 27, 28, 29, 30}, receiveBlockNumbers(t, events, 5))
+}
+
+func TestWatchBlockNumber_EmitMissedSubscription(t *testing.T) {
+	client := newWatchMockClient(t, "webSocket", make(chan string, 1))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlockNumber(ctx, client, public.WatchBlockNumberParameters{EmitMissed: true})
+
+	<-client.subscribed
+	go func() {
+		client.onData(json.RawMessage(`{"number":"0xa"}`))
+		client.onData(json.RawMessage(`{"number":"0xe"}`))
+	}()
+
+	var prev *uint64
+	for want := uint64(10); want <= 14; want++ {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			assert.Equal(t, want, ev.BlockNumber)
+			assert.Equal(t, prev, ev.PrevBlockNumber)
+			prev = &ev.BlockNumber
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for block %d", want)
+		}
+	}
+}
+
+func TestWatchBlocks_EmitsReorg(t *testing.T) {
+	chain := &reorgTestChain{}
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), chain.handle)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlocks(ctx, client, public.WatchBlocksParameters{})
+
+	next := func() public.WatchBlocksEvent {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			return ev
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for block event")
+			return public.WatchBlocksEvent{}
+		}
+	}
+
+	first := next()
+	require.NotNil(t, first.Block)
+	assert.Equal(t, common.HexToHash(reorgTestHash(0xb)), first.Block.Hash)
+
+	chain.reorg()
+
+	reorg := next()
+	assert.Nil(t, reorg.Block)
+	require.Len(t, reorg.Reorg, 1)
+	assert.Equal(t, common.HexToHash(reorgTestHash(0xb)), reorg.Reorg[0].Hash)
+
+	replacement := next()
+	require.NotNil(t, replacement.Block)
+	assert.Equal(t, uint64(0x11), replacement.Block.Number)
+	assert.Equal(t, common.HexToHash(reorgTestHash(0xbb)), replacement.Block.Hash)
+
+	head := next()
+	require.NotNil(t, head.Block)
+	assert.Equal(t, uint64(0x12), head.Block.Number)
+	assert.Equal(t, replacement.Block, head.PrevBlock)
+}
+
+func TestWatchBlocks_IgnoresLaggingHead(t *testing.T) {
+	blocks := map[uint64]map[string]any{
+		0x10: reorgTestBlock(0x10, reorgTestHash(0xa), reorgTestHash(0x9)),
+		0x11: reorgTestBlock(0x11, reorgTestHash(0xb), reorgTestHash(0xa)),
+		0x12: reorgTestBlock(0x12, reorgTestHash(0xc), reorgTestHash(0xb)),
+	}
+	// A load-balanced node serves 0x10, 0x11, then 0x10 again from a lagging
+	// backend before moving on to 0x12.
+	heads := []uint64{0x10, 0x11, 0x10}
+	var mu sync.Mutex
+	handler := func(method string, params []any) any {
+		if method != "eth_getBlockByNumber" {
+			return nil
+		}
+		if tag, _ := params[0].(string); tag != "latest" {
+			n, err := hexutil.DecodeUint64(tag)
+			if err != nil {
+				return nil
+			}
+			return blocks[n]
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		head := uint64(0x12)
+		if len(heads) > 0 {
+			head, heads = heads[0], heads[1:]
+		}
+		return blocks[head]
+	}
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlocks(ctx, client, public.WatchBlocksParameters{})
+
+	var got []uint64
+	for len(got) < 3 {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			require.Empty(t, ev.Reorg, "a lagging head is not a reorg")
+			require.NotNil(t, ev.Block)
+			got = append(got, ev.Block.Number)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for block event")
+		}
+	}
+	assert.Equal(t, []uint64{0x10, 0x11, 0x12}, got)
+}
+
+// fullTransactionsTestBlock returns a block with a legacy and an EIP-1559
+// transaction, in the form eth_getBlockByNumber returns when asked for full
+// transaction objects.
+func fullTransactionsTestBlock() map[string]any {
+	block := reorgTestBlock(0x10, reorgTestHash(0xa), reorgTestHash(0x9))
+	block["transactions"] = []map[string]any{
+		{
+			"type":             "0x0",
+			"hash":             "0x00000000000000000000000000000000000000000000000000000000000000c1",
+			"blockHash":        reorgTestHash(0xa),
+			"blockNumber":      "0x10",
+			"transactionIndex": "0x0",
+			"from":             "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+			"to":               "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+			"nonce":            "0x7",
+			"gas":              "0x5208",
+			"gasPrice":         "0x3b9aca00",
+			"value":            "0xde0b6b3a7640000",
+			"input":            "0x",
+			"chainId":          "0x1",
+			"v":                "0x25",
+			"r":                "0x1",
+			"s":                "0x2",
+		},
+		{
+			"type":                 "0x2",
+			"hash":                 "0x00000000000000000000000000000000000000000000000000000000000000c2",
+			"blockHash":            reorgTestHash(0xa),
+			"blockNumber":          "0x10",
+			"transactionIndex":     "0x1",
+			"from":                 "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+			"to":                   nil,
+			"nonce":                "0x0",
+			"gas":                  "0x30d40",
+			"gasPrice":             "0x3b9aca00",
+			"maxFeePerGas":         "0x77359400",
+			"maxPriorityFeePerGas": "0x3b9aca00",
+			"value":                "0x0",
+			"input":                "0x6080",
+			"chainId":              "0x1",
+			"accessList": []map[string]any{{
+				"address":     "0x1234567890123456789012345678901234567890",
+				"storageKeys": []string{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+			}},
+			"v":       "0x1",
+			"yParity": "0x1",
+			"r":       "0x3",
+			"s":       "0x4",
+		},
+	}
+	return block
+}
+
+func TestWatchBlocks_IncludeTransactions(t *testing.T) {
+	var includeTransactions atomic.Value
+	handler := func(method string, params []any) any {
+		if method != "eth_getBlockByNumber" {
+			return nil
+		}
+		includeTransactions.Store(params[1])
+		return fullTransactionsTestBlock()
+	}
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlocks(ctx, client, public.WatchBlocksParameters{
+		EmitOnBegin:         true,
+		IncludeTransactions: true,
+	})
+
+	var ev public.WatchBlocksEvent
+	select {
+	case ev = <-events:
+		require.NoError(t, ev.Error)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for block event")
+	}
+	assert.Equal(t, true, includeTransactions.Load())
+
+	assert.True(t, ev.FullTransactions)
+	require.NotNil(t, ev.Block)
+	require.Len(t, ev.Block.FullTransactions, 2)
+	assert.Equal(t, []common.Hash{
+		common.HexToHash("0xc1"),
+		common.HexToHash("0xc2"),
+	}, ev.Block.Transactions)
+
+	legacy := ev.Block.FullTransactions[0]
+	assert.Equal(t, uint8(0), legacy.Type)
+	assert.Equal(t, common.HexToHash("0xc1"), legacy.Hash)
+	assert.Equal(t, common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266"), legacy.From)
+	require.NotNil(t, legacy.To)
+	assert.Equal(t, common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8"), *legacy.To)
+	assert.Equal(t, uint64(7), legacy.Nonce)
+	assert.Equal(t, uint64(21000), legacy.Gas)
+	assert.Equal(t, big.NewInt(1e9), legacy.GasPrice)
+	assert.Equal(t, big.NewInt(1e18), legacy.Value)
+	assert.Nil(t, legacy.MaxFeePerGas)
+	assert.Nil(t, legacy.YParity)
+	assert.Equal(t, big.NewInt(37), legacy.V)
+	require.NotNil(t, legacy.BlockNumber)
+	assert.Equal(t, uint64(0x10), *legacy.BlockNumber)
+
+	dynamic := ev.Block.FullTransactions[1]
+	assert.Equal(t, uint8(2), dynamic.Type)
+	assert.Equal(t, common.HexToHash("0xc2"), dynamic.Hash)
+	assert.Nil(t, dynamic.To, "contract creation")
+	assert.Equal(t, []byte{0x60, 0x80}, dynamic.Input)
+	assert.Equal(t, big.NewInt(2e9), dynamic.MaxFeePerGas)
+	assert.Equal(t, big.NewInt(1e9), dynamic.MaxPriorityFeePerGas)
+	require.NotNil(t, dynamic.YParity)
+	assert.Equal(t, uint64(1), *dynamic.YParity)
+	require.Len(t, dynamic.AccessList, 1)
+	assert.Equal(t, common.HexToAddress("0x1234567890123456789012345678901234567890"), dynamic.AccessList[0].Address)
+	assert.Equal(t, []common.Hash{common.HexToHash("0x1")}, dynamic.AccessList[0].StorageKeys)
+	require.NotNil(t, dynamic.TransactionIndex)
+	assert.Equal(t, uint64(1), *dynamic.TransactionIndex)
+
+	// The decoded transactions convert to the GetTransaction type.
+	converted := (*public.TransactionResponse)(&ev.Block.FullTransactions[1])
+	assert.Equal(t, dynamic.Hash, converted.Hash)
+}
+
+func TestWatchBlocks_TransactionHashes(t *testing.T) {
+	handler := func(method string, params []any) any {
+		if method != "eth_getBlockByNumber" {
+			return nil
+		}
+		block := reorgTestBlock(0x10, reorgTestHash(0xa), reorgTestHash(0x9))
+		block["transactions"] = []string{
+			"0x00000000000000000000000000000000000000000000000000000000000000c1",
+		}
+		return block
+	}
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), handler)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchBlocks(ctx, client, public.WatchBlocksParameters{EmitOnBegin: true})
+
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		assert.False(t, ev.FullTransactions)
+		assert.Equal(t, []common.Hash{common.HexToHash("0xc1")}, ev.Block.Transactions)
+		assert.Nil(t, ev.Block.FullTransactions)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for block event")
+	}
+}
+
+// feeCacheWatchMockClient is a watchMockClient with fee caching enabled.
+type feeCacheWatchMockClient struct {
+	*watchMockClient
+}
+
+func (c *feeCacheWatchMockClient) FeeCacheTime() time.Duration { return time.Minute }
+
+func TestWatchBlocks_InvalidatesFeeCache(t *testing.T) {
+	var gasPriceCalls atomic.Int32
+	handler := func(method string, params []any) any {
+		switch method {
+		case "eth_gasPrice":
+			gasPriceCalls.Add(1)
+			return "0x4a817c800"
+		case "eth_getBlockByNumber":
+			if tag, _ := params[0].(string); tag == "0x11" {
+				return reorgTestBlock(0x11, reorgTestHash(0xb), reorgTestHash(0xa))
+			}
+			return reorgTestBlock(0x10, reorgTestHash(0xa), reorgTestHash(0x9))
+		}
+		return nil
+	}
+	client := &feeCacheWatchMockClient{
+		watchMockClient: newWatchMockClientWithHandler(t, "webSocket", make(chan string, 1), handler),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, err := public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	_, err = public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), gasPriceCalls.Load())
+
+	events := public.WatchBlocks(ctx, client, public.WatchBlocksParameters{})
+	<-client.subscribed
+	// The first head is skipped as the initial block, the second is emitted.
+	go func() {
+		client.onData(json.RawMessage(`{"number":"0x10"}`))
+		client.onData(json.RawMessage(`{"number":"0x11"}`))
+	}()
+
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for block event")
+	}
+
+	_, err = public.GetGasPrice(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), gasPriceCalls.Load())
+}
+
+func TestWatchEvent_ReorgMarksLogsRemoved(t *testing.T) {
+	chain := &reorgTestChain{}
+	// A null filter ID forces the getLogs fallback.
+	client := newWatchMockClientWithHandler(t, "http", make(chan string, 1), chain.handle)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	next := func() public.WatchEventEvent {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			return ev
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for logs")
+			return public.WatchEventEvent{}
+		}
+	}
+
+	first := next()
+	require.Len(t, first.Logs, 1)
+	assert.Equal(t, reorgTestHash(0xb), *first.Logs[0].BlockHash)
+	assert.False(t, first.Logs[0].Removed)
+
+	chain.reorg()
+
+	reorged := next()
+	require.Len(t, reorged.Logs, 2)
+	assert.True(t, reorged.Logs[0].Removed)
+	assert.Equal(t, reorgTestHash(0xb), *reorged.Logs[0].BlockHash)
+	assert.False(t, reorged.Logs[1].Removed)
+	assert.Equal(t, reorgTestHash(0xbb), *reorged.Logs[1].BlockHash)
+}
+
+// ============================================================================
+// Watch Filter Lifecycle Tests
+// ============================================================================
+
+func TestWatchPendingTransactions_FilterLifecycle(t *testing.T) {
+	var mu sync.Mutex
+	created := 0
+	uninstalled := make(chan string, 4)
+	txHash := "0x" + strings.Repeat("ab", 32)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_newPendingTransactionFilter":
+			mu.Lock()
+			created++
+			resp["result"] = hexutil.EncodeUint64(uint64(created))
+			mu.Unlock()
+		case "eth_getFilterChanges":
+			// The first filter expires on the node before it is ever polled.
+			if req.Params[0] == "0x1" {
+				resp["error"] = map[string]any{"code": -32000, "message": "filter not found"}
+			} else {
+				resp["result"] = []string{txHash}
+			}
+		case "eth_uninstallFilter":
+			uninstalled <- req.Params[0].(string)
+			resp["result"] = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	mc := createMockClient(t, server.URL)
+	mc.uid = t.Name()
+	client := &watchMockClient{mockClient: mc, transportType: "http", subscribed: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := public.WatchPendingTransactions(ctx, client, public.WatchPendingTransactionsParameters{})
+
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error, "expired filter should be recreated transparently")
+		assert.Equal(t, []common.Hash{common.HexToHash(txHash)}, ev.Hashes)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for pending transactions")
+	}
+
+	cancel()
+
+	select {
+	case id := <-uninstalled:
+		assert.Equal(t, "0x2", id)
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected eth_uninstallFilter on context cancel")
+	}
+	select {
+	case id := <-uninstalled:
+		t.Fatalf("filter %s uninstalled more than once", id)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, created)
+}
+
+func TestWatchEvent_BackfillsAfterFilterExpires(t *testing.T) {
+	var mu sync.Mutex
+	created, blockNumberCalls, changesCalls := 0, 0, 0
+	var getLogsFilter map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		mu.Lock()
+		defer mu.Unlock()
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case "eth_newFilter":
+			created++
+			resp["result"] = hexutil.EncodeUint64(uint64(created))
+		case "eth_blockNumber":
+			blockNumberCalls++
+			resp["result"] = "0x14"
+			if blockNumberCalls == 1 {
+				resp["result"] = "0x10"
+			}
+		case "eth_getFilterChanges":
+			changesCalls++
+			// The first filter expires on the node after one poll.
+			if req.Params[0] == "0x1" && changesCalls > 1 {
+				resp["error"] = map[string]any{"code": -32000, "message": "filter not found"}
+			} else {
+				resp["result"] = []any{}
+			}
+		case "eth_getLogs":
+			getLogsFilter = req.Params[0].(map[string]any)
+			var log map[string]any
+			require.NoError(t, json.Unmarshal([]byte(watchTestLog), &log))
+			log["blockNumber"] = "0x12"
+			resp["result"] = []any{log}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	mc := createMockClient(t, server.URL)
+	mc.uid = t.Name()
+	client := &watchMockClient{mockClient: mc, transportType: "http", subscribed: make(chan struct{})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := public.WatchEvent(ctx, client, public.WatchEventParameters{})
+
+	// The logs from blocks mined while the filter was gone are fetched with
+	// eth_getLogs, starting after the head seen when the watch started.
+	select {
+	case ev := <-events:
+		require.NoError(t, ev.Error)
+		require.Len(t, ev.Logs, 1)
+		assert.Equal(t, big.NewInt(0x12), ev.Logs[0].BlockNumber)
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for backfilled logs")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, created)
+	assert.Equal(t, "0x11", getLogsFilter["fromBlock"])
+	assert.Equal(t, "0x14", getLogsFilter["toBlock"])
+}
@@ -0,0 +1,75 @@
+package public_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// staticCallClient returns a client answering every eth_call with data.
+func staticCallClient(data []byte) *mockClient {
+	result, _ := json.Marshal(hexutil.Encode(data))
+	return &mockClient{transport: transport.NewCustomTransport(transport.CustomTransportConfig{
+		Request: func(ctx context.Context, req transport.RPCRequest) (*transport.RPCResponse, error) {
+			return &transport.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}, nil
+		},
+	})}
+}
+
+func FuzzMulticallDecode(f *testing.F) {
+	word := func(v *big.Int) []byte { return common.LeftPadBytes(v.Bytes(), 32) }
+	balance := word(big.NewInt(7))
+	valid := hexutil.MustDecode(encodeAggregate3Response([]multicallReturn{
+		{success: true, returnData: balance},
+		{success: false, returnData: []byte{0x01}},
+	}))
+
+	f.Add(valid)
+	f.Add(valid[:len(valid)/2])
+	f.Add(valid[:len(valid)-1])
+	for _, v := range []*big.Int{
+		new(big.Int).Lsh(big.NewInt(1), 255),
+		new(big.Int).SetUint64(1<<64 - 1),
+		new(big.Int).SetUint64(1<<63 - 1),
+		big.NewInt(1 << 40),
+	} {
+		// Oversized outer offset, array length, tuple offset and returnData length
+		for _, at := range []int{0, 32, 64, 160} {
+			data := append([]byte{}, valid...)
+			copy(data[at:at+32], word(v))
+			f.Add(data)
+		}
+	}
+
+	erc20, err := parseTestABI(multicallTestERC20ABI)
+	if err != nil {
+		f.Fatal(err)
+	}
+	multicallAddress := common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+	account := common.HexToAddress("0x01")
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, err := public.Multicall(context.Background(), staticCallClient(data), public.MulticallParameters{
+			Contracts: []public.MulticallContract{
+				{Address: common.HexToAddress("0x10"), FunctionName: "balanceOf", Args: []any{account}},
+				{Address: common.HexToAddress("0x11"), FunctionName: "balanceOf", Args: []any{account}},
+			},
+			ABI:              erc20,
+			MulticallAddress: &multicallAddress,
+		})
+		var decodingErr *abi.AbiDecodingError
+		if err != nil && len(data) >= 64 && !errors.As(err, &decodingErr) {
+			t.Fatalf("expected an AbiDecodingError, got %v", err)
+		}
+	})
+}
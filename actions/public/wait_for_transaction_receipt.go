@@ -5,10 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"time"
 
+	json "github.com/goccy/go-json"
+
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/types"
 )
 
@@ -16,8 +20,8 @@ import (
 type ReplacementReason string
 
 const (
-	// ReplacementReasonCancelled indicates the transaction was canceled (value === 0, sent to self).
-	ReplacementReasonCancelled ReplacementReason = "canceled"
+	// ReplacementReasonCancelled indicates the transaction was cancelled (value === 0, sent to self).
+	ReplacementReasonCancelled ReplacementReason = "cancelled"
 	// ReplacementReasonReplaced indicates the transaction was replaced with a different transaction.
 	ReplacementReasonReplaced ReplacementReason = "replaced"
 	// ReplacementReasonRepriced indicates the transaction was repriced (same tx, different gas).
@@ -46,9 +50,20 @@ type WaitForTransactionReceiptParameters struct {
 	// Default: 1
 	Confirmations uint64
 
+	// WaitForFinalized, instead of counting Confirmations, waits until the
+	// transaction's block is at or below the "finalized" block. On chains
+	// whose nodes do not support the finalized tag, Confirmations are
+	// counted instead.
+	WaitForFinalized bool
+
 	// OnReplaced is an optional callback to emit if the transaction has been replaced.
 	OnReplaced func(info ReplacementInfo)
 
+	// Poll forces polling even when the transport supports subscriptions.
+	// By default the receipt is checked on each newHeads notification over
+	// WebSocket/IPC, and on a PollingInterval ticker otherwise.
+	Poll *bool
+
 	// PollingInterval is the polling frequency (in duration).
 	// Default: 4 seconds
 	PollingInterval time.Duration
@@ -89,12 +104,17 @@ func (e *WaitForTransactionReceiptTimeoutError) Error() string {
 //
 // There are 3 types of transaction replacement reasons:
 //   - repriced: The gas price has been modified (e.g., different maxFeePerGas)
-//   - canceled: The transaction has been canceled (e.g., value === 0, sent to self)
+//   - cancelled: The transaction has been cancelled (e.g., value === 0, sent to self)
 //   - replaced: The transaction has been replaced (e.g., different value or data)
 //
 // JSON-RPC Methods:
-//   - Polls eth_getTransactionReceipt on each block until it has been processed.
-//   - If a transaction has been replaced, calls eth_getBlockByNumber to find the replacement.
+//   - Checks eth_getTransactionReceipt on each block until it has been processed.
+//     Over WebSocket/IPC, blocks come from an eth_subscribe "newHeads"
+//     subscription; otherwise eth_blockNumber is polled every PollingInterval.
+//   - Once the sender's nonce has been consumed without a receipt for the original,
+//     calls eth_getBlockByNumber to find the replacement.
+//   - With WaitForFinalized, calls eth_getBlockByNumber("finalized") on each
+//     block until the receipt's block is finalized.
 //
 // Example:
 //
@@ -145,98 +165,275 @@ func WaitForTransactionReceipt(ctx context.Context, client Client, params WaitFo
 
 	var transaction *TransactionResponse
 	var receipt *types.Receipt
+	var replacement *ReplacementInfo
+
+	// searchedThrough is the last block known not to hold a replacement:
+	// either the nonce was still unused there, or the block was searched.
+	var searchedThrough *uint64
+
+	// finalizedUnsupported is set once the node rejects the finalized tag,
+	// after which confirmations are counted instead.
+	finalizedUnsupported := false
+
+	// hasConfirmations reports whether a receipt has enough confirmations at
+	// blockNumber or, with WaitForFinalized, whether its block is finalized.
+	hasConfirmations := func(r *types.Receipt, blockNumber uint64) bool {
+		if params.WaitForFinalized && !finalizedUnsupported {
+			finalized, err := GetBlock(ctx, client, GetBlockParameters{BlockTag: BlockTagFinalized})
+			if err == nil {
+				return r.BlockNumber <= finalized.Number
+			}
+			if !isBlockTagUnsupported(err) {
+				return false // Transient error, retry on next block
+			}
+			finalizedUnsupported = true
+		}
+		return confirmations <= 1 || blockNumber-r.BlockNumber+1 >= confirmations
+	}
 
 	// Try to get the receipt immediately
 	receipt, _ = GetTransactionReceipt(ctx, client, GetTransactionReceiptParameters{
 		Hash: params.Hash,
 	})
 
-	if receipt != nil && confirmations <= 1 {
+	if receipt != nil && hasConfirmations(receipt, receipt.BlockNumber) {
 		return receipt, nil
 	}
 
-	// Poll for the receipt
-	ticker := time.NewTicker(pollingInterval)
-	defer ticker.Stop()
+	// checkBlock checks for the receipt (or a replacement) at blockNumber and
+	// returns it once it has enough confirmations.
+	checkBlock := func(blockNumber uint64) *types.Receipt {
+		// A replacement was already found and reported; wait for its confirmations.
+		if replacement != nil {
+			if hasConfirmations(replacement.TransactionReceipt, blockNumber) {
+				return replacement.TransactionReceipt
+			}
+			return nil
+		}
+
+		// Always check the original receipt first so a mined original is
+		// never mistaken for a replacement.
+		if receipt == nil {
+			var err error
+			receipt, err = GetTransactionReceipt(ctx, client, GetTransactionReceiptParameters{
+				Hash: params.Hash,
+			})
+			var receiptNotFoundErr *TransactionReceiptNotFoundError
+			if err != nil && !errors.As(err, &receiptNotFoundErr) {
+				return nil // Transient error, retry on next block
+			}
+		}
+
+		if receipt != nil {
+			if hasConfirmations(receipt, blockNumber) {
+				return receipt
+			}
+			return nil
+		}
+
+		if !checkReplacement {
+			return nil
+		}
+
+		// Fetch the original transaction once so it can be compared to a replacement.
+		if transaction == nil {
+			transaction, _ = getTransactionWithRetry(ctx, client, params.Hash, retryCount, retryDelay)
+			if transaction == nil {
+				return nil
+			}
+		}
+
+		// Only look for a replacement once the nonce has been consumed. Until then
+		// the original is still pending and may yet be mined.
+		nonce, err := GetTransactionCount(ctx, client, GetTransactionCountParameters{
+			Address:  transaction.From,
+			BlockTag: BlockTagLatest,
+		})
+		if err != nil {
+			return nil
+		}
+		if nonce <= transaction.Nonce {
+			searchedThrough = &blockNumber
+			return nil
+		}
+
+		// Re-check the original receipt: the nonce may have been consumed by the
+		// original itself between the two requests.
+		receipt, _ = GetTransactionReceipt(ctx, client, GetTransactionReceiptParameters{
+			Hash: params.Hash,
+		})
+		if receipt != nil {
+			if hasConfirmations(receipt, blockNumber) {
+				return receipt
+			}
+			return nil
+		}
+
+		// The replacement may have been mined in any block since the last one
+		// searched, e.g. when a polling interval spans several blocks.
+		from := blockNumber
+		if searchedThrough != nil && *searchedThrough < blockNumber {
+			from = *searchedThrough + 1
+		}
+		var (
+			replacementTx      *TransactionResponse
+			replacementReceipt *types.Receipt
+			reason             ReplacementReason
+		)
+		for n := from; n <= blockNumber; n++ {
+			replacementTx, replacementReceipt, reason, err = findReplacementTransaction(ctx, client, transaction, n, retryCount, retryDelay)
+			if err != nil {
+				return nil // Transient error, resume from this block on the next one
+			}
+			if replacementTx != nil {
+				break
+			}
+			searchedThrough = &n
+		}
+		if replacementTx == nil {
+			return nil
+		}
+
+		replacement = &ReplacementInfo{
+			Reason:              reason,
+			ReplacedTransaction: transaction,
+			Transaction:         replacementTx,
+			TransactionReceipt:  replacementReceipt,
+		}
+		if params.OnReplaced != nil {
+			params.OnReplaced(*replacement)
+		}
+
+		if hasConfirmations(replacementReceipt, blockNumber) {
+			return replacementReceipt
+		}
+		return nil
+	}
+
+	var result *types.Receipt
+	err := forEachHead(timeoutCtx, client, params.Poll, pollingInterval, func(blockNumber uint64) bool {
+		result = checkBlock(blockNumber)
+		return result != nil
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, &WaitForTransactionReceiptTimeoutError{Hash: params.Hash}
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// forEachHead calls fn with the latest block number on every new head until
+// fn returns true or ctx is done, in which case ctx's error is returned.
+// Heads come from a newHeads subscription when the transport supports one
+// (and poll does not force polling); otherwise, or if the subscription
+// fails, eth_blockNumber is polled every pollingInterval.
+func forEachHead(ctx context.Context, client Client, poll *bool, pollingInterval time.Duration, fn func(blockNumber uint64) bool) error {
+	var heads <-chan uint64
+	var headsFailed <-chan struct{}
+	if watchClient, ok := client.(WatchClient); ok && !ShouldPoll(watchClient, poll) {
+		var unsubscribe func()
+		heads, headsFailed, unsubscribe = subscribeHeadNumbers(watchClient)
+		defer unsubscribe()
+	}
+
+	var ticker *time.Ticker
+	var tickerC <-chan time.Time
+	startPolling := func() {
+		ticker = time.NewTicker(pollingInterval)
+		tickerC = ticker.C
+	}
+	defer func() {
+		if ticker != nil {
+			ticker.Stop()
+		}
+	}()
+	if heads == nil {
+		startPolling()
+	}
 
 	for {
 		select {
-		case <-timeoutCtx.Done():
-			if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
-				return nil, &WaitForTransactionReceiptTimeoutError{Hash: params.Hash}
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-headsFailed:
+			// The subscription failed; fall back to polling.
+			heads, headsFailed = nil, nil
+			startPolling()
+
+		case blockNumber := <-heads:
+			if fn(blockNumber) {
+				return nil
 			}
-			return nil, timeoutCtx.Err()
 
-		case <-ticker.C:
+		case <-tickerC:
 			// Get current block number
 			blockNumber, err := GetBlockNumber(ctx, client, GetBlockNumberParameters{})
 			if err != nil {
 				continue // Retry on next tick
 			}
-
-			// If we already have a valid receipt, check confirmations
-			if receipt != nil {
-				if confirmations > 1 {
-					if blockNumber-receipt.BlockNumber+1 < confirmations {
-						continue // Not enough confirmations yet
-					}
-				}
-				return receipt, nil
+			if fn(blockNumber) {
+				return nil
 			}
+		}
+	}
+}
 
-			// Try to get the transaction if we need to check for replacement
-			if checkReplacement && transaction == nil {
-				transaction, _ = getTransactionWithRetry(ctx, client, params.Hash, retryCount, retryDelay)
+// subscribeHeadNumbers subscribes to newHeads and delivers block numbers on
+// heads. Only the latest head is buffered, so a slow consumer skips ahead
+// rather than blocking the subscription. failed is closed if subscribing
+// fails or the subscription reports an error.
+func subscribeHeadNumbers(client WatchClient) (heads <-chan uint64, failed <-chan struct{}, unsubscribe func()) {
+	headsCh := make(chan uint64, 1)
+	failedCh := make(chan struct{})
+	var failOnce sync.Once
+	fail := func() { failOnce.Do(func() { close(failedCh) }) }
+
+	sub, err := client.Subscribe(
+		transport.NewHeadsSubscribeParams(),
+		func(data json.RawMessage) {
+			var header struct {
+				Number string `json:"number"`
 			}
-
-			// Try to get the receipt
-			receipt, err = GetTransactionReceipt(ctx, client, GetTransactionReceiptParameters{
-				Hash: params.Hash,
-			})
-
-			if err == nil && receipt != nil {
-				// Check confirmations
-				if confirmations > 1 {
-					if blockNumber-receipt.BlockNumber+1 < confirmations {
-						continue // Not enough confirmations yet
-					}
-				}
-				return receipt, nil
+			if err := json.Unmarshal(data, &header); err != nil {
+				return
 			}
-
-			// Receipt not found - check for replacement
-			var receiptNotFoundErr *TransactionReceiptNotFoundError
-			var txNotFoundErr *TransactionNotFoundError
-			if errors.As(err, &receiptNotFoundErr) || errors.As(err, &txNotFoundErr) {
-				if transaction == nil {
-					continue // No transaction to check for replacement
+			blockNumber, err := parseHexUint64(header.Number)
+			if err != nil {
+				return
+			}
+			// Replace any head the consumer has not read yet.
+			for {
+				select {
+				case headsCh <- blockNumber:
+					return
+				default:
 				}
-
-				// Try to find a replacement transaction in the current block
-				replacement, replacementReceipt, reason := findReplacementTransaction(ctx, client, transaction, blockNumber, retryCount, retryDelay)
-				if replacement != nil && replacementReceipt != nil {
-					// Check confirmations for replacement
-					if confirmations > 1 {
-						if blockNumber-replacementReceipt.BlockNumber+1 < confirmations {
-							continue // Not enough confirmations yet
-						}
-					}
-
-					// Call the onReplaced callback if provided
-					if params.OnReplaced != nil {
-						params.OnReplaced(ReplacementInfo{
-							Reason:              reason,
-							ReplacedTransaction: transaction,
-							Transaction:         replacement,
-							TransactionReceipt:  replacementReceipt,
-						})
-					}
-
-					return replacementReceipt, nil
+				select {
+				case <-headsCh:
+				default:
 				}
 			}
-		}
+		},
+		func(error) { fail() },
+	)
+	if err != nil || sub == nil {
+		fail()
+		return headsCh, failedCh, func() {}
 	}
+
+	return headsCh, failedCh, func() { _ = sub.Unsubscribe() }
+}
+
+// isBlockTagUnsupported reports whether err means the node does not know a
+// block tag such as "finalized": the block is missing, or the node rejected
+// the request.
+func isBlockTagUnsupported(err error) bool {
+	var blockNotFoundErr *BlockNotFoundError
+	var rpcErr *transport.RPCError
+	return errors.As(err, &blockNotFoundErr) || errors.As(err, &rpcErr)
 }
 
 // getTransactionWithRetry attempts to get a transaction with retries.
@@ -259,7 +456,9 @@ func getTransactionWithRetry(ctx context.Context, client Client, hash common.Has
 	return nil, lastErr
 }
 
-// findReplacementTransaction looks for a replacement transaction in the given block.
+// findReplacementTransaction looks for a replacement transaction in the given
+// block. It returns nil values if the block holds none, and an error if the
+// block could not be fully searched.
 func findReplacementTransaction(
 	ctx context.Context,
 	client Client,
@@ -267,44 +466,40 @@ func findReplacementTransaction(
 	blockNumber uint64,
 	retryCount int,
 	retryDelay func(int) time.Duration,
-) (*TransactionResponse, *types.Receipt, ReplacementReason) {
-	// Get the block with full transactions
+) (*TransactionResponse, *types.Receipt, ReplacementReason, error) {
+	// Get the block with its transaction hashes
 	var block *types.Block
-	var lastErr error
+	var err error
 
 	for i := 0; i < retryCount; i++ {
-		block, lastErr = GetBlock(ctx, client, GetBlockParameters{
-			BlockNumber:         &blockNumber,
-			IncludeTransactions: true,
+		block, err = GetBlock(ctx, client, GetBlockParameters{
+			BlockNumber: &blockNumber,
 		})
-		if lastErr == nil {
+		if err == nil {
 			break
 		}
 
 		// Check if it's a block not found error
 		var blockNotFoundErr *BlockNotFoundError
-		if !errors.As(lastErr, &blockNotFoundErr) {
-			return nil, nil, ""
+		if !errors.As(err, &blockNotFoundErr) {
+			return nil, nil, "", err
 		}
 
 		if i < retryCount-1 {
 			time.Sleep(retryDelay(i))
 		}
 	}
-
-	if block == nil {
-		return nil, nil, ""
+	if err != nil {
+		return nil, nil, "", err
 	}
 
 	// Look for a transaction with the same from address and nonce
-	// Note: When IncludeTransactions is true, we need to iterate differently
-	// For now, we'll fetch transactions individually based on the transaction hashes
 	for _, txHash := range block.Transactions {
 		tx, err := GetTransaction(ctx, client, GetTransactionParameters{
 			Hash: &txHash,
 		})
 		if err != nil {
-			continue
+			return nil, nil, "", err
 		}
 
 		// Check if this is a replacement (same from and nonce)
@@ -314,16 +509,16 @@ func findReplacementTransaction(
 				Hash: tx.Hash,
 			})
 			if err != nil {
-				continue
+				return nil, nil, "", err
 			}
 
 			// Determine the replacement reason
 			reason := determineReplacementReason(originalTx, tx)
-			return tx, receipt, reason
+			return tx, receipt, reason, nil
 		}
 	}
 
-	return nil, nil, ""
+	return nil, nil, "", nil
 }
 
 // determineReplacementReason determines why a transaction was replaced.
@@ -349,7 +544,7 @@ func determineReplacementReason(original, replacement *TransactionResponse) Repl
 		return ReplacementReasonRepriced
 	}
 
-	// Sent to self with zero value means canceled
+	// Sent to self with zero value means cancelled
 	zeroValue := replacement.Value == nil || replacement.Value.Cmp(big.NewInt(0)) == 0
 	sentToSelf := replacement.To != nil && replacement.From == *replacement.To
 
@@ -0,0 +1,230 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// WaitForTransactionReceiptByNonceParameters contains the parameters for the
+// WaitForTransactionReceiptByNonce action.
+type WaitForTransactionReceiptByNonceParameters struct {
+	// Address is the sender of the transaction. Required.
+	Address common.Address
+
+	// Nonce is the sender's nonce the transaction uses. Required.
+	Nonce uint64
+
+	// Hash is an optional guess of the transaction hash, e.g. the hash
+	// returned when the transaction was first sent. Its receipt is checked
+	// first, but any transaction that consumes the nonce is accepted.
+	Hash *common.Hash
+
+	// Confirmations is the number of confirmations (blocks that have passed) to wait before resolving.
+	// Default: 1
+	Confirmations uint64
+
+	// Poll forces polling even when the transport supports subscriptions.
+	Poll *bool
+
+	// PollingInterval is the polling frequency (in duration).
+	// Default: 4 seconds
+	PollingInterval time.Duration
+
+	// Timeout is the maximum time to wait before stopping polling.
+	// Default: 180 seconds
+	Timeout time.Duration
+}
+
+// WaitForTransactionReceiptByNonceReturnType is the return type for the
+// WaitForTransactionReceiptByNonce action.
+type WaitForTransactionReceiptByNonceReturnType struct {
+	// Hash is the hash of the transaction that consumed the nonce.
+	Hash common.Hash
+	// Receipt is that transaction's receipt.
+	Receipt *types.Receipt
+}
+
+// WaitForTransactionReceiptByNonceTimeoutError is returned when no
+// transaction with the sender and nonce was mined before the timeout.
+type WaitForTransactionReceiptByNonceTimeoutError struct {
+	Address common.Address
+	Nonce   uint64
+}
+
+func (e *WaitForTransactionReceiptByNonceTimeoutError) Error() string {
+	return fmt.Sprintf("timed out waiting for transaction receipt: from=%s nonce=%d", e.Address.Hex(), e.Nonce)
+}
+
+// WaitForTransactionReceiptByNonce waits for whichever transaction from
+// Address with Nonce is mined, and returns its hash and receipt.
+//
+// Unlike WaitForTransactionReceipt it does not need the final hash, so it
+// resolves however the transaction was sped up, cancelled or replaced, even
+// when the original was never seen by the node.
+//
+// On each new block the sender's transaction count is checked. Once it
+// exceeds Nonce, the block that consumed the nonce is located by bisecting
+// eth_getTransactionCount between the last block where the nonce was unused
+// and the new block, and that block's transactions are searched for the
+// sender and nonce. If the nonce was already consumed when waiting started,
+// the bisection starts from genesis and needs a node that serves historical
+// state.
+//
+// JSON-RPC Methods:
+//   - eth_getTransactionReceipt for Hash, if given
+//   - eth_getTransactionCount on each block (over WebSocket/IPC blocks come
+//     from a "newHeads" subscription, otherwise eth_blockNumber is polled)
+//   - eth_getBlockByNumber, eth_getTransactionByHash and
+//     eth_getTransactionReceipt once the nonce has been consumed
+//
+// Example:
+//
+//	result, err := public.WaitForTransactionReceiptByNonce(ctx, client, public.WaitForTransactionReceiptByNonceParameters{
+//	    Address: sender,
+//	    Nonce:   42,
+//	})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Nonce 42 consumed by %s in block %d\n", result.Hash.Hex(), result.Receipt.BlockNumber)
+func WaitForTransactionReceiptByNonce(ctx context.Context, client Client, params WaitForTransactionReceiptByNonceParameters) (*WaitForTransactionReceiptByNonceReturnType, error) {
+	confirmations := params.Confirmations
+	if confirmations == 0 {
+		confirmations = 1
+	}
+
+	pollingInterval := params.PollingInterval
+	if pollingInterval == 0 {
+		pollingInterval = 4 * time.Second
+	}
+
+	timeout := params.Timeout
+	if timeout == 0 {
+		timeout = 180 * time.Second
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var found *WaitForTransactionReceiptByNonceReturnType
+
+	// unusedAt is the latest block at which the nonce was seen unused.
+	var unusedAt *uint64
+
+	hasConfirmations := func(blockNumber uint64) bool {
+		return confirmations <= 1 || blockNumber-found.Receipt.BlockNumber+1 >= confirmations
+	}
+
+	// checkBlock looks for the transaction at blockNumber and reports whether
+	// it has been found with enough confirmations.
+	checkBlock := func(blockNumber uint64) bool {
+		if found != nil {
+			return hasConfirmations(blockNumber)
+		}
+
+		if params.Hash != nil {
+			receipt, err := GetTransactionReceipt(timeoutCtx, client, GetTransactionReceiptParameters{
+				Hash: *params.Hash,
+			})
+			if err == nil && receipt.From == params.Address {
+				found = &WaitForTransactionReceiptByNonceReturnType{Hash: *params.Hash, Receipt: receipt}
+				return hasConfirmations(blockNumber)
+			}
+		}
+
+		count, err := GetTransactionCount(timeoutCtx, client, GetTransactionCountParameters{
+			Address:     params.Address,
+			BlockNumber: &blockNumber,
+		})
+		if err != nil {
+			return false // Transient error, retry on next block
+		}
+		if count <= params.Nonce {
+			unusedAt = &blockNumber
+			return false
+		}
+
+		minedIn, err := findNonceBlock(timeoutCtx, client, params.Address, params.Nonce, unusedAt, blockNumber)
+		if err != nil {
+			return false
+		}
+		hash, receipt, err := findTransactionBySenderAndNonce(timeoutCtx, client, params.Address, params.Nonce, minedIn)
+		if err != nil {
+			return false
+		}
+		found = &WaitForTransactionReceiptByNonceReturnType{Hash: hash, Receipt: receipt}
+		return hasConfirmations(blockNumber)
+	}
+
+	// The transaction may already be mined.
+	if blockNumber, err := GetBlockNumber(timeoutCtx, client, GetBlockNumberParameters{}); err == nil && checkBlock(blockNumber) {
+		return found, nil
+	}
+
+	err := forEachHead(timeoutCtx, client, params.Poll, pollingInterval, checkBlock)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, &WaitForTransactionReceiptByNonceTimeoutError{Address: params.Address, Nonce: params.Nonce}
+		}
+		return nil, err
+	}
+	return found, nil
+}
+
+// findNonceBlock returns the first block in (unusedAt, usedAt] at which
+// address's transaction count exceeds nonce, i.e. the block that mined the
+// transaction with that nonce. A nil unusedAt searches from genesis.
+func findNonceBlock(ctx context.Context, client Client, address common.Address, nonce uint64, unusedAt *uint64, usedAt uint64) (uint64, error) {
+	lo, hi := uint64(0), usedAt
+	if unusedAt != nil {
+		lo = *unusedAt + 1
+	}
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		count, err := GetTransactionCount(ctx, client, GetTransactionCountParameters{
+			Address:     address,
+			BlockNumber: &mid,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if count > nonce {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return lo, nil
+}
+
+// findTransactionBySenderAndNonce returns the hash and receipt of the
+// transaction in blockNumber sent by address with nonce.
+func findTransactionBySenderAndNonce(ctx context.Context, client Client, address common.Address, nonce uint64, blockNumber uint64) (common.Hash, *types.Receipt, error) {
+	block, err := GetBlock(ctx, client, GetBlockParameters{BlockNumber: &blockNumber})
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	for _, txHash := range block.Transactions {
+		tx, err := GetTransaction(ctx, client, GetTransactionParameters{Hash: &txHash})
+		if err != nil {
+			return common.Hash{}, nil, err
+		}
+		if tx.From != address || tx.Nonce != nonce {
+			continue
+		}
+		receipt, err := GetTransactionReceipt(ctx, client, GetTransactionReceiptParameters{Hash: txHash})
+		if err != nil {
+			return common.Hash{}, nil, err
+		}
+		return txHash, receipt, nil
+	}
+
+	return common.Hash{}, nil, fmt.Errorf("no transaction from %s with nonce %d in block %d", address.Hex(), nonce, blockNumber)
+}
@@ -26,6 +26,12 @@ type WatchBlockNumberParameters struct {
 	// Default: false
 	EmitMissed bool
 
+	// MaxBackfill caps how many missed block numbers EmitMissed emits for a
+	// single gap. On a larger jump only the most recent MaxBackfill missed
+	// numbers are emitted before the new block number.
+	// Default: DefaultMaxBackfill
+	MaxBackfill uint64
+
 	// Poll forces polling mode even when WebSocket transport is available.
 	// If nil, automatically detects based on transport type.
 	Poll *bool
@@ -47,6 +53,9 @@ type WatchBlockNumberEvent struct {
 	Error error
 }
 
+// DefaultMaxBackfill is the default WatchBlockNumberParameters.MaxBackfill.
+const DefaultMaxBackfill uint64 = 100
+
 // blockNumberObserver is the global observer for block number subscriptions.
 var blockNumberObserver = observe.New[WatchBlockNumberEvent]()
 
@@ -117,10 +126,11 @@ func pollBlockNumber(
 	var prevBlockNumber *uint64
 
 	// Create observer ID for deduplication
-	observerID := fmt.Sprintf("watchBlockNumber.%s.%v.%v.%v",
+	observerID := fmt.Sprintf("watchBlockNumber.%s.%v.%v.%d.%v",
 		client.UID(),
 		params.EmitOnBegin,
 		params.EmitMissed,
+		params.MaxBackfill,
 		interval,
 	)
 
@@ -162,20 +172,9 @@ func pollBlockNumber(
 				}
 
 				// Emit missed blocks if enabled
-				if params.EmitMissed && prevBlockNumber != nil && blockNumber-*prevBlockNumber > 1 {
-					for i := *prevBlockNumber + 1; i < blockNumber; i++ {
-						prev := i - 1
-						select {
-						case sourceCh <- WatchBlockNumberEvent{
-							BlockNumber:     i,
-							PrevBlockNumber: &prev,
-						}:
-							prevCopy := i
-							prevBlockNumber = &prevCopy
-						case <-ctx.Done():
-							return
-						}
-					}
+				var ok bool
+				if prevBlockNumber, ok = emitMissedBlockNumbers(ctx, sourceCh, params, prevBlockNumber, blockNumber); !ok {
+					return
 				}
 
 				// Emit current block number if it's newer
@@ -265,20 +264,9 @@ func subscribeBlockNumber(
 			}
 
 			// Emit missed blocks if enabled
-			if params.EmitMissed && prevBlockNumber != nil && blockNumber-*prevBlockNumber > 1 {
-				for i := *prevBlockNumber + 1; i < blockNumber; i++ {
-					prev := i - 1
-					select {
-					case ch <- WatchBlockNumberEvent{
-						BlockNumber:     i,
-						PrevBlockNumber: &prev,
-					}:
-						prevCopy := i
-						prevBlockNumber = &prevCopy
-					case <-ctx.Done():
-						return
-					}
-				}
+			var ok bool
+			if prevBlockNumber, ok = emitMissedBlockNumbers(ctx, ch, params, prevBlockNumber, blockNumber); !ok {
+				return
 			}
 
 			// Emit current block number
@@ -316,3 +304,40 @@ func subscribeBlockNumber(
 		_ = sub.Unsubscribe()
 	}
 }
+
+// emitMissedBlockNumbers sends an event for each block number skipped between
+// prev and blockNumber, oldest first, when params.EmitMissed is set. At most
+// params.MaxBackfill of the most recent missed numbers are sent. It returns
+// the last block number sent (or prev if none were) and false if ctx was
+// cancelled.
+func emitMissedBlockNumbers(
+	ctx context.Context,
+	ch chan<- WatchBlockNumberEvent,
+	params WatchBlockNumberParameters,
+	prev *uint64,
+	blockNumber uint64,
+) (*uint64, bool) {
+	if !params.EmitMissed || prev == nil || blockNumber <= *prev+1 {
+		return prev, true
+	}
+
+	maxBackfill := params.MaxBackfill
+	if maxBackfill == 0 {
+		maxBackfill = DefaultMaxBackfill
+	}
+	from := *prev + 1
+	if blockNumber-from > maxBackfill {
+		from = blockNumber - maxBackfill
+	}
+
+	for i := from; i < blockNumber; i++ {
+		select {
+		case ch <- WatchBlockNumberEvent{BlockNumber: i, PrevBlockNumber: prev}:
+			emitted := i
+			prev = &emitted
+		case <-ctx.Done():
+			return prev, false
+		}
+	}
+	return prev, true
+}
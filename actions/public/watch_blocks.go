@@ -31,7 +31,8 @@ type WatchBlocksParameters struct {
 	EmitOnBegin bool
 
 	// IncludeTransactions determines whether to include full transaction objects
-	// in the block data.
+	// in the block data. When true, each emitted block's FullTransactions holds
+	// the decoded transactions; Block.Transactions always holds their hashes.
 	// Default: false
 	IncludeTransactions bool
 
@@ -52,6 +53,17 @@ type WatchBlocksEvent struct {
 	// PrevBlock is the previous block (nil for first event).
 	PrevBlock *types.Block
 
+	// Reorg lists previously emitted blocks that are no longer canonical,
+	// oldest first. Reorg events carry no Block; the canonical blocks that
+	// replace them follow as regular events.
+	Reorg []*types.Block
+
+	// FullTransactions reports whether the blocks in this event were fetched
+	// with full transaction objects (IncludeTransactions). When true,
+	// Block.FullTransactions holds the decoded transactions; otherwise only
+	// the hashes in Block.Transactions are set.
+	FullTransactions bool
+
 	// Error is any error that occurred while fetching the block.
 	Error error
 }
@@ -66,6 +78,8 @@ var blocksObserver = observe.New[WatchBlocksEvent]()
 //   - context.Context for cancellation
 //   - Observer pattern for deduplication (multiple watchers share one source)
 //   - Automatic transport detection (polling vs subscription)
+//   - Reorg detection: when a block does not build on the last emitted one,
+//     a Reorg event lists the orphaned blocks before the canonical ones are emitted
 //
 // JSON-RPC Methods:
 //   - When polling: calls eth_getBlockByNumber on a polling interval
@@ -90,6 +104,9 @@ var blocksObserver = observe.New[WatchBlocksEvent]()
 //	    fmt.Printf("Block %d: %d transactions\n",
 //	        event.Block.Number,
 //	        len(event.Block.Transactions))
+//	    for _, tx := range event.Block.FullTransactions {
+//	        fmt.Printf("  %s from %s (type %d)\n", tx.Hash.Hex(), tx.From.Hex(), tx.Type)
+//	    }
 //	}
 func WatchBlocks(
 	ctx context.Context,
@@ -113,14 +130,29 @@ func WatchBlocks(
 
 	// Create output channel
 	ch := make(chan WatchBlocksEvent, 10)
+	events := make(chan WatchBlocksEvent, 10)
 
 	go func() {
-		defer close(ch)
+		defer close(events)
 
 		if enablePolling {
-			pollBlocks(ctx, client, params, blockTag, pollingInterval, ch)
+			pollBlocks(ctx, client, params, blockTag, pollingInterval, events)
 		} else {
-			subscribeBlocks(ctx, client, params, blockTag, ch)
+			subscribeBlocks(ctx, client, params, blockTag, events)
+		}
+	}()
+
+	// Mark which form the blocks' transactions take
+	go func() {
+		defer close(ch)
+
+		for event := range events {
+			event.FullTransactions = event.Error == nil && params.IncludeTransactions
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -151,6 +183,8 @@ func pollBlocks(
 	// Use observer for deduplication
 	eventCh := blocksObserver.Subscribe(observerID, func() (<-chan WatchBlocksEvent, func()) {
 		sourceCh := make(chan WatchBlocksEvent, 10)
+		history := newBlockHistory(defaultReorgDepth)
+		fetch := watchBlocksFetcher(client, params)
 
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) (*types.Block, error) {
@@ -179,6 +213,18 @@ func pollBlocks(
 
 				block := result.Value
 
+				// Roll back to the common ancestor if the chain reorganized
+				if blockTag != BlockTagPending {
+					prev, duplicate, ok := emitBlocksReorg(ctx, sourceCh, history, fetch, block)
+					if !ok {
+						return
+					}
+					if duplicate {
+						continue
+					}
+					prevBlock = prev
+				}
+
 				// Skip if same as previous
 				if prevBlock != nil {
 					if block.Number == prevBlock.Number {
@@ -204,6 +250,7 @@ func pollBlocks(
 								PrevBlock: prevBlock,
 							}:
 								prevBlock = missedBlock
+								history.push(missedBlock)
 							case <-ctx.Done():
 								return
 							}
@@ -217,12 +264,14 @@ func pollBlocks(
 					block.Number > prevBlock.Number
 
 				if shouldEmit {
+					InvalidateFeeCache(client)
 					select {
 					case sourceCh <- WatchBlocksEvent{
 						Block:     block,
 						PrevBlock: prevBlock,
 					}:
 						prevBlock = block
+						history.push(block)
 					case <-ctx.Done():
 						return
 					}
@@ -253,6 +302,8 @@ func subscribeBlocks(
 ) {
 	var prevBlock *types.Block
 	emitFetched := true
+	history := newBlockHistory(defaultReorgDepth)
+	fetch := watchBlocksFetcher(client, params)
 
 	// Emit on begin if requested
 	if params.EmitOnBegin {
@@ -273,6 +324,7 @@ func subscribeBlocks(
 				PrevBlock: nil,
 			}:
 				prevBlock = block
+				history.push(block)
 				emitFetched = false
 			case <-ctx.Done():
 				return
@@ -306,6 +358,9 @@ func subscribeBlocks(
 				return
 			}
 
+			// A new head makes cached fee data stale
+			InvalidateFeeCache(client)
+
 			// Fetch full block
 			block, err := GetBlock(ctx, client, GetBlockParameters{
 				BlockNumber:         &blockNumber,
@@ -322,6 +377,13 @@ func subscribeBlocks(
 				return
 			}
 
+			// Roll back to the common ancestor if the chain reorganized
+			prev, duplicate, ok := emitBlocksReorg(ctx, ch, history, fetch, block)
+			if !ok || duplicate {
+				return
+			}
+			prevBlock = prev
+
 			// Emit missed blocks if enabled
 			if params.EmitMissed && prevBlock != nil {
 				if block.Number-prevBlock.Number > 1 {
@@ -341,6 +403,7 @@ func subscribeBlocks(
 							PrevBlock: prevBlock,
 						}:
 							prevBlock = missedBlock
+							history.push(missedBlock)
 						case <-ctx.Done():
 							return
 						}
@@ -356,6 +419,7 @@ func subscribeBlocks(
 			}:
 				emitFetched = false
 				prevBlock = block
+				history.push(block)
 			case <-ctx.Done():
 			}
 		},
@@ -383,3 +447,58 @@ func subscribeBlocks(
 		_ = sub.Unsubscribe()
 	}
 }
+
+// watchBlocksFetcher returns a blockFetcher that loads blocks by number with
+// the watcher's transaction setting.
+func watchBlocksFetcher(client WatchClient, params WatchBlocksParameters) blockFetcher {
+	return func(ctx context.Context, number uint64) (*types.Block, error) {
+		return GetBlock(ctx, client, GetBlockParameters{
+			BlockNumber:         &number,
+			IncludeTransactions: params.IncludeTransactions,
+		})
+	}
+}
+
+// emitBlocksReorg reconciles block against the emitted history. On a reorg it
+// emits the orphaned blocks followed by their canonical replacements.
+//
+// It returns the block that block should be emitted after, whether block was
+// already emitted, and false if ctx was cancelled.
+func emitBlocksReorg(
+	ctx context.Context,
+	ch chan<- WatchBlocksEvent,
+	history *blockHistory,
+	fetch blockFetcher,
+	block *types.Block,
+) (*types.Block, bool, bool) {
+	orphaned, replacements, duplicate, err := history.reconcile(ctx, fetch, block)
+	if err != nil {
+		select {
+		case ch <- WatchBlocksEvent{Error: fmt.Errorf("failed to check for reorg: %w", err)}:
+		case <-ctx.Done():
+			return nil, false, false
+		}
+		return history.last(), false, true
+	}
+	if duplicate || len(orphaned) == 0 {
+		return history.last(), duplicate, true
+	}
+
+	select {
+	case ch <- WatchBlocksEvent{Reorg: orphaned}:
+	case <-ctx.Done():
+		return nil, false, false
+	}
+
+	prev := history.last()
+	for _, replacement := range replacements {
+		select {
+		case ch <- WatchBlocksEvent{Block: replacement, PrevBlock: prev}:
+			history.push(replacement)
+			prev = replacement
+		case <-ctx.Done():
+			return nil, false, false
+		}
+	}
+	return prev, false, true
+}
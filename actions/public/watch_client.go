@@ -243,3 +243,11 @@ func (e *WatchError) Error() string {
 func (e *WatchError) Unwrap() error {
 	return e.Cause
 }
+
+// Is reports whether target is a sentinel WatchError such as
+// ErrSubscriptionNotSupported with the same message, so a copy of the
+// sentinel carrying a Cause still matches it with errors.Is.
+func (e *WatchError) Is(target error) bool {
+	t, ok := target.(*WatchError)
+	return ok && t.Cause == nil && t.Message == e.Message
+}
@@ -2,7 +2,9 @@ package public
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	json "github.com/goccy/go-json"
@@ -32,15 +34,19 @@ type WatchContractEventParameters struct {
 	EventName string
 
 	// Args are indexed event arguments to filter by.
-	// Keys are parameter names, values are the expected values.
+	// Keys are parameter names, values are the expected values. Indexed
+	// string and bytes args are matched by the keccak256 hash of the value:
+	// pass the value to have it hashed, or the common.Hash itself.
 	Args map[string]any
 
 	// FromBlock is the block number to start watching from.
 	// If set, forces polling mode.
 	FromBlock *uint64
 
-	// Strict determines whether logs must match the event definition exactly.
-	// When true, logs with mismatched indexed/non-indexed arguments are skipped.
+	// Strict determines how logs that cannot be decoded with the ABI are
+	// handled. When false, they are left out of Logs and reported in
+	// DecodeErrors. When true, the first such log ends the stream with a
+	// *LogDecodeError in Error.
 	// Default: false
 	Strict bool
 
@@ -66,15 +72,39 @@ type WatchContractEventParameters struct {
 // WatchContractEventEvent represents an event from WatchContractEvent.
 type WatchContractEventEvent struct {
 	// Logs are the decoded event logs.
-	// Each log includes Args and EventName from ABI decoding.
+	// Each log includes Args and EventName from ABI decoding alongside the
+	// raw Topics and Data.
 	// When Batch is true, this may contain multiple logs.
 	// When Batch is false, this will contain a single log.
 	Logs []formatters.Log
 
-	// Error is any error that occurred.
+	// DecodeErrors are the logs that could not be decoded with the ABI.
+	// They are only reported when Strict is false.
+	DecodeErrors []*LogDecodeError
+
+	// Error is a transport or subscription error, or in strict mode the
+	// *LogDecodeError that ended the stream.
 	Error error
 }
 
+// LogDecodeError reports a log that could not be decoded with the watched
+// event's ABI. Log is the log as received from the node.
+type LogDecodeError struct {
+	Log   formatters.Log
+	Cause error
+}
+
+func (e *LogDecodeError) Error() string {
+	if e.Log.TransactionHash != nil && e.Log.LogIndex != nil {
+		return fmt.Sprintf("failed to decode log %d of transaction %s: %v", *e.Log.LogIndex, *e.Log.TransactionHash, e.Cause)
+	}
+	return fmt.Sprintf("failed to decode log: %v", e.Cause)
+}
+
+func (e *LogDecodeError) Unwrap() error {
+	return e.Cause
+}
+
 // contractEventObserver is the global observer for contract event subscriptions.
 var contractEventObserver = observe.New[WatchContractEventEvent]()
 
@@ -121,6 +151,9 @@ var contractEventObserver = observe.New[WatchContractEventEvent]()
 //	        log.Printf("error: %v", event.Error)
 //	        continue
 //	    }
+//	    for _, decodeErr := range event.DecodeErrors {
+//	        log.Printf("skipped log: %v", decodeErr)
+//	    }
 //	    for _, log := range event.Logs {
 //	        fmt.Printf("Transfer from %v to %v: %v\n",
 //	            log.Args["from"],
@@ -156,22 +189,43 @@ func WatchContractEvent(
 		defer close(ch)
 
 		if enablePolling {
-			pollContractEvent(ctx, client, params, batchMode, pollingInterval, ch)
-		} else {
-			subscribeContractEvent(ctx, client, params, batchMode, ch)
+			pollContractEvent(ctx, client, params, batchMode, pollingInterval, 0, ch)
+			return
+		}
+
+		// Fall back to polling if the subscription can't be established
+		// or fails mid-stream, rather than terminating the channel. Polling
+		// resumes after the last block the subscription delivered logs from.
+		// A strict-mode decode failure, already sent on ch, ends the stream instead.
+		cursor := &logCursor{}
+		err := subscribeContractEvent(ctx, client, params, batchMode, cursor, ch)
+		var decodeErr *LogDecodeError
+		if errors.As(err, &decodeErr) {
+			return
+		}
+		if err != nil && ctx.Err() == nil {
+			select {
+			case ch <- WatchContractEventEvent{Error: fmt.Errorf("subscription failed, falling back to polling: %w", err)}:
+			case <-ctx.Done():
+				return
+			}
+			pollContractEvent(ctx, client, params, batchMode, pollingInterval, cursor.lastBlock(), ch)
 		}
 	}()
 
 	return ch
 }
 
-// pollContractEvent implements contract event watching using polling.
+// pollContractEvent implements contract event watching using polling. A
+// non-zero resumeAfter is the last block already delivered; polling then
+// starts with the logs from the following blocks.
 func pollContractEvent(
 	ctx context.Context,
 	client WatchClient,
 	params WatchContractEventParameters,
 	batchMode bool,
 	interval time.Duration,
+	resumeAfter uint64,
 	ch chan<- WatchContractEventEvent,
 ) {
 	// Build topics from ABI
@@ -179,7 +233,7 @@ func pollContractEvent(
 	strict := params.Strict
 
 	// Create observer ID for deduplication
-	observerID := fmt.Sprintf("watchContractEvent.%s.%v.%v.%s.%v.%v.%v",
+	observerID := fmt.Sprintf("watchContractEvent.%s.%v.%v.%s.%v.%v.%v.%d",
 		client.UID(),
 		params.Address,
 		params.Args,
@@ -187,45 +241,66 @@ func pollContractEvent(
 		batchMode,
 		strict,
 		interval,
+		resumeAfter,
 	)
 
 	// Use observer for deduplication
 	eventCh := contractEventObserver.Subscribe(observerID, func() (<-chan WatchContractEventEvent, func()) {
 		sourceCh := make(chan WatchContractEventEvent, 100)
 
-		var filterID FilterID
+		// pollCtx stops polling when a strict-mode decode failure ends the stream.
+		pollCtx, stopPolling := context.WithCancel(ctx)
+
 		var previousBlockNumber uint64
 		var filterSupported = true
 		initialized := false
 
+		cursor := &logCursor{}
 		if params.FromBlock != nil {
 			previousBlockNumber = *params.FromBlock - 1
+			cursor.last = previousBlockNumber
+		}
+		if resumeAfter != 0 {
+			previousBlockNumber = resumeAfter
+			cursor.last = resumeAfter
 		}
 
+		filter := newWatchFilter(client, func(ctx context.Context) (FilterID, error) {
+			f, err := CreateEventFilter(ctx, client, CreateEventFilterParameters{
+				Address:      params.Address,
+				Topics:       topics,
+				FromBlock:    params.FromBlock,
+				FromBlockTag: BlockTagLatest,
+			})
+			if err != nil {
+				return "", err
+			}
+			return f.ID, nil
+		})
+
 		// Start polling
-		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]formatters.Log, error) {
+		pollResults := poll.Poll(pollCtx, func(ctx context.Context) ([]formatters.Log, error) {
 			// First iteration: create filter
 			if !initialized {
-				if filterSupported {
-					filter, err := CreateEventFilter(ctx, client, CreateEventFilterParameters{
-						Address:      params.Address,
-						Topics:       topics,
-						FromBlock:    params.FromBlock,
-						FromBlockTag: BlockTagLatest,
-					})
-					if err != nil {
-						filterSupported = false
-					} else {
-						filterID = filter.ID
-					}
-				}
 				initialized = true
+				if _, err := filter.ensure(ctx); err != nil {
+					filterSupported = false
+					return nil, nil
+				}
+				// The filter only reports new logs; fill in the blocks
+				// since the last delivered one when resuming.
+				if resumeAfter != 0 {
+					return backfillLogs(ctx, client, params.Address, topics, cursor)
+				}
+				if params.FromBlock == nil {
+					startLogCursor(ctx, client, cursor)
+				}
 				return nil, nil
 			}
 
 			// Subsequent iterations: get filter changes or use getLogs fallback
-			if filterSupported && filterID != "" {
-				return GetFilterChangesLogs(ctx, client, filterID)
+			if filterSupported {
+				return pollLogFilterChanges(ctx, filter, cursor, params.Address, topics)
 			}
 
 			// Fallback to getLogs
@@ -262,18 +337,11 @@ func pollContractEvent(
 		// Process poll results with ABI decoding
 		go func() {
 			defer close(sourceCh)
-			defer func() {
-				if filterID != "" {
-					_, _ = UninstallFilter(context.Background(), client, filterID)
-				}
-			}()
+			defer filter.uninstall()
+			defer stopPolling()
 
 			for result := range pollResults {
 				if result.Error != nil {
-					if filterID != "" && isInvalidInputError(result.Error) {
-						initialized = false
-						filterID = ""
-					}
 					select {
 					case sourceCh <- WatchContractEventEvent{Error: result.Error}:
 					case <-ctx.Done():
@@ -288,35 +356,27 @@ func pollContractEvent(
 				}
 
 				// Decode logs using ABI
-				decodedLogs := decodeContractEventLogs(logs, params.ABI, params.EventName, strict)
-				if len(decodedLogs) == 0 {
-					continue
-				}
+				decodedLogs, decodeErrors := decodeContractEventLogs(logs, params.ABI, params.EventName, strict)
 
 				// Emit logs
 				if batchMode {
-					select {
-					case sourceCh <- WatchContractEventEvent{Logs: decodedLogs}:
-					case <-ctx.Done():
+					if !sendContractEvent(ctx, sourceCh, decodedLogs, decodeErrors, strict) {
 						return
 					}
 				} else {
 					for _, log := range decodedLogs {
-						select {
-						case sourceCh <- WatchContractEventEvent{Logs: []formatters.Log{log}}:
-						case <-ctx.Done():
+						if !sendContractEvent(ctx, sourceCh, []formatters.Log{log}, nil, strict) {
 							return
 						}
 					}
+					if !sendContractEvent(ctx, sourceCh, nil, decodeErrors, strict) {
+						return
+					}
 				}
 			}
 		}()
 
-		return sourceCh, func() {
-			if filterID != "" {
-				_, _ = UninstallFilter(context.Background(), client, filterID)
-			}
-		}
+		return sourceCh, filter.uninstall
 	})
 
 	// Forward events to output channel
@@ -330,44 +390,26 @@ func pollContractEvent(
 }
 
 // subscribeContractEvent implements contract event watching using WebSocket subscription.
+// Delivered logs are recorded in cursor. It returns nil when ctx is
+// cancelled, or the subscription error otherwise.
 func subscribeContractEvent(
 	ctx context.Context,
 	client WatchClient,
 	params WatchContractEventParameters,
 	batchMode bool,
+	cursor *logCursor,
 	ch chan<- WatchContractEventEvent,
-) {
+) error {
 	// Build topics from ABI
 	topics := buildContractEventTopics(params.ABI, params.EventName, params.Args)
 
 	// Build address filter
-	var addressFilter any
-	if params.Address != nil {
-		switch addr := params.Address.(type) {
-		case common.Address:
-			addressFilter = addr.Hex()
-		case *common.Address:
-			if addr != nil {
-				addressFilter = addr.Hex()
-			}
-		case []common.Address:
-			addrs := make([]string, len(addr))
-			for i, a := range addr {
-				addrs[i] = a.Hex()
-			}
-			addressFilter = addrs
-		case string:
-			addressFilter = addr
-		case []string:
-			addressFilter = addr
-		}
-	}
+	addressFilter := formatLogAddress(params.Address)
 
 	if batchMode {
-		subscribeContractEventBatched(ctx, client, addressFilter, topics, params, ch)
-	} else {
-		subscribeContractEventDirect(ctx, client, addressFilter, topics, params, ch)
+		return subscribeContractEventBatched(ctx, client, addressFilter, topics, params, cursor, ch)
 	}
+	return subscribeContractEventDirect(ctx, client, addressFilter, topics, params, cursor, ch)
 }
 
 // subscribeContractEventBatched subscribes with batching enabled.
@@ -377,8 +419,9 @@ func subscribeContractEventBatched(
 	addressFilter any,
 	topics []any,
 	params WatchContractEventParameters,
+	cursor *logCursor,
 	ch chan<- WatchContractEventEvent,
-) {
+) error {
 	// Create a channel for individual logs
 	logCh := make(chan formatters.Log, 1000)
 
@@ -389,56 +432,81 @@ func subscribeContractEventBatched(
 	})
 	batches := collector.Collect(ctx, logCh)
 
-	// Forward batches to output channel
+	// Subscription errors and strict-mode decode failures end the subscription
+	subErr := make(chan error, 1)
+
+	// Decode and forward batches to output channel
+	forwarded := make(chan struct{})
 	go func() {
+		defer close(forwarded)
 		for batch := range batches {
-			if len(batch) > 0 {
+			decodedLogs, decodeErrors := decodeContractEventLogs(batch, params.ABI, params.EventName, params.Strict)
+			if !sendContractEvent(ctx, ch, decodedLogs, decodeErrors, params.Strict) {
 				select {
-				case ch <- WatchContractEventEvent{Logs: batch}:
-				case <-ctx.Done():
-					return
+				case subErr <- decodeErrors[0]:
+				default:
 				}
+				// Drain so the collector can finish once logCh is closed
+				for range batches {
+				}
+				return
 			}
+			cursor.observe(batch)
 		}
 	}()
 
 	// Subscribe to logs
+	var logMu sync.Mutex
+	logClosed := false
 	sub, err := client.Subscribe(
 		transport.LogsSubscribeParams(addressFilter, topics),
 		func(data json.RawMessage) {
-			log := parseContractLogFromSubscription(data, params)
-			if log != nil {
-				select {
-				case logCh <- *log:
-				case <-ctx.Done():
-				}
+			log := parseLogFromSubscription(data)
+			if log == nil {
+				return
+			}
+			logMu.Lock()
+			defer logMu.Unlock()
+			if logClosed {
+				return
+			}
+			select {
+			case logCh <- *log:
+			case <-ctx.Done():
 			}
 		},
 		func(err error) {
 			select {
-			case ch <- WatchContractEventEvent{Error: err}:
-			case <-ctx.Done():
+			case subErr <- err:
+			default:
 			}
 		},
 	)
 
-	if err != nil {
-		close(logCh)
+	if err == nil {
+		// Wait for cancellation or a subscription failure
 		select {
-		case ch <- WatchContractEventEvent{Error: fmt.Errorf("failed to subscribe: %w", err)}:
 		case <-ctx.Done():
+		case err = <-subErr:
+		}
+		if sub != nil {
+			_ = sub.Unsubscribe()
 		}
-		return
+	} else {
+		err = fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	// Wait for context cancellation
-	<-ctx.Done()
-
-	// Cleanup
+	// Cleanup: flush pending batches before handing the channel back
+	logMu.Lock()
+	logClosed = true
 	close(logCh)
-	if sub != nil {
-		_ = sub.Unsubscribe()
+	logMu.Unlock()
+	<-forwarded
+
+	if ctx.Err() != nil {
+		return nil
 	}
+	return err
 }
 
 // subscribeContractEventDirect subscribes without batching.
@@ -448,68 +516,97 @@ func subscribeContractEventDirect(
 	addressFilter any,
 	topics []any,
 	params WatchContractEventParameters,
+	cursor *logCursor,
 	ch chan<- WatchContractEventEvent,
-) {
+) error {
 	// Subscribe to logs
+	subErr := make(chan error, 1)
+	var mu sync.Mutex
+	stopped := false
 	sub, err := client.Subscribe(
 		transport.LogsSubscribeParams(addressFilter, topics),
 		func(data json.RawMessage) {
-			log := parseContractLogFromSubscription(data, params)
-			if log != nil {
-				select {
-				case ch <- WatchContractEventEvent{Logs: []formatters.Log{*log}}:
-				case <-ctx.Done():
+			log := parseLogFromSubscription(data)
+			if log == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if stopped {
+				return
+			}
+			decodedLogs, decodeErrors := decodeContractEventLogs([]formatters.Log{*log}, params.ABI, params.EventName, params.Strict)
+			if !sendContractEvent(ctx, ch, decodedLogs, decodeErrors, params.Strict) {
+				if ctx.Err() == nil {
+					stopped = true
+					select {
+					case subErr <- decodeErrors[0]:
+					default:
+					}
 				}
+				return
 			}
+			cursor.observe([]formatters.Log{*log})
 		},
 		func(err error) {
 			select {
-			case ch <- WatchContractEventEvent{Error: err}:
-			case <-ctx.Done():
+			case subErr <- err:
+			default:
 			}
 		},
 	)
-
 	if err != nil {
-		select {
-		case ch <- WatchContractEventEvent{Error: fmt.Errorf("failed to subscribe: %w", err)}:
-		case <-ctx.Done():
-		}
-		return
+		return fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	// Wait for context cancellation
-	<-ctx.Done()
+	// Wait for cancellation or a subscription failure
+	select {
+	case <-ctx.Done():
+		err = nil
+	case err = <-subErr:
+	}
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
 
 	// Unsubscribe
 	if sub != nil {
 		_ = sub.Unsubscribe()
 	}
+	return err
 }
 
-// parseContractLogFromSubscription parses and decodes a log from a subscription notification.
-func parseContractLogFromSubscription(data json.RawMessage, params WatchContractEventParameters) *formatters.Log {
-	var rpcLog formatters.RpcLog
-	if err := json.Unmarshal(data, &rpcLog); err != nil {
-		return nil
+// sendContractEvent sends the decoded logs and, outside strict mode, the
+// decode errors as one event. In strict mode a decode error is sent as a
+// separate Error event after the logs. It reports false when the stream
+// should end, either because ctx is done or because of a strict-mode decode
+// error.
+func sendContractEvent(
+	ctx context.Context,
+	ch chan<- WatchContractEventEvent,
+	logs []formatters.Log,
+	decodeErrors []*LogDecodeError,
+	strict bool,
+) bool {
+	event := WatchContractEventEvent{Logs: logs}
+	if !strict {
+		event.DecodeErrors = decodeErrors
 	}
-
-	log := formatters.FormatLog(rpcLog, nil)
-
-	// Decode using ABI
-	if params.ABI != nil {
-		decodedLogs := decodeContractEventLogs([]formatters.Log{log}, params.ABI, params.EventName, params.Strict)
-		if len(decodedLogs) > 0 {
-			return &decodedLogs[0]
-		}
-		// In non-strict mode, return the log anyway
-		if !params.Strict {
-			return &log
+	if len(event.Logs) > 0 || len(event.DecodeErrors) > 0 {
+		select {
+		case ch <- event:
+		case <-ctx.Done():
+			return false
 		}
-		return nil
 	}
-
-	return &log
+	if !strict || len(decodeErrors) == 0 {
+		return true
+	}
+	select {
+	case ch <- WatchContractEventEvent{Error: decodeErrors[0]}:
+	case <-ctx.Done():
+	}
+	return false
 }
 
 // buildContractEventTopics builds topic filters from an ABI event.
@@ -542,7 +639,7 @@ func buildContractEventTopics(abi *viemabi.ABI, eventName string, args map[strin
 		for _, input := range event.Inputs {
 			if input.Indexed {
 				if argValue, ok := args[input.Name]; ok {
-					topics = append(topics, encodeFilterTopic(argValue))
+					topics = append(topics, encodeArgFilterTopic(input, argValue))
 				} else {
 					topics = append(topics, nil) // Match any
 				}
@@ -553,69 +650,86 @@ func buildContractEventTopics(abi *viemabi.ABI, eventName string, args map[strin
 	return topics
 }
 
-// decodeContractEventLogs decodes event logs using an ABI.
-func decodeContractEventLogs(logs []formatters.Log, abi *viemabi.ABI, eventName string, strict bool) []formatters.Log {
+// decodeContractEventLogs decodes event logs using an ABI. Logs that cannot
+// be decoded are returned as decode errors instead. In strict mode decoding
+// stops at the first failure.
+func decodeContractEventLogs(logs []formatters.Log, abi *viemabi.ABI, eventName string, strict bool) ([]formatters.Log, []*LogDecodeError) {
 	if abi == nil {
-		return logs
+		return logs, nil
 	}
 
-	// Find the event in the ABI
 	var event *viemabi.Event
-	for _, e := range abi.Events {
-		if eventName == "" || e.Name == eventName {
-			eventCopy := e
-			event = &eventCopy
-			break
-		}
+	if eventName != "" {
+		event, _ = abi.GetEvent(eventName)
 	}
 
-	if event == nil {
-		if strict {
-			return nil
-		}
-		return logs
-	}
-
-	var decodedLogs []formatters.Log
+	var (
+		decodedLogs  []formatters.Log
+		decodeErrors []*LogDecodeError
+	)
 	for _, log := range logs {
-		// Verify topic matches event signature
-		if len(log.Topics) == 0 {
-			if !strict {
-				decodedLogs = append(decodedLogs, log)
-			}
-			continue
-		}
-
-		// Check if first topic matches event signature
-		if log.Topics[0] != event.Topic.Hex() {
-			if !strict {
-				decodedLogs = append(decodedLogs, log)
-			}
-			continue
-		}
-
-		// Try to decode the event
-		rawTopics := make([]common.Hash, len(log.Topics))
-		for i, t := range log.Topics {
-			rawTopics[i] = common.HexToHash(t)
-		}
-
-		decoded, err := abi.DecodeEventLogByName(eventName, rawTopics, common.FromHex(log.Data))
+		decoded, err := decodeContractEventLog(log, abi, eventName, event)
 		if err != nil {
+			decodeErrors = append(decodeErrors, &LogDecodeError{Log: log, Cause: err})
 			if strict {
-				continue
+				break
 			}
-			// Add without decoding in non-strict mode
-			log.EventName = eventName
-			decodedLogs = append(decodedLogs, log)
 			continue
 		}
 
 		// Add decoded args to log
 		log.EventName = decoded.EventName
 		log.Args = decoded.Args
+		log.HashedArgs = decoded.HashedArgs
 		decodedLogs = append(decodedLogs, log)
 	}
 
-	return decodedLogs
+	return decodedLogs, decodeErrors
+}
+
+// decodeContractEventLog decodes a single log as eventName, or as any event
+// in the ABI when eventName is empty. event is eventName's ABI entry, if found.
+// Logs whose topics or data do not fit the event (e.g. an ERC-721 Transfer
+// decoded with an ERC-20 ABI) are rejected rather than partially decoded.
+func decodeContractEventLog(log formatters.Log, abi *viemabi.ABI, eventName string, event *viemabi.Event) (*viemabi.DecodedEventLog, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("log has no topics")
+	}
+
+	rawTopics := make([]common.Hash, len(log.Topics))
+	for i, t := range log.Topics {
+		rawTopics[i] = common.HexToHash(t)
+	}
+	data := common.FromHex(log.Data)
+
+	if eventName == "" {
+		var err error
+		if event, err = abi.GetEventByTopic(rawTopics[0]); err != nil {
+			return nil, err
+		}
+	} else if event == nil {
+		return nil, fmt.Errorf("event %q not found on ABI", eventName)
+	} else if rawTopics[0] != event.Topic {
+		return nil, fmt.Errorf("log topic %s does not match event %q", rawTopics[0].Hex(), eventName)
+	}
+
+	indexed, nonIndexed := 0, 0
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed++
+		} else {
+			nonIndexed++
+		}
+	}
+	if !event.Anonymous {
+		indexed++
+	}
+	if len(rawTopics) != indexed {
+		return nil, fmt.Errorf("event %q expects %d topics, log has %d", event.Name, indexed, len(rawTopics))
+	}
+	if nonIndexed > 0 && len(data) == 0 {
+		return nil, fmt.Errorf("event %q expects data, log has none", event.Name)
+	}
+
+	return abi.DecodeEventLogByName(event.Name, rawTopics, data)
 }
@@ -3,15 +3,16 @@ package public
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"strings"
 	"sync"
 	"time"
 
 	json "github.com/goccy/go-json"
 
-	"github.com/ethereum/go-ethereum/common"
-
 	viemabi "github.com/ChefBingbong/viem-go/abi"
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils/batch"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 	"github.com/ChefBingbong/viem-go/utils/observe"
@@ -34,7 +35,9 @@ type WatchEventParameters struct {
 	Events []*viemabi.Event
 
 	// Args are indexed event arguments to filter by.
-	// Keys are parameter names, values are the expected values.
+	// Keys are parameter names, values are the expected values. Indexed
+	// string and bytes args are matched by the keccak256 hash of the value:
+	// pass the value to have it hashed, or the common.Hash itself.
 	Args map[string]any
 
 	// FromBlock is the block number to start watching from.
@@ -96,7 +99,8 @@ var eventObserver = observe.New[WatchEventEvent]()
 //   - Calls eth_newFilter to create a filter
 //   - Calls eth_getFilterChanges on a polling interval
 //   - When polling without filter support:
-//   - Calls eth_getLogs for each block range
+//   - Calls eth_getBlockByNumber and eth_getLogs for each block range
+//   - On a reorg, logs from orphaned blocks are re-emitted with Removed set
 //   - When subscribing: uses eth_subscribe with "logs" event
 //
 // Example:
@@ -147,86 +151,149 @@ func WatchEvent(
 		defer close(ch)
 
 		if enablePolling {
-			pollEvent(ctx, client, params, batchMode, pollingInterval, ch)
-		} else {
-			subscribeEvent(ctx, client, params, batchMode, ch)
+			pollEvent(ctx, client, params, batchMode, pollingInterval, 0, ch)
+			return
+		}
+
+		// Fall back to polling if the subscription can't be established
+		// or fails mid-stream, rather than terminating the channel. Polling
+		// resumes after the last block the subscription delivered logs from.
+		cursor := &logCursor{}
+		if err := subscribeEvent(ctx, client, params, batchMode, cursor, ch); err != nil && ctx.Err() == nil {
+			select {
+			case ch <- WatchEventEvent{Error: fmt.Errorf("subscription failed, falling back to polling: %w", err)}:
+			case <-ctx.Done():
+				return
+			}
+			pollEvent(ctx, client, params, batchMode, pollingInterval, cursor.lastBlock(), ch)
 		}
 	}()
 
 	return ch
 }
 
-// pollEvent implements event watching using polling.
+// pollEvent implements event watching using polling. A non-zero resumeAfter
+// is the last block already delivered; polling then starts with the logs
+// from the following blocks.
 func pollEvent(
 	ctx context.Context,
 	client WatchClient,
 	params WatchEventParameters,
 	batchMode bool,
 	interval time.Duration,
+	resumeAfter uint64,
 	ch chan<- WatchEventEvent,
 ) {
 	// Build topics from event definitions
 	topics := buildEventTopics(params.Event, params.Events, params.Args)
 
 	// Create observer ID for deduplication
-	observerID := fmt.Sprintf("watchEvent.%s.%v.%v.%v.%v.%v",
+	observerID := fmt.Sprintf("watchEvent.%s.%v.%v.%v.%v.%v.%d",
 		client.UID(),
 		params.Address,
 		params.Args,
 		batchMode,
 		params.FromBlock,
 		interval,
+		resumeAfter,
 	)
 
 	// Use observer for deduplication
 	eventCh := eventObserver.Subscribe(observerID, func() (<-chan WatchEventEvent, func()) {
 		sourceCh := make(chan WatchEventEvent, 100)
 
-		var filterID FilterID
 		var previousBlockNumber uint64
 		var filterSupported = true
 		initialized := false
 
+		// Track scanned blocks and emitted logs so that logs from orphaned
+		// blocks can be re-emitted as removed when the getLogs fallback is
+		// used (filters and subscriptions report removed logs themselves).
+		history := newBlockHistory(defaultReorgDepth)
+		var recentLogs, removedLogs []formatters.Log
+
+		cursor := &logCursor{}
 		if params.FromBlock != nil {
 			previousBlockNumber = *params.FromBlock - 1
+			cursor.last = previousBlockNumber
+		}
+		if resumeAfter != 0 {
+			previousBlockNumber = resumeAfter
+			cursor.last = resumeAfter
 		}
 
+		filter := newWatchFilter(client, func(ctx context.Context) (FilterID, error) {
+			f, err := CreateEventFilter(ctx, client, CreateEventFilterParameters{
+				Address:      params.Address,
+				Topics:       topics,
+				FromBlock:    params.FromBlock,
+				FromBlockTag: BlockTagLatest,
+			})
+			if err != nil {
+				return "", err
+			}
+			return f.ID, nil
+		})
+
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]formatters.Log, error) {
 			// First iteration: create filter
 			if !initialized {
-				if filterSupported {
-					filter, err := CreateEventFilter(ctx, client, CreateEventFilterParameters{
-						Address:      params.Address,
-						Topics:       topics,
-						FromBlock:    params.FromBlock,
-						FromBlockTag: BlockTagLatest,
-					})
-					if err != nil {
-						// Filter creation failed - fall back to getLogs
-						filterSupported = false
-					} else {
-						filterID = filter.ID
-					}
-				}
 				initialized = true
+				if _, err := filter.ensure(ctx); err != nil {
+					// Filter creation failed - fall back to getLogs
+					filterSupported = false
+					return nil, nil
+				}
+				// The filter only reports new logs; fill in the blocks
+				// since the last delivered one when resuming.
+				if resumeAfter != 0 {
+					return backfillLogs(ctx, client, params.Address, topics, cursor)
+				}
+				if params.FromBlock == nil {
+					startLogCursor(ctx, client, cursor)
+				}
 				return nil, nil
 			}
 
 			// Subsequent iterations: get filter changes or use getLogs fallback
-			if filterSupported && filterID != "" {
-				return GetFilterChangesLogs(ctx, client, filterID)
+			if filterSupported {
+				return pollLogFilterChanges(ctx, filter, cursor, params.Address, topics)
 			}
 
 			// Fallback to getLogs
-			blockNumber, err := GetBlockNumber(ctx, client, GetBlockNumberParameters{})
+			block, err := GetBlock(ctx, client, GetBlockParameters{BlockTag: BlockTagLatest})
 			if err != nil {
 				return nil, err
 			}
+			blockNumber := block.Number
 
-			// Skip if no new blocks
-			if previousBlockNumber != 0 && previousBlockNumber == blockNumber {
-				return nil, nil
+			orphaned, _, duplicate, err := history.reconcile(ctx, func(ctx context.Context, number uint64) (*types.Block, error) {
+				return GetBlock(ctx, client, GetBlockParameters{BlockNumber: &number})
+			}, block)
+			if err != nil {
+				return nil, err
+			}
+			if !duplicate {
+				history.push(block)
+			}
+
+			// Queue logs from orphaned blocks as removed and rescan from
+			// the common ancestor
+			if len(orphaned) > 0 {
+				var removed []formatters.Log
+				removed, recentLogs = removeOrphanedLogs(recentLogs, orphaned)
+				removedLogs = append(removedLogs, removed...)
+				if orphaned[0].Number > 0 && orphaned[0].Number-1 < previousBlockNumber {
+					previousBlockNumber = orphaned[0].Number - 1
+				}
+			}
+
+			// Skip if no new blocks (or the node returned an older head)
+			if previousBlockNumber != 0 && blockNumber <= previousBlockNumber {
+				removed := removedLogs
+				removedLogs = nil
+				return removed, nil
 			}
 
 			// Get logs for the new blocks
@@ -244,8 +311,14 @@ func pollEvent(
 				ToBlock:   &blockNumber,
 			})
 
+			if err != nil {
+				return nil, err
+			}
 			previousBlockNumber = blockNumber
-			return logs, err
+			recentLogs = trimRecentLogs(append(recentLogs, logs...), blockNumber)
+			removed := removedLogs
+			removedLogs = nil
+			return append(removed, logs...), nil
 		}, poll.Options{
 			Interval:    interval,
 			EmitOnBegin: true,
@@ -254,21 +327,10 @@ func pollEvent(
 		// Process poll results
 		go func() {
 			defer close(sourceCh)
-			defer func() {
-				// Cleanup: uninstall filter
-				if filterID != "" {
-					_, _ = UninstallFilter(context.Background(), client, filterID)
-				}
-			}()
+			defer filter.uninstall()
 
 			for result := range pollResults {
 				if result.Error != nil {
-					// Check if filter was invalidated
-					if filterID != "" && isInvalidInputError(result.Error) {
-						// Reinitialize filter
-						initialized = false
-						filterID = ""
-					}
 					select {
 					case sourceCh <- WatchEventEvent{Error: result.Error}:
 					case <-ctx.Done():
@@ -302,11 +364,7 @@ func pollEvent(
 			}
 		}()
 
-		return sourceCh, func() {
-			if filterID != "" {
-				_, _ = UninstallFilter(context.Background(), client, filterID)
-			}
-		}
+		return sourceCh, filter.uninstall
 	})
 
 	// Forward events to output channel
@@ -320,44 +378,26 @@ func pollEvent(
 }
 
 // subscribeEvent implements event watching using WebSocket subscription.
+// Delivered logs are recorded in cursor. It returns nil when ctx is
+// cancelled, or the subscription error otherwise.
 func subscribeEvent(
 	ctx context.Context,
 	client WatchClient,
 	params WatchEventParameters,
 	batchMode bool,
+	cursor *logCursor,
 	ch chan<- WatchEventEvent,
-) {
+) error {
 	// Build topics from event definitions
 	topics := buildEventTopics(params.Event, params.Events, params.Args)
 
 	// Build address filter
-	var addressFilter any
-	if params.Address != nil {
-		switch addr := params.Address.(type) {
-		case common.Address:
-			addressFilter = addr.Hex()
-		case *common.Address:
-			if addr != nil {
-				addressFilter = addr.Hex()
-			}
-		case []common.Address:
-			addrs := make([]string, len(addr))
-			for i, a := range addr {
-				addrs[i] = a.Hex()
-			}
-			addressFilter = addrs
-		case string:
-			addressFilter = addr
-		case []string:
-			addressFilter = addr
-		}
-	}
+	addressFilter := formatLogAddress(params.Address)
 
 	if batchMode {
-		subscribeEventBatched(ctx, client, addressFilter, topics, params, ch)
-	} else {
-		subscribeEventDirect(ctx, client, addressFilter, topics, params, ch)
+		return subscribeEventBatched(ctx, client, addressFilter, topics, cursor, ch)
 	}
+	return subscribeEventDirect(ctx, client, addressFilter, topics, cursor, ch)
 }
 
 // subscribeEventBatched subscribes with batching enabled.
@@ -366,9 +406,9 @@ func subscribeEventBatched(
 	client WatchClient,
 	addressFilter any,
 	topics []any,
-	params WatchEventParameters,
+	cursor *logCursor,
 	ch chan<- WatchEventEvent,
-) {
+) error {
 	// Create a channel for individual logs
 	logCh := make(chan formatters.Log, 1000)
 
@@ -380,11 +420,14 @@ func subscribeEventBatched(
 	batches := collector.Collect(ctx, logCh)
 
 	// Forward batches to output channel
+	forwarded := make(chan struct{})
 	go func() {
+		defer close(forwarded)
 		for batch := range batches {
 			if len(batch) > 0 {
 				select {
 				case ch <- WatchEventEvent{Logs: batch}:
+					cursor.observe(batch)
 				case <-ctx.Done():
 					return
 				}
@@ -393,42 +436,58 @@ func subscribeEventBatched(
 	}()
 
 	// Subscribe to logs
+	subErr := make(chan error, 1)
+	var logMu sync.Mutex
+	logClosed := false
 	sub, err := client.Subscribe(
 		transport.LogsSubscribeParams(addressFilter, topics),
 		func(data json.RawMessage) {
-			log := parseLogFromSubscription(data, params)
-			if log != nil {
-				select {
-				case logCh <- *log:
-				case <-ctx.Done():
-				}
+			log := parseLogFromSubscription(data)
+			if log == nil {
+				return
+			}
+			logMu.Lock()
+			defer logMu.Unlock()
+			if logClosed {
+				return
+			}
+			select {
+			case logCh <- *log:
+			case <-ctx.Done():
 			}
 		},
 		func(err error) {
 			select {
-			case ch <- WatchEventEvent{Error: err}:
-			case <-ctx.Done():
+			case subErr <- err:
+			default:
 			}
 		},
 	)
 
-	if err != nil {
-		close(logCh)
+	if err == nil {
+		// Wait for cancellation or a subscription failure
 		select {
-		case ch <- WatchEventEvent{Error: fmt.Errorf("failed to subscribe: %w", err)}:
 		case <-ctx.Done():
+		case err = <-subErr:
+		}
+		if sub != nil {
+			_ = sub.Unsubscribe()
 		}
-		return
+	} else {
+		err = fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	// Wait for context cancellation
-	<-ctx.Done()
-
-	// Cleanup
+	// Cleanup: flush pending batches before handing the channel back
+	logMu.Lock()
+	logClosed = true
 	close(logCh)
-	if sub != nil {
-		_ = sub.Unsubscribe()
+	logMu.Unlock()
+	<-forwarded
+
+	if ctx.Err() != nil {
+		return nil
 	}
+	return err
 }
 
 // subscribeEventDirect subscribes without batching.
@@ -437,48 +496,61 @@ func subscribeEventDirect(
 	client WatchClient,
 	addressFilter any,
 	topics []any,
-	params WatchEventParameters,
+	cursor *logCursor,
 	ch chan<- WatchEventEvent,
-) {
+) error {
 	// Subscribe to logs
+	subErr := make(chan error, 1)
+	var mu sync.Mutex
+	stopped := false
 	sub, err := client.Subscribe(
 		transport.LogsSubscribeParams(addressFilter, topics),
 		func(data json.RawMessage) {
-			log := parseLogFromSubscription(data, params)
-			if log != nil {
-				select {
-				case ch <- WatchEventEvent{Logs: []formatters.Log{*log}}:
-				case <-ctx.Done():
-				}
+			log := parseLogFromSubscription(data)
+			if log == nil {
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if stopped {
+				return
+			}
+			select {
+			case ch <- WatchEventEvent{Logs: []formatters.Log{*log}}:
+				cursor.observe([]formatters.Log{*log})
+			case <-ctx.Done():
 			}
 		},
 		func(err error) {
 			select {
-			case ch <- WatchEventEvent{Error: err}:
-			case <-ctx.Done():
+			case subErr <- err:
+			default:
 			}
 		},
 	)
-
 	if err != nil {
-		select {
-		case ch <- WatchEventEvent{Error: fmt.Errorf("failed to subscribe: %w", err)}:
-		case <-ctx.Done():
-		}
-		return
+		return fmt.Errorf("failed to subscribe: %w", err)
 	}
 
-	// Wait for context cancellation
-	<-ctx.Done()
+	// Wait for cancellation or a subscription failure
+	select {
+	case <-ctx.Done():
+		err = nil
+	case err = <-subErr:
+	}
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
 
 	// Unsubscribe
 	if sub != nil {
 		_ = sub.Unsubscribe()
 	}
+	return err
 }
 
 // parseLogFromSubscription parses a log from a subscription notification.
-func parseLogFromSubscription(data json.RawMessage, params WatchEventParameters) *formatters.Log {
+func parseLogFromSubscription(data json.RawMessage) *formatters.Log {
 	var rpcLog formatters.RpcLog
 	if err := json.Unmarshal(data, &rpcLog); err != nil {
 		return nil
@@ -524,7 +596,7 @@ func buildEventTopics(event *viemabi.Event, events []*viemabi.Event, args map[st
 		for _, input := range e.Inputs {
 			if input.Indexed {
 				if argValue, ok := args[input.Name]; ok {
-					topics = append(topics, encodeFilterTopic(argValue))
+					topics = append(topics, encodeArgFilterTopic(input, argValue))
 				} else {
 					topics = append(topics, nil) // Match any
 				}
@@ -645,3 +717,37 @@ func processEventLog(log formatters.Log, abi *viemabi.ABI) formatters.Log {
 	// This is a simplified version - full implementation would use ABI decoding
 	return log
 }
+
+// removeOrphanedLogs splits recent logs into those emitted from orphaned
+// blocks, returned as copies with Removed set, and those still canonical.
+func removeOrphanedLogs(recent []formatters.Log, orphaned []*types.Block) (removed, kept []formatters.Log) {
+	hashes := make(map[string]struct{}, len(orphaned))
+	for _, block := range orphaned {
+		hashes[strings.ToLower(block.Hash.Hex())] = struct{}{}
+	}
+
+	for _, log := range recent {
+		if log.BlockHash != nil {
+			if _, ok := hashes[strings.ToLower(*log.BlockHash)]; ok {
+				log.Removed = true
+				removed = append(removed, log)
+				continue
+			}
+		}
+		kept = append(kept, log)
+	}
+	return removed, kept
+}
+
+// trimRecentLogs drops logs older than the reorg tracking depth.
+func trimRecentLogs(recent []formatters.Log, head uint64) []formatters.Log {
+	if head < defaultReorgDepth {
+		return recent
+	}
+	oldest := new(big.Int).SetUint64(head - defaultReorgDepth)
+	i := 0
+	for i < len(recent) && recent[i].BlockNumber != nil && recent[i].BlockNumber.Cmp(oldest) < 0 {
+		i++
+	}
+	return recent[i:]
+}
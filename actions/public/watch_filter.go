@@ -0,0 +1,239 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+)
+
+var (
+	// errWatchFilterClosed is returned when a watcher polls after its filter was uninstalled.
+	errWatchFilterClosed = errors.New("watch filter has been uninstalled")
+
+	// errEmptyFilterID is returned when the node accepts a filter without returning an ID.
+	errEmptyFilterID = errors.New("node returned an empty filter id")
+)
+
+// watchFilter manages the node-side filter behind a polling watcher.
+//
+// The filter is created lazily, recreated when the node reports it as expired
+// (e.g. "filter not found"), and uninstalled exactly once when the watcher stops.
+type watchFilter struct {
+	client Client
+	create func(ctx context.Context) (FilterID, error)
+
+	mu     sync.Mutex
+	id     FilterID
+	closed bool
+}
+
+func newWatchFilter(client Client, create func(ctx context.Context) (FilterID, error)) *watchFilter {
+	return &watchFilter{client: client, create: create}
+}
+
+// ensure returns the current filter ID, installing the filter if needed.
+func (f *watchFilter) ensure(ctx context.Context) (FilterID, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return "", errWatchFilterClosed
+	}
+	if f.id != "" {
+		return f.id, nil
+	}
+
+	id, err := f.create(ctx)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", errEmptyFilterID
+	}
+	f.id = id
+	return id, nil
+}
+
+// recreate discards an expired filter ID and installs a new filter.
+func (f *watchFilter) recreate(ctx context.Context, expired FilterID) (FilterID, error) {
+	f.mu.Lock()
+	if f.id == expired {
+		f.id = ""
+	}
+	f.mu.Unlock()
+
+	return f.ensure(ctx)
+}
+
+// uninstall removes the filter from the node. It is safe to call more than once.
+func (f *watchFilter) uninstall() {
+	f.mu.Lock()
+	id := f.id
+	f.id = ""
+	f.closed = true
+	f.mu.Unlock()
+
+	if id != "" {
+		_, _ = UninstallFilter(context.Background(), f.client, id)
+	}
+}
+
+// pollFilterChanges fetches changes for the watcher's filter, transparently
+// recreating the filter once if the node no longer recognizes it.
+func pollFilterChanges[T any](
+	ctx context.Context,
+	filter *watchFilter,
+	get func(ctx context.Context, client Client, id FilterID) (T, error),
+) (T, error) {
+	var zero T
+
+	id, err := filter.ensure(ctx)
+	if err != nil {
+		return zero, err
+	}
+
+	changes, err := get(ctx, filter.client, id)
+	if err == nil || !isInvalidInputError(err) {
+		return changes, err
+	}
+
+	// The filter expired on the node; the fresh filter has no changes yet.
+	if _, err := filter.recreate(ctx, id); err != nil {
+		return zero, err
+	}
+	return zero, nil
+}
+
+// pollLogFilterChanges fetches new logs for the watcher's filter. If the node
+// no longer recognizes the filter, it installs a new one and backfills the
+// blocks after the last delivered one with eth_getLogs, so the logs emitted
+// while the filter was gone are not lost.
+func pollLogFilterChanges(
+	ctx context.Context,
+	filter *watchFilter,
+	cursor *logCursor,
+	address any,
+	topics []any,
+) ([]formatters.Log, error) {
+	id, err := filter.ensure(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	logs, err := GetFilterChangesLogs(ctx, filter.client, id)
+	if err == nil {
+		logs = cursor.dedupe(logs)
+		cursor.observe(logs)
+		return logs, nil
+	}
+	if !isInvalidInputError(err) {
+		return nil, err
+	}
+
+	if _, err := filter.recreate(ctx, id); err != nil {
+		return nil, err
+	}
+	return backfillLogs(ctx, filter.client, address, topics, cursor)
+}
+
+// startLogCursor marks the blocks up to the current head as seen when a
+// watcher starts at the latest block, so a backfill after the filter expires
+// has a starting point even if no log was delivered yet. Errors are ignored;
+// the cursor then starts at the first delivered log.
+func startLogCursor(ctx context.Context, client Client, cursor *logCursor) {
+	head, err := GetBlockNumber(ctx, client, GetBlockNumberParameters{})
+	if err != nil {
+		return
+	}
+	cursor.mu.Lock()
+	cursor.last = max(cursor.last, head)
+	cursor.mu.Unlock()
+}
+
+// logCursor records the newest block a log watcher has delivered logs from,
+// so that a gap in delivery (a dropped subscription or an expired filter) can
+// be backfilled with eth_getLogs.
+type logCursor struct {
+	mu sync.Mutex
+	// last is the newest block whose logs have been delivered, or 0 if
+	// nothing has been delivered yet.
+	last uint64
+	// filled is the head of the latest backfill. Filter changes up to it were
+	// already delivered by the backfill.
+	filled uint64
+}
+
+// observe records the blocks of delivered logs.
+func (c *logCursor) observe(logs []formatters.Log) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, log := range logs {
+		if log.BlockNumber != nil && !log.Removed && log.BlockNumber.Uint64() > c.last {
+			c.last = log.BlockNumber.Uint64()
+		}
+	}
+}
+
+// lastBlock returns the newest block whose logs have been delivered, or 0.
+func (c *logCursor) lastBlock() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.last
+}
+
+// dedupe drops logs that a backfill already delivered. Removed logs are kept,
+// since a reorg can retract a backfilled log.
+func (c *logCursor) dedupe(logs []formatters.Log) []formatters.Log {
+	c.mu.Lock()
+	filled := c.filled
+	c.mu.Unlock()
+	if filled == 0 {
+		return logs
+	}
+
+	kept := logs[:0:0]
+	for _, log := range logs {
+		if !log.Removed && log.BlockNumber != nil && log.BlockNumber.Uint64() <= filled {
+			continue
+		}
+		kept = append(kept, log)
+	}
+	return kept
+}
+
+// backfillLogs fetches the logs from the block after the cursor's last
+// delivered block up to the current head. It does nothing if the cursor has
+// not delivered anything yet.
+func backfillLogs(ctx context.Context, client Client, address any, topics []any, cursor *logCursor) ([]formatters.Log, error) {
+	last := cursor.lastBlock()
+	if last == 0 {
+		return nil, nil
+	}
+
+	head, err := GetBlockNumber(ctx, client, GetBlockNumberParameters{ForceRefresh: true})
+	if err != nil {
+		return nil, err
+	}
+	if head <= last {
+		return nil, nil
+	}
+
+	fromBlock := last + 1
+	logs, err := GetLogs(ctx, client, GetLogsParameters{
+		Address:   address,
+		Topics:    topics,
+		FromBlock: &fromBlock,
+		ToBlock:   &head,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor.mu.Lock()
+	cursor.filled = head
+	cursor.last = max(cursor.last, head)
+	cursor.mu.Unlock()
+	return logs, nil
+}
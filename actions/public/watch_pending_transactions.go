@@ -131,22 +131,28 @@ func pollPendingTransactions(
 		sourceCh := make(chan WatchPendingTransactionsEvent, 100)
 
 		// Create filter
-		var filterID FilterID
+		filter := newWatchFilter(client, func(ctx context.Context) (FilterID, error) {
+			f, err := CreatePendingTransactionFilter(ctx, client)
+			if err != nil {
+				return "", err
+			}
+			return f.ID, nil
+		})
+		initialized := false
 
 		// Start polling
 		pollResults := poll.Poll(ctx, func(ctx context.Context) ([]common.Hash, error) {
 			// Create filter on first poll
-			if filterID == "" {
-				filter, err := CreatePendingTransactionFilter(ctx, client)
-				if err != nil {
+			if !initialized {
+				if _, err := filter.ensure(ctx); err != nil {
 					return nil, err
 				}
-				filterID = filter.ID
+				initialized = true
 				return nil, nil // First poll just creates filter
 			}
 
-			// Get filter changes
-			return GetFilterChangesTransactions(ctx, client, filterID)
+			// Get filter changes, recreating the filter if it expired
+			return pollFilterChanges(ctx, filter, GetFilterChangesTransactions)
 		}, poll.Options{
 			Interval:    interval,
 			EmitOnBegin: true,
@@ -155,12 +161,7 @@ func pollPendingTransactions(
 		// Process poll results
 		go func() {
 			defer close(sourceCh)
-			defer func() {
-				// Cleanup: uninstall filter
-				if filterID != "" {
-					_, _ = UninstallFilter(context.Background(), client, filterID)
-				}
-			}()
+			defer filter.uninstall()
 
 			for result := range pollResults {
 				if result.Error != nil {
@@ -197,11 +198,7 @@ func pollPendingTransactions(
 			}
 		}()
 
-		return sourceCh, func() {
-			if filterID != "" {
-				_, _ = UninstallFilter(context.Background(), client, filterID)
-			}
-		}
+		return sourceCh, filter.uninstall
 	})
 
 	// Forward events to output channel
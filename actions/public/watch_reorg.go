@@ -0,0 +1,115 @@
+package public
+
+import (
+	"context"
+
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// defaultReorgDepth is the number of recently emitted blocks kept for reorg
+// detection. Reorgs deeper than this are reported up to the tracked depth.
+const defaultReorgDepth = 64
+
+// blockFetcher fetches the canonical block at a given height.
+type blockFetcher func(ctx context.Context, number uint64) (*types.Block, error)
+
+// blockHistory tracks recently emitted blocks so that watchers can detect
+// when a new block no longer builds on what was previously emitted.
+type blockHistory struct {
+	blocks []*types.Block
+	depth  int
+}
+
+func newBlockHistory(depth int) *blockHistory {
+	return &blockHistory{depth: depth}
+}
+
+// last returns the most recently tracked block, or nil if none.
+func (h *blockHistory) last() *types.Block {
+	if len(h.blocks) == 0 {
+		return nil
+	}
+	return h.blocks[len(h.blocks)-1]
+}
+
+// at returns the tracked block at number, or nil if it is not tracked.
+func (h *blockHistory) at(number uint64) *types.Block {
+	for i := len(h.blocks) - 1; i >= 0; i-- {
+		if h.blocks[i].Number == number {
+			return h.blocks[i]
+		}
+	}
+	return nil
+}
+
+// push records block as the newest canonical block.
+func (h *blockHistory) push(block *types.Block) {
+	h.blocks = append(h.blocks, block)
+	if len(h.blocks) > h.depth {
+		h.blocks = h.blocks[len(h.blocks)-h.depth:]
+	}
+}
+
+// reconcile checks block against the tracked chain and rolls the history back
+// to the common ancestor if block is not a descendant of the last tracked block.
+//
+// It returns the tracked blocks that are no longer canonical and the canonical
+// blocks that replaced them (both oldest first, excluding block itself).
+// duplicate is true when block needs no action: it is already tracked, or it
+// is an older head (e.g. from a lagging node) that agrees with the history.
+func (h *blockHistory) reconcile(
+	ctx context.Context,
+	fetch blockFetcher,
+	block *types.Block,
+) (orphaned, replacements []*types.Block, duplicate bool, err error) {
+	last := h.last()
+	if last == nil {
+		return nil, nil, false, nil
+	}
+	if block.Hash == last.Hash {
+		return nil, nil, true, nil
+	}
+	if block.Number == last.Number+1 && block.ParentHash == last.Hash {
+		return nil, nil, false, nil
+	}
+	if block.Number <= last.Number {
+		// Only a different hash at a tracked height means the chain changed.
+		if tracked := h.at(block.Number); tracked == nil || tracked.Hash == block.Hash {
+			return nil, nil, true, nil
+		}
+	}
+
+	// Tracked blocks at or above the new height have been replaced outright.
+	i := len(h.blocks)
+	for i > 0 && h.blocks[i-1].Number >= block.Number {
+		i--
+	}
+
+	// Walk back until a tracked block matches the canonical chain.
+	for ; i > 0; i-- {
+		tracked := h.blocks[i-1]
+		if tracked.Number+1 == block.Number && block.ParentHash == tracked.Hash {
+			break
+		}
+
+		canonical, err := fetch(ctx, tracked.Number)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if canonical.Hash == tracked.Hash {
+			break
+		}
+		replacements = append(replacements, canonical)
+	}
+
+	orphaned = append(orphaned, h.blocks[i:]...)
+	h.blocks = h.blocks[:i]
+	reverseBlocks(replacements)
+	return orphaned, replacements, false, nil
+}
+
+func reverseBlocks(blocks []*types.Block) {
+	for i, j := 0, len(blocks)-1; i < j; i, j = i+1, j-1 {
+		blocks[i], blocks[j] = blocks[j], blocks[i]
+	}
+}
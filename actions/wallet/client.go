@@ -104,3 +104,30 @@ type AuthorizationSignableAccount interface {
 	// SignAuthorization signs an EIP-7702 authorization and returns the signed authorization.
 	SignAuthorization(auth types.AuthorizationRequest) (*types.SignedAuthorization, error)
 }
+
+// HashSignableAccount represents an account that can sign raw 32-byte hashes locally.
+type HashSignableAccount interface {
+	Account
+	// SignHash signs the hash as-is (no EIP-191 prefix) and returns the signature as a hex string.
+	SignHash(hash [32]byte) (string, error)
+}
+
+// NonceManager tracks the next nonce per address and chain so consecutive
+// sends from the same account don't each round-trip eth_getTransactionCount
+// or collide on the same pending nonce. accounts.NewNonceManager provides an
+// in-memory implementation.
+// This mirrors viem's NonceManager type.
+type NonceManager interface {
+	// Consume returns the next nonce for the sequence and advances it.
+	Consume(ctx context.Context, params types.NonceManagerParameters) (uint64, error)
+	// Reset discards local state so the next Consume resyncs from the chain.
+	Reset(params types.NonceManagerParameters)
+}
+
+// NonceManagerClient is implemented by clients configured with a default NonceManager.
+// Actions detect it via type assertion, so clients without one are unaffected.
+type NonceManagerClient interface {
+	Client
+	// NonceManager returns the client's nonce manager, or nil if none is configured.
+	NonceManager() NonceManager
+}
@@ -2,6 +2,7 @@ package wallet
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -9,7 +10,9 @@ import (
 
 	"github.com/ChefBingbong/viem-go/actions/public"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
 
@@ -40,6 +43,17 @@ type PrepareTransactionRequestParameters struct {
 	// ChainID optionally specifies the chain ID.
 	ChainID *int64
 
+	// Kzg, if set, computes blob versioned hashes and sidecars from Blobs.
+	Kzg kzg.Kzg
+
+	// Sidecars are the EIP-4844 blob sidecars (blob, commitment, proof) for the
+	// network wrapper. Filled from Blobs when "sidecars" is prepared and Kzg is set.
+	Sidecars []transaction.BlobSidecar
+
+	// NonceManager, if set, supplies the nonce instead of eth_getTransactionCount.
+	// If nil, uses the client's nonce manager when it implements NonceManagerClient.
+	NonceManager NonceManager
+
 	// Transaction fields
 	AccessList           []formatters.AccessListItem       `json:"accessList,omitempty"`
 	AuthorizationList    []transaction.SignedAuthorization `json:"authorizationList,omitempty"`
@@ -100,6 +114,11 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 		parameters = DefaultParameters
 	}
 
+	// An explicit type overrides inference, but must agree with the fields
+	if err := assertTransactionType(&params); err != nil {
+		return nil, err
+	}
+
 	// Set from address if account is available
 	if account != nil && params.From == "" {
 		params.From = account.Address().Hex()
@@ -120,14 +139,57 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 		return int64(chainID), nil
 	}
 
-	// ---------- Fill nonce ----------
-	if containsParam(parameters, "nonce") && params.Nonce == nil && account != nil {
-		nonce, err := public.GetTransactionCount(ctx, client, public.GetTransactionCountParameters{
-			Address:  common.HexToAddress(account.Address().Hex()),
-			BlockTag: "pending",
+	// ---------- Fill blob versioned hashes & sidecars ----------
+	// This mirrors viem's: if (parameters.includes('blobVersionedHashes') && blobs && kzg) { ... }
+	if len(params.Blobs) > 0 && params.Kzg != nil &&
+		(containsParam(parameters, "blobVersionedHashes") || containsParam(parameters, "sidecars")) {
+		filled, err := transaction.FillBlobSidecars(&transaction.Transaction{
+			Blobs:               params.Blobs,
+			BlobVersionedHashes: params.BlobVersionedHashes,
+			Sidecars:            params.Sidecars,
+			Kzg:                 params.Kzg,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to get nonce: %w", err)
+			return nil, fmt.Errorf("failed to compute blob sidecars: %w", err)
+		}
+		if containsParam(parameters, "blobVersionedHashes") {
+			params.BlobVersionedHashes = filled.BlobVersionedHashes
+		}
+		if containsParam(parameters, "sidecars") {
+			params.Sidecars = filled.Sidecars
+		}
+	}
+
+	// ---------- Fill nonce ----------
+	if containsParam(parameters, "nonce") && params.Nonce == nil && account != nil {
+		address := common.HexToAddress(account.Address().Hex())
+		fetchNonce := func(ctx context.Context) (uint64, error) {
+			return public.GetTransactionCount(ctx, client, public.GetTransactionCountParameters{
+				Address:  address,
+				BlockTag: "pending",
+			})
+		}
+
+		var nonce uint64
+		if nonceManager := resolveNonceManager(client, params.NonceManager); nonceManager != nil {
+			chainID, err := resolveChainID()
+			if err != nil {
+				return nil, fmt.Errorf("failed to get chain ID: %w", err)
+			}
+			nonce, err = nonceManager.Consume(ctx, types.NonceManagerParameters{
+				Address: address,
+				ChainID: chainID,
+				Source:  fetchNonce,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get nonce: %w", err)
+			}
+		} else {
+			var err error
+			nonce, err = fetchNonce(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get nonce: %w", err)
+			}
 		}
 		n := int(nonce)
 		params.Nonce = &n
@@ -142,99 +204,34 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 		params.ChainID = &chainID
 	}
 
-	// ---------- Fill type ----------
-	if (containsParam(parameters, "fees") || containsParam(parameters, "type")) && params.Type == "" {
-		// Try to infer the transaction type from existing fields
-		txType := inferTransactionType(&params)
-		if txType != "" {
-			params.Type = txType
-		} else {
-			// Determine from the network: if the latest block has baseFeePerGas, it's EIP-1559
-			block, blockErr := public.GetBlock(ctx, client, public.GetBlockParameters{
-				BlockTag: "latest",
-			})
-			if blockErr != nil {
-				return nil, fmt.Errorf("failed to get latest block: %w", blockErr)
-			}
-			if block != nil && block.BaseFeePerGas != nil {
-				params.Type = formatters.TransactionTypeEIP1559
-			} else {
-				params.Type = formatters.TransactionTypeLegacy
-			}
+	// Authorizations must be valid on the transaction's chain (or on any chain)
+	if params.ChainID != nil {
+		if err := transaction.AssertAuthorizationList(params.AuthorizationList, int(*params.ChainID)); err != nil {
+			return nil, err
 		}
 	}
 
-	// ---------- Fill fees ----------
-	if containsParam(parameters, "fees") {
-		if params.Type != formatters.TransactionTypeLegacy && params.Type != formatters.TransactionTypeEIP2930 {
-			// EIP-1559 fees
-			if params.MaxFeePerGas == nil || params.MaxPriorityFeePerGas == nil {
-				fees, feeErr := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{
-					Type: public.FeeValuesTypeEIP1559,
-				})
-				if feeErr != nil {
-					return nil, fmt.Errorf("failed to estimate fees: %w", feeErr)
-				}
-
-				// Check if existing maxFeePerGas is too low
-				if params.MaxPriorityFeePerGas == nil && params.MaxFeePerGas != nil &&
-					params.MaxFeePerGas.Cmp(fees.MaxPriorityFeePerGas) < 0 {
-					return nil, fmt.Errorf(
-						"maxFeePerGas (%s) cannot be lower than maxPriorityFeePerGas (%s)",
-						params.MaxFeePerGas.String(), fees.MaxPriorityFeePerGas.String(),
-					)
-				}
-
-				if params.MaxPriorityFeePerGas == nil {
-					params.MaxPriorityFeePerGas = fees.MaxPriorityFeePerGas
-				}
-				if params.MaxFeePerGas == nil {
-					params.MaxFeePerGas = fees.MaxFeePerGas
-				}
-			}
-		} else {
-			// Legacy fees
-			if params.MaxFeePerGas != nil || params.MaxPriorityFeePerGas != nil {
-				return nil, fmt.Errorf("EIP-1559 fees (maxFeePerGas/maxPriorityFeePerGas) are not supported for legacy/eip2930 transactions")
-			}
-
-			if params.GasPrice == nil {
-				fees, feeErr := public.EstimateFeesPerGas(ctx, client, public.EstimateFeesPerGasParameters{
-					Type: public.FeeValuesTypeLegacy,
-				})
-				if feeErr != nil {
-					return nil, fmt.Errorf("failed to estimate gas price: %w", feeErr)
-				}
-				params.GasPrice = fees.GasPrice
-			}
+	// ---------- Fill type, fees & gas ----------
+	// These are filled the same way for any sender, so they are delegated to
+	// public.PrepareTransactionRequest.
+	var fill []string
+	for _, param := range []string{"type", "fees", "gas"} {
+		if containsParam(parameters, param) {
+			fill = append(fill, param)
 		}
 	}
-
-	// ---------- Fill gas ----------
-	if containsParam(parameters, "gas") && params.Gas == nil {
-		estimateParams := public.EstimateGasParameters{
-			To:                   toCommonAddressPtr(params.To),
-			Data:                 hexToBytes(params.Data),
-			Value:                params.Value,
-			GasPrice:             params.GasPrice,
-			MaxFeePerGas:         params.MaxFeePerGas,
-			MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
-			MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
-		}
-		if account != nil {
-			addr := common.HexToAddress(account.Address().Hex())
-			estimateParams.Account = &addr
-		}
-		if params.Nonce != nil {
-			n := uint64(*params.Nonce)
-			estimateParams.Nonce = &n
+	if len(fill) > 0 {
+		filled, err := public.PrepareTransactionRequest(ctx, client, toPublicPrepareParams(&params, account, fill))
+		if err != nil {
+			return nil, err
 		}
-
-		gas, gasErr := public.EstimateGas(ctx, client, estimateParams)
-		if gasErr != nil {
-			return nil, fmt.Errorf("failed to estimate gas: %w", gasErr)
+		params.Type = formatters.TransactionType(filled.Type)
+		params.GasPrice = filled.GasPrice
+		params.MaxFeePerGas = filled.MaxFeePerGas
+		params.MaxPriorityFeePerGas = filled.MaxPriorityFeePerGas
+		if filled.Gas != nil {
+			params.Gas = new(big.Int).SetUint64(*filled.Gas)
 		}
-		params.Gas = new(big.Int).SetUint64(gas)
 	}
 
 	// Validate the final request
@@ -250,25 +247,15 @@ func PrepareTransactionRequest(ctx context.Context, client Client, params Prepar
 	return &params, nil
 }
 
-// inferTransactionType attempts to infer the transaction type from the request fields.
-// This mirrors viem's getTransactionType logic.
-func inferTransactionType(params *PrepareTransactionRequestParameters) formatters.TransactionType {
-	if len(params.AuthorizationList) > 0 {
-		return formatters.TransactionTypeEIP7702
-	}
-	if len(params.BlobVersionedHashes) > 0 || len(params.Blobs) > 0 || params.MaxFeePerBlobGas != nil {
-		return formatters.TransactionTypeEIP4844
-	}
-	if params.MaxFeePerGas != nil || params.MaxPriorityFeePerGas != nil {
-		return formatters.TransactionTypeEIP1559
-	}
-	if len(params.AccessList) > 0 {
-		return formatters.TransactionTypeEIP2930
+// assertTransactionType returns an error if the request's fields conflict with
+// each other or with its explicit Type (see transaction.GetTransactionType).
+// A request whose type can't be inferred yet is not an error here.
+func assertTransactionType(params *PrepareTransactionRequestParameters) error {
+	_, err := transaction.GetTransactionType(preparedParamsToTransaction(params))
+	if errors.Is(err, transaction.ErrConflictingTransactionFields) {
+		return err
 	}
-	if params.GasPrice != nil {
-		return formatters.TransactionTypeLegacy
-	}
-	return "" // Cannot infer
+	return nil
 }
 
 // containsParam checks if a parameter list contains a given parameter.
@@ -281,6 +268,65 @@ func containsParam(params []string, param string) bool {
 	return false
 }
 
+// resolveNonceManager returns the explicit nonce manager if set, otherwise the
+// client's default nonce manager, or nil if neither is configured.
+func resolveNonceManager(client Client, nonceManager NonceManager) NonceManager {
+	if nonceManager != nil {
+		return nonceManager
+	}
+	if nmc, ok := client.(NonceManagerClient); ok {
+		return nmc.NonceManager()
+	}
+	return nil
+}
+
+// toPublicPrepareParams converts a wallet request to the parameters of
+// public.PrepareTransactionRequest, filling only the given parameters.
+func toPublicPrepareParams(params *PrepareTransactionRequestParameters, account Account, parameters []string) public.PrepareTransactionRequestParameters {
+	prepare := public.PrepareTransactionRequestParameters{
+		Parameters:           parameters,
+		To:                   toCommonAddressPtr(params.To),
+		Data:                 hexToBytes(params.Data),
+		Value:                params.Value,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+		AccessList:           toTypesAccessList(params.AccessList),
+		ChainID:              params.ChainID,
+		Type:                 transaction.TransactionType(params.Type),
+	}
+	if account != nil {
+		addr := common.HexToAddress(account.Address().Hex())
+		prepare.Account = &addr
+	}
+	if params.Nonce != nil {
+		n := uint64(*params.Nonce)
+		prepare.Nonce = &n
+	}
+	if params.Gas != nil {
+		gas := params.Gas.Uint64()
+		prepare.Gas = &gas
+	}
+	for _, blob := range params.Blobs {
+		prepare.Blobs = append(prepare.Blobs, common.FromHex(blob))
+	}
+	for _, hash := range params.BlobVersionedHashes {
+		prepare.BlobVersionedHashes = append(prepare.BlobVersionedHashes, common.HexToHash(hash))
+	}
+	for _, auth := range params.AuthorizationList {
+		prepare.AuthorizationList = append(prepare.AuthorizationList, types.SignedAuthorization{
+			Address: auth.Address,
+			ChainId: auth.ChainId,
+			Nonce:   auth.Nonce,
+			R:       auth.R,
+			S:       auth.S,
+			YParity: auth.YParity,
+		})
+	}
+	return prepare
+}
+
 // toCommonAddressPtr converts a hex address string to *common.Address, or nil if empty.
 func toCommonAddressPtr(addr string) *common.Address {
 	if addr == "" {
@@ -290,6 +336,23 @@ func toCommonAddressPtr(addr string) *common.Address {
 	return &a
 }
 
+// toTypesAccessList converts a formatted access list to types.AccessList,
+// returning nil for an empty list.
+func toTypesAccessList(accessList []formatters.AccessListItem) types.AccessList {
+	if len(accessList) == 0 {
+		return nil
+	}
+	result := make(types.AccessList, len(accessList))
+	for i, item := range accessList {
+		keys := make([]common.Hash, len(item.StorageKeys))
+		for j, key := range item.StorageKeys {
+			keys[j] = common.HexToHash(key)
+		}
+		result[i] = types.AccessTuple{Address: common.HexToAddress(item.Address), StorageKeys: keys}
+	}
+	return result
+}
+
 // hexToBytes converts a hex string to bytes, returning nil for empty strings.
 func hexToBytes(hex string) []byte {
 	if hex == "" || hex == "0x" {
@@ -9,6 +9,7 @@ import (
 	json "github.com/goccy/go-json"
 
 	viemabi "github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/utils/data"
 	"github.com/ChefBingbong/viem-go/utils/encoding"
 )
@@ -47,6 +48,11 @@ type SendCallsParameters struct {
 	// Capabilities is the optional capabilities to request.
 	Capabilities map[string]any `json:"capabilities,omitempty"`
 
+	// PaymasterService requests sponsorship from an ERC-7677 paymaster
+	// service. It is sent as the "paymasterService" capability, unless
+	// Capabilities already contains one.
+	PaymasterService *PaymasterService
+
 	// ForceAtomic when true, requires the batch to execute atomically. Default: false.
 	ForceAtomic bool
 
@@ -65,6 +71,30 @@ type SendCallsParameters struct {
 	ExperimentalFallbackDelay *int
 }
 
+// PaymasterService configures the ERC-7677 paymaster service a wallet should
+// use to sponsor a call batch.
+type PaymasterService struct {
+	// URL is the paymaster service URL.
+	URL string
+	// Context is passed to the paymaster service as is (optional).
+	Context map[string]any
+	// Optional when true, lets wallets that do not support paymasters send
+	// the batch unsponsored.
+	Optional bool
+}
+
+// capability returns the wallet_sendCalls capability for the service.
+func (p *PaymasterService) capability() map[string]any {
+	capability := map[string]any{"url": p.URL}
+	if p.Context != nil {
+		capability["context"] = p.Context
+	}
+	if p.Optional {
+		capability["optional"] = true
+	}
+	return capability
+}
+
 // SendCallsReturnType is the return type for the SendCalls action.
 // This mirrors viem's SendCallsReturnType type.
 type SendCallsReturnType struct {
@@ -123,20 +153,7 @@ func SendCalls(ctx context.Context, client Client, params SendCallsParameters) (
 		return nil, fmt.Errorf("chain is required for wallet_sendCalls")
 	}
 
-	// Propagate client.DataSuffix() to capabilities if not already set.
-	// Mirrors viem's: if (client.dataSuffix && !parameters.capabilities?.dataSuffix) { ... }
-	capabilities := params.Capabilities
-	if clientSuffix := client.DataSuffix(); len(clientSuffix) > 0 {
-		if capabilities == nil {
-			capabilities = make(map[string]any)
-		}
-		if _, hasDataSuffix := capabilities["dataSuffix"]; !hasDataSuffix {
-			capabilities["dataSuffix"] = map[string]any{
-				"value":    encoding.BytesToHex(clientSuffix),
-				"optional": true,
-			}
-		}
-	}
+	capabilities := resolveSendCallsCapabilities(client, params)
 
 	// Encode calls (mirrors viem's calls.map encoding)
 	rpcCalls := make([]sendCallsRpcCall, len(params.Calls))
@@ -189,7 +206,7 @@ func SendCalls(ctx context.Context, client Client, params SendCallsParameters) (
 	if err != nil {
 		// Handle fallback to eth_sendTransaction
 		if params.ExperimentalFallback && isMethodNotSupportedError(err) {
-			return sendCallsFallback(ctx, client, account, params, rpcCalls)
+			return sendCallsFallback(ctx, client, account, params, capabilities, rpcCalls)
 		}
 		return nil, fmt.Errorf("wallet_sendCalls failed: %w", err)
 	}
@@ -209,6 +226,37 @@ func SendCalls(ctx context.Context, client Client, params SendCallsParameters) (
 	return &result, nil
 }
 
+// resolveSendCallsCapabilities returns the capabilities to send with
+// wallet_sendCalls. params.Capabilities is copied, not modified.
+func resolveSendCallsCapabilities(client Client, params SendCallsParameters) map[string]any {
+	var capabilities map[string]any
+	if params.Capabilities != nil || params.PaymasterService != nil || len(client.DataSuffix()) > 0 {
+		capabilities = make(map[string]any, len(params.Capabilities)+2)
+		for k, v := range params.Capabilities {
+			capabilities[k] = v
+		}
+	}
+
+	if params.PaymasterService != nil {
+		if _, ok := capabilities["paymasterService"]; !ok {
+			capabilities["paymasterService"] = params.PaymasterService.capability()
+		}
+	}
+
+	// Propagate client.DataSuffix() to capabilities if not already set.
+	// Mirrors viem's: if (client.dataSuffix && !parameters.capabilities?.dataSuffix) { ... }
+	if clientSuffix := client.DataSuffix(); len(clientSuffix) > 0 {
+		if _, hasDataSuffix := capabilities["dataSuffix"]; !hasDataSuffix {
+			capabilities["dataSuffix"] = map[string]any{
+				"value":    encoding.BytesToHex(clientSuffix),
+				"optional": true,
+			}
+		}
+	}
+
+	return capabilities
+}
+
 // sendCallsFallback falls back to individual eth_sendTransaction calls.
 // This mirrors viem's experimental_fallback branch.
 func sendCallsFallback(
@@ -216,11 +264,12 @@ func sendCallsFallback(
 	client Client,
 	account Account,
 	params SendCallsParameters,
+	capabilities map[string]any,
 	rpcCalls []sendCallsRpcCall,
 ) (*SendCallsReturnType, error) {
 	// Check for non-optional capabilities
-	if params.Capabilities != nil {
-		for _, cap := range params.Capabilities {
+	if capabilities != nil {
+		for _, cap := range capabilities {
 			if capMap, ok := cap.(map[string]any); ok {
 				if optional, exists := capMap["optional"]; exists {
 					if optBool, ok := optional.(bool); ok && !optBool {
@@ -300,11 +349,12 @@ func isMethodNotSupportedError(err error) bool {
 	if err == nil {
 		return false
 	}
+	if transport.IsMethodNotSupported(err) {
+		return true
+	}
 	errStr := err.Error()
 	lower := toLower(errStr)
-	return contains(lower, "method not found") ||
-		contains(lower, "method not supported") ||
-		contains(lower, "does not exist") ||
+	return contains(lower, "does not exist") ||
 		contains(lower, "is not available") ||
 		contains(lower, "missing or invalid") ||
 		contains(lower, "did not match any variant") ||
@@ -8,13 +8,18 @@ import (
 
 	json "github.com/goccy/go-json"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/ChefBingbong/viem-go/actions/public"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
 	"github.com/ChefBingbong/viem-go/utils"
 	"github.com/ChefBingbong/viem-go/utils/authorization"
 	"github.com/ChefBingbong/viem-go/utils/data"
 	"github.com/ChefBingbong/viem-go/utils/encoding"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 	"github.com/ChefBingbong/viem-go/utils/signature"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
@@ -40,6 +45,31 @@ type SendTransactionParameters struct {
 	// Takes precedence over client.DataSuffix().
 	DataSuffix string
 
+	// Kzg computes the KZG commitments and proofs for Blobs, from which the
+	// blob versioned hashes and the network wrapper sidecars are derived.
+	// Required to send blob transactions from local accounts unless
+	// BlobVersionedHashes are supplied and the node accepts them without sidecars.
+	Kzg kzg.Kzg
+
+	// AutoAccessList, when true and AccessList is empty, generates an access
+	// list with eth_createAccessList and attaches it if the transaction's gas
+	// estimate is lower with it than without. If the access list cannot be
+	// generated or estimated, the transaction is sent without one. Ignored
+	// for legacy transactions.
+	AutoAccessList bool
+
+	// NonceManager, if set, supplies nonces for local accounts instead of
+	// fetching them with eth_getTransactionCount on every send. If nil, uses the
+	// client's nonce manager when it implements NonceManagerClient.
+	NonceManager NonceManager
+
+	// DryRun, when true, builds the transaction (and, for local accounts,
+	// signs it) without sending it. SendTransactionDetailed returns the
+	// built request and, for local accounts, the signed serialized
+	// transaction and its hash. A nonce taken from a nonce manager is
+	// handed back.
+	DryRun bool
+
 	// Transaction fields
 	AccessList           []formatters.AccessListItem       `json:"accessList,omitempty"`
 	AuthorizationList    []transaction.SignedAuthorization `json:"authorizationList,omitempty"`
@@ -61,6 +91,19 @@ type SendTransactionParameters struct {
 // It is the transaction hash as a hex string.
 type SendTransactionReturnType = string
 
+// SendTransactionResult is the return type for the SendTransactionDetailed action.
+type SendTransactionResult struct {
+	// Hash is the transaction hash. In a dry run from a JSON-RPC account the
+	// transaction is not signed, so Hash is empty.
+	Hash string
+	// Request is the transaction with the nonce, chain ID, type, fees and
+	// gas filled in. It is set for local accounts and for dry runs.
+	Request *PrepareTransactionRequestParameters
+	// SerializedTransaction is the signed serialized transaction. It is set
+	// for local accounts only.
+	SerializedTransaction string
+}
+
 // SendTransaction creates, signs, and sends a new transaction to the network.
 //
 //   - For JSON-RPC accounts (or when no local signer is available), sends via `eth_sendTransaction`.
@@ -88,13 +131,37 @@ type SendTransactionReturnType = string
 //	    Value:   big.NewInt(1000000000000000000),
 //	})
 func SendTransaction(ctx context.Context, client Client, params SendTransactionParameters) (SendTransactionReturnType, error) {
+	result, err := SendTransactionDetailed(ctx, client, params)
+	if err != nil {
+		return "", err
+	}
+	return result.Hash, nil
+}
+
+// SendTransactionDetailed sends a transaction like SendTransaction, and also
+// returns the built transaction and, for local accounts, the signed
+// serialized transaction.
+//
+// With DryRun set, the transaction is built and signed but not sent: neither
+// eth_sendTransaction nor eth_sendRawTransaction is called. This is useful
+// to preview a transaction or to sign it for broadcasting elsewhere.
+//
+// Example:
+//
+//	result, err := wallet.SendTransactionDetailed(ctx, client, wallet.SendTransactionParameters{
+//	    To:     "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+//	    Value:  big.NewInt(1000000000000000000),
+//	    DryRun: true,
+//	})
+//	fmt.Println(result.Hash, result.SerializedTransaction)
+func SendTransactionDetailed(ctx context.Context, client Client, params SendTransactionParameters) (*SendTransactionResult, error) {
 	// Resolve account: param > client
 	account := params.Account
 	if account == nil {
 		account = client.Account()
 	}
 	if account == nil {
-		return "", &AccountNotFoundError{DocsPath: "/docs/actions/wallet/sendTransaction"}
+		return nil, &AccountNotFoundError{DocsPath: "/docs/actions/wallet/sendTransaction"}
 	}
 
 	// Resolve data suffix: param > client
@@ -116,7 +183,22 @@ func SendTransaction(ctx context.Context, client Client, params SendTransactionP
 		MaxFeePerGas:         params.MaxFeePerGas,
 		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
 	}); err != nil {
-		return "", err
+		return nil, err
+	}
+
+	// Reject fields that conflict with each other or with an explicit Type
+	if err := assertTransactionType(&PrepareTransactionRequestParameters{
+		AccessList:           params.AccessList,
+		AuthorizationList:    params.AuthorizationList,
+		BlobVersionedHashes:  params.BlobVersionedHashes,
+		Blobs:                params.Blobs,
+		GasPrice:             params.GasPrice,
+		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Type:                 params.Type,
+	}); err != nil {
+		return nil, err
 	}
 
 	// Resolve `to` — infer from authorizationList if not provided.
@@ -125,11 +207,15 @@ func SendTransaction(ctx context.Context, client Client, params SendTransactionP
 	if to == "" && len(params.AuthorizationList) > 0 {
 		recovered, recoverErr := recoverAuthorizationAddr(params.AuthorizationList[0])
 		if recoverErr != nil {
-			return "", fmt.Errorf("`to` is required. Could not infer from `authorizationList`: %w", recoverErr)
+			return nil, fmt.Errorf("`to` is required. Could not infer from `authorizationList`: %w", recoverErr)
 		}
 		to = recovered
 	}
 
+	if params.AutoAccessList && len(params.AccessList) == 0 && !isLegacyTransaction(params) {
+		params.AccessList = generateAccessList(ctx, client, account, params, txData, to)
+	}
+
 	// Check if this is a local account that can sign transactions
 	signable, isLocal := account.(TransactionSignableAccount)
 
@@ -142,6 +228,73 @@ func SendTransaction(ctx context.Context, client Client, params SendTransactionP
 	return sendTransactionViaLocalSign(ctx, client, account, signable, params, txData, to)
 }
 
+// isLegacyTransaction reports whether the transaction will be sent as a
+// legacy transaction: either its Type says so, or it has no Type and sets
+// GasPrice without any EIP-1559 fee fields. Legacy transactions can't carry an
+// access list.
+func isLegacyTransaction(params SendTransactionParameters) bool {
+	if params.Type != "" {
+		return params.Type == formatters.TransactionTypeLegacy
+	}
+	return params.GasPrice != nil && params.MaxFeePerGas == nil && params.MaxPriorityFeePerGas == nil
+}
+
+// generateAccessList returns an access list for the transaction if it
+// lowers the gas estimate, or nil otherwise. Errors from the node are not
+// fatal: the transaction is then sent as requested.
+func generateAccessList(
+	ctx context.Context,
+	client Client,
+	account Account,
+	params SendTransactionParameters,
+	txData string,
+	to string,
+) []formatters.AccessListItem {
+	from := common.HexToAddress(account.Address().Hex())
+	request := public.EstimateGasParameters{
+		Account:              &from,
+		To:                   toCommonAddressPtr(to),
+		Data:                 hexToBytes(txData),
+		Value:                params.Value,
+		GasPrice:             params.GasPrice,
+		MaxFeePerGas:         params.MaxFeePerGas,
+		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+	}
+
+	created, err := public.CreateAccessList(ctx, client, public.CreateAccessListParameters{
+		Account:              request.Account,
+		To:                   request.To,
+		Data:                 request.Data,
+		Value:                request.Value,
+		GasPrice:             request.GasPrice,
+		MaxFeePerGas:         request.MaxFeePerGas,
+		MaxPriorityFeePerGas: request.MaxPriorityFeePerGas,
+	})
+	if err != nil || len(created.AccessList) == 0 {
+		return nil
+	}
+
+	without, err := public.EstimateGas(ctx, client, request)
+	if err != nil {
+		return nil
+	}
+	request.AccessList = created.AccessList
+	with, err := public.EstimateGas(ctx, client, request)
+	if err != nil || with >= without {
+		return nil
+	}
+
+	accessList := make([]formatters.AccessListItem, len(created.AccessList))
+	for i, tuple := range created.AccessList {
+		keys := make([]string, len(tuple.StorageKeys))
+		for j, key := range tuple.StorageKeys {
+			keys[j] = key.Hex()
+		}
+		accessList[i] = formatters.AccessListItem{Address: tuple.Address.Hex(), StorageKeys: keys}
+	}
+	return accessList
+}
+
 // sendTransactionViaRPC handles the JSON-RPC account path using eth_sendTransaction.
 // This mirrors viem's `account?.type === 'json-rpc'` branch, including the
 // wallet_sendTransaction namespace fallback with LRU caching.
@@ -152,7 +305,7 @@ func sendTransactionViaRPC(
 	params SendTransactionParameters,
 	txData string,
 	to string,
-) (string, error) {
+) (*SendTransactionResult, error) {
 	// Resolve chain
 	ch := params.Chain
 	if ch == nil {
@@ -170,16 +323,23 @@ func sendTransactionViaRPC(
 	if ch != nil {
 		cid, err := public.GetChainID(ctx, client)
 		if err != nil {
-			return "", fmt.Errorf("failed to get chain ID: %w", err)
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
 		}
 		chainID = &cid
 		if assertChainID {
 			if chainErr := viemchain.AssertCurrentChain(ch, int64(cid)); chainErr != nil {
-				return "", chainErr
+				return nil, chainErr
 			}
 		}
 	}
 
+	// Authorizations must be valid on this chain (or on any chain)
+	if chainID != nil {
+		if err := transaction.AssertAuthorizationList(params.AuthorizationList, int(*chainID)); err != nil {
+			return nil, err
+		}
+	}
+
 	// Format the transaction request (mirrors viem's formatTransactionRequest)
 	txRequest := formatters.TransactionRequest{
 		Data:                 txData,
@@ -235,10 +395,49 @@ func sendTransactionViaRPC(
 		rpcReq.ChainID = encoding.NumberToHex(new(big.Int).SetUint64(*chainID))
 	}
 
+	if params.DryRun {
+		// The wallet signs on send, so a dry run can only fill in the
+		// transaction; there is no signed transaction or hash.
+		prepared, err := PrepareTransactionRequest(ctx, client, PrepareTransactionRequestParameters{
+			Account:              account,
+			Chain:                ch,
+			AccessList:           params.AccessList,
+			AuthorizationList:    params.AuthorizationList,
+			BlobVersionedHashes:  params.BlobVersionedHashes,
+			Blobs:                params.Blobs,
+			Data:                 txData,
+			Gas:                  params.Gas,
+			GasPrice:             params.GasPrice,
+			MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
+			MaxFeePerGas:         params.MaxFeePerGas,
+			MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+			Nonce:                params.Nonce,
+			NonceManager:         params.NonceManager,
+			To:                   to,
+			Type:                 params.Type,
+			Value:                params.Value,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to prepare transaction request: %w", err)
+		}
+		// Hand back a nonce consumed from the nonce manager.
+		if nonceManager := resolveNonceManager(client, params.NonceManager); nonceManager != nil && params.Nonce == nil && prepared.ChainID != nil {
+			nonceManager.Reset(types.NonceManagerParameters{
+				Address: account.Address(),
+				ChainID: *prepared.ChainID,
+			})
+		}
+		return &SendTransactionResult{Request: prepared}, nil
+	}
+
 	// Send with wallet_sendTransaction namespace fallback.
 	// Mirrors viem's: try eth_sendTransaction first, on certain RPC errors
 	// retry with wallet_sendTransaction, cache the result per client.UID().
-	return sendWithNamespaceFallback(ctx, client, rpcReq)
+	hash, err := sendWithNamespaceFallback(ctx, client, rpcReq)
+	if err != nil {
+		return nil, err
+	}
+	return &SendTransactionResult{Hash: hash}, nil
 }
 
 // sendTransactionViaLocalSign handles the local account path: prepare + sign + sendRawTransaction.
@@ -251,7 +450,7 @@ func sendTransactionViaLocalSign(
 	params SendTransactionParameters,
 	txData string,
 	to string,
-) (string, error) {
+) (*SendTransactionResult, error) {
 	// Resolve chain
 	ch := params.Chain
 	if ch == nil {
@@ -274,15 +473,45 @@ func sendTransactionViaLocalSign(
 		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
 		MaxFeePerGas:         params.MaxFeePerGas,
 		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
+		Kzg:                  params.Kzg,
 		Nonce:                params.Nonce,
+		NonceManager:         params.NonceManager,
 		To:                   to,
 		Type:                 params.Type,
 		Value:                params.Value,
 	}
 
+	// If the nonce comes from a nonce manager, a failure after it was consumed
+	// leaves a gap in the local sequence. Reset it on every failure so the
+	// next send resyncs from the chain. The chain ID is resolved up front so
+	// the reset uses the same key as the consume, even when Prepare fails.
+	sent := false
+	if nonceManager := resolveNonceManager(client, params.NonceManager); nonceManager != nil && params.Nonce == nil {
+		var chainID int64
+		if ch != nil {
+			chainID = ch.ID
+		} else {
+			id, err := public.GetChainID(ctx, client)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get chain ID: %w", err)
+			}
+			chainID = int64(id)
+		}
+		prepareParams.ChainID = &chainID
+
+		defer func() {
+			if !sent {
+				nonceManager.Reset(types.NonceManagerParameters{
+					Address: account.Address(),
+					ChainID: chainID,
+				})
+			}
+		}()
+	}
+
 	prepared, err := PrepareTransactionRequest(ctx, client, prepareParams)
 	if err != nil {
-		return "", fmt.Errorf("failed to prepare transaction request: %w", err)
+		return nil, fmt.Errorf("failed to prepare transaction request: %w", err)
 	}
 
 	// Convert prepared params to a Transaction for local signing
@@ -292,14 +521,28 @@ func sendTransactionViaLocalSign(
 	// This mirrors viem's: account.signTransaction(request, { serializer })
 	serializedTx, signErr := signable.SignTransaction(tx)
 	if signErr != nil {
-		return "", fmt.Errorf("failed to sign transaction: %w", signErr)
+		return nil, fmt.Errorf("failed to sign transaction: %w", signErr)
+	}
+
+	// A dry run stops here; the deferred reset hands a managed nonce back.
+	if params.DryRun {
+		hash, err := transaction.GetTransactionHash(serializedTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash signed transaction: %w", err)
+		}
+		return &SendTransactionResult{Hash: hash, Request: prepared, SerializedTransaction: serializedTx}, nil
 	}
 
 	// Send the raw signed transaction
 	// This mirrors viem's: sendRawTransaction({ serializedTransaction })
-	return SendRawTransaction(ctx, client, SendRawTransactionParameters{
+	hash, err := SendRawTransaction(ctx, client, SendRawTransactionParameters{
 		SerializedTransaction: serializedTx,
 	})
+	if err != nil {
+		return nil, err
+	}
+	sent = true
+	return &SendTransactionResult{Hash: hash, Request: prepared, SerializedTransaction: serializedTx}, nil
 }
 
 // sendWithNamespaceFallback sends a transaction via eth_sendTransaction, falling back
@@ -355,10 +598,7 @@ func sendWithNamespaceFallback(ctx context.Context, client Client, rpcReq any) (
 	}
 
 	// wallet_sendTransaction also failed — check if it's MethodNotFound/NotSupported/NotImplemented
-	walletErrStr := strings.ToLower(walletErr.Error())
-	if strings.Contains(walletErrStr, "method not found") ||
-		strings.Contains(walletErrStr, "method not supported") ||
-		strings.Contains(walletErrStr, "not implemented") {
+	if transport.IsMethodNotSupported(walletErr) {
 		// The wallet namespace is confirmed not supported; cache and throw the original error
 		supportsWalletNamespace.Set(uid, false)
 		return "", fmt.Errorf("eth_sendTransaction failed: %w", originalErr)
@@ -376,12 +616,12 @@ func isNamespaceFallbackError(err error) bool {
 	if err == nil {
 		return false
 	}
+	if transport.IsMethodNotSupported(err) {
+		return true
+	}
 	lower := strings.ToLower(err.Error())
 	return strings.Contains(lower, "invalid input") ||
-		strings.Contains(lower, "invalid params") ||
-		strings.Contains(lower, "method not found") ||
-		strings.Contains(lower, "method not supported") ||
-		strings.Contains(lower, "not implemented")
+		strings.Contains(lower, "invalid params")
 }
 
 // recoverAuthorizationAddr recovers the signer address from a signed EIP-7702 authorization.
@@ -426,6 +666,8 @@ func preparedParamsToTransaction(params *PrepareTransactionRequestParameters) *t
 		MaxFeePerBlobGas:     params.MaxFeePerBlobGas,
 		BlobVersionedHashes:  params.BlobVersionedHashes,
 		Blobs:                params.Blobs,
+		Sidecars:             params.Sidecars,
+		Kzg:                  params.Kzg,
 	}
 
 	if params.ChainID != nil {
@@ -0,0 +1,100 @@
+package wallet
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+)
+
+// WaitOptions configures how SendTransactionAndWait waits for the receipt.
+type WaitOptions struct {
+	// Confirmations is the number of blocks to wait for after inclusion.
+	// Default: 1.
+	Confirmations uint64
+
+	// PollingInterval is the receipt polling interval.
+	// Defaults to client.PollingInterval().
+	PollingInterval time.Duration
+
+	// Timeout is the maximum time to wait for the receipt.
+	// Default: 180 seconds (see public.WaitForTransactionReceipt).
+	Timeout time.Duration
+
+	// OnReplaced is called if the transaction is replaced (sped up,
+	// cancelled or repriced) while waiting.
+	OnReplaced func(info public.ReplacementInfo)
+
+	// ThrowOnRevert returns a *TransactionReceiptRevertedError when the
+	// receipt status is 0 (reverted).
+	ThrowOnRevert bool
+}
+
+// SendTransactionAndWaitReturnType is the return type for SendTransactionAndWait.
+type SendTransactionAndWaitReturnType = public.WaitForTransactionReceiptReturnType
+
+// SendTransactionAndWait sends a transaction and waits for its receipt.
+//
+// It is SendTransaction followed by public.WaitForTransactionReceipt on the
+// returned hash. The receipt is polled through publicClient, or through
+// client if publicClient is nil (including a typed nil such as a nil
+// *client.PublicClient).
+//
+// Example:
+//
+//	receipt, err := wallet.SendTransactionAndWait(ctx, walletClient, publicClient, wallet.SendTransactionParameters{
+//	    To:    "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+//	    Value: big.NewInt(1e18),
+//	}, wallet.WaitOptions{Confirmations: 2, ThrowOnRevert: true})
+func SendTransactionAndWait(
+	ctx context.Context,
+	client Client,
+	publicClient public.Client,
+	params SendTransactionParameters,
+	opts WaitOptions,
+) (SendTransactionAndWaitReturnType, error) {
+	hash, err := SendTransaction(ctx, client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if isNilClient(publicClient) {
+		publicClient = client
+	}
+
+	pollingInterval := opts.PollingInterval
+	if pollingInterval == 0 {
+		pollingInterval = client.PollingInterval()
+	}
+
+	receipt, err := public.WaitForTransactionReceipt(ctx, publicClient, public.WaitForTransactionReceiptParameters{
+		Hash:            common.HexToHash(hash),
+		Confirmations:   opts.Confirmations,
+		OnReplaced:      opts.OnReplaced,
+		PollingInterval: pollingInterval,
+		Timeout:         opts.Timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction receipt: %w", err)
+	}
+
+	if opts.ThrowOnRevert && receipt.Status == 0 {
+		return nil, &TransactionReceiptRevertedError{Receipt: rpcReceiptToFormattersReceipt(receipt)}
+	}
+
+	return receipt, nil
+}
+
+// isNilClient reports whether c is nil or holds a nil pointer. A nil
+// *PublicClient passed as public.Client is not == nil, but calling it panics.
+func isNilClient(c public.Client) bool {
+	if c == nil {
+		return true
+	}
+	v := reflect.ValueOf(c)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
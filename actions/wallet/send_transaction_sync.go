@@ -187,6 +187,13 @@ func sendTransactionSyncViaRPC(
 		}
 	}
 
+	// Authorizations must be valid on this chain (or on any chain)
+	if chainID != nil {
+		if err := transaction.AssertAuthorizationList(params.AuthorizationList, int(*chainID)); err != nil {
+			return nil, err
+		}
+	}
+
 	// Format the transaction request
 	txRequest := formatters.TransactionRequest{
 		Data:                 txData,
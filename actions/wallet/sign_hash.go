@@ -0,0 +1,54 @@
+package wallet
+
+import (
+	"context"
+)
+
+// SignHashParameters contains the parameters for the SignHash action.
+type SignHashParameters struct {
+	// Account is the account to sign with. If nil, uses the client's account.
+	Account Account
+
+	// Hash is the 32-byte hash to sign.
+	Hash [32]byte
+}
+
+// SignHashReturnType is the return type for the SignHash action (hex string).
+type SignHashReturnType = string
+
+// SignHash signs a raw 32-byte hash without the EIP-191 message prefix, as
+// needed by smart-account and meta-transaction flows that define their own
+// digest.
+//
+// Note: This action requires a local account that implements HashSignableAccount.
+// JSON-RPC accounts are not supported, since wallets do not expose raw hash
+// signing.
+//
+// Example:
+//
+//	sig, err := wallet.SignHash(ctx, client, wallet.SignHashParameters{
+//	    Account: myLocalAccount,
+//	    Hash:    userOpHash,
+//	})
+func SignHash(ctx context.Context, client Client, params SignHashParameters) (SignHashReturnType, error) {
+	// Resolve account: param > client
+	account := params.Account
+	if account == nil {
+		account = client.Account()
+	}
+	if account == nil {
+		return "", &AccountNotFoundError{DocsPath: "/docs/actions/wallet/signHash"}
+	}
+
+	signable, ok := account.(HashSignableAccount)
+	if !ok {
+		return "", &AccountTypeNotSupportedError{
+			DocsPath: "/docs/actions/wallet/signHash",
+			MetaMessages: []string{
+				"The `signHash` Action does not support JSON-RPC Accounts.",
+			},
+		}
+	}
+
+	return signable.SignHash(params.Hash)
+}
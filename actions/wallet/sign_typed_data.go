@@ -3,10 +3,12 @@ package wallet
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	json "github.com/goccy/go-json"
 
+	viemchain "github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/utils/signature"
 )
 
@@ -17,6 +19,7 @@ type SignTypedDataParameters struct {
 	Account Account
 
 	// Domain contains the EIP-712 domain parameters.
+	// If Domain.ChainId is nil, it defaults to the client's chain ID.
 	Domain signature.TypedDataDomain
 
 	// Types contains the type definitions (excluding EIP712Domain, which is auto-generated).
@@ -27,6 +30,12 @@ type SignTypedDataParameters struct {
 
 	// Message is the structured message to sign.
 	Message map[string]any
+
+	// AssertChainID when true, defaults Domain.ChainId to the client's chain
+	// ID and returns a *chain.ChainMismatchError if a provided Domain.ChainId
+	// differs from it. When false, the domain is signed as given.
+	// Default: true.
+	AssertChainID *bool
 }
 
 // SignTypedDataReturnType is the return type for the SignTypedData action (hex string).
@@ -79,16 +88,35 @@ func SignTypedData(ctx context.Context, client Client, params SignTypedDataParam
 		return "", &AccountNotFoundError{DocsPath: "/docs/actions/wallet/signTypedData"}
 	}
 
+	// Determine if we should assert chain ID (default: true)
+	assertChainID := true
+	if params.AssertChainID != nil {
+		assertChainID = *params.AssertChainID
+	}
+
+	// Default the domain chain ID to the client's chain, and reject a
+	// conflicting one (mirrors SendTransaction's chain assertion)
+	domain := params.Domain
+	if ch := client.Chain(); ch != nil && assertChainID {
+		if domain.ChainId == nil {
+			domain.ChainId = big.NewInt(ch.ID)
+		} else if !domain.ChainId.IsInt64() {
+			return "", fmt.Errorf("%w: domain chain id %s", viemchain.ErrInvalidChainID, domain.ChainId)
+		} else if chainErr := viemchain.AssertCurrentChain(ch, domain.ChainId.Int64()); chainErr != nil {
+			return "", chainErr
+		}
+	}
+
 	// Build the complete types map with EIP712Domain (mirrors viem's getTypesForEIP712Domain)
 	types := make(map[string][]signature.TypedDataField)
 	for k, v := range params.Types {
 		types[k] = v
 	}
-	types["EIP712Domain"] = getTypesForEIP712Domain(params.Domain)
+	types["EIP712Domain"] = getTypesForEIP712Domain(domain)
 
 	// Build the typed data definition for local signing and validation
 	typedData := signature.TypedDataDefinition{
-		Domain:      params.Domain,
+		Domain:      domain,
 		Types:       types,
 		PrimaryType: params.PrimaryType,
 		Message:     params.Message,
@@ -148,10 +176,8 @@ func getTypesForEIP712Domain(domain signature.TypedDataDomain) []signature.Typed
 // byte ranges, integer ranges, etc. This mirrors viem's validateTypedData.
 func validateTypedData(data signature.TypedDataDefinition) error {
 	// Validate the domain
-	if data.Domain.VerifyingContract != "" {
-		if !isValidHexAddress(data.Domain.VerifyingContract) {
-			return fmt.Errorf("invalid verifying contract address: %s", data.Domain.VerifyingContract)
-		}
+	if err := signature.ValidateTypedDataDomain(data.Domain); err != nil {
+		return err
 	}
 
 	// Validate the primary type exists in types
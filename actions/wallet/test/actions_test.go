@@ -2,22 +2,31 @@ package wallet_test
 
 import (
 	"context"
+	"crypto/sha256"
+	"errors"
 	"math/big"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	json "github.com/goccy/go-json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ChefBingbong/viem-go/accounts"
 	"github.com/ChefBingbong/viem-go/actions/wallet"
 	"github.com/ChefBingbong/viem-go/chain"
 	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/blob"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 	"github.com/ChefBingbong/viem-go/utils/signature"
 	utiltx "github.com/ChefBingbong/viem-go/utils/transaction"
@@ -79,6 +88,23 @@ func (a *mockTransactionSignableAccount) SignTransaction(tx *utiltx.Transaction)
 	return a.signFn(tx)
 }
 
+// mockKzg is a mock KZG implementation for testing.
+type mockKzg struct{}
+
+func (m *mockKzg) BlobToKzgCommitment(b []byte) ([]byte, error) {
+	hash := sha256.Sum256(b)
+	commitment := make([]byte, 48)
+	copy(commitment, hash[:])
+	return commitment, nil
+}
+
+func (m *mockKzg) ComputeBlobKzgProof(b []byte, commitment []byte) ([]byte, error) {
+	hash := sha256.Sum256(append(b, commitment...))
+	proof := make([]byte, 48)
+	copy(proof, hash[:])
+	return proof, nil
+}
+
 // mockAuthorizationSignableAccount implements wallet.AuthorizationSignableAccount.
 type mockAuthorizationSignableAccount struct {
 	address common.Address
@@ -430,6 +456,87 @@ func TestSendTransaction_WithMaxFeeAndPriority(t *testing.T) {
 	assert.NotEmpty(t, hash)
 }
 
+func TestSendTransaction_ExplicitTypeConflict(t *testing.T) {
+	var sent bool
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			sent = true
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:  &mockAccount{address: sourceAddr},
+		To:       targetAddr.Hex(),
+		Type:     formatters.TransactionTypeEIP1559,
+		GasPrice: big.NewInt(20000000000),
+	})
+	require.ErrorIs(t, err, utiltx.ErrConflictingTransactionFields)
+	assert.False(t, sent)
+
+	_, err = wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:      &mockAccount{address: sourceAddr},
+		To:           targetAddr.Hex(),
+		GasPrice:     big.NewInt(20000000000),
+		MaxFeePerGas: big.NewInt(50000000000),
+	})
+	require.ErrorIs(t, err, utiltx.ErrConflictingTransactionFields)
+	assert.False(t, sent)
+}
+
+func TestPrepareTransactionRequest_ExplicitType(t *testing.T) {
+	var methods []string
+	server := createTestServer(t, func(method string, params []any) any {
+		methods = append(methods, method)
+		switch method {
+		case "eth_gasPrice":
+			return "0x4a817c800" // 20 gwei
+		case "eth_getBlockByNumber":
+			return map[string]any{
+				"number":        "0x10",
+				"baseFeePerGas": "0x3b9aca00",
+				"hash":          "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"transactions":  []string{},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	// The latest block has a base fee, but the explicit type is honored
+	prepared, err := wallet.PrepareTransactionRequest(context.Background(), client, wallet.PrepareTransactionRequestParameters{
+		Account:    &mockAccount{address: sourceAddr},
+		To:         targetAddr.Hex(),
+		Type:       formatters.TransactionTypeLegacy,
+		Parameters: []string{"type", "fees"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, formatters.TransactionTypeLegacy, prepared.Type)
+	assert.Equal(t, big.NewInt(24000000000), prepared.GasPrice) // eth_gasPrice * default 1.2 multiplier
+	assert.Nil(t, prepared.MaxFeePerGas)
+	assert.NotContains(t, methods, "eth_maxPriorityFeePerGas")
+
+	_, err = wallet.PrepareTransactionRequest(context.Background(), client, wallet.PrepareTransactionRequestParameters{
+		Account:      &mockAccount{address: sourceAddr},
+		To:           targetAddr.Hex(),
+		Type:         formatters.TransactionTypeLegacy,
+		MaxFeePerGas: big.NewInt(50000000000),
+		Parameters:   []string{"type", "fees"},
+	})
+	require.ErrorIs(t, err, utiltx.ErrConflictingTransactionFields)
+}
+
 func TestSendTransaction_WithNonce(t *testing.T) {
 	server := createTestServer(t, func(method string, params []any) any {
 		switch method {
@@ -458,6 +565,132 @@ func TestSendTransaction_WithNonce(t *testing.T) {
 	assert.NotEmpty(t, hash)
 }
 
+// autoAccessListServer answers eth_createAccessList with a single-slot list and
+// eth_estimateGas with gasWith or gasWithout depending on whether the request
+// carries an access list. The eth_sendTransaction request is captured.
+func autoAccessListServer(t *testing.T, gasWithout, gasWith string, sent *map[string]any, methods *[]string) *httptest.Server {
+	return createTestServer(t, func(method string, params []any) any {
+		*methods = append(*methods, method)
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_createAccessList":
+			return map[string]any{
+				"accessList": []any{map[string]any{
+					"address":     targetAddr.Hex(),
+					"storageKeys": []any{"0x0000000000000000000000000000000000000000000000000000000000000001"},
+				}},
+				"gasUsed": "0x6000",
+			}
+		case "eth_estimateGas":
+			if _, ok := params[0].(map[string]any)["accessList"]; ok {
+				return gasWith
+			}
+			return gasWithout
+		case "eth_sendTransaction":
+			*sent = params[0].(map[string]any)
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+}
+
+func TestSendTransaction_AutoAccessList(t *testing.T) {
+	var sent map[string]any
+	var methods []string
+	server := autoAccessListServer(t, "0x7000", "0x6800", &sent, &methods)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:        &mockAccount{address: sourceAddr},
+		To:             targetAddr.Hex(),
+		Data:           "0xa9059cbb",
+		AutoAccessList: true,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, methods, "eth_createAccessList")
+	require.NotNil(t, sent)
+	accessList, ok := sent["accessList"].([]any)
+	require.True(t, ok, "access list should be attached")
+	require.Len(t, accessList, 1)
+	entry := accessList[0].(map[string]any)
+	assert.True(t, strings.EqualFold(targetAddr.Hex(), entry["address"].(string)))
+	assert.Equal(t, []any{"0x0000000000000000000000000000000000000000000000000000000000000001"}, entry["storageKeys"])
+}
+
+func TestSendTransaction_AutoAccessListSkippedWhenNotCheaper(t *testing.T) {
+	var sent map[string]any
+	var methods []string
+	server := autoAccessListServer(t, "0x6800", "0x7000", &sent, &methods)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:        &mockAccount{address: sourceAddr},
+		To:             targetAddr.Hex(),
+		Data:           "0xa9059cbb",
+		AutoAccessList: true,
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, methods, "eth_createAccessList")
+	require.NotNil(t, sent)
+	assert.NotContains(t, sent, "accessList")
+}
+
+func TestSendTransaction_AutoAccessListKeepsExplicitList(t *testing.T) {
+	var sent map[string]any
+	var methods []string
+	server := autoAccessListServer(t, "0x7000", "0x6800", &sent, &methods)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	explicit := []formatters.AccessListItem{{Address: sourceAddr.Hex(), StorageKeys: []string{}}}
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:        &mockAccount{address: sourceAddr},
+		To:             targetAddr.Hex(),
+		AccessList:     explicit,
+		AutoAccessList: true,
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, methods, "eth_createAccessList")
+	accessList := sent["accessList"].([]any)
+	require.Len(t, accessList, 1)
+	assert.True(t, strings.EqualFold(sourceAddr.Hex(), accessList[0].(map[string]any)["address"].(string)))
+}
+
+func TestSendTransaction_AutoAccessListSkippedForGasPriceOnly(t *testing.T) {
+	var sent map[string]any
+	var methods []string
+	server := autoAccessListServer(t, "0x7000", "0x6800", &sent, &methods)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:        &mockAccount{address: sourceAddr},
+		To:             targetAddr.Hex(),
+		Data:           "0xa9059cbb",
+		GasPrice:       big.NewInt(1000000000),
+		AutoAccessList: true,
+	})
+	require.NoError(t, err)
+
+	assert.NotContains(t, methods, "eth_createAccessList")
+	require.NotNil(t, sent)
+	assert.NotContains(t, sent, "accessList")
+}
+
 func TestSendTransaction_LocalAccount(t *testing.T) {
 	var capturedMethod string
 	server := createTestServer(t, func(method string, params []any) any {
@@ -496,30 +729,409 @@ func TestSendTransaction_LocalAccount(t *testing.T) {
 	localAccount := &mockTransactionSignableAccount{
 		address: sourceAddr,
 		signFn: func(tx *utiltx.Transaction) (string, error) {
-			return "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33", nil
+			return "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33", nil
+		},
+	}
+
+	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+		Account: localAccount,
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1000000000000000000),
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	// Local account path should end with eth_sendRawTransaction
+	assert.Equal(t, "eth_sendRawTransaction", capturedMethod)
+}
+
+// dryRunTestServer answers the requests needed to prepare a transaction and
+// counts any attempt to send one.
+func dryRunTestServer(t *testing.T, sends *atomic.Int32) *httptest.Server {
+	return createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x7"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			return "0x5208"
+		case "eth_sendTransaction", "wallet_sendTransaction", "eth_sendRawTransaction":
+			sends.Add(1)
+			return "0x" + strings.Repeat("ab", 32)
+		}
+		return nil
+	})
+}
+
+func TestSendTransaction_DryRunLocalAccount(t *testing.T) {
+	var sends atomic.Int32
+	server := dryRunTestServer(t, &sends)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	const serializedTx = "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33"
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return serializedTx, nil
+		},
+	}
+
+	result, err := wallet.SendTransactionDetailed(context.Background(), client, wallet.SendTransactionParameters{
+		Account: localAccount,
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+		DryRun:  true,
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, sends.Load())
+	assert.Equal(t, serializedTx, result.SerializedTransaction)
+	assert.Equal(t, crypto.Keccak256Hash(common.FromHex(serializedTx)).Hex(), result.Hash)
+	require.NotNil(t, result.Request.Nonce)
+	assert.Equal(t, 7, *result.Request.Nonce)
+	require.NotNil(t, signed)
+	assert.Equal(t, 7, signed.Nonce)
+
+	// SendTransaction reports the hash the transaction would have.
+	hash, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account: localAccount,
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+		DryRun:  true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, result.Hash, hash)
+	assert.Zero(t, sends.Load())
+}
+
+func TestSendTransaction_DryRunJSONRPCAccount(t *testing.T) {
+	var sends atomic.Int32
+	server := dryRunTestServer(t, &sends)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	result, err := wallet.SendTransactionDetailed(context.Background(), client, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+		DryRun:  true,
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, sends.Load())
+	assert.Empty(t, result.Hash)
+	assert.Empty(t, result.SerializedTransaction)
+	require.NotNil(t, result.Request.Nonce)
+	assert.Equal(t, 7, *result.Request.Nonce)
+	assert.Equal(t, big.NewInt(21000), result.Request.Gas)
+}
+
+func TestSendTransaction_DryRunReturnsManagedNonce(t *testing.T) {
+	var sends atomic.Int32
+	server := dryRunTestServer(t, &sends)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	var nonces []int
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			nonces = append(nonces, tx.Nonce)
+			return "0x02", nil
+		},
+	}
+
+	nonceManager := accounts.NewNonceManager()
+	for i := 0; i < 2; i++ {
+		_, err := wallet.SendTransactionDetailed(context.Background(), client, wallet.SendTransactionParameters{
+			Account:      localAccount,
+			NonceManager: nonceManager,
+			To:           targetAddr.Hex(),
+			DryRun:       true,
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int{7, 7}, nonces)
+	assert.Zero(t, sends.Load())
+}
+
+func TestSendTransaction_DataSuffix(t *testing.T) {
+	var capturedParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			capturedParams = params
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.dataSuffix = []byte{0x12, 0x34, 0x56, 0x78}
+	ctx := context.Background()
+
+	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+		Data:    "0xdeadbeef",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	require.NotEmpty(t, capturedParams)
+}
+
+func TestSendTransaction_ParamDataSuffixOverridesClient(t *testing.T) {
+	var capturedParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			capturedParams = params
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.dataSuffix = []byte{0xAA, 0xBB}
+	ctx := context.Background()
+
+	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+		Account:    &mockAccount{address: sourceAddr},
+		To:         targetAddr.Hex(),
+		Data:       "0xdeadbeef",
+		DataSuffix: "0xccdd",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+	require.NotEmpty(t, capturedParams)
+}
+
+func nonceManagerTestServer(t *testing.T, transactionCount *int) *httptest.Server {
+	t.Helper()
+	return createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			*transactionCount++
+			return "0x0"
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_getBlockByNumber":
+			return map[string]any{
+				"number":        "0x10",
+				"baseFeePerGas": "0x3b9aca00",
+				"gasLimit":      "0x1c9c380",
+				"gasUsed":       "0x0",
+				"timestamp":     "0x60000000",
+				"hash":          "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"parentHash":    "0x0000000000000000000000000000000000000000000000000000000000000000",
+				"transactions":  []string{},
+			}
+		case "eth_estimateGas":
+			return "0x5208"
+		case "eth_sendRawTransaction":
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		}
+		return nil
+	})
+}
+
+func TestSendTransaction_NonceManager(t *testing.T) {
+	var transactionCount int
+	server := nonceManagerTestServer(t, &transactionCount)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	ctx := context.Background()
+
+	var nonces []int
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			nonces = append(nonces, tx.Nonce)
+			return "0x02", nil
+		},
+	}
+
+	nonceManager := accounts.NewNonceManager()
+	for i := 0; i < 3; i++ {
+		_, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+			Account:      localAccount,
+			NonceManager: nonceManager,
+			To:           targetAddr.Hex(),
+			Value:        big.NewInt(1),
+		})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, []int{0, 1, 2}, nonces)
+	assert.Equal(t, 1, transactionCount)
+}
+
+func TestSendTransaction_NonceManagerResetsOnError(t *testing.T) {
+	var transactionCount int
+	server := nonceManagerTestServer(t, &transactionCount)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	ctx := context.Background()
+
+	var nonces []int
+	failSign := true
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			nonces = append(nonces, tx.Nonce)
+			if failSign {
+				return "", errors.New("signer unavailable")
+			}
+			return "0x02", nil
+		},
+	}
+
+	nonceManager := accounts.NewNonceManager()
+	params := wallet.SendTransactionParameters{
+		Account:      localAccount,
+		NonceManager: nonceManager,
+		To:           targetAddr.Hex(),
+		Value:        big.NewInt(1),
+	}
+
+	_, err := wallet.SendTransaction(ctx, client, params)
+	require.Error(t, err)
+
+	failSign = false
+	_, err = wallet.SendTransaction(ctx, client, params)
+	require.NoError(t, err)
+
+	// The failed send must not leave a gap: the nonce is resynced and reused.
+	assert.Equal(t, []int{0, 0}, nonces)
+	assert.Equal(t, 2, transactionCount)
+}
+
+func TestSendTransaction_NonceManagerResetsOnPrepareErrorWithoutChain(t *testing.T) {
+	var transactionCount int
+	failEstimate := true
+	inner := nonceManagerTestServer(t, &transactionCount)
+	defer inner.Close()
+	innerClient := createMockClient(t, inner.URL)
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_estimateGas" && failEstimate {
+			return "not-a-quantity"
+		}
+		resp, err := innerClient.Request(context.Background(), method, params...)
+		require.NoError(t, err)
+		return resp.Result
+	})
+	defer server.Close()
+
+	// No chain configured: the chain ID comes from eth_chainId.
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	var nonces []int
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			nonces = append(nonces, tx.Nonce)
+			return "0x02", nil
+		},
+	}
+
+	nonceManager := accounts.NewNonceManager()
+	params := wallet.SendTransactionParameters{
+		Account:      localAccount,
+		NonceManager: nonceManager,
+		To:           targetAddr.Hex(),
+		Value:        big.NewInt(1),
+	}
+
+	_, err := wallet.SendTransaction(ctx, client, params)
+	require.Error(t, err)
+
+	failEstimate = false
+	_, err = wallet.SendTransaction(ctx, client, params)
+	require.NoError(t, err)
+
+	// The nonce consumed by the failed prepare is released and reused.
+	assert.Equal(t, []int{0}, nonces)
+	assert.Equal(t, 2, transactionCount)
+}
+
+func TestSendTransaction_LocalAccountBlobs(t *testing.T) {
+	var transactionCount int
+	server := nonceManagerTestServer(t, &transactionCount)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	ctx := context.Background()
+
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return "0x03", nil
 		},
 	}
 
-	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
-		Account: localAccount,
-		To:      targetAddr.Hex(),
-		Value:   big.NewInt(1000000000000000000),
+	blobs, err := blob.ToBlobsHex([]byte("hello world"))
+	require.NoError(t, err)
+
+	_, err = wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
+		Account:          localAccount,
+		To:               targetAddr.Hex(),
+		Blobs:            blobs,
+		Kzg:              &mockKzg{},
+		MaxFeePerBlobGas: big.NewInt(1000000000),
 	})
+	require.NoError(t, err)
+
+	require.NotNil(t, signed)
+	assert.Equal(t, utiltx.TransactionTypeEIP4844, signed.Type)
+	require.Len(t, signed.Sidecars, 1)
+	require.Len(t, signed.BlobVersionedHashes, 1)
 
+	commitment, err := encoding.HexToBytes(signed.Sidecars[0].Commitment)
 	require.NoError(t, err)
-	assert.NotEmpty(t, hash)
-	// Local account path should end with eth_sendRawTransaction
-	assert.Equal(t, "eth_sendRawTransaction", capturedMethod)
+	assert.Equal(t, blob.CommitmentToVersionedHashHex(commitment, utiltx.VersionedHashVersionKzg), signed.BlobVersionedHashes[0])
 }
 
-func TestSendTransaction_DataSuffix(t *testing.T) {
-	var capturedParams []any
+func TestSendTransaction_LocalAccountEIP7702(t *testing.T) {
+	var rawTx string
 	server := createTestServer(t, func(method string, params []any) any {
 		switch method {
-		case "eth_chainId":
-			return "0x1"
-		case "eth_sendTransaction":
-			capturedParams = params
+		case "eth_sendRawTransaction":
+			rawTx = params[0].(string)
 			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
 		}
 		return nil
@@ -528,28 +1140,106 @@ func TestSendTransaction_DataSuffix(t *testing.T) {
 
 	client := createMockClient(t, server.URL)
 	client.chain = testChain(1)
-	client.dataSuffix = []byte{0x12, 0x34, 0x56, 0x78}
-	ctx := context.Background()
 
-	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
-		Account: &mockAccount{address: sourceAddr},
-		To:      targetAddr.Hex(),
-		Data:    "0xdeadbeef",
+	account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.NoError(t, err)
+
+	delegate := "0x5FbDB2315678afecb367f032d93F642f64180aa3"
+	auth, err := account.SignAuthorization(types.AuthorizationRequest{Address: delegate, ChainId: 1, Nonce: 1})
+	require.NoError(t, err)
+
+	nonce := 0
+	_, err = wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account:              account,
+		AuthorizationList:    utiltx.NewSignedAuthorizationList(*auth),
+		Gas:                  big.NewInt(100000),
+		MaxFeePerGas:         big.NewInt(2000000000),
+		MaxPriorityFeePerGas: big.NewInt(1000000000),
+		Nonce:                &nonce,
+		To:                   account.Address().Hex(),
+		Type:                 formatters.TransactionTypeEIP7702,
+		AssertChainID:        new(bool),
 	})
+	require.NoError(t, err)
+
+	// The raw transaction is a type-0x04 set-code transaction
+	require.True(t, strings.HasPrefix(rawTx, "0x04"), rawTx)
+
+	// Decoding is strict, so this also checks that integers (including the
+	// authorization r/s) are canonically encoded.
+	var tx setCodeTxRLP
+	require.NoError(t, rlp.DecodeBytes(common.FromHex(rawTx)[1:], &tx))
+	assert.Equal(t, uint64(1), tx.ChainID.Uint64())
+	assert.Equal(t, account.Address(), tx.To)
+
+	require.Len(t, tx.AuthList, 1)
+	assert.Equal(t, uint64(1), tx.AuthList[0].ChainID.Uint64())
+	assert.Equal(t, common.HexToAddress(delegate), tx.AuthList[0].Address)
+	assert.Equal(t, uint64(1), tx.AuthList[0].Nonce)
+
+	// The authorization and the transaction are both signed by the account
+	authHash := crypto.Keccak256(append([]byte{0x05}, mustRLP(t, []any{tx.AuthList[0].ChainID, tx.AuthList[0].Address, tx.AuthList[0].Nonce})...))
+	assert.Equal(t, account.Address(), recoverSigner(t, authHash, tx.AuthList[0].V, tx.AuthList[0].R, tx.AuthList[0].S))
+
+	txHash := crypto.Keccak256(append([]byte{0x04}, mustRLP(t, []any{
+		tx.ChainID, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, tx.Value, tx.Data, tx.AccessList, tx.AuthList,
+	})...))
+	assert.Equal(t, account.Address(), recoverSigner(t, txHash, tx.V, tx.R, tx.S))
+}
+
+// setCodeTxRLP is the RLP layout of a signed EIP-7702 transaction payload.
+type setCodeTxRLP struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList []struct {
+		Address     common.Address
+		StorageKeys []common.Hash
+	}
+	AuthList []struct {
+		ChainID *big.Int
+		Address common.Address
+		Nonce   uint64
+		V       uint8
+		R       *big.Int
+		S       *big.Int
+	}
+	V uint8
+	R *big.Int
+	S *big.Int
+}
 
+func mustRLP(t *testing.T, v any) []byte {
+	t.Helper()
+	encoded, err := rlp.EncodeToBytes(v)
 	require.NoError(t, err)
-	assert.NotEmpty(t, hash)
-	require.NotEmpty(t, capturedParams)
+	return encoded
 }
 
-func TestSendTransaction_ParamDataSuffixOverridesClient(t *testing.T) {
-	var capturedParams []any
+func recoverSigner(t *testing.T, hash []byte, v uint8, r, s *big.Int) common.Address {
+	t.Helper()
+	sig := make([]byte, 65)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = v
+	pub, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(*pub)
+}
+
+func TestSendTransaction_AuthorizationChainMismatch(t *testing.T) {
+	var sent bool
 	server := createTestServer(t, func(method string, params []any) any {
 		switch method {
 		case "eth_chainId":
 			return "0x1"
 		case "eth_sendTransaction":
-			capturedParams = params
+			sent = true
 			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
 		}
 		return nil
@@ -558,19 +1248,18 @@ func TestSendTransaction_ParamDataSuffixOverridesClient(t *testing.T) {
 
 	client := createMockClient(t, server.URL)
 	client.chain = testChain(1)
-	client.dataSuffix = []byte{0xAA, 0xBB}
-	ctx := context.Background()
 
-	hash, err := wallet.SendTransaction(ctx, client, wallet.SendTransactionParameters{
-		Account:    &mockAccount{address: sourceAddr},
-		To:         targetAddr.Hex(),
-		Data:       "0xdeadbeef",
-		DataSuffix: "0xccdd",
+	_, err := wallet.SendTransaction(context.Background(), client, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+		AuthorizationList: []utiltx.SignedAuthorization{{
+			Authorization: utiltx.Authorization{Address: targetAddr.Hex(), ChainId: 10},
+			R:             "0x01",
+			S:             "0x01",
+		}},
 	})
-
-	require.NoError(t, err)
-	assert.NotEmpty(t, hash)
-	require.NotEmpty(t, capturedParams)
+	require.ErrorIs(t, err, utiltx.ErrInvalidChainId)
+	assert.False(t, sent)
 }
 
 // ============================================================================
@@ -1065,6 +1754,110 @@ func TestSignTypedData_InvalidPrimaryType(t *testing.T) {
 	assert.Contains(t, err.Error(), "invalid primary type")
 }
 
+func TestSignTypedData_SaltOnlyDomain(t *testing.T) {
+	account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.NoError(t, err)
+
+	client := &mockClient{}
+	ctx := context.Background()
+	params := wallet.SignTypedDataParameters{
+		Account: account,
+		Domain: signature.TypedDataDomain{
+			Salt: "0x0000000000000000000000000000000000000000000000000000000000000001",
+		},
+		Types:       map[string][]signature.TypedDataField{"Test": {{Name: "value", Type: "uint256"}}},
+		PrimaryType: "Test",
+		Message:     map[string]any{"value": big.NewInt(1)},
+	}
+
+	sig, err := wallet.SignTypedData(ctx, client, params)
+	require.NoError(t, err)
+
+	valid, err := signature.VerifyTypedData(account.Address().Hex(), signature.TypedDataDefinition{
+		Domain:      params.Domain,
+		Types:       params.Types,
+		PrimaryType: params.PrimaryType,
+		Message:     params.Message,
+	}, sig)
+	require.NoError(t, err)
+	assert.True(t, valid)
+}
+
+func TestSignTypedData_InvalidSalt(t *testing.T) {
+	client := &mockClient{}
+	ctx := context.Background()
+
+	_, err := wallet.SignTypedData(ctx, client, wallet.SignTypedDataParameters{
+		Account:     &mockAccount{address: sourceAddr},
+		Domain:      signature.TypedDataDomain{Name: "Test", Salt: "0x1234"},
+		Types:       map[string][]signature.TypedDataField{"Test": {{Name: "value", Type: "uint256"}}},
+		PrimaryType: "Test",
+		Message:     map[string]any{"value": big.NewInt(1)},
+	})
+
+	var fieldErr *signature.InvalidDomainFieldError
+	require.ErrorAs(t, err, &fieldErr)
+	assert.Equal(t, "salt", fieldErr.Field)
+}
+
+func TestSignTypedData_DefaultsDomainChainID(t *testing.T) {
+	var signed signature.TypedDataDefinition
+	localAccount := &mockTypedDataSignableAccount{
+		address: sourceAddr,
+		signFn: func(data signature.TypedDataDefinition) (string, error) {
+			signed = data
+			return "0xsig", nil
+		},
+	}
+
+	client := &mockClient{chain: testChain(10)}
+	ctx := context.Background()
+
+	_, err := wallet.SignTypedData(ctx, client, wallet.SignTypedDataParameters{
+		Account:     localAccount,
+		Domain:      signature.TypedDataDomain{Name: "Test", Version: "1"},
+		Types:       map[string][]signature.TypedDataField{"Test": {{Name: "value", Type: "uint256"}}},
+		PrimaryType: "Test",
+		Message:     map[string]any{"value": big.NewInt(1)},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, signed.Domain.ChainId)
+	assert.Equal(t, int64(10), signed.Domain.ChainId.Int64())
+	assert.Contains(t, signed.Types["EIP712Domain"], signature.TypedDataField{Name: "chainId", Type: "uint256"})
+}
+
+func TestSignTypedData_DomainChainIDMismatch(t *testing.T) {
+	localAccount := &mockTypedDataSignableAccount{
+		address: sourceAddr,
+		signFn: func(data signature.TypedDataDefinition) (string, error) {
+			return "0xsig", nil
+		},
+	}
+
+	client := &mockClient{chain: testChain(10)}
+	ctx := context.Background()
+	params := wallet.SignTypedDataParameters{
+		Account:     localAccount,
+		Domain:      signature.TypedDataDomain{Name: "Test", ChainId: big.NewInt(1)},
+		Types:       map[string][]signature.TypedDataField{"Test": {{Name: "value", Type: "uint256"}}},
+		PrimaryType: "Test",
+		Message:     map[string]any{"value": big.NewInt(1)},
+	}
+
+	_, err := wallet.SignTypedData(ctx, client, params)
+	var mismatch *chain.ChainMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, int64(1), mismatch.CurrentChainID)
+	assert.Equal(t, int64(10), mismatch.Chain.ID)
+
+	assertChainID := false
+	params.AssertChainID = &assertChainID
+	sig, err := wallet.SignTypedData(ctx, client, params)
+	require.NoError(t, err)
+	assert.Equal(t, "0xsig", sig)
+}
+
 // ============================================================================
 // WriteContract Tests
 // ============================================================================
@@ -1152,18 +1945,304 @@ func TestWriteContract_WithValue(t *testing.T) {
 	client.chain = testChain(1)
 	ctx := context.Background()
 
-	abiJSON := `[{"inputs":[],"name":"mint","outputs":[],"stateMutability":"payable","type":"function"}]`
+	abiJSON := `[{"inputs":[],"name":"mint","outputs":[],"stateMutability":"payable","type":"function"}]`
+
+	hash, err := wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
+		Account:      &mockAccount{address: sourceAddr},
+		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:          abiJSON,
+		FunctionName: "mint",
+		Value:        big.NewInt(1000000000000000000), // 1 ETH
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, hash)
+}
+
+// writeContractLocalServer serves the RPC calls made by a local-account
+// WriteContract and records the eth_estimateGas request, if any.
+func writeContractLocalServer(t *testing.T, estimateRequest *map[string]any) *httptest.Server {
+	return createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x3"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			*estimateRequest = params[0].(map[string]any)
+			return "0x15f90" // 90000
+		case "eth_sendRawTransaction":
+			return "0xwritehash000000000000000000000000000000000000000000000000000003"
+		}
+		return nil
+	})
+}
+
+func TestWriteContract_LocalAccountEstimatesGas(t *testing.T) {
+	var estimateRequest map[string]any
+	server := writeContractLocalServer(t, &estimateRequest)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return "0x02", nil
+		},
+	}
+
+	abiJSON := `[{"inputs":[{"name":"tokenId","type":"uint32"}],"name":"mint","outputs":[],"stateMutability":"payable","type":"function"}]`
+	_, err := wallet.WriteContract(context.Background(), client, wallet.WriteContractParameters{
+		Account:      localAccount,
+		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:          abiJSON,
+		FunctionName: "mint",
+		Args:         []any{uint32(69420)},
+		Value:        big.NewInt(1000),
+	})
+	require.NoError(t, err)
+
+	// mint(69420) calldata and the payable value are estimated against
+	require.NotNil(t, estimateRequest)
+	assert.Equal(t, "0xa71bbebe"+"0000000000000000000000000000000000000000000000000000000000010f2c", estimateRequest["data"])
+	assert.Equal(t, "0x3e8", estimateRequest["value"])
+	assert.Equal(t, sourceAddr.Hex(), common.HexToAddress(estimateRequest["from"].(string)).Hex())
+
+	require.NotNil(t, signed)
+	assert.Equal(t, big.NewInt(90000), signed.Gas)
+	assert.Equal(t, 3, signed.Nonce)
+	assert.NotNil(t, signed.MaxFeePerGas)
+}
+
+func TestWriteContract_LocalAccountSuppliedGasAndFees(t *testing.T) {
+	var estimateRequest map[string]any
+	server := writeContractLocalServer(t, &estimateRequest)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return "0x02", nil
+		},
+	}
+
+	abiJSON := `[{"inputs":[],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	_, err := wallet.WriteContract(context.Background(), client, wallet.WriteContractParameters{
+		Account:              localAccount,
+		Address:              "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:                  abiJSON,
+		FunctionName:         "mint",
+		Gas:                  big.NewInt(123_456),
+		MaxFeePerGas:         big.NewInt(50_000_000_000),
+		MaxPriorityFeePerGas: big.NewInt(2_000_000_000),
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, estimateRequest, "supplied gas must skip eth_estimateGas")
+	require.NotNil(t, signed)
+	assert.Equal(t, big.NewInt(123_456), signed.Gas)
+	assert.Equal(t, big.NewInt(50_000_000_000), signed.MaxFeePerGas)
+	assert.Equal(t, big.NewInt(2_000_000_000), signed.MaxPriorityFeePerGas)
+}
+
+func TestWriteContractDetailed_Default(t *testing.T) {
+	var sent map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_getTransactionCount":
+			return "0x7"
+		case "eth_getBlockByNumber":
+			return map[string]any{"number": "0x10", "baseFeePerGas": "0x3b9aca00"}
+		case "eth_maxPriorityFeePerGas":
+			return "0x3b9aca00"
+		case "eth_estimateGas":
+			return "0x15f90"
+		case "eth_sendTransaction":
+			sent = params[0].(map[string]any)
+			return "0xwritehash000000000000000000000000000000000000000000000000000004"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	abiJSON := `[{"inputs":[{"name":"tokenId","type":"uint32"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	result, err := wallet.WriteContractDetailed(context.Background(), client, wallet.WriteContractParameters{
+		Account:      &mockAccount{address: sourceAddr},
+		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:          abiJSON,
+		FunctionName: "mint",
+		Args:         []any{uint32(69420)},
+		DataSuffix:   "0xdeadbeef",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xwritehash000000000000000000000000000000000000000000000000000004", result.Hash)
+
+	calldata := "0xa71bbebe" + "0000000000000000000000000000000000000000000000000000000000010f2c" + "deadbeef"
+	request := result.Request
+	require.NotNil(t, request)
+	require.NotNil(t, request.Nonce)
+	assert.Equal(t, 7, *request.Nonce)
+	assert.Equal(t, calldata, request.Data)
+	assert.Equal(t, "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2", request.To)
+	assert.Equal(t, sourceAddr.Hex(), request.From)
+	require.NotNil(t, request.ChainID)
+	assert.Equal(t, int64(1), *request.ChainID)
+	assert.Equal(t, big.NewInt(90000), request.Gas)
+	assert.NotNil(t, request.MaxFeePerGas)
+	assert.NotNil(t, request.MaxPriorityFeePerGas)
+
+	// The node is sent the same request
+	require.NotNil(t, sent)
+	assert.Equal(t, "0x7", sent["nonce"])
+	assert.Equal(t, calldata, sent["data"])
+	assert.Equal(t, "0x15f90", sent["gas"])
+	assert.Equal(t, encoding.NumberToHex(request.MaxFeePerGas), sent["maxFeePerGas"])
+}
+
+func TestWriteContractDetailed_LocalAccount(t *testing.T) {
+	var estimateRequest map[string]any
+	server := writeContractLocalServer(t, &estimateRequest)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	var signed *utiltx.Transaction
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signed = tx
+			return "0x02", nil
+		},
+	}
+
+	abiJSON := `[{"inputs":[{"name":"tokenId","type":"uint32"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	result, err := wallet.WriteContractDetailed(context.Background(), client, wallet.WriteContractParameters{
+		Account:      localAccount,
+		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:          abiJSON,
+		FunctionName: "mint",
+		Args:         []any{uint32(69420)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xwritehash000000000000000000000000000000000000000000000000000003", result.Hash)
+
+	request := result.Request
+	require.NotNil(t, request.Nonce)
+	assert.Equal(t, 3, *request.Nonce)
+	assert.Equal(t, "0xa71bbebe"+"0000000000000000000000000000000000000000000000000000000000010f2c", request.Data)
+	assert.Equal(t, formatters.TransactionTypeEIP1559, request.Type)
+
+	// The signed transaction matches the returned request
+	require.NotNil(t, signed)
+	assert.Equal(t, *request.Nonce, signed.Nonce)
+	assert.Equal(t, request.Gas, signed.Gas)
+	assert.Equal(t, request.MaxFeePerGas, signed.MaxFeePerGas)
+	assert.Equal(t, request.MaxPriorityFeePerGas, signed.MaxPriorityFeePerGas)
+}
+
+func TestWriteContractDetailed_DryRun(t *testing.T) {
+	var sends atomic.Int32
+	server := dryRunTestServer(t, &sends)
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	const serializedTx = "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33"
+	var signedData string
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			signedData = tx.Data
+			return serializedTx, nil
+		},
+	}
+
+	abiJSON := `[{"inputs":[{"name":"tokenId","type":"uint32"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	result, err := wallet.WriteContractDetailed(context.Background(), client, wallet.WriteContractParameters{
+		Account:      localAccount,
+		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+		ABI:          abiJSON,
+		FunctionName: "mint",
+		Args:         []any{uint32(69420)},
+		DryRun:       true,
+	})
+
+	require.NoError(t, err)
+	assert.Zero(t, sends.Load())
+	assert.Equal(t, serializedTx, result.SerializedTransaction)
+	assert.Equal(t, crypto.Keccak256Hash(common.FromHex(serializedTx)).Hex(), result.Hash)
+	assert.Equal(t, "0xa71bbebe"+"0000000000000000000000000000000000000000000000000000000000010f2c", signedData)
+}
+
+// nonceManagerClient is a mockClient with a default nonce manager.
+type nonceManagerClient struct {
+	*mockClient
+	nonceManager wallet.NonceManager
+}
+
+func (c *nonceManagerClient) NonceManager() wallet.NonceManager { return c.nonceManager }
+
+func TestWriteContractDetailed_NonceManagerResetsOnError(t *testing.T) {
+	var transactionCount int
+	server := nonceManagerTestServer(t, &transactionCount)
+	defer server.Close()
+
+	inner := createMockClient(t, server.URL)
+	inner.chain = testChain(1)
+	client := &nonceManagerClient{mockClient: inner, nonceManager: accounts.NewNonceManager()}
 
-	hash, err := wallet.WriteContract(ctx, client, wallet.WriteContractParameters{
-		Account:      &mockAccount{address: sourceAddr},
+	var nonces []int
+	failSign := true
+	localAccount := &mockTransactionSignableAccount{
+		address: sourceAddr,
+		signFn: func(tx *utiltx.Transaction) (string, error) {
+			nonces = append(nonces, tx.Nonce)
+			if failSign {
+				return "", errors.New("signer unavailable")
+			}
+			return "0x02", nil
+		},
+	}
+
+	abiJSON := `[{"inputs":[],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+	params := wallet.WriteContractParameters{
+		Account:      localAccount,
 		Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
 		ABI:          abiJSON,
 		FunctionName: "mint",
-		Value:        big.NewInt(1000000000000000000), // 1 ETH
-	})
+	}
+
+	_, err := wallet.WriteContractDetailed(context.Background(), client, params)
+	require.Error(t, err)
 
+	failSign = false
+	result, err := wallet.WriteContractDetailed(context.Background(), client, params)
 	require.NoError(t, err)
-	assert.NotEmpty(t, hash)
+
+	// The nonce consumed for the failed write is resynced and reused
+	assert.Equal(t, 0, *result.Request.Nonce)
+	assert.Equal(t, []int{0, 0}, nonces)
+	assert.Equal(t, 2, transactionCount)
 }
 
 // ============================================================================
@@ -1659,6 +2738,78 @@ func TestSendCalls_DefaultVersion(t *testing.T) {
 	}
 }
 
+func TestSendCalls_PaymasterService(t *testing.T) {
+	var capturedParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "wallet_sendCalls" {
+			capturedParams = params
+			return map[string]any{"id": "0x123"}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.account = &mockAccount{address: sourceAddr}
+	ctx := context.Background()
+
+	capabilities := map[string]any{"atomic": map[string]any{"optional": true}}
+	_, err := wallet.SendCalls(ctx, client, wallet.SendCallsParameters{
+		Calls:        []wallet.Call{{To: targetAddr.Hex()}},
+		Capabilities: capabilities,
+		PaymasterService: &wallet.PaymasterService{
+			URL:     "https://paymaster.example.com",
+			Context: map[string]any{"policyId": "abc"},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, capturedParams, 1)
+	paramMap, ok := capturedParams[0].(map[string]any)
+	require.True(t, ok)
+	sent, ok := paramMap["capabilities"].(map[string]any)
+	require.True(t, ok, "capabilities missing from wallet_sendCalls params")
+	assert.Equal(t, map[string]any{"optional": true}, sent["atomic"])
+	assert.Equal(t, map[string]any{
+		"url":     "https://paymaster.example.com",
+		"context": map[string]any{"policyId": "abc"},
+	}, sent["paymasterService"])
+
+	// The caller's map is not modified.
+	assert.NotContains(t, capabilities, "paymasterService")
+}
+
+func TestSendCalls_PaymasterServiceCapabilityTakesPrecedence(t *testing.T) {
+	var capturedParams []any
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "wallet_sendCalls" {
+			capturedParams = params
+			return map[string]any{"id": "0x123"}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	client.account = &mockAccount{address: sourceAddr}
+	ctx := context.Background()
+
+	_, err := wallet.SendCalls(ctx, client, wallet.SendCallsParameters{
+		Calls: []wallet.Call{{To: targetAddr.Hex()}},
+		Capabilities: map[string]any{
+			"paymasterService": map[string]any{"url": "https://explicit.example.com"},
+		},
+		PaymasterService: &wallet.PaymasterService{URL: "https://ignored.example.com"},
+	})
+	require.NoError(t, err)
+
+	paramMap := capturedParams[0].(map[string]any)
+	sent := paramMap["capabilities"].(map[string]any)
+	assert.Equal(t, map[string]any{"url": "https://explicit.example.com"}, sent["paymasterService"])
+}
+
 // ============================================================================
 // GetCallsStatus Tests
 // ============================================================================
@@ -1717,6 +2868,125 @@ func TestGetCallsStatus_Pending(t *testing.T) {
 	assert.Equal(t, "pending", status.Status)
 }
 
+// ============================================================================
+// WaitForCallsStatus Tests
+// ============================================================================
+
+func TestWaitForCallsStatus_PendingThenSuccess(t *testing.T) {
+	var polls atomic.Int32
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "wallet_getCallsStatus" {
+			return nil
+		}
+		if polls.Add(1) < 3 {
+			return map[string]any{
+				"atomic":   true,
+				"status":   float64(100),
+				"version":  "2.0.0",
+				"receipts": []any{},
+			}
+		}
+		return map[string]any{
+			"atomic":  true,
+			"chainId": "0x1",
+			"status":  float64(200),
+			"version": "2.0.0",
+			"receipts": []any{map[string]any{
+				"blockHash":       "0x00000000000000000000000000000000000000000000000000000000000000aa",
+				"blockNumber":     "0x10",
+				"gasUsed":         "0x5208",
+				"status":          "0x1",
+				"transactionHash": "0x00000000000000000000000000000000000000000000000000000000000000bb",
+			}},
+		}
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	status, err := wallet.WaitForCallsStatus(ctx, client, wallet.WaitForCallsStatusParameters{
+		ID:              "0xdeadbeef",
+		PollingInterval: 10 * time.Millisecond,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), polls.Load())
+	assert.Equal(t, 200, status.StatusCode)
+	assert.Equal(t, "success", status.Status)
+	require.Len(t, status.Receipts, 1)
+	assert.Equal(t, "success", status.Receipts[0].Status)
+	assert.Equal(t, int64(16), status.Receipts[0].BlockNumber.Int64())
+}
+
+func TestWaitForCallsStatus_Failure(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "wallet_getCallsStatus" {
+			return map[string]any{
+				"atomic":   true,
+				"status":   float64(500),
+				"version":  "2.0.0",
+				"receipts": []any{},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	ctx := context.Background()
+
+	status, err := wallet.WaitForCallsStatus(ctx, client, wallet.WaitForCallsStatusParameters{
+		ID: "0xdeadbeef",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "failure", status.Status)
+
+	_, err = wallet.WaitForCallsStatus(ctx, client, wallet.WaitForCallsStatusParameters{
+		ID:             "0xdeadbeef",
+		ThrowOnFailure: true,
+	})
+	var failedErr *wallet.BundleFailedError
+	require.ErrorAs(t, err, &failedErr)
+	assert.Equal(t, 500, failedErr.Result.StatusCode)
+}
+
+func TestWaitForCallsStatus_Timeout(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "wallet_getCallsStatus" {
+			return map[string]any{
+				"atomic":   true,
+				"status":   float64(100),
+				"version":  "2.0.0",
+				"receipts": []any{},
+			}
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+
+	_, err := wallet.WaitForCallsStatus(context.Background(), client, wallet.WaitForCallsStatusParameters{
+		ID:              "0xdeadbeef",
+		PollingInterval: 10 * time.Millisecond,
+		Timeout:         50 * time.Millisecond,
+	})
+	var timeoutErr *wallet.WaitForCallsStatusTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "0xdeadbeef", timeoutErr.ID)
+
+	// Cancelling the caller's context is not reported as a timeout.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = wallet.WaitForCallsStatus(ctx, client, wallet.WaitForCallsStatusParameters{
+		ID:              "0xdeadbeef",
+		PollingInterval: 10 * time.Millisecond,
+	})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, errors.As(err, &timeoutErr))
+}
+
 // ============================================================================
 // ShowCallsStatus Tests
 // ============================================================================
@@ -1937,6 +3207,224 @@ func TestSignAuthorization_NonLocalAccount(t *testing.T) {
 	assert.True(t, ok, "expected AccountTypeNotSupportedError, got %T: %v", err, err)
 }
 
+// ============================================================================
+// SignHash Tests
+// ============================================================================
+
+func TestSignHash_PrivateKeyAccount(t *testing.T) {
+	client := &mockClient{}
+	ctx := context.Background()
+
+	account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.NoError(t, err)
+
+	hash := crypto.Keccak256Hash([]byte("user operation"))
+	sig, err := wallet.SignHash(ctx, client, wallet.SignHashParameters{
+		Account: account,
+		Hash:    hash,
+	})
+	require.NoError(t, err)
+
+	// Same signature as signing the hash directly, with no EIP-191 prefix.
+	direct, err := account.Sign(hash.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, direct, sig)
+
+	recovered, err := signature.RecoverAddress(hash.Hex(), sig)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address().Hex(), recovered)
+}
+
+func TestSignHash_ClientAccount(t *testing.T) {
+	account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.NoError(t, err)
+	client := &mockClient{account: account}
+
+	hash := crypto.Keccak256Hash([]byte("meta transaction"))
+	sig, err := wallet.SignHash(context.Background(), client, wallet.SignHashParameters{Hash: hash})
+	require.NoError(t, err)
+
+	recovered, err := signature.RecoverAddress(hash.Hex(), sig)
+	require.NoError(t, err)
+	assert.Equal(t, account.Address().Hex(), recovered)
+}
+
+func TestSignHash_NoAccount(t *testing.T) {
+	_, err := wallet.SignHash(context.Background(), &mockClient{}, wallet.SignHashParameters{})
+
+	require.Error(t, err)
+	_, ok := err.(*wallet.AccountNotFoundError)
+	assert.True(t, ok, "expected AccountNotFoundError")
+}
+
+func TestSignHash_NonLocalAccount(t *testing.T) {
+	_, err := wallet.SignHash(context.Background(), &mockClient{}, wallet.SignHashParameters{
+		Account: &mockAccount{address: sourceAddr},
+	})
+
+	require.Error(t, err)
+	_, ok := err.(*wallet.AccountTypeNotSupportedError)
+	assert.True(t, ok, "expected AccountTypeNotSupportedError, got %T: %v", err, err)
+}
+
+// ============================================================================
+// SendTransactionAndWait Tests
+// ============================================================================
+
+const sendAndWaitHash = "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+
+func sendAndWaitReceipt(status string) map[string]any {
+	return map[string]any{
+		"transactionHash":   sendAndWaitHash,
+		"transactionIndex":  "0x0",
+		"blockHash":         "0x1234567890123456789012345678901234567890123456789012345678901234",
+		"blockNumber":       "0x10",
+		"from":              sourceAddr.Hex(),
+		"to":                targetAddr.Hex(),
+		"cumulativeGasUsed": "0x5208",
+		"gasUsed":           "0x5208",
+		"logs":              []any{},
+		"status":            status,
+		"logsBloom":         "0x00",
+		"effectiveGasPrice": "0x3b9aca00",
+		"type":              "0x2",
+	}
+}
+
+func TestSendTransactionAndWait_Default(t *testing.T) {
+	var receiptHash string
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			return sendAndWaitHash
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionReceipt":
+			receiptHash = params[0].(string)
+			return sendAndWaitReceipt("0x1")
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	receipt, err := wallet.SendTransactionAndWait(context.Background(), client, nil, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+		Value:   big.NewInt(1),
+	}, wallet.WaitOptions{ThrowOnRevert: true, Timeout: 5 * time.Second})
+
+	require.NoError(t, err)
+	assert.Equal(t, sendAndWaitHash, receiptHash)
+	assert.Equal(t, common.HexToHash(sendAndWaitHash), receipt.TransactionHash)
+	assert.Equal(t, uint64(1), receipt.Status)
+	assert.Equal(t, uint64(16), receipt.BlockNumber)
+}
+
+func TestSendTransactionAndWait_UsesPublicClientForReceipt(t *testing.T) {
+	walletServer := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			return sendAndWaitHash
+		}
+		return nil
+	})
+	defer walletServer.Close()
+
+	publicServer := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionReceipt":
+			return sendAndWaitReceipt("0x1")
+		}
+		return nil
+	})
+	defer publicServer.Close()
+
+	client := createMockClient(t, walletServer.URL)
+	client.chain = testChain(1)
+	publicClient := createMockClient(t, publicServer.URL)
+	publicClient.uid = "test-send-and-wait-public"
+
+	receipt, err := wallet.SendTransactionAndWait(context.Background(), client, publicClient, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+	}, wallet.WaitOptions{Timeout: 5 * time.Second})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), receipt.Status)
+}
+
+func TestSendTransactionAndWait_TypedNilPublicClient(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			return sendAndWaitHash
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionReceipt":
+			return sendAndWaitReceipt("0x1")
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+
+	// A nil pointer in a non-nil interface falls back to client as well
+	var publicClient *mockClient
+	receipt, err := wallet.SendTransactionAndWait(context.Background(), client, publicClient, wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+	}, wallet.WaitOptions{Timeout: 5 * time.Second})
+
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), receipt.Status)
+}
+
+func TestSendTransactionAndWait_ThrowOnRevert(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_chainId":
+			return "0x1"
+		case "eth_sendTransaction":
+			return sendAndWaitHash
+		case "eth_blockNumber":
+			return "0x10"
+		case "eth_getTransactionReceipt":
+			return sendAndWaitReceipt("0x0")
+		}
+		return nil
+	})
+	defer server.Close()
+
+	client := createMockClient(t, server.URL)
+	client.chain = testChain(1)
+	params := wallet.SendTransactionParameters{
+		Account: &mockAccount{address: sourceAddr},
+		To:      targetAddr.Hex(),
+	}
+
+	_, err := wallet.SendTransactionAndWait(context.Background(), client, nil, params, wallet.WaitOptions{ThrowOnRevert: true, Timeout: 5 * time.Second})
+	var reverted *wallet.TransactionReceiptRevertedError
+	require.ErrorAs(t, err, &reverted)
+
+	// Without ThrowOnRevert the reverted receipt is returned
+	receipt, err := wallet.SendTransactionAndWait(context.Background(), client, nil, params, wallet.WaitOptions{Timeout: 5 * time.Second})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), receipt.Status)
+}
+
 // ============================================================================
 // SendRawTransactionSync Tests
 // ============================================================================
@@ -112,7 +112,7 @@ func WaitForCallsStatus(ctx context.Context, client Client, params WaitForCallsS
 	// Emit on begin: check immediately
 	result, err := getCallsStatusWithRetry(timeoutCtx, client, params.ID, retryCount, retryDelay)
 	if err != nil {
-		return nil, err
+		return nil, waitForCallsStatusError(ctx, timeoutCtx, params.ID, err)
 	}
 	if params.ThrowOnFailure && result.Status == "failure" {
 		return nil, &BundleFailedError{Result: result}
@@ -124,11 +124,11 @@ func WaitForCallsStatus(ctx context.Context, client Client, params WaitForCallsS
 	for {
 		select {
 		case <-timeoutCtx.Done():
-			return nil, &WaitForCallsStatusTimeoutError{ID: params.ID}
+			return nil, waitForCallsStatusError(ctx, timeoutCtx, params.ID, timeoutCtx.Err())
 		case <-ticker.C:
 			result, err := getCallsStatusWithRetry(timeoutCtx, client, params.ID, retryCount, retryDelay)
 			if err != nil {
-				return nil, err
+				return nil, waitForCallsStatusError(ctx, timeoutCtx, params.ID, err)
 			}
 			if params.ThrowOnFailure && result.Status == "failure" {
 				return nil, &BundleFailedError{Result: result}
@@ -140,6 +140,18 @@ func WaitForCallsStatus(ctx context.Context, client Client, params WaitForCallsS
 	}
 }
 
+// waitForCallsStatusError maps err to a WaitForCallsStatusTimeoutError when
+// the wait timed out. Cancellation of the caller's context is returned as is.
+func waitForCallsStatusError(ctx, timeoutCtx context.Context, id string, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if timeoutCtx.Err() != nil {
+		return &WaitForCallsStatusTimeoutError{ID: id}
+	}
+	return err
+}
+
 // getCallsStatusWithRetry calls GetCallsStatus with retry logic.
 // This mirrors viem's withRetry wrapper.
 func getCallsStatusWithRetry(
@@ -7,6 +7,9 @@ import (
 
 	viemabi "github.com/ChefBingbong/viem-go/abi"
 	viemchain "github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/data"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
 	"github.com/ChefBingbong/viem-go/utils/formatters"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
@@ -43,6 +46,10 @@ type WriteContractParameters struct {
 	// Value is the amount of ETH to send with the transaction.
 	Value *big.Int
 
+	// DryRun, when true, builds (and, for local accounts, signs) the
+	// transaction without sending it. See SendTransactionParameters.DryRun.
+	DryRun bool
+
 	// Transaction fields
 	AccessList           []formatters.AccessListItem       `json:"accessList,omitempty"`
 	AuthorizationList    []transaction.SignedAuthorization `json:"authorizationList,omitempty"`
@@ -95,37 +102,158 @@ type WriteContractReturnType = SendTransactionReturnType
 //	    Args:         []any{uint32(69420)},
 //	})
 func WriteContract(ctx context.Context, client Client, params WriteContractParameters) (WriteContractReturnType, error) {
+	request, err := writeContractRequest(client, params)
+	if err != nil {
+		return "", err
+	}
+
+	// Delegate to SendTransaction (mirrors viem's sendTransaction({ data, to: address, account, ...request }))
+	hash, txErr := SendTransaction(ctx, client, request)
+	if txErr != nil {
+		return "", wrapContractError(txErr, params)
+	}
+
+	return hash, nil
+}
+
+// WriteContractResult is the return type for the WriteContractDetailed action.
+type WriteContractResult struct {
+	// Hash is the transaction hash. It is empty in a dry run from a
+	// JSON-RPC account.
+	Hash string
+
+	// Request is the transaction as submitted, with the nonce, chain ID, type,
+	// fees and gas filled in and the encoded calldata (including any data
+	// suffix) in Data.
+	Request *PrepareTransactionRequestParameters
+
+	// SerializedTransaction is the signed serialized transaction. It is set
+	// for local accounts only.
+	SerializedTransaction string
+}
+
+// WriteContractDetailed executes a write function on a contract like
+// WriteContract, and also returns the submitted transaction request.
+//
+// The request is prepared with PrepareTransactionRequest before it is sent,
+// so the returned nonce and fees are the ones the transaction was sent with.
+// This parallels the request returned by SimulateContract.
+//
+// Example:
+//
+//	result, err := wallet.WriteContractDetailed(ctx, client, wallet.WriteContractParameters{
+//	    Address:      "0xFBA3912Ca04dd458c843e2EE08967fC04f3579c2",
+//	    ABI:          erc20ABI,
+//	    FunctionName: "transfer",
+//	    Args:         []any{toAddress, amount},
+//	})
+//	fmt.Println(result.Hash, *result.Request.Nonce)
+func WriteContractDetailed(ctx context.Context, client Client, params WriteContractParameters) (*WriteContractResult, error) {
+	request, err := writeContractRequest(client, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve data suffix: param > client (as SendTransaction does), so the
+	// returned request carries the calldata that is actually sent.
+	calldata := request.Data
+	if request.DataSuffix == "" && len(client.DataSuffix()) > 0 {
+		request.DataSuffix = encoding.BytesToHex(client.DataSuffix())
+	}
+	if request.DataSuffix != "" {
+		calldata = data.ConcatHex(calldata, request.DataSuffix)
+	}
+
+	ch := request.Chain
+	if ch == nil {
+		ch = client.Chain()
+	}
+
+	prepared, err := PrepareTransactionRequest(ctx, client, PrepareTransactionRequestParameters{
+		Account:              request.Account,
+		Chain:                ch,
+		AccessList:           request.AccessList,
+		AuthorizationList:    request.AuthorizationList,
+		BlobVersionedHashes:  request.BlobVersionedHashes,
+		Blobs:                request.Blobs,
+		Data:                 calldata,
+		Gas:                  request.Gas,
+		GasPrice:             request.GasPrice,
+		MaxFeePerBlobGas:     request.MaxFeePerBlobGas,
+		MaxFeePerGas:         request.MaxFeePerGas,
+		MaxPriorityFeePerGas: request.MaxPriorityFeePerGas,
+		Nonce:                request.Nonce,
+		To:                   request.To,
+		Type:                 request.Type,
+		Value:                request.Value,
+	})
+	if err != nil {
+		return nil, wrapContractError(fmt.Errorf("failed to prepare transaction request: %w", err), params)
+	}
+
+	// A nonce consumed from the nonce manager during preparation is not
+	// returned to it if sending fails or nothing is sent; reset so the next
+	// send resyncs.
+	if params.Nonce == nil && prepared.ChainID != nil {
+		if nonceManager := resolveNonceManager(client, nil); nonceManager != nil {
+			defer func() {
+				if err != nil || params.DryRun {
+					nonceManager.Reset(types.NonceManagerParameters{
+						Address: request.Account.Address(),
+						ChainID: *prepared.ChainID,
+					})
+				}
+			}()
+		}
+	}
+
+	// Send exactly what was prepared
+	request.Nonce = prepared.Nonce
+	request.Gas = prepared.Gas
+	request.GasPrice = prepared.GasPrice
+	request.MaxFeePerGas = prepared.MaxFeePerGas
+	request.MaxPriorityFeePerGas = prepared.MaxPriorityFeePerGas
+	request.Type = prepared.Type
+
+	sent, err := SendTransactionDetailed(ctx, client, request)
+	if err != nil {
+		return nil, wrapContractError(err, params)
+	}
+
+	return &WriteContractResult{Hash: sent.Hash, Request: prepared, SerializedTransaction: sent.SerializedTransaction}, nil
+}
+
+// writeContractRequest resolves the account and encodes the contract call
+// into the SendTransaction parameters shared by WriteContract and
+// WriteContractDetailed.
+func writeContractRequest(client Client, params WriteContractParameters) (SendTransactionParameters, error) {
 	// Resolve account: param > client
 	account := params.Account
 	if account == nil {
 		account = client.Account()
 	}
 	if account == nil {
-		return "", &AccountNotFoundError{DocsPath: "/docs/contract/writeContract"}
+		return SendTransactionParameters{}, &AccountNotFoundError{DocsPath: "/docs/contract/writeContract"}
 	}
 
 	// Parse the ABI
 	parsedABI, err := parseABIParam(params.ABI)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse ABI: %w", err)
+		return SendTransactionParameters{}, fmt.Errorf("failed to parse ABI: %w", err)
 	}
 
 	// Encode function data (mirrors viem's encodeFunctionData({ abi, args, functionName }))
 	calldata, err := parsedABI.EncodeFunctionData(params.FunctionName, params.Args...)
 	if err != nil {
-		return "", wrapContractError(err, params)
+		return SendTransactionParameters{}, wrapContractError(err, params)
 	}
 
-	// Convert encoded calldata to hex string
-	calldataHex := "0x" + fmt.Sprintf("%x", calldata)
-
-	// Delegate to SendTransaction (mirrors viem's sendTransaction({ data, to: address, account, ...request }))
-	hash, txErr := SendTransaction(ctx, client, SendTransactionParameters{
+	return SendTransactionParameters{
 		Account:              account,
 		Chain:                params.Chain,
 		AssertChainID:        params.AssertChainID,
 		DataSuffix:           params.DataSuffix,
-		Data:                 calldataHex,
+		Data:                 "0x" + fmt.Sprintf("%x", calldata),
 		To:                   params.Address,
 		Value:                params.Value,
 		AccessList:           params.AccessList,
@@ -139,12 +267,8 @@ func WriteContract(ctx context.Context, client Client, params WriteContractParam
 		MaxPriorityFeePerGas: params.MaxPriorityFeePerGas,
 		Nonce:                params.Nonce,
 		Type:                 params.Type,
-	})
-	if txErr != nil {
-		return "", wrapContractError(txErr, params)
-	}
-
-	return hash, nil
+		DryRun:               params.DryRun,
+	}, nil
 }
 
 // wrapContractError wraps an error with contract context information.
@@ -80,6 +80,15 @@ func copyContracts(c *ChainContracts) *ChainContracts {
 	if c.EnsUniversalResolver != nil {
 		out.EnsUniversalResolver = copyChainContract(c.EnsUniversalResolver)
 	}
+	if c.GasPriceOracle != nil {
+		out.GasPriceOracle = copyChainContract(c.GasPriceOracle)
+	}
+	if c.Portal != nil {
+		out.Portal = copyChainContract(c.Portal)
+	}
+	if c.L2OutputOracle != nil {
+		out.L2OutputOracle = copyChainContract(c.L2OutputOracle)
+	}
 	return out
 }
 
@@ -34,5 +34,8 @@ var Optimism = chain.DefineChain(chain.Chain{
 			Address:      common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"),
 			BlockCreated: uint64Ptr(4_286_263),
 		},
+		GasPriceOracle: &chain.ChainContract{
+			Address: common.HexToAddress("0x420000000000000000000000000000000000000F"),
+		},
 	},
 })
@@ -0,0 +1,76 @@
+package definitions
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+// registry indexes every chain definition in this package.
+var registry = struct {
+	all    []*chain.Chain
+	byID   map[int64]*chain.Chain
+	byName map[string]*chain.Chain
+}{
+	byID:   make(map[int64]*chain.Chain),
+	byName: make(map[string]*chain.Chain),
+}
+
+func init() {
+	register("mainnet", &Mainnet)
+	register("arbitrum", &Arbitrum)
+	register("avalanche", &Avalanche)
+	register("bsc", &Bsc)
+	register("optimism", &Optimism)
+	register("polygon", &Polygon)
+
+	sort.Slice(registry.all, func(i, j int) bool {
+		return registry.all[i].ID < registry.all[j].ID
+	})
+}
+
+// register adds c under its ID, its display name and key (the name of its
+// variable in this package, e.g. "mainnet").
+func register(key string, c *chain.Chain) {
+	registry.all = append(registry.all, c)
+	registry.byID[c.ID] = c
+	registry.byName[strings.ToLower(key)] = c
+	registry.byName[strings.ToLower(c.Name)] = c
+}
+
+// ByID returns the chain definition with the given chain ID.
+// The returned chain is a copy and may be modified freely.
+func ByID(id int64) (*chain.Chain, bool) {
+	c, ok := registry.byID[id]
+	if !ok {
+		return nil, false
+	}
+	return copyChain(c), true
+}
+
+// ByName returns the chain definition with the given name. Both the display
+// name ("OP Mainnet") and the variable name ("optimism") match, case
+// insensitively. The returned chain is a copy and may be modified freely.
+func ByName(name string) (*chain.Chain, bool) {
+	c, ok := registry.byName[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		return nil, false
+	}
+	return copyChain(c), true
+}
+
+// All returns every chain definition, ordered by chain ID.
+// The returned chains are copies and may be modified freely.
+func All() []*chain.Chain {
+	out := make([]*chain.Chain, len(registry.all))
+	for i, c := range registry.all {
+		out[i] = copyChain(c)
+	}
+	return out
+}
+
+func copyChain(c *chain.Chain) *chain.Chain {
+	out := chain.DefineChain(*c)
+	return &out
+}
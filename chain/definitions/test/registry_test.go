@@ -0,0 +1,51 @@
+package definitions_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+)
+
+func TestByID(t *testing.T) {
+	polygon, ok := definitions.ByID(137)
+	require.True(t, ok)
+	assert.Equal(t, definitions.Polygon.Name, polygon.Name)
+	assert.Equal(t, definitions.Polygon.NativeCurrency, polygon.NativeCurrency)
+
+	_, ok = definitions.ByID(999_999_999)
+	assert.False(t, ok)
+}
+
+func TestByName(t *testing.T) {
+	for _, name := range []string{"OP Mainnet", "op mainnet", "optimism", "Optimism"} {
+		c, ok := definitions.ByName(name)
+		require.True(t, ok, name)
+		assert.Equal(t, int64(10), c.ID, name)
+	}
+
+	_, ok := definitions.ByName("not a chain")
+	assert.False(t, ok)
+}
+
+func TestAll(t *testing.T) {
+	all := definitions.All()
+	require.Len(t, all, 6)
+	for i := 1; i < len(all); i++ {
+		assert.Less(t, all[i-1].ID, all[i].ID)
+	}
+	assert.Equal(t, int64(1), all[0].ID)
+}
+
+func TestLookupReturnsCopy(t *testing.T) {
+	mainnet, ok := definitions.ByID(1)
+	require.True(t, ok)
+	mainnet.Name = "changed"
+	mainnet.RpcUrls["default"] = mainnet.RpcUrls["custom"]
+
+	again, _ := definitions.ByID(1)
+	assert.Equal(t, "Ethereum", again.Name)
+	assert.Equal(t, definitions.Mainnet.DefaultRpcUrl(), again.DefaultRpcUrl())
+}
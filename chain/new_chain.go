@@ -0,0 +1,166 @@
+package chain
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidChainOptions is returned by NewChain when a required field is missing.
+var ErrInvalidChainOptions = fmt.Errorf("chain: invalid chain options")
+
+// ChainOptions contains the fields needed to define a custom chain with NewChain.
+type ChainOptions struct {
+	// ID is the chain ID. Required.
+	ID int64
+	// Name is the chain name. Default: "Chain <ID>".
+	Name string
+	// RpcURL is the default HTTP RPC URL. Required.
+	RpcURL string
+	// WebSocketURL is the default WebSocket RPC URL (optional).
+	WebSocketURL string
+	// NativeCurrency is the chain's native currency. Required.
+	NativeCurrency ChainNativeCurrency
+	// BlockTime is the block time in milliseconds (optional).
+	BlockTime int64
+	// Testnet marks the chain as a testnet.
+	Testnet bool
+}
+
+// ChainOption configures a chain built by NewChain.
+type ChainOption func(*Chain)
+
+// NewChain builds a custom chain definition from opts, applying each option
+// in order. Use it for private, dev or L2 chains that have no definition in
+// chain/definitions.
+//
+// Returns ErrInvalidChainOptions if ID, RpcURL or NativeCurrency is missing.
+//
+// Example:
+//
+//	devnet, err := chain.NewChain(chain.ChainOptions{
+//	    ID:             31337,
+//	    RpcURL:         "http://127.0.0.1:8545",
+//	    NativeCurrency: chain.ChainNativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18},
+//	}, chain.WithMulticall3(multicallAddress, 0))
+func NewChain(opts ChainOptions, options ...ChainOption) (*Chain, error) {
+	if opts.ID <= 0 {
+		return nil, fmt.Errorf("%w: ID must be positive", ErrInvalidChainOptions)
+	}
+	if opts.RpcURL == "" {
+		return nil, fmt.Errorf("%w: RpcURL is required", ErrInvalidChainOptions)
+	}
+	if opts.NativeCurrency.Name == "" || opts.NativeCurrency.Symbol == "" || opts.NativeCurrency.Decimals == 0 {
+		return nil, fmt.Errorf("%w: NativeCurrency requires a name, symbol and decimals", ErrInvalidChainOptions)
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = fmt.Sprintf("Chain %d", opts.ID)
+	}
+
+	rpcUrls := ChainRpcUrls{HTTP: []string{opts.RpcURL}}
+	if opts.WebSocketURL != "" {
+		rpcUrls.WebSocket = []string{opts.WebSocketURL}
+	}
+
+	c := DefineChain(Chain{
+		ID:             opts.ID,
+		Name:           name,
+		NativeCurrency: opts.NativeCurrency,
+		RpcUrls:        map[string]ChainRpcUrls{"default": rpcUrls},
+		Testnet:        opts.Testnet,
+	})
+	if opts.BlockTime > 0 {
+		blockTime := opts.BlockTime
+		c.BlockTime = &blockTime
+	}
+
+	for _, option := range options {
+		option(&c)
+	}
+
+	return &c, nil
+}
+
+// WithMulticall3 sets the Multicall3 contract. blockCreated is the block the
+// contract was deployed at, or 0 if unknown. Multicall rejects calls at
+// earlier blocks unless they are deployless.
+func WithMulticall3(address common.Address, blockCreated uint64) ChainOption {
+	return func(c *Chain) {
+		contracts(c).Multicall3 = newChainContract(address, blockCreated)
+	}
+}
+
+// WithEnsRegistry sets the ENS registry contract. blockCreated is the block
+// the contract was deployed at, or 0 if unknown.
+func WithEnsRegistry(address common.Address, blockCreated uint64) ChainOption {
+	return func(c *Chain) {
+		contracts(c).EnsRegistry = newChainContract(address, blockCreated)
+	}
+}
+
+// WithEnsUniversalResolver sets the ENS universal resolver contract.
+// blockCreated is the block the contract was deployed at, or 0 if unknown.
+func WithEnsUniversalResolver(address common.Address, blockCreated uint64) ChainOption {
+	return func(c *Chain) {
+		contracts(c).EnsUniversalResolver = newChainContract(address, blockCreated)
+	}
+}
+
+// WithGasPriceOracle sets the OP Stack GasPriceOracle predeploy, used to
+// estimate the L1 data fee of L2 transactions.
+func WithGasPriceOracle(address common.Address) ChainOption {
+	return func(c *Chain) {
+		contracts(c).GasPriceOracle = newChainContract(address, 0)
+	}
+}
+
+// WithPortal sets the OP Stack OptimismPortal contract on the settlement
+// chain, used for deposits and withdrawals.
+func WithPortal(address common.Address) ChainOption {
+	return func(c *Chain) {
+		contracts(c).Portal = newChainContract(address, 0)
+	}
+}
+
+// WithL2OutputOracle sets the OP Stack L2OutputOracle contract on the
+// settlement chain, used to prove withdrawals.
+func WithL2OutputOracle(address common.Address) ChainOption {
+	return func(c *Chain) {
+		contracts(c).L2OutputOracle = newChainContract(address, 0)
+	}
+}
+
+// WithBlockExplorer sets the default block explorer.
+func WithBlockExplorer(name, url string) ChainOption {
+	return func(c *Chain) {
+		if c.BlockExplorers == nil {
+			c.BlockExplorers = make(map[string]ChainBlockExplorer)
+		}
+		c.BlockExplorers["default"] = ChainBlockExplorer{Name: name, URL: url}
+	}
+}
+
+// WithSourceID sets the ID of the chain this chain settles to (e.g. 1 for
+// an L2 on Ethereum).
+func WithSourceID(sourceID int64) ChainOption {
+	return func(c *Chain) {
+		c.SourceID = &sourceID
+	}
+}
+
+func contracts(c *Chain) *ChainContracts {
+	if c.Contracts == nil {
+		c.Contracts = &ChainContracts{}
+	}
+	return c.Contracts
+}
+
+func newChainContract(address common.Address, blockCreated uint64) *ChainContract {
+	contract := &ChainContract{Address: address}
+	if blockCreated > 0 {
+		contract.BlockCreated = &blockCreated
+	}
+	return contract
+}
@@ -0,0 +1,76 @@
+package chain_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/chain"
+)
+
+var testNativeCurrency = chain.ChainNativeCurrency{Name: "Ether", Symbol: "ETH", Decimals: 18}
+
+func TestNewChain(t *testing.T) {
+	multicall := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	registry := common.HexToAddress("0x2000000000000000000000000000000000000002")
+
+	c, err := chain.NewChain(chain.ChainOptions{
+		ID:             31337,
+		RpcURL:         "http://127.0.0.1:8545",
+		WebSocketURL:   "ws://127.0.0.1:8545",
+		NativeCurrency: testNativeCurrency,
+		BlockTime:      1_000,
+		Testnet:        true,
+	},
+		chain.WithMulticall3(multicall, 5),
+		chain.WithEnsRegistry(registry, 0),
+		chain.WithBlockExplorer("Devscan", "http://127.0.0.1:4000"),
+		chain.WithSourceID(1),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(31337), c.ID)
+	assert.Equal(t, "Chain 31337", c.Name)
+	assert.Equal(t, "http://127.0.0.1:8545", c.DefaultRpcUrl())
+	assert.Equal(t, []string{"ws://127.0.0.1:8545"}, c.RpcUrls["default"].WebSocket)
+	assert.Equal(t, int64(1_000), *c.BlockTime)
+	assert.True(t, c.Testnet)
+	assert.Equal(t, "Devscan", c.DefaultBlockExplorer().Name)
+	assert.Equal(t, int64(1), *c.SourceID)
+
+	require.NotNil(t, c.Contracts)
+	assert.Equal(t, multicall, c.Contracts.Multicall3.Address)
+	assert.Equal(t, uint64(5), *c.Contracts.Multicall3.BlockCreated)
+	assert.Equal(t, registry, c.Contracts.EnsRegistry.Address)
+	assert.Nil(t, c.Contracts.EnsRegistry.BlockCreated)
+	assert.Nil(t, c.Contracts.EnsUniversalResolver)
+}
+
+func TestNewChain_Validation(t *testing.T) {
+	valid := chain.ChainOptions{ID: 1, RpcURL: "http://localhost:8545", NativeCurrency: testNativeCurrency}
+
+	tests := []struct {
+		name   string
+		modify func(*chain.ChainOptions)
+	}{
+		{"missing id", func(o *chain.ChainOptions) { o.ID = 0 }},
+		{"missing rpc url", func(o *chain.ChainOptions) { o.RpcURL = "" }},
+		{"missing native currency", func(o *chain.ChainOptions) { o.NativeCurrency = chain.ChainNativeCurrency{} }},
+		{"missing decimals", func(o *chain.ChainOptions) { o.NativeCurrency.Decimals = 0 }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := valid
+			tt.modify(&opts)
+			_, err := chain.NewChain(opts)
+			assert.True(t, errors.Is(err, chain.ErrInvalidChainOptions), "got %v", err)
+		})
+	}
+
+	c, err := chain.NewChain(valid)
+	require.NoError(t, err)
+	assert.Nil(t, c.Contracts)
+}
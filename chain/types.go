@@ -31,10 +31,16 @@ type ChainRpcUrls struct {
 }
 
 // ChainContracts contains well-known contract addresses.
+//
+// Portal and L2OutputOracle belong to OP Stack L2 chains but are deployed on
+// the settlement chain (SourceID).
 type ChainContracts struct {
 	Multicall3           *ChainContract `json:"multicall3,omitempty"`
 	EnsRegistry          *ChainContract `json:"ensRegistry,omitempty"`
 	EnsUniversalResolver *ChainContract `json:"ensUniversalResolver,omitempty"`
+	GasPriceOracle       *ChainContract `json:"gasPriceOracle,omitempty"`
+	Portal               *ChainContract `json:"portal,omitempty"`
+	L2OutputOracle       *ChainContract `json:"l2OutputOracle,omitempty"`
 }
 
 // Chain is the basic chain definition, mirroring viem's Chain type.
@@ -3,8 +3,12 @@ package client
 import (
 	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
+	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -67,12 +71,18 @@ type ClientConfig struct {
 	CCIPRead *CCIPReadOptions
 	// Chain is the chain configuration.
 	Chain *Chain
+	// DetectChain, when Chain is nil, detects the chain with DetectChain the
+	// first time Chain() is called.
+	DetectChain bool
 	// DataSuffix is the data suffix to append to transaction data.
 	DataSuffix []byte
 	// ExperimentalBlockTag is the default block tag for RPC requests.
 	ExperimentalBlockTag BlockTag
 	// Key is a key for the client.
 	Key string
+	// Logger receives warnings from actions, such as a retried request.
+	// Default: slog.Default().
+	Logger *slog.Logger
 	// Name is a name for the client.
 	Name string
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
@@ -108,12 +118,18 @@ type BaseClient struct {
 	ccipRead *CCIPReadOptions
 	// Chain is the chain configuration.
 	chain *Chain
+	// detectChain enables lazy chain detection when chain is nil.
+	detectChain bool
+	chainErr    error
+	chainMu     sync.Mutex
 	// DataSuffix is the data suffix to append to transaction data.
 	dataSuffix []byte
 	// ExperimentalBlockTag is the default block tag for RPC requests.
 	experimentalBlockTag BlockTag
 	// Key is a key for the client.
 	key string
+	// Logger receives warnings from actions.
+	logger *slog.Logger
 	// Name is a name for the client.
 	name string
 	// PollingInterval is the frequency for polling.
@@ -125,11 +141,27 @@ type BaseClient struct {
 	// UID is a unique identifier for the client.
 	uid string
 
+	// Request counters reported by Stats.
+	requestCount  atomic.Uint64
+	errorCount    atomic.Uint64
+	lastRequestAt atomic.Int64 // unix nanoseconds, 0 before the first request
+
 	// extensions holds extended functionality
 	extensions map[string]any
 	extMu      sync.RWMutex
 }
 
+// ClientStats is a snapshot of a client's request counters.
+type ClientStats struct {
+	// RequestCount is the number of requests sent through Request.
+	RequestCount uint64
+	// ErrorCount is the number of those requests that returned an error.
+	ErrorCount uint64
+	// LastRequestAt is when the most recent request was sent. It is the zero
+	// time if no request has been sent.
+	LastRequestAt time.Time
+}
+
 // CreateClient creates a new base client with the given configuration.
 // This is the low-level client factory - prefer CreatePublicClient or
 // CreateWalletClient for most use cases.
@@ -184,9 +216,11 @@ func CreateClient(config ClientConfig) (*BaseClient, error) {
 		cacheTime:            config.CacheTime,
 		ccipRead:             config.CCIPRead,
 		chain:                config.Chain,
+		detectChain:          config.DetectChain && config.Chain == nil,
 		dataSuffix:           config.DataSuffix,
 		experimentalBlockTag: experimentalBlockTag,
 		key:                  config.Key,
+		logger:               config.Logger,
 		name:                 config.Name,
 		pollingInterval:      config.PollingInterval,
 		transport:            tr,
@@ -219,10 +253,65 @@ func (c *BaseClient) CacheTime() time.Duration {
 }
 
 // Chain returns the chain configuration.
+//
+// If the client was created with DetectChain and no Chain, the first call
+// detects the chain over the transport, bounded by detectChainTimeout. A
+// failed detection returns nil; the error is kept and reported by ChainError,
+// and detection is only retried through DetectChain.
 func (c *BaseClient) Chain() *Chain {
+	if !c.detectChain {
+		return c.chain
+	}
+
+	c.chainMu.Lock()
+	defer c.chainMu.Unlock()
+	if c.chain == nil && c.chainErr == nil {
+		ctx, cancel := context.WithTimeout(context.Background(), detectChainTimeout)
+		defer cancel()
+		c.detectChainLocked(ctx)
+	}
 	return c.chain
 }
 
+// DetectChain returns the client's chain, detecting it over the transport with
+// ctx if the client was created with DetectChain and it isn't known yet. Unlike
+// Chain, it retries a previously failed detection and returns the error.
+//
+// Example:
+//
+//	ch, err := c.DetectChain(ctx)
+func (c *BaseClient) DetectChain(ctx context.Context) (*Chain, error) {
+	if !c.detectChain {
+		return c.chain, nil
+	}
+
+	c.chainMu.Lock()
+	defer c.chainMu.Unlock()
+	if c.chain == nil {
+		c.detectChainLocked(ctx)
+	}
+	return c.chain, c.chainErr
+}
+
+// ChainError returns the error of the last failed chain detection, or nil.
+func (c *BaseClient) ChainError() error {
+	c.chainMu.Lock()
+	defer c.chainMu.Unlock()
+	return c.chainErr
+}
+
+// detectChainLocked detects the chain and records the outcome. Must be called
+// with chainMu held.
+func (c *BaseClient) detectChainLocked(ctx context.Context) {
+	detected, err := DetectChain(ctx, c.transport)
+	if err != nil {
+		c.chainErr = fmt.Errorf("failed to detect chain: %w", err)
+		return
+	}
+	c.chain = detected
+	c.chainErr = nil
+}
+
 // DataSuffix returns the data suffix.
 func (c *BaseClient) DataSuffix() []byte {
 	return c.dataSuffix
@@ -238,6 +327,14 @@ func (c *BaseClient) Key() string {
 	return c.key
 }
 
+// Logger returns the client logger, or slog.Default() if none is configured.
+func (c *BaseClient) Logger() *slog.Logger {
+	if c.logger == nil {
+		return slog.Default()
+	}
+	return c.logger
+}
+
 // Name returns the client name.
 func (c *BaseClient) Name() string {
 	return c.name
@@ -258,11 +355,25 @@ func (c *BaseClient) Type() string {
 	return c.clientType
 }
 
-// UID returns the unique client identifier.
+// UID returns the unique client identifier. It is fixed when the client is
+// created and differs between client instances.
 func (c *BaseClient) UID() string {
 	return c.uid
 }
 
+// Stats returns a snapshot of the client's request counters. It is safe to
+// call concurrently with requests.
+func (c *BaseClient) Stats() ClientStats {
+	stats := ClientStats{
+		RequestCount: c.requestCount.Load(),
+		ErrorCount:   c.errorCount.Load(),
+	}
+	if last := c.lastRequestAt.Load(); last != 0 {
+		stats.LastRequestAt = time.Unix(0, last)
+	}
+	return stats
+}
+
 // Request sends a raw JSON-RPC request.
 // This is the only RPC method on BaseClient - use PublicClient or WalletClient
 // for typed method wrappers.
@@ -271,7 +382,15 @@ func (c *BaseClient) Request(ctx context.Context, method string, params ...any)
 		Method: method,
 		Params: params,
 	}
-	return c.transport.Request(ctx, req)
+
+	c.requestCount.Add(1)
+	c.lastRequestAt.Store(time.Now().UnixNano())
+
+	resp, err := c.transport.Request(ctx, req)
+	if err != nil {
+		c.errorCount.Add(1)
+	}
+	return resp, err
 }
 
 // Close closes the client and its underlying transport.
@@ -307,12 +426,19 @@ func (c *BaseClient) Extensions() map[string]any {
 	return result
 }
 
+// uidSequence makes fallback UIDs unique within the process.
+var uidSequence atomic.Uint64
+
 // generateUID generates a unique identifier.
 func generateUID(length int) string {
 	bytes := make([]byte, (length+1)/2)
 	if _, err := rand.Read(bytes); err != nil {
-		// Fallback to timestamp-based ID
-		return hex.EncodeToString([]byte(time.Now().String()))[:length]
+		// Fallback to a process-unique ID. The low bytes of the sequence
+		// come first so IDs differ even when truncated.
+		fallback := make([]byte, 16)
+		binary.LittleEndian.PutUint64(fallback, uidSequence.Add(1))
+		binary.LittleEndian.PutUint64(fallback[8:], uint64(time.Now().UnixNano()))
+		return hex.EncodeToString(fallback)[:length]
 	}
 	return hex.EncodeToString(bytes)[:length]
 }
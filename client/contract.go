@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	json "github.com/goccy/go-json"
 
@@ -29,6 +30,11 @@ type ReadContractOptions struct {
 	From *common.Address
 	// Block is the block tag to read from (default: latest).
 	Block BlockTag
+	// CacheTime, if positive, reuses the result of an identical read (same
+	// address, function, args, block and caller) for this long. Reads pinned
+	// to a block number stay cached until evicted. Suited to values that
+	// rarely change, such as decimals or symbol.
+	CacheTime time.Duration
 }
 
 // WithFunction returns a copy of the options with the function name and args set.
@@ -126,9 +132,9 @@ func (c *PublicClient) ReadContract(ctx context.Context, opts ReadContractOption
 	// Execute eth_call
 	var result []byte
 	if opts.Block != "" {
-		result, err = c.Call(ctx, callReq, opts.Block)
+		result, err = c.CachedCall(ctx, callReq, opts.CacheTime, opts.Block)
 	} else {
-		result, err = c.Call(ctx, callReq)
+		result, err = c.CachedCall(ctx, callReq, opts.CacheTime)
 	}
 	if err != nil {
 		return nil, err
@@ -241,8 +247,8 @@ func (c *PublicClient) PrepareContractWrite(ctx context.Context, opts PrepareCon
 
 	// Get chain ID if available
 	var chainID *big.Int
-	if c.chain != nil {
-		chainID = big.NewInt(c.chain.ID)
+	if ch := c.Chain(); ch != nil {
+		chainID = big.NewInt(ch.ID)
 	}
 
 	tx := &types.Transaction{
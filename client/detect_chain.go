@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// detectChainTimeout bounds the lazy chain detection done by BaseClient.Chain.
+const detectChainTimeout = 10 * time.Second
+
+// DetectChain queries eth_chainId over tr and returns the matching chain
+// definition from chain/definitions.
+//
+// If the chain ID has no known definition, a minimal Chain is returned with
+// only the ID, a generic name and an 18-decimal ETH native currency.
+//
+// Example:
+//
+//	tr, _ := transport.HTTP(url)(transport.TransportParams{})
+//	c, err := client.DetectChain(ctx, tr)
+func DetectChain(ctx context.Context, tr transport.Transport) (*Chain, error) {
+	resp, err := tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+	if err != nil {
+		return nil, fmt.Errorf("eth_chainId failed: %w", err)
+	}
+
+	var hexChainID string
+	if err := json.Unmarshal(resp.Result, &hexChainID); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chain id: %w", err)
+	}
+	chainID, err := hexutil.DecodeUint64(hexChainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chain id: %w", err)
+	}
+
+	if c, ok := definitions.ByID(int64(chainID)); ok {
+		return c, nil
+	}
+
+	return &Chain{
+		ID:   int64(chainID),
+		Name: fmt.Sprintf("Chain %d", chainID),
+		NativeCurrency: chain.ChainNativeCurrency{
+			Name:     "Ether",
+			Symbol:   "ETH",
+			Decimals: 18,
+		},
+	}, nil
+}
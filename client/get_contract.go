@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+)
+
+// ErrContractWalletClientRequired is returned when a write action is called on
+// a contract handle created with a public client only.
+var ErrContractWalletClientRequired = errors.New("client: contract write requires a wallet client")
+
+// GetContractOptions contains options for creating a contract handle.
+// This mirrors viem's GetContractParameters.
+type GetContractOptions struct {
+	// Address is the contract address.
+	Address common.Address
+	// ABI is the contract ABI as a JSON string, []byte, or *abi.ABI.
+	ABI any
+	// Client is a *PublicClient (read-only actions) or a *WalletClient (all actions).
+	Client any
+}
+
+// Contract is a contract handle that closes over an address, ABI, and client,
+// so actions only need the function name and arguments.
+// Create one with GetContract.
+type Contract struct {
+	address common.Address
+	abi     *abi.ABI
+	public  *PublicClient
+	wallet  *WalletClient
+}
+
+// GetContract creates a contract handle bound to an address, ABI, and client.
+//
+// With a public client, Read, Simulate, EstimateGas, and WatchEvent are available.
+// With a wallet client, Write is also available and the wallet's account is used
+// as the caller for Simulate and EstimateGas.
+//
+// This is equivalent to viem's `getContract` utility.
+//
+// Example:
+//
+//	usdc, err := client.GetContract(client.GetContractOptions{
+//	    Address: common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"),
+//	    ABI:     erc20ABI,
+//	    Client:  walletClient,
+//	})
+//
+//	balance, err := usdc.Read(ctx, "balanceOf", owner)
+//	hash, err := usdc.Write(ctx, "transfer", recipient, amount)
+func GetContract(opts GetContractOptions) (*Contract, error) {
+	parsedABI, err := parseABIInput(opts.ABI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
+	}
+
+	contract := &Contract{
+		address: opts.Address,
+		abi:     parsedABI,
+	}
+
+	switch c := opts.Client.(type) {
+	case *PublicClient:
+		contract.public = c
+	case *WalletClient:
+		// Public actions only need the underlying transport and config, so a
+		// wallet client can serve them through the same base client.
+		contract.public = &PublicClient{BaseClient: c.BaseClient, feeCacheTime: c.feeCacheTime}
+		contract.wallet = c
+	default:
+		return nil, fmt.Errorf("unsupported client type: %T (expected *PublicClient or *WalletClient)", opts.Client)
+	}
+
+	return contract, nil
+}
+
+// Address returns the contract address.
+func (c *Contract) Address() common.Address {
+	return c.address
+}
+
+// ABI returns the parsed contract ABI.
+func (c *Contract) ABI() *abi.ABI {
+	return c.abi
+}
+
+// PublicClient returns the client used for read-only actions. For a handle
+// created with a wallet client, it shares the wallet client's transport and
+// configuration.
+func (c *Contract) PublicClient() *PublicClient {
+	return c.public
+}
+
+// Read calls a read-only contract function and returns its decoded result.
+// A single return value is unwrapped; multiple return values are returned as []any.
+func (c *Contract) Read(ctx context.Context, functionName string, args ...any) (any, error) {
+	decoded, err := c.public.ReadContractWithABI(ctx, c.address, c.abi, functionName, args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(decoded) == 1 {
+		return decoded[0], nil
+	}
+	return decoded, nil
+}
+
+// Write executes a state-changing contract function and returns the transaction hash.
+// Returns ErrContractWalletClientRequired if the handle has no wallet client.
+func (c *Contract) Write(ctx context.Context, functionName string, args ...any) (string, error) {
+	if c.wallet == nil {
+		return "", ErrContractWalletClientRequired
+	}
+	return wallet.WriteContract(ctx, c.wallet, wallet.WriteContractParameters{
+		Address:      c.address.Hex(),
+		ABI:          c.abi,
+		FunctionName: functionName,
+		Args:         args,
+	})
+}
+
+// Simulate simulates a contract function call and returns its result together
+// with a request that can be used for a write.
+func (c *Contract) Simulate(ctx context.Context, functionName string, args ...any) (*public.SimulateContractReturnType, error) {
+	return public.SimulateContract(ctx, c.public, public.SimulateContractParameters{
+		Account:      c.account(),
+		Address:      c.address,
+		ABI:          c.abi,
+		FunctionName: functionName,
+		Args:         args,
+	})
+}
+
+// EstimateGas estimates the gas required to execute a contract function.
+func (c *Contract) EstimateGas(ctx context.Context, functionName string, args ...any) (uint64, error) {
+	return public.EstimateContractGas(ctx, c.public, public.EstimateContractGasParameters{
+		Account:      c.account(),
+		Address:      c.address,
+		ABI:          c.abi,
+		FunctionName: functionName,
+		Args:         args,
+	})
+}
+
+// WatchEvent watches for contract events. Address and ABI are taken from the
+// handle; all other parameters are passed through.
+func (c *Contract) WatchEvent(ctx context.Context, params public.WatchContractEventParameters) <-chan public.WatchContractEventEvent {
+	params.Address = c.address
+	params.ABI = c.abi
+	return public.WatchContractEvent(ctx, c.public, params)
+}
+
+// account returns the wallet account address to use as the caller, if any.
+func (c *Contract) account() *common.Address {
+	if c.wallet == nil {
+		return nil
+	}
+	account := c.wallet.Account()
+	if account == nil {
+		return nil
+	}
+	address := account.Address()
+	return &address
+}
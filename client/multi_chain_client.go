@@ -0,0 +1,271 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// ErrChainNotConfigured is returned when a multi-chain client is asked for a
+// chain ID that has no client registered.
+var ErrChainNotConfigured = errors.New("client: chain not configured")
+
+// MultiChainClientOptions contains options shared by every per-chain client
+// created by NewMultiChainClient. Fields left unset on an individual
+// PublicClientConfig are filled in from these options.
+type MultiChainClientOptions struct {
+	// Batch contains batch settings.
+	Batch *BatchOptions
+	// CacheTime is the time (in ms) that cached data will remain in memory.
+	CacheTime time.Duration
+	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
+	PollingInterval time.Duration
+	// Transport is the transport factory to use when a config has none.
+	// transport.HTTP("") resolves the URL from each config's Chain.
+	Transport transport.TransportFactory
+}
+
+// MultiChainClient routes public actions to a per-chain PublicClient by chain ID.
+// It owns the lifecycle of its clients: Close closes all of them.
+type MultiChainClient struct {
+	clients map[int64]*PublicClient
+	mu      sync.RWMutex
+}
+
+// NewMultiChainClient creates a PublicClient for every entry in configs and
+// returns a router that dispatches to them by chain ID.
+//
+// If a config sets Chain, its ID must match the map key so a client can never
+// be registered under the wrong chain.
+//
+// Example:
+//
+//	mc, err := client.NewMultiChainClient(map[int64]client.PublicClientConfig{
+//	    1:   {Chain: definitions.Mainnet},
+//	    137: {Chain: definitions.Polygon},
+//	}, client.MultiChainClientOptions{Transport: transport.HTTP("")})
+//	defer mc.Close()
+//
+//	polygon, err := mc.For(137)
+func NewMultiChainClient(configs map[int64]PublicClientConfig, options ...MultiChainClientOptions) (*MultiChainClient, error) {
+	var opts MultiChainClientOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+
+	mc := &MultiChainClient{clients: make(map[int64]*PublicClient, len(configs))}
+
+	for chainID, config := range configs {
+		if config.Chain != nil && config.Chain.ID != chainID {
+			_ = mc.Close()
+			return nil, fmt.Errorf("client: chain ID mismatch: registered as %d but chain %q has ID %d", chainID, config.Chain.Name, config.Chain.ID)
+		}
+
+		if config.Batch == nil {
+			config.Batch = opts.Batch
+		}
+		if config.CacheTime == 0 {
+			config.CacheTime = opts.CacheTime
+		}
+		if config.PollingInterval == 0 {
+			config.PollingInterval = opts.PollingInterval
+		}
+		if config.Transport == nil {
+			config.Transport = opts.Transport
+		}
+
+		c, err := CreatePublicClient(config)
+		if err != nil {
+			_ = mc.Close()
+			return nil, fmt.Errorf("client: failed to create client for chain %d: %w", chainID, err)
+		}
+		mc.clients[chainID] = c
+	}
+
+	return mc, nil
+}
+
+// NewMultiChainClientFromClients returns a router over already-created public clients,
+// keyed by chain ID. The router takes ownership of the clients: Close closes them.
+//
+// If a client has a chain configured, its ID must match the map key.
+//
+// Example:
+//
+//	mc, err := client.NewMultiChainClientFromClients(map[int64]*client.PublicClient{
+//	    1:   mainnetClient,
+//	    137: polygonClient,
+//	})
+//
+//	balance, err := mc.GetBalanceOnChain(ctx, 137, owner)
+func NewMultiChainClientFromClients(clients map[int64]*PublicClient) (*MultiChainClient, error) {
+	mc := &MultiChainClient{clients: make(map[int64]*PublicClient, len(clients))}
+	for chainID, c := range clients {
+		if c == nil {
+			return nil, fmt.Errorf("client: nil client registered for chain %d", chainID)
+		}
+		if ch := c.Chain(); ch != nil && ch.ID != chainID {
+			return nil, fmt.Errorf("client: chain ID mismatch: registered as %d but chain %q has ID %d", chainID, ch.Name, ch.ID)
+		}
+		mc.clients[chainID] = c
+	}
+	return mc, nil
+}
+
+// NewMultichainClient returns a router over already-created public clients,
+// keyed by chain ID. It is the same as NewMultiChainClientFromClients.
+//
+// Example:
+//
+//	mc, err := client.NewMultichainClient(map[int64]*client.PublicClient{
+//	    1:   mainnetClient,
+//	    137: polygonClient,
+//	})
+//
+//	polygon, err := mc.ForChain(137)
+func NewMultichainClient(clients map[int64]*PublicClient) (*MultiChainClient, error) {
+	return NewMultiChainClientFromClients(clients)
+}
+
+// For returns the client registered for chainID.
+// Returns ErrChainNotConfigured if no client is registered for it.
+func (m *MultiChainClient) For(chainID int64) (*PublicClient, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.clients[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrChainNotConfigured, chainID)
+	}
+	return c, nil
+}
+
+// ForChain returns the client registered for chainID. It is the same as For.
+// Returns ErrChainNotConfigured if no client is registered for it.
+func (m *MultiChainClient) ForChain(chainID int64) (*PublicClient, error) {
+	return m.For(chainID)
+}
+
+// Has reports whether a client is registered for chainID.
+func (m *MultiChainClient) Has(chainID int64) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	_, ok := m.clients[chainID]
+	return ok
+}
+
+// ChainIDs returns the registered chain IDs in ascending order.
+func (m *MultiChainClient) ChainIDs() []int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ids := make([]int64, 0, len(m.clients))
+	for id := range m.clients {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// Chain returns the chain configuration of the client registered for chainID.
+// The returned chain is nil if the client was created without one.
+func (m *MultiChainClient) Chain(chainID int64) (*chain.Chain, error) {
+	c, err := m.For(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return c.Chain(), nil
+}
+
+// GetBalanceOnChain returns the balance of address on the chain registered for chainID.
+// Returns ErrChainNotConfigured if no client is registered for it.
+func (m *MultiChainClient) GetBalanceOnChain(ctx context.Context, chainID int64, address common.Address, blockTag ...BlockTag) (*big.Int, error) {
+	c, err := m.For(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetBalance(ctx, address, blockTag...)
+}
+
+// ChainBlockNumberEvent is a block number event tagged with the chain it came from.
+type ChainBlockNumberEvent struct {
+	ChainID int64
+	public.WatchBlockNumberEvent
+}
+
+// WatchAllBlockNumbers watches the block number on every registered chain and
+// multiplexes the events into a single channel tagged with the chain ID.
+//
+// The returned unsubscribe function stops every per-chain watcher and waits
+// for them to exit; cancelling ctx does the same without waiting. The channel
+// is closed once every per-chain watcher has stopped.
+//
+// Example:
+//
+//	events, unsubscribe := mc.WatchAllBlockNumbers(ctx, public.WatchBlockNumberParameters{})
+//	defer unsubscribe()
+//
+//	for ev := range events {
+//	    fmt.Println(ev.ChainID, ev.BlockNumber)
+//	}
+func (m *MultiChainClient) WatchAllBlockNumbers(ctx context.Context, params public.WatchBlockNumberParameters) (<-chan ChainBlockNumberEvent, func()) {
+	m.mu.RLock()
+	clients := make(map[int64]*PublicClient, len(m.clients))
+	for chainID, c := range m.clients {
+		clients[chainID] = c
+	}
+	m.mu.RUnlock()
+
+	ctx, cancel := context.WithCancel(ctx)
+	out := make(chan ChainBlockNumberEvent, len(clients))
+	var wg sync.WaitGroup
+	for chainID, c := range clients {
+		wg.Add(1)
+		go func(chainID int64, events <-chan public.WatchBlockNumberEvent) {
+			defer wg.Done()
+			for ev := range events {
+				select {
+				case out <- ChainBlockNumberEvent{ChainID: chainID, WatchBlockNumberEvent: ev}:
+				case <-ctx.Done():
+					// Drain until the watcher sees the cancellation and closes.
+					for range events {
+					}
+					return
+				}
+			}
+		}(chainID, c.WatchBlockNumber(ctx, params))
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	unsubscribe := func() {
+		cancel()
+		wg.Wait()
+	}
+	return out, unsubscribe
+}
+
+// Close closes every registered client and returns the joined errors, if any.
+func (m *MultiChainClient) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var errs []error
+	for chainID, c := range m.clients {
+		if err := c.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("chain %d: %w", chainID, err))
+		}
+	}
+	m.clients = make(map[int64]*PublicClient)
+	return errors.Join(errs...)
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"log/slog"
 	"math/big"
 	"time"
 
@@ -25,10 +26,19 @@ type PublicClientConfig struct {
 	CacheTime time.Duration
 	// Chain is the chain configuration.
 	Chain *chain.Chain
+	// DetectChain, when Chain is nil, detects the chain with DetectChain the
+	// first time Chain() is called.
+	DetectChain bool
 	// ExperimentalBlockTag is the default block tag for RPC requests.
 	ExperimentalBlockTag BlockTag
+	// FeeCacheTime is how long GetGasPrice and EstimateFeesPerGas results are
+	// cached. The cache is dropped when WatchBlocks sees a new block.
+	// Default: 0 (no caching).
+	FeeCacheTime time.Duration
 	// Key is a key for the client (default: "public").
 	Key string
+	// Logger receives warnings from actions. Default: slog.Default().
+	Logger *slog.Logger
 	// Name is a name for the client (default: "Public Client").
 	Name string
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
@@ -42,6 +52,8 @@ type PublicClientConfig struct {
 // This mirrors viem's createPublicClient.
 type PublicClient struct {
 	*BaseClient
+
+	feeCacheTime time.Duration
 }
 
 // CreatePublicClient creates a new public client with the given configuration.
@@ -70,8 +82,10 @@ func CreatePublicClient(config PublicClientConfig) (*PublicClient, error) {
 		Batch:                config.Batch,
 		CacheTime:            config.CacheTime,
 		Chain:                config.Chain,
+		DetectChain:          config.DetectChain,
 		ExperimentalBlockTag: config.ExperimentalBlockTag,
 		Key:                  key,
+		Logger:               config.Logger,
 		Name:                 name,
 		PollingInterval:      config.PollingInterval,
 		Transport:            config.Transport,
@@ -83,7 +97,12 @@ func CreatePublicClient(config PublicClientConfig) (*PublicClient, error) {
 		return nil, err
 	}
 
-	return &PublicClient{BaseClient: base}, nil
+	return &PublicClient{BaseClient: base, feeCacheTime: config.FeeCacheTime}, nil
+}
+
+// FeeCacheTime returns how long fee results are cached.
+func (c *PublicClient) FeeCacheTime() time.Duration {
+	return c.feeCacheTime
 }
 
 // ---- Public Actions (Read Methods) ----
@@ -93,6 +112,18 @@ func (c *PublicClient) GetBlockNumber(ctx context.Context) (uint64, error) {
 	return public.GetBlockNumber(ctx, c, public.GetBlockNumberParameters{})
 }
 
+// InvalidateBlockNumberCache drops the cached block number so the next
+// GetBlockNumber call fetches a fresh one.
+func (c *PublicClient) InvalidateBlockNumberCache() {
+	public.InvalidateBlockNumberCache(c)
+}
+
+// InvalidateFeeCache drops cached fee data so the next GetGasPrice or
+// EstimateFeesPerGas call fetches fresh values.
+func (c *PublicClient) InvalidateFeeCache() {
+	public.InvalidateFeeCache(c)
+}
+
 // GetChainID returns the chain ID.
 func (c *PublicClient) GetChainID(ctx context.Context) (uint64, error) {
 	return public.GetChainID(ctx, c)
@@ -103,6 +134,11 @@ func (c *PublicClient) GetGasPrice(ctx context.Context) (*big.Int, error) {
 	return public.GetGasPrice(ctx, c)
 }
 
+// GetBlobBaseFee returns the current base fee per blob gas in wei.
+func (c *PublicClient) GetBlobBaseFee(ctx context.Context) (*big.Int, error) {
+	return public.GetBlobBaseFee(ctx, c)
+}
+
 // GetBalance returns the balance of an address in wei.
 // This delegates to the standalone public.GetBalance action.
 func (c *PublicClient) GetBalance(ctx context.Context, address common.Address, blockTag ...BlockTag) (*big.Int, error) {
@@ -113,6 +149,12 @@ func (c *PublicClient) GetBalance(ctx context.Context, address common.Address, b
 	return public.GetBalance(ctx, c, params)
 }
 
+// GetBalances returns the balances of several addresses in wei, ordered
+// like params.Addresses.
+func (c *PublicClient) GetBalances(ctx context.Context, params public.GetBalancesParameters) ([]*big.Int, error) {
+	return public.GetBalances(ctx, c, params)
+}
+
 // GetTransactionCount returns the nonce for an address.
 func (c *PublicClient) GetTransactionCount(ctx context.Context, address common.Address, blockTag ...BlockTag) (uint64, error) {
 	params := public.GetTransactionCountParameters{Address: address}
@@ -128,15 +170,16 @@ func (c *PublicClient) GetCode(ctx context.Context, address common.Address, bloc
 	if len(blockTag) > 0 {
 		params.BlockTag = blockTag[0]
 	}
-	code, err := public.GetCode(ctx, c, params)
-	if err != nil {
-		return nil, err
-	}
-	// Preserve legacy behavior: return empty slice for "no code" instead of nil.
-	if code == nil {
-		return []byte{}, nil
+	return public.GetCode(ctx, c, params)
+}
+
+// GetBytecode returns the bytecode at an address, or nil if there is no contract.
+func (c *PublicClient) GetBytecode(ctx context.Context, address common.Address, blockTag ...BlockTag) ([]byte, error) {
+	params := public.GetBytecodeParameters{Address: address}
+	if len(blockTag) > 0 {
+		params.BlockTag = blockTag[0]
 	}
-	return code, nil
+	return public.GetBytecode(ctx, c, params)
 }
 
 // GetStorageAt returns the value at a storage position.
@@ -159,6 +202,25 @@ func (c *PublicClient) GetStorageAt(ctx context.Context, address common.Address,
 	return value, nil
 }
 
+// GetStorageAtVerified returns the value at a storage position after
+// verifying its proof against the block's state root.
+func (c *PublicClient) GetStorageAtVerified(ctx context.Context, address common.Address, slot common.Hash, blockTag ...BlockTag) ([]byte, error) {
+	params := public.GetStorageAtVerifiedParameters{
+		Address: address,
+		Slot:    slot,
+	}
+	if len(blockTag) > 0 {
+		params.BlockTag = blockTag[0]
+	}
+	return public.GetStorageAtVerified(ctx, c, params)
+}
+
+// GetStorageAtMany returns the values of several storage slots at an address,
+// ordered like params.Slots.
+func (c *PublicClient) GetStorageAtMany(ctx context.Context, params public.GetStorageAtManyParameters) ([][]byte, error) {
+	return public.GetStorageAtMany(ctx, c, params)
+}
+
 // CallRequest represents the parameters for an eth_call request.
 type CallRequest = types.CallRequest
 
@@ -204,6 +266,27 @@ func (c *PublicClient) EstimateGas(ctx context.Context, call CallRequest) (uint6
 	return public.EstimateGas(ctx, c, params)
 }
 
+// EstimateGasBatch estimates gas for many independent calls.
+// Results are aligned by index with calls; a reverting call only fails its own entry.
+func (c *PublicClient) EstimateGasBatch(ctx context.Context, calls []CallRequest) ([]public.EstimateGasBatchResult, error) {
+	requests := make([]public.EstimateGasParameters, len(calls))
+	for i, call := range calls {
+		to := call.To // addressable
+		requests[i] = public.EstimateGasParameters{
+			Account:  call.From,
+			To:       &to,
+			Data:     call.Data,
+			Value:    call.Value,
+			GasPrice: call.GasPrice,
+		}
+		if call.Gas > 0 {
+			gas := call.Gas
+			requests[i].Gas = &gas
+		}
+	}
+	return public.EstimateGasBatch(ctx, c, public.EstimateGasBatchParameters{Requests: requests})
+}
+
 // GetBlock returns a block by tag.
 // This delegates to the standalone public.GetBlock action.
 func (c *PublicClient) GetBlock(ctx context.Context, blockTag BlockTag, includeTransactions bool) (*types.Block, error) {
@@ -255,6 +338,18 @@ func (c *PublicClient) GetBlockByHash(ctx context.Context, hash common.Hash, inc
 	return block, nil
 }
 
+// GetUncle returns the uncle (ommer) block at an index within a block.
+// This delegates to the standalone public.GetUncle action.
+func (c *PublicClient) GetUncle(ctx context.Context, params public.GetUncleParameters) (*types.Block, error) {
+	return public.GetUncle(ctx, c, params)
+}
+
+// GetUncleCount returns the number of uncles in a block.
+// This delegates to the standalone public.GetUncleCount action.
+func (c *PublicClient) GetUncleCount(ctx context.Context, params public.GetUncleCountParameters) (uint64, error) {
+	return public.GetUncleCount(ctx, c, params)
+}
+
 // GetTransaction returns a transaction by hash.
 // This delegates to the standalone public.GetTransaction action.
 func (c *PublicClient) GetTransaction(ctx context.Context, hash common.Hash) (*public.TransactionResponse, error) {
@@ -302,6 +397,12 @@ func (c *PublicClient) GetLogs(ctx context.Context, filter FilterQuery) ([]types
 	return logs, nil
 }
 
+// StreamLogs scans a block range for logs in chunks and sends them on the
+// returned channel as they arrive. Close the context to stop the scan.
+func (c *PublicClient) StreamLogs(ctx context.Context, params public.StreamLogsParameters) <-chan public.LogOrError {
+	return public.StreamLogs(ctx, c, params)
+}
+
 // GetFeeHistory returns fee history.
 func (c *PublicClient) GetFeeHistory(ctx context.Context, blockCount uint64, newestBlock BlockTag, rewardPercentiles []float64) (json.RawMessage, error) {
 	history, err := public.GetFeeHistory(ctx, c, public.GetFeeHistoryParameters{
@@ -325,7 +426,7 @@ func (c *PublicClient) GetMaxPriorityFeePerGas(ctx context.Context) (*big.Int, e
 }
 
 // GetProof returns the account and storage values with Merkle proof.
-func (c *PublicClient) GetProof(ctx context.Context, address common.Address, storageKeys []common.Hash, blockTag ...BlockTag) (json.RawMessage, error) {
+func (c *PublicClient) GetProof(ctx context.Context, address common.Address, storageKeys []common.Hash, blockTag ...BlockTag) (public.GetProofReturnType, error) {
 	params := public.GetProofParameters{
 		Address:     address,
 		StorageKeys: storageKeys,
@@ -333,15 +434,7 @@ func (c *PublicClient) GetProof(ctx context.Context, address common.Address, sto
 	if len(blockTag) > 0 {
 		params.BlockTag = blockTag[0]
 	}
-	proof, err := public.GetProof(ctx, c, params)
-	if err != nil {
-		return nil, err
-	}
-	b, marshalErr := json.Marshal(proof)
-	if marshalErr != nil {
-		return nil, marshalErr
-	}
-	return json.RawMessage(b), nil
+	return public.GetProof(ctx, c, params)
 }
 
 // WaitForTransactionReceipt waits for a transaction to be mined and returns its receipt.
@@ -349,6 +442,15 @@ func (c *PublicClient) WaitForTransactionReceipt(ctx context.Context, hash commo
 	return public.WaitForTransactionReceipt(ctx, c, public.WaitForTransactionReceiptParameters{Hash: hash})
 }
 
+// WaitForTransactionReceiptByNonce waits for whichever transaction from address
+// with nonce is mined and returns its hash and receipt.
+func (c *PublicClient) WaitForTransactionReceiptByNonce(ctx context.Context, address common.Address, nonce uint64) (*public.WaitForTransactionReceiptByNonceReturnType, error) {
+	return public.WaitForTransactionReceiptByNonce(ctx, c, public.WaitForTransactionReceiptByNonceParameters{
+		Address: address,
+		Nonce:   nonce,
+	})
+}
+
 // ---- Watch Actions ----
 
 // TransportType returns the type of transport being used.
@@ -357,7 +459,8 @@ func (c *PublicClient) TransportType() string {
 	return c.transport.Config().Type
 }
 
-// Subscribe creates a WebSocket subscription.
+// Subscribe creates a subscription on a WebSocket transport, or on an
+// EIP-1193 transport whose provider supports eth_subscribe.
 // Implements the WatchClient interface.
 // Returns ErrSubscriptionNotSupported if the transport doesn't support subscriptions.
 func (c *PublicClient) Subscribe(
@@ -369,6 +472,9 @@ func (c *PublicClient) Subscribe(
 	if wsTransport, ok := c.transport.(*transport.WebSocketTransport); ok {
 		return wsTransport.Subscribe(params, onData, onError)
 	}
+	if eip1193, ok := c.transport.(*transport.EIP1193Transport); ok && eip1193.SupportsSubscriptions() {
+		return eip1193.Subscribe(params, onData, onError)
+	}
 	return nil, public.ErrSubscriptionNotSupported
 }
 
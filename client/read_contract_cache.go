@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/utils"
+)
+
+// readContractCache holds the raw eth_call results of cached contract reads
+// across clients. Keys are scoped by client UID, so clients never share
+// results.
+var readContractCache = utils.NewLruMap[cachedRead](4096)
+
+type cachedRead struct {
+	data []byte
+	// expiresAt is zero for reads pinned to a block, which never go stale.
+	expiresAt time.Time
+}
+
+// CachedCall is like Call, but reuses the result of an identical call made
+// within cacheTime. Calls are identical when they have the same contract
+// address, function selector, encoded arguments, block and caller.
+//
+// Reads pinned to a block number or hash cannot change, so their results are
+// kept until evicted regardless of cacheTime. A cacheTime of 0 disables
+// caching.
+func (c *PublicClient) CachedCall(ctx context.Context, call CallRequest, cacheTime time.Duration, blockTag ...BlockTag) ([]byte, error) {
+	if cacheTime <= 0 {
+		return c.Call(ctx, call, blockTag...)
+	}
+
+	var block BlockTag
+	if len(blockTag) > 0 {
+		block = blockTag[0]
+	}
+	if block == "" {
+		block = BlockTagLatest
+	}
+
+	key := readContractCacheKey(c.UID(), call, block)
+	if cached, ok := readContractCache.Get(key); ok {
+		if cached.expiresAt.IsZero() || time.Now().Before(cached.expiresAt) {
+			return append([]byte(nil), cached.data...), nil
+		}
+		readContractCache.Delete(key)
+	}
+
+	data, err := c.Call(ctx, call, blockTag...)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := cachedRead{data: append([]byte(nil), data...)}
+	if !isPinnedBlock(block) {
+		entry.expiresAt = time.Now().Add(cacheTime)
+	}
+	readContractCache.Set(key, entry)
+
+	return data, nil
+}
+
+// readContractCacheKey returns the cache key for a contract read: the
+// contract address, function selector, hash of the encoded arguments, block
+// and caller.
+func readContractCacheKey(uid string, call CallRequest, block BlockTag) string {
+	selector, args := call.Data, []byte(nil)
+	if len(call.Data) >= 4 {
+		selector, args = call.Data[:4], call.Data[4:]
+	}
+	from := ""
+	if call.From != nil {
+		from = call.From.Hex()
+	}
+	return fmt.Sprintf("readContract.%s.%s.%x.%s.%s.%s",
+		uid, call.To.Hex(), selector, crypto.Keccak256Hash(args).Hex(), block, from)
+}
+
+// isPinnedBlock reports whether block is a block number or hash rather than
+// a tag such as "latest" whose block moves.
+func isPinnedBlock(block BlockTag) bool {
+	return strings.HasPrefix(string(block), "0x")
+}
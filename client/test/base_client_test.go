@@ -4,6 +4,8 @@ import (
 	"context"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -206,6 +208,38 @@ func TestPublicClient_Call(t *testing.T) {
 	assert.NotEmpty(t, result)
 }
 
+func TestPublicClient_FeeCacheTime(t *testing.T) {
+	var calls atomic.Int32
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_gasPrice" {
+			calls.Add(1)
+			return "0x4a817c800"
+		}
+		return "0x0"
+	})
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport:    transport.HTTP(server.URL),
+		FeeCacheTime: time.Minute,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+	assert.Equal(t, time.Minute, c.FeeCacheTime())
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		_, err := c.GetGasPrice(ctx)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	c.InvalidateFeeCache()
+	_, err = c.GetGasPrice(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
 func TestBaseClient_Extend(t *testing.T) {
 	server := createTestServer(t, func(method string, params []any) any {
 		return "0x1"
@@ -301,3 +335,184 @@ func TestPublicActions(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, uint64(1), chainID)
 }
+
+func TestDetectChain(t *testing.T) {
+	detect := func(t *testing.T, chainID string) *client.Chain {
+		server := createTestServer(t, func(method string, params []any) any {
+			require.Equal(t, "eth_chainId", method)
+			return chainID
+		})
+		defer server.Close()
+
+		tr, err := transport.HTTP(server.URL)(transport.TransportParams{})
+		require.NoError(t, err)
+		defer tr.Close()
+
+		c, err := client.DetectChain(context.Background(), tr)
+		require.NoError(t, err)
+		return c
+	}
+
+	t.Run("known chain", func(t *testing.T) {
+		c := detect(t, "0x1")
+		assert.Equal(t, int64(1), c.ID)
+		assert.Equal(t, "Ethereum", c.Name)
+		require.NotNil(t, c.Contracts)
+		assert.Equal(t, common.HexToAddress("0xca11bde05977b3631167028862be2a173976ca11"), c.Contracts.Multicall3.Address)
+	})
+
+	t.Run("unknown chain", func(t *testing.T) {
+		c := detect(t, "0x7a69")
+		assert.Equal(t, int64(31337), c.ID)
+		assert.Equal(t, "Chain 31337", c.Name)
+		assert.Equal(t, "ETH", c.NativeCurrency.Symbol)
+		assert.Equal(t, uint8(18), c.NativeCurrency.Decimals)
+		assert.Nil(t, c.Contracts)
+	})
+}
+
+func TestCreatePublicClient_DetectChain(t *testing.T) {
+	var chainIDCalls int
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_chainId" {
+			chainIDCalls++
+			return "0xa"
+		}
+		return "0x0"
+	})
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport:   transport.HTTP(server.URL),
+		DetectChain: true,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	assert.Equal(t, 0, chainIDCalls, "detection should be lazy")
+
+	detected := c.Chain()
+	require.NotNil(t, detected)
+	assert.Equal(t, int64(10), detected.ID)
+	assert.Equal(t, "OP Mainnet", detected.Name)
+
+	// The detected chain is cached.
+	assert.Same(t, detected, c.Chain())
+	assert.Equal(t, 1, chainIDCalls)
+}
+
+func TestCreatePublicClient_DetectChainFailure(t *testing.T) {
+	var chainIDCalls int
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		chainIDCalls++
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0xa"}
+		if fail {
+			delete(resp, "result")
+			resp["error"] = map[string]any{"code": -32000, "message": "unavailable"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport:   transport.HTTP(server.URL, transport.HTTPTransportConfig{RetryCount: 0}),
+		DetectChain: true,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	assert.Nil(t, c.Chain())
+	assert.ErrorContains(t, c.ChainError(), "unavailable")
+
+	// The failure is remembered rather than retried on every call.
+	assert.Nil(t, c.Chain())
+	assert.Equal(t, 1, chainIDCalls)
+
+	// DetectChain retries explicitly.
+	fail = false
+	detected, err := c.DetectChain(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), detected.ID)
+	assert.Same(t, detected, c.Chain())
+	assert.NoError(t, c.ChainError())
+	assert.Equal(t, 2, chainIDCalls)
+}
+
+func TestClientStats(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		if req.Method == "eth_fail" {
+			resp["error"] = map[string]any{"code": -32000, "message": "boom"}
+		} else {
+			resp["result"] = "0x1"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	c, err := client.CreateClient(client.ClientConfig{
+		Transport: transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	stats := c.Stats()
+	assert.Zero(t, stats.RequestCount)
+	assert.Zero(t, stats.ErrorCount)
+	assert.True(t, stats.LastRequestAt.IsZero())
+
+	const workers, perWorker = 8, 25
+	before := time.Now()
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				method := "eth_chainId"
+				if i%5 == 0 {
+					method = "eth_fail"
+				}
+				_, _ = c.Request(context.Background(), method)
+				_ = c.Stats()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	stats = c.Stats()
+	assert.Equal(t, uint64(workers*perWorker), stats.RequestCount)
+	assert.Equal(t, uint64(workers*perWorker/5), stats.ErrorCount)
+	assert.False(t, stats.LastRequestAt.Before(before))
+	assert.False(t, stats.LastRequestAt.After(time.Now()))
+}
+
+func TestClientUID_UniquePerInstance(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		c, err := client.CreateClient(client.ClientConfig{Transport: transport.HTTP("http://localhost")})
+		require.NoError(t, err)
+
+		uid := c.UID()
+		assert.NotEmpty(t, uid)
+		assert.Equal(t, uid, c.UID(), "UID must be stable")
+		assert.False(t, seen[uid], "duplicate UID %s", uid)
+		seen[uid] = true
+	}
+}
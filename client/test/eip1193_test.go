@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// walletProvider is a fake EIP-1193 provider standing in for an external
+// wallet that signs eth_sendTransaction itself.
+type walletProvider struct {
+	sent []any
+}
+
+func (p *walletProvider) Request(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	switch method {
+	case "eth_chainId":
+		return json.RawMessage(`"0x1"`), nil
+	case "eth_sendTransaction":
+		p.sent = params
+		return json.RawMessage(`"0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"`), nil
+	}
+	return nil, &transport.RPCError{Code: transport.RPCErrorCodeMethodNotFound, Message: "method not found"}
+}
+
+func TestWalletClient_EIP1193Transport(t *testing.T) {
+	provider := &walletProvider{}
+	from := common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+
+	c, err := client.CreateWalletClient(client.WalletClientConfig{
+		Account:   client.NewAddressAccount(from),
+		Transport: transport.EIP1193(provider),
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	chainID, err := public.GetChainID(context.Background(), c)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), chainID)
+
+	hash, err := c.SendTransaction(context.Background(), wallet.SendTransactionParameters{
+		To:    "0x70997970c51812dc3a010c7d01b50e0d17dc79c8",
+		Value: big.NewInt(1),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1", hash)
+
+	require.Len(t, provider.sent, 1)
+	tx := provider.sent[0].(map[string]any)
+	assert.Equal(t, from, common.HexToAddress(tx["from"].(string)))
+	assert.Equal(t, common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8"), common.HexToAddress(tx["to"].(string)))
+	assert.Equal(t, "0x1", tx["value"])
+}
@@ -0,0 +1,114 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+const getContractTestABI = `[
+	{"name":"balanceOf","type":"function","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var (
+	getContractTestAddress = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	getContractTestOwner   = common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+)
+
+func TestGetContract_Read(t *testing.T) {
+	var callTo string
+	server := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_call" {
+			callTo = params[0].(map[string]any)["to"].(string)
+			return "0x00000000000000000000000000000000000000000000000000000000000003e8"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	publicClient, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport: transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	defer publicClient.Close()
+
+	contract, err := client.GetContract(client.GetContractOptions{
+		Address: getContractTestAddress,
+		ABI:     getContractTestABI,
+		Client:  publicClient,
+	})
+	require.NoError(t, err)
+
+	balance, err := contract.Read(context.Background(), "balanceOf", getContractTestOwner)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000), balance)
+	assert.True(t, strings.EqualFold(getContractTestAddress.Hex(), callTo))
+
+	_, err = contract.Write(context.Background(), "transfer", getContractTestOwner, big.NewInt(1))
+	assert.True(t, errors.Is(err, client.ErrContractWalletClientRequired))
+}
+
+func TestGetContract_Write(t *testing.T) {
+	var sent map[string]any
+	server := createTestServer(t, func(method string, params []any) any {
+		switch method {
+		case "eth_sendTransaction":
+			sent = params[0].(map[string]any)
+			return "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		case "eth_estimateGas":
+			return "0x5208"
+		}
+		return nil
+	})
+	defer server.Close()
+
+	walletClient, err := client.CreateWalletClient(client.WalletClientConfig{
+		Account:      client.NewAddressAccount(getContractTestOwner),
+		FeeCacheTime: time.Minute,
+		Transport:    transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	defer walletClient.Close()
+
+	contract, err := client.GetContract(client.GetContractOptions{
+		Address: getContractTestAddress,
+		ABI:     getContractTestABI,
+		Client:  walletClient,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, contract.PublicClient().FeeCacheTime())
+
+	recipient := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	hash, err := contract.Write(context.Background(), "transfer", recipient, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1", hash)
+
+	require.NotNil(t, sent)
+	assert.True(t, strings.EqualFold(getContractTestAddress.Hex(), sent["to"].(string)))
+	assert.True(t, strings.EqualFold(getContractTestOwner.Hex(), sent["from"].(string)))
+	assert.True(t, strings.HasPrefix(sent["data"].(string), "0xa9059cbb"))
+
+	gas, err := contract.EstimateGas(context.Background(), "transfer", recipient, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(21000), gas)
+}
+
+func TestGetContract_UnsupportedClient(t *testing.T) {
+	_, err := client.GetContract(client.GetContractOptions{
+		Address: getContractTestAddress,
+		ABI:     getContractTestABI,
+		Client:  "not a client",
+	})
+	assert.Error(t, err)
+}
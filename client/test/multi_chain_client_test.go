@@ -0,0 +1,218 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/chain"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+func TestMultiChainClient_For(t *testing.T) {
+	mainnetServer := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_blockNumber" {
+			return "0x1"
+		}
+		return "0x0"
+	})
+	defer mainnetServer.Close()
+
+	polygonServer := createTestServer(t, func(method string, params []any) any {
+		if method == "eth_blockNumber" {
+			return "0x89"
+		}
+		return "0x0"
+	})
+	defer polygonServer.Close()
+
+	mc, err := client.NewMultiChainClient(map[int64]client.PublicClientConfig{
+		1:   {Chain: &chain.Chain{ID: 1, Name: "Ethereum"}, Transport: transport.HTTP(mainnetServer.URL)},
+		137: {Chain: &chain.Chain{ID: 137, Name: "Polygon"}, Transport: transport.HTTP(polygonServer.URL)},
+	})
+	require.NoError(t, err)
+	defer mc.Close()
+
+	assert.Equal(t, []int64{1, 137}, mc.ChainIDs())
+	assert.True(t, mc.Has(137))
+	assert.False(t, mc.Has(10))
+
+	ctx := context.Background()
+
+	polygon, err := mc.For(137)
+	require.NoError(t, err)
+	blockNumber, err := polygon.GetBlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0x89), blockNumber)
+
+	mainnet, err := mc.For(1)
+	require.NoError(t, err)
+	blockNumber, err = mainnet.GetBlockNumber(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), blockNumber)
+
+	c, err := mc.Chain(137)
+	require.NoError(t, err)
+	assert.Equal(t, "Polygon", c.Name)
+
+	_, err = mc.For(10)
+	assert.True(t, errors.Is(err, client.ErrChainNotConfigured))
+}
+
+func TestMultiChainClient_SharedTransport(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return "0x1"
+	})
+	defer server.Close()
+
+	mc, err := client.NewMultiChainClient(map[int64]client.PublicClientConfig{
+		1: {},
+		5: {},
+	}, client.MultiChainClientOptions{Transport: transport.HTTP(server.URL)})
+	require.NoError(t, err)
+	defer mc.Close()
+
+	c, err := mc.For(5)
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, c.Transport().Value().URL)
+}
+
+func TestMultiChainClient_ChainIDMismatch(t *testing.T) {
+	server := createTestServer(t, func(method string, params []any) any {
+		return "0x1"
+	})
+	defer server.Close()
+
+	_, err := client.NewMultiChainClient(map[int64]client.PublicClientConfig{
+		10: {Chain: &chain.Chain{ID: 1, Name: "Ethereum"}, Transport: transport.HTTP(server.URL)},
+	})
+	assert.Error(t, err)
+}
+
+// newMultiChainTestClients creates two public clients on mock chains 1 and 137
+// whose servers answer eth_blockNumber and eth_getBalance with distinct values.
+func newMultiChainTestClients(t *testing.T) map[int64]*client.PublicClient {
+	t.Helper()
+	clients := map[int64]*client.PublicClient{}
+	for _, mock := range []struct {
+		chain       *chain.Chain
+		blockNumber string
+		balance     string
+	}{
+		{&chain.Chain{ID: 1, Name: "Ethereum"}, "0x1", "0x64"},
+		{&chain.Chain{ID: 137, Name: "Polygon"}, "0x89", "0xc8"},
+	} {
+		server := createTestServer(t, func(method string, params []any) any {
+			switch method {
+			case "eth_blockNumber":
+				return mock.blockNumber
+			case "eth_getBalance":
+				return mock.balance
+			}
+			return "0x0"
+		})
+		t.Cleanup(server.Close)
+
+		c, err := client.CreatePublicClient(client.PublicClientConfig{
+			Chain:     mock.chain,
+			Transport: transport.HTTP(server.URL),
+		})
+		require.NoError(t, err)
+		clients[mock.chain.ID] = c
+	}
+	return clients
+}
+
+func TestMultiChainClientFromClients_Routing(t *testing.T) {
+	mc, err := client.NewMultiChainClientFromClients(newMultiChainTestClients(t))
+	require.NoError(t, err)
+	defer mc.Close()
+
+	ctx := context.Background()
+	owner := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	balance, err := mc.GetBalanceOnChain(ctx, 1, owner)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(100), balance)
+
+	balance, err = mc.GetBalanceOnChain(ctx, 137, owner)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(200), balance)
+
+	polygon, err := mc.For(137)
+	require.NoError(t, err)
+	assert.Equal(t, int64(137), polygon.Chain().ID)
+
+	_, err = mc.GetBalanceOnChain(ctx, 10, owner)
+	assert.True(t, errors.Is(err, client.ErrChainNotConfigured))
+	_, err = mc.For(10)
+	assert.True(t, errors.Is(err, client.ErrChainNotConfigured))
+}
+
+func TestMultiChainClientFromClients_ChainIDMismatch(t *testing.T) {
+	clients := newMultiChainTestClients(t)
+	defer clients[1].Close()
+	defer clients[137].Close()
+
+	_, err := client.NewMultiChainClientFromClients(map[int64]*client.PublicClient{10: clients[1]})
+	assert.Error(t, err)
+}
+
+func TestNewMultichainClient_ForChain(t *testing.T) {
+	mc, err := client.NewMultichainClient(newMultiChainTestClients(t))
+	require.NoError(t, err)
+	defer mc.Close()
+
+	polygon, err := mc.ForChain(137)
+	require.NoError(t, err)
+	assert.Equal(t, int64(137), polygon.Chain().ID)
+
+	balance, err := polygon.GetBalance(context.Background(), common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(200), balance)
+
+	_, err = mc.ForChain(10)
+	assert.True(t, errors.Is(err, client.ErrChainNotConfigured))
+}
+
+func TestMultiChainClient_WatchAllBlockNumbers(t *testing.T) {
+	mc, err := client.NewMultiChainClientFromClients(newMultiChainTestClients(t))
+	require.NoError(t, err)
+	defer mc.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	poll := true
+	events, unsubscribe := mc.WatchAllBlockNumbers(ctx, public.WatchBlockNumberParameters{
+		EmitOnBegin:     true,
+		Poll:            &poll,
+		PollingInterval: 50 * time.Millisecond,
+	})
+
+	seen := map[int64]uint64{}
+	for len(seen) < 2 {
+		select {
+		case ev := <-events:
+			require.NoError(t, ev.Error)
+			seen[ev.ChainID] = ev.BlockNumber
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for block numbers from both chains")
+		}
+	}
+	assert.Equal(t, map[int64]uint64{1: 1, 137: 0x89}, seen)
+
+	// Unsubscribing without reading further events must not block, and the
+	// channel is closed once it returns.
+	unsubscribe()
+	for range events {
+	}
+}
@@ -0,0 +1,126 @@
+package client_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// readCacheTestClient returns a client whose eth_call answers balanceOf(owner)
+// with the owner address as the balance, and counts the eth_calls made.
+func readCacheTestClient(t *testing.T, calls *atomic.Int32) *client.PublicClient {
+	t.Helper()
+	server := createTestServer(t, func(method string, params []any) any {
+		if method != "eth_call" {
+			return nil
+		}
+		calls.Add(1)
+		data := params[0].(map[string]any)["data"].(string)
+		return "0x" + data[len(data)-64:]
+	})
+	t.Cleanup(server.Close)
+
+	publicClient, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport: transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = publicClient.Close() })
+	return publicClient
+}
+
+func balanceOfOptions(owner common.Address, cacheTime time.Duration) client.ReadContractOptions {
+	return client.ReadContractOptions{
+		Address:      getContractTestAddress,
+		ABI:          getContractTestABI,
+		FunctionName: "balanceOf",
+		Args:         []any{owner},
+		CacheTime:    cacheTime,
+	}
+}
+
+func TestReadContract_CacheTime(t *testing.T) {
+	var calls atomic.Int32
+	c := readCacheTestClient(t, &calls)
+	ctx := context.Background()
+
+	first, err := c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, time.Minute))
+	require.NoError(t, err)
+	second, err := c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+	assert.Equal(t, new(big.Int).SetBytes(getContractTestOwner.Bytes()), second)
+	assert.Equal(t, int32(1), calls.Load(), "second read must be served from the cache")
+}
+
+func TestReadContract_CacheKeyedByArgs(t *testing.T) {
+	var calls atomic.Int32
+	c := readCacheTestClient(t, &calls)
+	ctx := context.Background()
+	other := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	balance, err := c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, time.Minute))
+	require.NoError(t, err)
+	otherBalance, err := c.ReadContract(ctx, balanceOfOptions(other, time.Minute))
+	require.NoError(t, err)
+
+	assert.Equal(t, new(big.Int).SetBytes(getContractTestOwner.Bytes()), balance)
+	assert.Equal(t, new(big.Int).SetBytes(other.Bytes()), otherBalance)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestReadContract_CacheExpires(t *testing.T) {
+	var calls atomic.Int32
+	c := readCacheTestClient(t, &calls)
+	ctx := context.Background()
+
+	_, err := c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, 20*time.Millisecond))
+	require.NoError(t, err)
+	time.Sleep(40 * time.Millisecond)
+	_, err = c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, 20*time.Millisecond))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestReadContract_CachePinnedBlock(t *testing.T) {
+	var calls atomic.Int32
+	c := readCacheTestClient(t, &calls)
+	ctx := context.Background()
+
+	opts := balanceOfOptions(getContractTestOwner, 20*time.Millisecond)
+	opts.Block = "0x10"
+	_, err := c.ReadContract(ctx, opts)
+	require.NoError(t, err)
+	time.Sleep(40 * time.Millisecond)
+	_, err = c.ReadContract(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), calls.Load(), "reads pinned to a block do not expire")
+
+	// A different block is a different read
+	opts.Block = "0x11"
+	_, err = c.ReadContract(ctx, opts)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestReadContract_NoCacheByDefault(t *testing.T) {
+	var calls atomic.Int32
+	c := readCacheTestClient(t, &calls)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := c.ReadContract(ctx, balanceOfOptions(getContractTestOwner, 0))
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(2), calls.Load())
+}
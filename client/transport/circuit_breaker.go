@@ -0,0 +1,237 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by a CircuitBreakerTransport while its circuit is
+// open, without contacting the inner transport.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitBreakerConfig contains configuration for the circuit-breaking transport.
+type CircuitBreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that trips the
+	// circuit open. Defaults to 5.
+	FailureThreshold int
+	// OpenDuration is how long the circuit stays open before probing the
+	// endpoint again. Defaults to 30s.
+	OpenDuration time.Duration
+	// HalfOpenProbes is the number of concurrent requests let through while
+	// half-open. Defaults to 1.
+	HalfOpenProbes int
+}
+
+// CircuitState is the state of a circuit breaker.
+type CircuitState int
+
+const (
+	// CircuitClosed forwards every request.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every request with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen forwards up to HalfOpenProbes requests to probe recovery.
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreakerTransport wraps a transport with a circuit breaker.
+//
+// After FailureThreshold consecutive failures the circuit opens and requests
+// fail fast with ErrCircuitOpen for OpenDuration, so a dead endpoint is not
+// hammered and a surrounding fallback transport moves on immediately. The
+// circuit then half-opens: a successful probe closes it again, a failed one
+// re-opens it.
+//
+// Execution reverts prove the endpoint is healthy and count as successes.
+// Requests whose own context was cancelled are not counted either way.
+type CircuitBreakerTransport struct {
+	inner Transport
+
+	threshold    int
+	openDuration time.Duration
+	maxProbes    int
+
+	mu       sync.Mutex
+	state    CircuitState
+	failures int
+	openedAt time.Time
+	probes   int
+}
+
+// WithCircuitBreaker wraps a transport factory with a circuit breaker.
+// It composes with other factories, e.g. Fallback(WithCircuitBreaker(HTTP(url), cfg), ...).
+func WithCircuitBreaker(inner TransportFactory, config CircuitBreakerConfig) TransportFactory {
+	return func(params TransportParams) (Transport, error) {
+		t, err := inner(params)
+		if err != nil {
+			return nil, err
+		}
+		return NewCircuitBreakerTransport(t, config)
+	}
+}
+
+// NewCircuitBreakerTransport wraps an existing transport with a circuit breaker.
+func NewCircuitBreakerTransport(inner Transport, config CircuitBreakerConfig) (*CircuitBreakerTransport, error) {
+	if config.FailureThreshold < 0 || config.OpenDuration < 0 || config.HalfOpenProbes < 0 {
+		return nil, errors.New("circuit breaker config values must not be negative")
+	}
+	if config.FailureThreshold == 0 {
+		config.FailureThreshold = 5
+	}
+	if config.OpenDuration == 0 {
+		config.OpenDuration = 30 * time.Second
+	}
+	if config.HalfOpenProbes == 0 {
+		config.HalfOpenProbes = 1
+	}
+
+	return &CircuitBreakerTransport{
+		inner:        inner,
+		threshold:    config.FailureThreshold,
+		openDuration: config.OpenDuration,
+		maxProbes:    config.HalfOpenProbes,
+	}, nil
+}
+
+// Config returns the inner transport's configuration, so transport type
+// detection (e.g. polling vs subscriptions) is unaffected by the wrapper.
+func (t *CircuitBreakerTransport) Config() TransportConfig {
+	return t.inner.Config()
+}
+
+// Request forwards the request to the inner transport unless the circuit is
+// open, in which case it returns ErrCircuitOpen.
+func (t *CircuitBreakerTransport) Request(ctx context.Context, req RPCRequest) (*RPCResponse, error) {
+	probe, err := t.acquire()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.inner.Request(ctx, req)
+
+	switch {
+	case err == nil || IsExecutionReverted(err):
+		t.record(probe, true)
+	case ctx.Err() != nil:
+		t.release(probe)
+	default:
+		t.record(probe, false)
+	}
+	return resp, err
+}
+
+// Value returns the inner transport's attributes.
+func (t *CircuitBreakerTransport) Value() *TransportValue {
+	return t.inner.Value()
+}
+
+// Close closes the inner transport.
+func (t *CircuitBreakerTransport) Close() error {
+	return t.inner.Close()
+}
+
+// Inner returns the wrapped transport.
+func (t *CircuitBreakerTransport) Inner() Transport {
+	return t.inner
+}
+
+// State returns the current state of the circuit.
+func (t *CircuitBreakerTransport) State() CircuitState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(time.Now())
+	return t.state
+}
+
+// acquire checks whether a request may be sent. probe reports whether the
+// request holds one of the half-open probe slots, which must be given back
+// with record or release.
+func (t *CircuitBreakerTransport) acquire() (probe bool, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.advance(time.Now())
+	switch t.state {
+	case CircuitOpen:
+		return false, ErrCircuitOpen
+	case CircuitHalfOpen:
+		if t.probes >= t.maxProbes {
+			return false, ErrCircuitOpen
+		}
+		t.probes++
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// record updates the circuit with the outcome of a request.
+func (t *CircuitBreakerTransport) record(probe, success bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if probe {
+		t.probes--
+	}
+
+	if success {
+		// A request that started before the circuit (re-)opened must not
+		// close it again; only a probe or a request in the closed state can.
+		if t.state != CircuitOpen {
+			t.state = CircuitClosed
+			t.failures = 0
+		}
+		return
+	}
+
+	switch t.state {
+	case CircuitHalfOpen:
+		t.trip()
+	case CircuitClosed:
+		t.failures++
+		if t.failures >= t.threshold {
+			t.trip()
+		}
+	}
+}
+
+// release gives back a probe slot without recording an outcome.
+func (t *CircuitBreakerTransport) release(probe bool) {
+	if !probe {
+		return
+	}
+	t.mu.Lock()
+	t.probes--
+	t.mu.Unlock()
+}
+
+// trip opens the circuit. Must be called with mu held.
+func (t *CircuitBreakerTransport) trip() {
+	t.state = CircuitOpen
+	t.openedAt = time.Now()
+	t.failures = 0
+}
+
+// advance moves an open circuit to half-open once OpenDuration has elapsed.
+// Must be called with mu held.
+func (t *CircuitBreakerTransport) advance(now time.Time) {
+	if t.state == CircuitOpen && now.Sub(t.openedAt) >= t.openDuration {
+		t.state = CircuitHalfOpen
+	}
+}
@@ -0,0 +1,197 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// EIP1193Provider is a provider exposing an EIP-1193 style request function,
+// e.g. a browser-injected wallet reached through gopherjs/wasm or an
+// external signer.
+//
+// Request receives the JSON-RPC params as plain JSON values and returns the
+// JSON result of the call. Errors returned by the provider should be
+// *RPCError values where possible so that the code and data are preserved;
+// other errors are passed through unchanged.
+type EIP1193Provider interface {
+	Request(ctx context.Context, method string, params []any) (json.RawMessage, error)
+}
+
+// EIP1193SubscriptionProvider is an EIP1193Provider that can also deliver
+// eth_subscribe notifications. params are the eth_subscribe params, e.g.
+// ["newHeads"]; onData is called with the result of each notification.
+type EIP1193SubscriptionProvider interface {
+	EIP1193Provider
+	Subscribe(params []any, onData func(data json.RawMessage), onError func(err error)) (*Subscription, error)
+}
+
+// EIP1193TransportConfig contains configuration for an EIP-1193 transport.
+type EIP1193TransportConfig struct {
+	// Key is the transport key.
+	Key string
+	// Name is the transport name.
+	Name string
+	// Methods specifies which RPC methods to allow/block.
+	Methods *MethodFilter
+	// RetryCount is the maximum number of retry attempts.
+	RetryCount int
+	// RetryDelay is the base delay between retries.
+	RetryDelay time.Duration
+	// Timeout is the request timeout. Requests that wait on the user, such
+	// as eth_sendTransaction in a browser wallet, need a generous timeout.
+	Timeout time.Duration
+}
+
+// DefaultEIP1193TransportConfig returns default EIP-1193 transport configuration.
+func DefaultEIP1193TransportConfig() EIP1193TransportConfig {
+	return EIP1193TransportConfig{
+		Key:        "eip1193",
+		Name:       "EIP-1193 Provider",
+		RetryCount: 3,
+		RetryDelay: 150 * time.Millisecond,
+		Timeout:    5 * time.Minute,
+	}
+}
+
+// ErrProviderSubscriptionsNotSupported is returned when subscribing through
+// an EIP-1193 provider that does not implement EIP1193SubscriptionProvider.
+var ErrProviderSubscriptionsNotSupported = errors.New("provider does not support eth_subscribe")
+
+// EIP1193Transport adapts an EIP1193Provider to the Transport interface.
+// Like viem's custom transport, it reports the "custom" type, so watch
+// actions poll unless subscriptions are requested explicitly.
+type EIP1193Transport struct {
+	*CustomTransport
+	provider EIP1193Provider
+}
+
+// EIP1193 creates a transport factory that sends every request through an
+// EIP-1193 provider. An optional config overrides the defaults.
+//
+// Example:
+//
+//	client, err := client.CreateWalletClient(client.WalletClientConfig{
+//	    Transport: transport.EIP1193(provider),
+//	})
+func EIP1193(provider EIP1193Provider, config ...EIP1193TransportConfig) TransportFactory {
+	cfg := DefaultEIP1193TransportConfig()
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	return func(params TransportParams) (Transport, error) {
+		c := cfg
+		if params.RetryCount != nil {
+			c.RetryCount = *params.RetryCount
+		}
+		if params.Timeout != nil {
+			c.Timeout = *params.Timeout
+		}
+		return NewEIP1193Transport(provider, c), nil
+	}
+}
+
+// NewEIP1193Transport creates a new EIP-1193 transport.
+func NewEIP1193Transport(provider EIP1193Provider, config EIP1193TransportConfig) *EIP1193Transport {
+	if config.Key == "" {
+		config.Key = "eip1193"
+	}
+	if config.Name == "" {
+		config.Name = "EIP-1193 Provider"
+	}
+
+	t := &EIP1193Transport{provider: provider}
+	t.CustomTransport = NewCustomTransport(CustomTransportConfig{
+		Key:        config.Key,
+		Name:       config.Name,
+		Methods:    config.Methods,
+		Request:    t.request,
+		RetryCount: config.RetryCount,
+		RetryDelay: config.RetryDelay,
+		Timeout:    config.Timeout,
+	})
+	return t
+}
+
+// request forwards a JSON-RPC request to the provider and wraps its result.
+func (t *EIP1193Transport) request(ctx context.Context, req RPCRequest) (*RPCResponse, error) {
+	params, err := requestParams(req.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := t.provider.Request(ctx, req.Method, params)
+	if err != nil {
+		var rpcErr *RPCError
+		if errors.As(err, &rpcErr) {
+			return &RPCResponse{JSONRPC: "2.0", ID: req.ID, Error: rpcErr}, nil
+		}
+		return nil, err
+	}
+	if result == nil {
+		result = json.RawMessage("null")
+	}
+	return &RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: result}, nil
+}
+
+// requestParams converts RPCRequest params to the positional list passed to
+// the provider. Params are round-tripped through JSON so the provider sees
+// plain JSON values (maps, slices, strings) rather than Go request structs,
+// as it would over a JavaScript bridge.
+func requestParams(params any) ([]any, error) {
+	if params == nil {
+		return []any{}, nil
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	list := []any{}
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, errors.New("EIP-1193 request params must be a list")
+	}
+	return list, nil
+}
+
+// Provider returns the underlying EIP-1193 provider.
+func (t *EIP1193Transport) Provider() EIP1193Provider {
+	return t.provider
+}
+
+// SupportsSubscriptions reports whether the provider implements
+// EIP1193SubscriptionProvider.
+func (t *EIP1193Transport) SupportsSubscriptions() bool {
+	_, ok := t.provider.(EIP1193SubscriptionProvider)
+	return ok
+}
+
+// Subscribe forwards an eth_subscribe subscription to the provider.
+// Returns ErrProviderSubscriptionsNotSupported if the provider cannot
+// subscribe.
+func (t *EIP1193Transport) Subscribe(
+	params SubscribeParams,
+	onData func(data json.RawMessage),
+	onError func(err error),
+) (*Subscription, error) {
+	provider, ok := t.provider.(EIP1193SubscriptionProvider)
+	if !ok {
+		return nil, ErrProviderSubscriptionsNotSupported
+	}
+
+	subParams := []any{params.Type}
+	if params.Params != nil {
+		subParams = append(subParams, params.Params)
+	}
+	return provider.Subscribe(subParams, onData, onError)
+}
+
+// Value returns transport-specific attributes.
+func (t *EIP1193Transport) Value() *TransportValue {
+	attributes := map[string]any{"provider": t.provider}
+	if t.SupportsSubscriptions() {
+		attributes["subscribe"] = t.Subscribe
+	}
+	return &TransportValue{Attributes: attributes}
+}
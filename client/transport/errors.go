@@ -1,7 +1,12 @@
 package transport
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
 
 	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
@@ -14,6 +19,10 @@ var (
 	ErrSocketClosed = rpc.ErrSocketClosed
 	// ErrTimeout is returned when a request times out.
 	ErrTimeout = rpc.ErrTimeout
+	// ErrResponseIDMismatch is returned when a response id does not match the request id.
+	ErrResponseIDMismatch = rpc.ErrResponseIDMismatch
+	// ErrDuplicateRequestID is returned when a request id is already in flight.
+	ErrDuplicateRequestID = rpc.ErrDuplicateRequestID
 	// ErrMethodNotSupported is returned when a method is not allowed.
 	ErrMethodNotSupported = errors.New("method not supported")
 )
@@ -54,3 +63,126 @@ const (
 	RPCErrorCodeLimitExceeded       = rpc.RPCErrorCodeLimitExceeded
 	RPCErrorCodeVersionUnsupported  = rpc.RPCErrorCodeVersionUnsupported
 )
+
+// MethodNotSupportedError is returned by actions when the node does not
+// implement the requested RPC method.
+type MethodNotSupportedError struct {
+	Method string
+	Cause  error
+}
+
+func (e *MethodNotSupportedError) Error() string {
+	return fmt.Sprintf("method %q is not supported by this node", e.Method)
+}
+
+func (e *MethodNotSupportedError) Unwrap() error {
+	return e.Cause
+}
+
+// RPCErrorCodeExecutionReverted is the error code nodes return for a call
+// or gas estimation that reverted.
+const RPCErrorCodeExecutionReverted = 3
+
+// AsRPCError returns the JSON-RPC error in err's chain, if any.
+func AsRPCError(err error) (*RPCError, bool) {
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) {
+		return rpcErr, true
+	}
+	return nil, false
+}
+
+// IsMethodNotSupported reports whether err indicates that the node does not
+// implement the requested method (-32601, -32004 or a provider message such
+// as geth's "the method x does not exist/is not available").
+func IsMethodNotSupported(err error) bool {
+	if err == nil {
+		return false
+	}
+	if rpcErr, ok := AsRPCError(err); ok {
+		if rpcErr.Code == RPCErrorCodeMethodNotFound || rpcErr.Code == RPCErrorCodeMethodNotSupported {
+			return true
+		}
+	}
+	var unsupported *MethodNotSupportedError
+	if errors.Is(err, ErrMethodNotSupported) || errors.As(err, &unsupported) {
+		return true
+	}
+	return errorContains(err,
+		"method not found",
+		"method not supported",
+		"does not exist/is not available",
+		"unsupported method",
+	)
+}
+
+// IsRateLimited reports whether err indicates that the request was rate
+// limited (-32005, HTTP 429 or a provider message).
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	if rpcErr, ok := AsRPCError(err); ok && rpcErr.Code == RPCErrorCodeLimitExceeded {
+		return true
+	}
+	var httpErr *HTTPRequestError
+	if errors.As(err, &httpErr) && httpErr.Status == http.StatusTooManyRequests {
+		return true
+	}
+	return errorContains(err,
+		"rate limit",
+		"too many requests",
+		"exceeded its compute units",
+		"request limit reached",
+	)
+}
+
+// IsExecutionReverted reports whether err indicates that a call or gas
+// estimation reverted (code 3 or an "execution reverted" message).
+func IsExecutionReverted(err error) bool {
+	if err == nil {
+		return false
+	}
+	if rpcErr, ok := AsRPCError(err); ok && rpcErr.Code == RPCErrorCodeExecutionReverted {
+		return true
+	}
+	return errorContains(err, "execution reverted", "vm execution error")
+}
+
+// IsTimeout reports whether err indicates that the request timed out,
+// either locally (context deadline, network timeout) or at the provider
+// (HTTP 408/504 or a provider message).
+func IsTimeout(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrTimeout) {
+		return true
+	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var httpErr *HTTPRequestError
+	if errors.As(err, &httpErr) &&
+		(httpErr.Status == http.StatusRequestTimeout || httpErr.Status == http.StatusGatewayTimeout) {
+		return true
+	}
+	return errorContains(err, "timeout", "timed out")
+}
+
+// errorContains reports whether err's message contains any of patterns,
+// case insensitively.
+func errorContains(err error, patterns ...string) bool {
+	lower := strings.ToLower(err.Error())
+	for _, pattern := range patterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
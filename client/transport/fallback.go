@@ -167,10 +167,8 @@ func (t *FallbackTransport) Request(ctx context.Context, req RPCRequest) (*RPCRe
 		return nil, ErrMethodNotSupported
 	}
 
-	// Ensure request has required fields
-	if req.ID == nil {
-		req.ID = NextID()
-	}
+	// Ensure request has required fields. The id is left to the inner
+	// transports so that their IDGenerator is honoured.
 	if req.JSONRPC == "" {
 		req.JSONRPC = "2.0"
 	}
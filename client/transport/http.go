@@ -2,7 +2,9 @@ package transport
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
 	"time"
 
 	"github.com/ChefBingbong/viem-go/utils/rpc"
@@ -26,16 +28,42 @@ type HTTPTransportConfig struct {
 	RetryDelay time.Duration
 	// Timeout is the request timeout.
 	Timeout time.Duration
-	// Headers are additional HTTP headers.
+	// DialTimeout bounds establishing the TCP connection, so a dead
+	// endpoint fails fast and a fallback transport can rotate.
+	// Defaults to rpc.DefaultDialTimeout.
+	DialTimeout time.Duration
+	// KeepAlive is the TCP keep-alive period of connections.
+	// Defaults to rpc.DefaultKeepAlive.
+	KeepAlive time.Duration
+	// MaxIdleConns is the maximum number of idle connections kept open.
+	// Defaults to rpc.DefaultMaxIdleConns.
+	MaxIdleConns int
+	// TLSConfig configures TLS, e.g. custom root CAs or client certificates.
+	TLSConfig *tls.Config
+	// Headers are additional HTTP headers, e.g. a provider API key.
 	Headers map[string]string
-	// OnRequest is called before each request.
+	// RequestModifier is called with each outgoing *http.Request after
+	// Headers are applied, e.g. to add an Authorization header or sign the
+	// body. Returning an error aborts the request.
+	RequestModifier func(req *http.Request) error
+	// HTTPClient overrides the client used to send requests, e.g. to
+	// configure a proxy. Timeout and the connection settings above are not
+	// applied to a custom client.
+	HTTPClient *http.Client
+	// OnRequest is called before each request with the *http.Request.
 	OnRequest func(req any) error
-	// OnResponse is called after each response.
+	// OnResponse is called after each response with the *http.Response.
 	OnResponse func(resp any) error
 	// Raw returns RPC errors as responses instead of throwing.
 	Raw bool
+	// IDGenerator returns the id for each request. Defaults to
+	// TransportParams.IDGenerator, then to an atomic counter.
+	IDGenerator IDFunc
 }
 
+// HTTPConfig is an alias for HTTPTransportConfig.
+type HTTPConfig = HTTPTransportConfig
+
 // BatchConfig contains batching configuration.
 type BatchConfig struct {
 	// Enabled enables batching.
@@ -49,11 +77,14 @@ type BatchConfig struct {
 // DefaultHTTPTransportConfig returns default HTTP transport configuration.
 func DefaultHTTPTransportConfig() HTTPTransportConfig {
 	return HTTPTransportConfig{
-		Key:        "http",
-		Name:       "HTTP JSON-RPC",
-		RetryCount: 3,
-		RetryDelay: 150 * time.Millisecond,
-		Timeout:    10 * time.Second,
+		Key:          "http",
+		Name:         "HTTP JSON-RPC",
+		RetryCount:   3,
+		RetryDelay:   150 * time.Millisecond,
+		Timeout:      rpc.DefaultTimeout,
+		DialTimeout:  rpc.DefaultDialTimeout,
+		KeepAlive:    rpc.DefaultKeepAlive,
+		MaxIdleConns: rpc.DefaultMaxIdleConns,
 	}
 }
 
@@ -95,6 +126,9 @@ func HTTP(url string, config ...HTTPTransportConfig) TransportFactory {
 		if params.Timeout != nil {
 			cfg.Timeout = *params.Timeout
 		}
+		if cfg.IDGenerator == nil {
+			cfg.IDGenerator = params.IDGenerator
+		}
 
 		return NewHTTPTransport(cfg)
 	}
@@ -103,9 +137,25 @@ func HTTP(url string, config ...HTTPTransportConfig) TransportFactory {
 // NewHTTPTransport creates a new HTTP transport.
 func NewHTTPTransport(config HTTPTransportConfig) (*HTTPTransport, error) {
 	// Create HTTP client
+	if config.IDGenerator == nil {
+		config.IDGenerator = func() any { return NextID() }
+	}
+
 	clientOpts := rpc.HTTPClientOptions{
-		Timeout: config.Timeout,
-		Headers: config.Headers,
+		Timeout:      config.Timeout,
+		DialTimeout:  config.DialTimeout,
+		KeepAlive:    config.KeepAlive,
+		MaxIdleConns: config.MaxIdleConns,
+		TLSConfig:    config.TLSConfig,
+		Headers:      config.Headers,
+		HTTPClient:   config.HTTPClient,
+		OnRequest:    config.requestHook(),
+		IDGenerator:  config.IDGenerator,
+	}
+	if config.OnResponse != nil {
+		clientOpts.OnResponse = func(resp *http.Response) error {
+			return config.OnResponse(resp)
+		}
 	}
 
 	client, err := rpc.NewHTTPClient(config.URL, clientOpts)
@@ -133,6 +183,24 @@ func NewHTTPTransport(config HTTPTransportConfig) (*HTTPTransport, error) {
 	return transport, nil
 }
 
+// requestHook combines RequestModifier and OnRequest into a single hook.
+func (c HTTPTransportConfig) requestHook() func(req *http.Request) error {
+	if c.RequestModifier == nil && c.OnRequest == nil {
+		return nil
+	}
+	return func(req *http.Request) error {
+		if c.RequestModifier != nil {
+			if err := c.RequestModifier(req); err != nil {
+				return err
+			}
+		}
+		if c.OnRequest != nil {
+			return c.OnRequest(req)
+		}
+		return nil
+	}
+}
+
 // Config returns the transport configuration.
 func (t *HTTPTransport) Config() TransportConfig {
 	return TransportConfig{
@@ -161,7 +229,7 @@ func (t *HTTPTransport) Request(ctx context.Context, req RPCRequest) (*RPCRespon
 		Params:  req.Params,
 	}
 	if body.ID == nil {
-		body.ID = NextID()
+		body.ID = t.config.IDGenerator()
 	}
 
 	// Use batch scheduler if available
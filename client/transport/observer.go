@@ -0,0 +1,206 @@
+package transport
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// RequestEvent describes an RPC request that is about to be sent.
+type RequestEvent struct {
+	// Transport is the type of the observed transport (e.g. "http").
+	Transport string
+	// Method is the JSON-RPC method.
+	Method string
+	// Params are the request params. Observers must treat them as read-only.
+	Params any
+}
+
+// ResponseEvent describes the outcome of an RPC request.
+type ResponseEvent struct {
+	RequestEvent
+	// Duration is the time spent in the inner transport, including retries.
+	Duration time.Duration
+	// Response is the RPC response, or nil if the request failed.
+	Response *RPCResponse
+	// Err is the error returned by the inner transport, if any.
+	Err error
+}
+
+// Observer receives a callback before and after every request made through
+// an ObserverTransport. OnResponse is called even when the request fails.
+type Observer interface {
+	OnRequest(ctx context.Context, event RequestEvent)
+	OnResponse(ctx context.Context, event ResponseEvent)
+}
+
+// ObserverFuncs adapts a pair of functions to the Observer interface.
+// Either function may be nil.
+type ObserverFuncs struct {
+	Request  func(ctx context.Context, event RequestEvent)
+	Response func(ctx context.Context, event ResponseEvent)
+}
+
+// OnRequest implements Observer.
+func (o ObserverFuncs) OnRequest(ctx context.Context, event RequestEvent) {
+	if o.Request != nil {
+		o.Request(ctx, event)
+	}
+}
+
+// OnResponse implements Observer.
+func (o ObserverFuncs) OnResponse(ctx context.Context, event ResponseEvent) {
+	if o.Response != nil {
+		o.Response(ctx, event)
+	}
+}
+
+// ObserverTransport wraps a transport and reports every request to a set of observers.
+type ObserverTransport struct {
+	inner     Transport
+	observers []Observer
+}
+
+// WithObserver wraps a transport factory so that every request is reported to
+// the given observers. It composes with other factories, e.g.
+// WithObserver(WithRateLimit(HTTP(url), cfg), SlogObserver(nil)).
+func WithObserver(inner TransportFactory, observers ...Observer) TransportFactory {
+	return func(params TransportParams) (Transport, error) {
+		t, err := inner(params)
+		if err != nil {
+			return nil, err
+		}
+		return NewObserverTransport(t, observers...), nil
+	}
+}
+
+// NewObserverTransport wraps an existing transport with observers.
+func NewObserverTransport(inner Transport, observers ...Observer) *ObserverTransport {
+	return &ObserverTransport{inner: inner, observers: observers}
+}
+
+// Config returns the inner transport's configuration.
+func (t *ObserverTransport) Config() TransportConfig {
+	return t.inner.Config()
+}
+
+// Request forwards the request to the inner transport, notifying observers
+// before it is sent and after it completes.
+func (t *ObserverTransport) Request(ctx context.Context, req RPCRequest) (*RPCResponse, error) {
+	event := RequestEvent{
+		Transport: t.inner.Config().Type,
+		Method:    req.Method,
+		Params:    copyParams(req.Params),
+	}
+	for _, o := range t.observers {
+		o.OnRequest(ctx, event)
+	}
+
+	start := time.Now()
+	resp, err := t.inner.Request(ctx, req)
+	result := ResponseEvent{
+		RequestEvent: event,
+		Duration:     time.Since(start),
+		Response:     resp,
+		Err:          err,
+	}
+	for _, o := range t.observers {
+		o.OnResponse(ctx, result)
+	}
+
+	return resp, err
+}
+
+// Value returns the inner transport's attributes.
+func (t *ObserverTransport) Value() *TransportValue {
+	return t.inner.Value()
+}
+
+// Close closes the inner transport.
+func (t *ObserverTransport) Close() error {
+	return t.inner.Close()
+}
+
+// Inner returns the wrapped transport.
+func (t *ObserverTransport) Inner() Transport {
+	return t.inner
+}
+
+// copyParams returns a shallow copy of positional params so that an observer
+// appending to or reassigning elements cannot affect the request being sent.
+func copyParams(params any) any {
+	if p, ok := params.([]any); ok {
+		return append([]any(nil), p...)
+	}
+	return params
+}
+
+// SlogObserver returns an Observer that logs each completed request via slog.
+// Successful requests are logged at Debug level and failures at Warn level.
+// If logger is nil, slog.Default() is used.
+func SlogObserver(logger *slog.Logger) Observer {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return ObserverFuncs{
+		Response: func(ctx context.Context, event ResponseEvent) {
+			attrs := []slog.Attr{
+				slog.String("transport", event.Transport),
+				slog.String("method", event.Method),
+				slog.Duration("duration", event.Duration),
+			}
+			if event.Err != nil {
+				attrs = append(attrs, slog.Any("error", event.Err))
+				logger.LogAttrs(ctx, slog.LevelWarn, "rpc request failed", attrs...)
+				return
+			}
+			logger.LogAttrs(ctx, slog.LevelDebug, "rpc request", attrs...)
+		},
+	}
+}
+
+// PrometheusMetrics holds the metric callbacks used by PrometheusObserver.
+//
+// The callbacks keep this package free of a Prometheus dependency; wire them
+// to your collectors, e.g.:
+//
+//	duration := prometheus.NewHistogramVec(opts, []string{"method", "status"})
+//	inFlight := prometheus.NewGaugeVec(opts, []string{"method"})
+//	observer := transport.PrometheusObserver(transport.PrometheusMetrics{
+//	    ObserveDuration: func(method, status string, seconds float64) {
+//	        duration.WithLabelValues(method, status).Observe(seconds)
+//	    },
+//	    AddInFlight: func(method string, delta float64) {
+//	        inFlight.WithLabelValues(method).Add(delta)
+//	    },
+//	})
+type PrometheusMetrics struct {
+	// ObserveDuration records a completed request. status is "ok" or "error".
+	ObserveDuration func(method, status string, seconds float64)
+	// AddInFlight adjusts the number of in-flight requests (optional).
+	AddInFlight func(method string, delta float64)
+}
+
+// PrometheusObserver returns an Observer that reports request durations and
+// in-flight counts through the given metric callbacks.
+func PrometheusObserver(metrics PrometheusMetrics) Observer {
+	return ObserverFuncs{
+		Request: func(_ context.Context, event RequestEvent) {
+			if metrics.AddInFlight != nil {
+				metrics.AddInFlight(event.Method, 1)
+			}
+		},
+		Response: func(_ context.Context, event ResponseEvent) {
+			if metrics.AddInFlight != nil {
+				metrics.AddInFlight(event.Method, -1)
+			}
+			if metrics.ObserveDuration != nil {
+				status := "ok"
+				if event.Err != nil {
+					status = "error"
+				}
+				metrics.ObserveDuration(event.Method, status, event.Duration.Seconds())
+			}
+		},
+	}
+}
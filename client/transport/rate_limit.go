@@ -0,0 +1,175 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimitConfig contains configuration for the rate-limiting transport.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate. Required.
+	RequestsPerSecond float64
+	// Burst is the maximum number of requests that may be sent at once
+	// after a quiet period. Defaults to 1.
+	Burst int
+}
+
+// RateLimitStats is a snapshot of a rate limiter's state for metrics.
+type RateLimitStats struct {
+	// Available is the number of requests that can be sent immediately.
+	Available float64
+	// Waiting is the number of requests currently blocked on the limiter.
+	Waiting int
+	// Allowed is the total number of requests forwarded so far.
+	Allowed uint64
+	// Utilization is the fraction of the burst currently in use, in [0, 1].
+	Utilization float64
+}
+
+// RateLimitTransport wraps a transport with a client-side token-bucket limiter.
+//
+// Each request waits for a token (respecting context cancellation) before it
+// is forwarded. Retries performed by the inner transport are not limited
+// separately, so a request and its retries consume a single token.
+type RateLimitTransport struct {
+	inner Transport
+
+	rate  float64
+	burst float64
+
+	mu      sync.Mutex
+	tokens  float64
+	last    time.Time
+	waiting int
+	allowed uint64
+}
+
+// WithRateLimit wraps a transport factory with a token-bucket rate limiter.
+// It composes with other factories, e.g. Fallback(WithRateLimit(HTTP(url), cfg), ...).
+func WithRateLimit(inner TransportFactory, config RateLimitConfig) TransportFactory {
+	return func(params TransportParams) (Transport, error) {
+		t, err := inner(params)
+		if err != nil {
+			return nil, err
+		}
+		return NewRateLimitTransport(t, config)
+	}
+}
+
+// NewRateLimitTransport wraps an existing transport with a token-bucket rate limiter.
+func NewRateLimitTransport(inner Transport, config RateLimitConfig) (*RateLimitTransport, error) {
+	if config.RequestsPerSecond <= 0 || math.IsInf(config.RequestsPerSecond, 0) || math.IsNaN(config.RequestsPerSecond) {
+		return nil, errors.New("rate limit requires a positive RequestsPerSecond")
+	}
+	if config.Burst <= 0 {
+		config.Burst = 1
+	}
+
+	return &RateLimitTransport{
+		inner:  inner,
+		rate:   config.RequestsPerSecond,
+		burst:  float64(config.Burst),
+		tokens: float64(config.Burst),
+		last:   time.Now(),
+	}, nil
+}
+
+// Config returns the inner transport's configuration, so transport type
+// detection (e.g. polling vs subscriptions) is unaffected by the wrapper.
+func (t *RateLimitTransport) Config() TransportConfig {
+	return t.inner.Config()
+}
+
+// Request waits for a token and forwards the request to the inner transport.
+func (t *RateLimitTransport) Request(ctx context.Context, req RPCRequest) (*RPCResponse, error) {
+	if err := t.wait(ctx); err != nil {
+		return nil, err
+	}
+	return t.inner.Request(ctx, req)
+}
+
+// Value returns the inner transport's attributes.
+func (t *RateLimitTransport) Value() *TransportValue {
+	return t.inner.Value()
+}
+
+// Close closes the inner transport.
+func (t *RateLimitTransport) Close() error {
+	return t.inner.Close()
+}
+
+// Inner returns the wrapped transport.
+func (t *RateLimitTransport) Inner() Transport {
+	return t.inner
+}
+
+// Stats returns a snapshot of the limiter's state.
+func (t *RateLimitTransport) Stats() RateLimitStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.refill(time.Now())
+	available := math.Max(t.tokens, 0)
+	return RateLimitStats{
+		Available:   available,
+		Waiting:     t.waiting,
+		Allowed:     t.allowed,
+		Utilization: 1 - available/t.burst,
+	}
+}
+
+// Utilization returns the fraction of the burst currently in use, in [0, 1].
+func (t *RateLimitTransport) Utilization() float64 {
+	return t.Stats().Utilization
+}
+
+// wait reserves a token, sleeping until it becomes available. If ctx is
+// cancelled first, the reservation is returned to the bucket.
+func (t *RateLimitTransport) wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.refill(time.Now())
+	t.tokens--
+	if t.tokens >= 0 {
+		t.allowed++
+		t.mu.Unlock()
+		return nil
+	}
+	delay := time.Duration(-t.tokens / t.rate * float64(time.Second))
+	t.waiting++
+	t.mu.Unlock()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		t.mu.Lock()
+		t.waiting--
+		t.allowed++
+		t.mu.Unlock()
+		return nil
+	case <-ctx.Done():
+		t.mu.Lock()
+		t.waiting--
+		t.tokens++
+		t.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// refill adds tokens for the time elapsed since the last refill. Must be
+// called with mu held.
+func (t *RateLimitTransport) refill(now time.Time) {
+	elapsed := now.Sub(t.last).Seconds()
+	t.last = now
+	if elapsed > 0 {
+		t.tokens = math.Min(t.burst, t.tokens+elapsed*t.rate)
+	}
+}
@@ -0,0 +1,147 @@
+package transport_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// newRPCErrorTransport returns an HTTP transport whose server answers every
+// request with rpcErr.
+func newRPCErrorTransport(t *testing.T, rpcErr map[string]any) transport.Transport {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req transport.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{
+			"jsonrpc": "2.0",
+			"id":      req.ID,
+			"error":   rpcErr,
+		})
+	}))
+	t.Cleanup(server.Close)
+
+	tr, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{URL: server.URL, RetryCount: 0})
+	require.NoError(t, err)
+	t.Cleanup(func() { tr.Close() })
+	return tr
+}
+
+func TestRPCError_MethodNotFound(t *testing.T) {
+	tr := newRPCErrorTransport(t, map[string]any{
+		"code":    -32601,
+		"message": "the method eth_blobBaseFee does not exist/is not available",
+	})
+
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_blobBaseFee"})
+	require.Error(t, err)
+
+	// Actions wrap transport errors with %w; the predicates see through it.
+	wrapped := fmt.Errorf("eth_blobBaseFee failed: %w", err)
+
+	rpcErr, ok := transport.AsRPCError(wrapped)
+	require.True(t, ok)
+	assert.Equal(t, transport.RPCErrorCodeMethodNotFound, rpcErr.Code)
+
+	assert.True(t, transport.IsMethodNotSupported(wrapped))
+	assert.False(t, transport.IsRateLimited(wrapped))
+	assert.False(t, transport.IsExecutionReverted(wrapped))
+	assert.False(t, transport.IsTimeout(wrapped))
+
+	notSupported := &transport.MethodNotSupportedError{Method: "eth_blobBaseFee", Cause: err}
+	assert.True(t, transport.IsMethodNotSupported(notSupported))
+	assert.ErrorIs(t, notSupported, err)
+}
+
+func TestRPCError_NotImplementedIsNotMethodNotSupported(t *testing.T) {
+	tr := newRPCErrorTransport(t, map[string]any{
+		"code":    -32000,
+		"message": "historical state not implemented for this block",
+	})
+
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_getBalance"})
+	require.Error(t, err)
+	assert.False(t, transport.IsMethodNotSupported(err))
+}
+
+func TestRPCError_ExecutionReverted(t *testing.T) {
+	tr := newRPCErrorTransport(t, map[string]any{
+		"code":    3,
+		"message": "execution reverted: Ownable: caller is not the owner",
+		"data":    "0x08c379a0",
+	})
+
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_call"})
+	require.Error(t, err)
+	wrapped := fmt.Errorf("eth_call failed: %w", err)
+
+	var rpcErr *transport.RPCError
+	require.True(t, errors.As(wrapped, &rpcErr))
+	assert.Equal(t, transport.RPCErrorCodeExecutionReverted, rpcErr.Code)
+	assert.Equal(t, "0x08c379a0", rpcErr.Data)
+
+	assert.True(t, transport.IsExecutionReverted(wrapped))
+	assert.False(t, transport.IsMethodNotSupported(wrapped))
+	assert.False(t, transport.IsRateLimited(wrapped))
+}
+
+func TestRPCError_RateLimited(t *testing.T) {
+	tr := newRPCErrorTransport(t, map[string]any{
+		"code":    -32005,
+		"message": "limit exceeded",
+	})
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_getLogs"})
+	require.Error(t, err)
+	assert.True(t, transport.IsRateLimited(err))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	httpTr, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{URL: server.URL, RetryCount: 0})
+	require.NoError(t, err)
+	defer httpTr.Close()
+
+	_, err = httpTr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.Error(t, err)
+	assert.True(t, transport.IsRateLimited(err))
+	assert.False(t, transport.IsMethodNotSupported(err))
+}
+
+func TestRPCError_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	tr, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{
+		URL:        server.URL,
+		Timeout:    50 * time.Millisecond,
+		RetryCount: 0,
+	})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.Error(t, err)
+	assert.True(t, transport.IsTimeout(err))
+	assert.False(t, transport.IsMethodNotSupported(err))
+
+	assert.True(t, transport.IsTimeout(fmt.Errorf("wrapped: %w", context.DeadlineExceeded)))
+	assert.False(t, transport.IsTimeout(context.Canceled))
+	assert.False(t, transport.IsTimeout(nil))
+}
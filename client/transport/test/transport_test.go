@@ -1,18 +1,28 @@
 package transport_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log/slog"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	json "github.com/goccy/go-json"
+	"github.com/gorilla/websocket"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/utils/rpc"
 )
 
 func TestHTTPTransport_BasicRequest(t *testing.T) {
@@ -164,6 +174,69 @@ func TestHTTPTransport_Timeout(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestHTTPTransport_NonResponsiveServer(t *testing.T) {
+	// Accept connections but never answer, like a hung endpoint.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	tr, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{
+		URL:         "http://" + listener.Addr().String(),
+		Timeout:     200 * time.Millisecond,
+		DialTimeout: 100 * time.Millisecond,
+		RetryCount:  0,
+	})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	start := time.Now()
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	elapsed := time.Since(start)
+
+	require.Error(t, err)
+	assert.True(t, transport.IsTimeout(err), "expected timeout, got %v", err)
+	assert.Less(t, elapsed, time.Second)
+}
+
+func TestHTTPTransport_TLSConfig(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req transport.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	defer server.Close()
+
+	// Without the server's CA the handshake fails.
+	untrusted, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{URL: server.URL})
+	require.NoError(t, err)
+	defer untrusted.Close()
+	_, err = untrusted.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.Error(t, err)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	trusted, err := transport.NewHTTPTransport(transport.HTTPTransportConfig{
+		URL:       server.URL,
+		TLSConfig: &tls.Config{RootCAs: roots},
+	})
+	require.NoError(t, err)
+	defer trusted.Close()
+
+	resp, err := trusted.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, `"0x1"`, string(resp.Result))
+}
+
 func TestHTTPTransport_MethodFilter(t *testing.T) {
 	// Create a test server
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -213,6 +286,181 @@ func TestHTTPTransport_MethodFilter(t *testing.T) {
 	assert.Equal(t, transport.ErrMethodNotSupported, err)
 }
 
+func TestHTTPTransport_IDGenerator(t *testing.T) {
+	var received atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req transport.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		received.Store(req.ID)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	defer server.Close()
+
+	tr, err := transport.HTTP(server.URL)(transport.TransportParams{
+		IDGenerator: func() any { return "req-7f3a" },
+	})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	resp, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, "req-7f3a", received.Load())
+	assert.Equal(t, "req-7f3a", resp.ID)
+}
+
+func TestHTTPTransport_ResponseIDMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req transport.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		// Answer with another request's id, as a misrouting load balancer would
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": 999999, "result": "0x1"})
+	}))
+	defer server.Close()
+
+	retryCount := 0
+	tr, err := transport.HTTP(server.URL)(transport.TransportParams{RetryCount: &retryCount})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, transport.ErrResponseIDMismatch)
+}
+
+func TestHTTPTransport_HeadersAndRequestModifier(t *testing.T) {
+	var headers atomic.Value
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers.Store(r.Header.Clone())
+
+		var req transport.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+	}))
+	defer server.Close()
+
+	var roundTrips atomic.Int64
+	config := transport.DefaultHTTPTransportConfig()
+	config.Headers = map[string]string{"X-Api-Key": "secret"}
+	config.RequestModifier = func(req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer token")
+		return nil
+	}
+	config.HTTPClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		roundTrips.Add(1)
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+
+	tr, err := transport.HTTP(server.URL, config)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+
+	got := headers.Load().(http.Header)
+	assert.Equal(t, "secret", got.Get("X-Api-Key"))
+	assert.Equal(t, "Bearer token", got.Get("Authorization"))
+	assert.Equal(t, int64(1), roundTrips.Load())
+}
+
+func TestHTTPTransport_RequestModifierError(t *testing.T) {
+	var calls atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	errNoCredentials := errors.New("no credentials")
+	config := transport.DefaultHTTPTransportConfig()
+	config.RetryCount = 0
+	config.RequestModifier = func(req *http.Request) error {
+		return errNoCredentials
+	}
+
+	tr, err := transport.HTTP(server.URL, config)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	assert.ErrorIs(t, err, errNoCredentials)
+	assert.Zero(t, calls.Load())
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWebSocketTransport_ResponseIDs(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		var unanswered any
+		for {
+			var req transport.RPCRequest
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			switch req.Method {
+			case "eth_blockNumber":
+				// Answered only after the client has given up on it.
+				unanswered = req.ID
+			case "eth_chainId":
+				if unanswered != nil {
+					_ = conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": unanswered, "result": "0x1"})
+					unanswered = nil
+				}
+				_ = conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": "0x1"})
+			case "eth_gasPrice":
+				_ = conn.WriteJSON(map[string]any{"jsonrpc": "2.0", "id": nil, "error": map[string]any{"code": -32700, "message": "parse error"}})
+			}
+		}
+	}))
+	defer server.Close()
+
+	var counter atomic.Int64
+	retryCount := 0
+	timeout := 200 * time.Millisecond
+	tr, err := transport.WebSocket("ws" + strings.TrimPrefix(server.URL, "http"))(transport.TransportParams{
+		RetryCount: &retryCount,
+		Timeout:    &timeout,
+		IDGenerator: func() any {
+			return "ws-" + string(rune('a'+counter.Add(1)))
+		},
+	})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	ctx := context.Background()
+
+	resp, err := tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, "ws-b", resp.ID)
+
+	_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_blockNumber"})
+	require.Error(t, err)
+
+	// The late response to the timed-out request is dropped rather than
+	// failing the request now in flight.
+	resp, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, "ws-d", resp.ID)
+
+	// A null-id parse error is attributed to the sole in-flight request.
+	_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_gasPrice"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "parse error")
+}
+
 func TestCustomTransport(t *testing.T) {
 	callCount := 0
 
@@ -314,6 +562,21 @@ func TestTransportConfig(t *testing.T) {
 	assert.Equal(t, 10*time.Second, cfg.Timeout)
 }
 
+func TestDefaultHTTPTransportConfig(t *testing.T) {
+	cfg := transport.DefaultHTTPTransportConfig()
+	assert.Equal(t, 30*time.Second, cfg.Timeout)
+	assert.Equal(t, 10*time.Second, cfg.DialTimeout)
+	assert.Equal(t, rpc.DefaultKeepAlive, cfg.KeepAlive)
+	assert.Equal(t, rpc.DefaultMaxIdleConns, cfg.MaxIdleConns)
+
+	var httpCfg transport.HTTPConfig = cfg
+	tr, err := transport.HTTP("http://localhost:8545", httpCfg)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+	assert.Equal(t, "http", tr.Config().Type)
+	assert.Equal(t, 30*time.Second, tr.Config().Timeout)
+}
+
 func TestMethodFilter(t *testing.T) {
 	// Test with include list
 	filter := &transport.MethodFilter{
@@ -349,3 +612,417 @@ func TestTransportValue(t *testing.T) {
 	assert.NotNil(t, value)
 	assert.Equal(t, server.URL, value.URL)
 }
+
+func newCountingTransport(calls *atomic.Int64) transport.TransportFactory {
+	return transport.Custom(transport.CustomTransportConfig{
+		Request: func(ctx context.Context, req transport.RPCRequest) (*transport.RPCResponse, error) {
+			calls.Add(1)
+			return &transport.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage(`"0x1"`)}, nil
+		},
+	})
+}
+
+func TestRateLimitTransport_EnforcesRate(t *testing.T) {
+	var calls atomic.Int64
+	tr, err := transport.WithRateLimit(newCountingTransport(&calls), transport.RateLimitConfig{
+		RequestsPerSecond: 10,
+	})(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	// The first request uses the initial token; the remaining 5 wait 100ms each.
+	const n = 6
+	start := time.Now()
+	for i := 0; i < n; i++ {
+		_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+		require.NoError(t, err)
+	}
+
+	assert.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+	assert.Equal(t, int64(n), calls.Load())
+	assert.Equal(t, "custom", tr.Config().Type)
+
+	stats := tr.(*transport.RateLimitTransport).Stats()
+	assert.Equal(t, uint64(n), stats.Allowed)
+	assert.Equal(t, 0, stats.Waiting)
+}
+
+func TestRateLimitTransport_Burst(t *testing.T) {
+	var calls atomic.Int64
+	tr, err := transport.NewRateLimitTransport(mustTransport(t, newCountingTransport(&calls)), transport.RateLimitConfig{
+		RequestsPerSecond: 1,
+		Burst:             3,
+	})
+	require.NoError(t, err)
+
+	assert.Zero(t, tr.Utilization())
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+		require.NoError(t, err)
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+	assert.InDelta(t, 1, tr.Utilization(), 0.01)
+}
+
+func TestRateLimitTransport_ContextCancelUnblocks(t *testing.T) {
+	var calls atomic.Int64
+	tr, err := transport.NewRateLimitTransport(mustTransport(t, newCountingTransport(&calls)), transport.RateLimitConfig{
+		RequestsPerSecond: 0.5,
+	})
+	require.NoError(t, err)
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Equal(t, int64(1), calls.Load())
+	assert.Equal(t, 0, tr.Stats().Waiting)
+}
+
+func TestRateLimitTransport_ComposesWithFallback(t *testing.T) {
+	var calls atomic.Int64
+	tr, err := transport.Fallback(
+		transport.WithRateLimit(newCountingTransport(&calls), transport.RateLimitConfig{RequestsPerSecond: 100}),
+	)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), calls.Load())
+}
+
+func TestRateLimitTransport_InvalidConfig(t *testing.T) {
+	var calls atomic.Int64
+	_, err := transport.WithRateLimit(newCountingTransport(&calls), transport.RateLimitConfig{})(transport.TransportParams{})
+	assert.Error(t, err)
+}
+
+// newFlakyTransport returns a transport that fails with err while *down is
+// true and succeeds otherwise, counting the requests that reach it.
+func newFlakyTransport(calls *atomic.Int64, down *atomic.Bool, err error) transport.TransportFactory {
+	return transport.Custom(transport.CustomTransportConfig{
+		Request: func(ctx context.Context, req transport.RPCRequest) (*transport.RPCResponse, error) {
+			calls.Add(1)
+			if down.Load() {
+				return nil, err
+			}
+			return &transport.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage(`"0x1"`)}, nil
+		},
+	})
+}
+
+func TestCircuitBreakerTransport_TripsAfterThreshold(t *testing.T) {
+	var calls atomic.Int64
+	var down atomic.Bool
+	down.Store(true)
+	tr, err := transport.NewCircuitBreakerTransport(
+		mustTransport(t, newFlakyTransport(&calls, &down, errors.New("connection refused"))),
+		transport.CircuitBreakerConfig{FailureThreshold: 3, OpenDuration: time.Hour},
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, transport.CircuitClosed, tr.State())
+		_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, transport.ErrCircuitOpen)
+	}
+	assert.Equal(t, transport.CircuitOpen, tr.State())
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	assert.ErrorIs(t, err, transport.ErrCircuitOpen)
+	assert.Equal(t, int64(3), calls.Load())
+}
+
+func TestCircuitBreakerTransport_RecoversAfterOpenDuration(t *testing.T) {
+	var calls atomic.Int64
+	var down atomic.Bool
+	down.Store(true)
+	tr, err := transport.WithCircuitBreaker(
+		newFlakyTransport(&calls, &down, errors.New("connection refused")),
+		transport.CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: 50 * time.Millisecond},
+	)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+	cb := tr.(*transport.CircuitBreakerTransport)
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.Error(t, err)
+	assert.Equal(t, transport.CircuitOpen, cb.State())
+
+	// A failed probe re-opens the circuit for another window.
+	time.Sleep(60 * time.Millisecond)
+	assert.Equal(t, transport.CircuitHalfOpen, cb.State())
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	assert.NotErrorIs(t, err, transport.ErrCircuitOpen)
+	assert.Equal(t, transport.CircuitOpen, cb.State())
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	assert.ErrorIs(t, err, transport.ErrCircuitOpen)
+
+	// Once the endpoint is back, the next probe closes the circuit.
+	down.Store(false)
+	time.Sleep(60 * time.Millisecond)
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, transport.CircuitClosed, cb.State())
+	assert.Equal(t, int64(3), calls.Load())
+}
+
+func TestCircuitBreakerTransport_IgnoresExecutionReverted(t *testing.T) {
+	var calls atomic.Int64
+	var down atomic.Bool
+	down.Store(true)
+	tr, err := transport.NewCircuitBreakerTransport(
+		mustTransport(t, newFlakyTransport(&calls, &down, &transport.RPCError{Code: 3, Message: "execution reverted"})),
+		transport.CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Hour},
+	)
+	require.NoError(t, err)
+
+	for i := 0; i < 5; i++ {
+		_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_call"})
+		assert.True(t, transport.IsExecutionReverted(err))
+	}
+	assert.Equal(t, transport.CircuitClosed, tr.State())
+	assert.Equal(t, int64(5), calls.Load())
+}
+
+func TestCircuitBreakerTransport_FallbackSkipsOpenCircuit(t *testing.T) {
+	var deadCalls, liveCalls atomic.Int64
+	var down atomic.Bool
+	down.Store(true)
+	tr, err := transport.Fallback(
+		transport.WithCircuitBreaker(
+			newFlakyTransport(&deadCalls, &down, errors.New("connection refused")),
+			transport.CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Hour},
+		),
+		newCountingTransport(&liveCalls),
+	)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int64(1), deadCalls.Load())
+	assert.Equal(t, int64(3), liveCalls.Load())
+}
+
+func mustTransport(t *testing.T, factory transport.TransportFactory) transport.Transport {
+	tr, err := factory(transport.TransportParams{})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = tr.Close() })
+	return tr
+}
+
+func TestObserverTransport_ReportsRequests(t *testing.T) {
+	inner := transport.Custom(transport.CustomTransportConfig{
+		Request: func(ctx context.Context, req transport.RPCRequest) (*transport.RPCResponse, error) {
+			time.Sleep(5 * time.Millisecond)
+			if req.Method == "eth_fail" {
+				return nil, errors.New("boom")
+			}
+			return &transport.RPCResponse{JSONRPC: "2.0", ID: req.ID, Result: json.RawMessage(`"0x1"`)}, nil
+		},
+	})
+
+	var requests []transport.RequestEvent
+	var responses []transport.ResponseEvent
+	tr, err := transport.WithObserver(inner, transport.ObserverFuncs{
+		Request: func(ctx context.Context, event transport.RequestEvent) {
+			requests = append(requests, event)
+			// Mutating the observed params must not affect the request.
+			if params, ok := event.Params.([]any); ok {
+				params[0] = "mutated"
+			}
+		},
+		Response: func(ctx context.Context, event transport.ResponseEvent) {
+			responses = append(responses, event)
+		},
+	})(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	params := []any{"0xabc", "latest"}
+	resp, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_getBalance", Params: params})
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc", params[0])
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_fail"})
+	require.Error(t, err)
+
+	require.Len(t, requests, 2)
+	require.Len(t, responses, 2)
+
+	assert.Equal(t, "eth_getBalance", responses[0].Method)
+	assert.Equal(t, "custom", responses[0].Transport)
+	assert.GreaterOrEqual(t, responses[0].Duration, 5*time.Millisecond)
+	assert.Same(t, resp, responses[0].Response)
+	assert.NoError(t, responses[0].Err)
+
+	assert.Equal(t, "eth_fail", responses[1].Method)
+	assert.NotZero(t, responses[1].Duration)
+	assert.Nil(t, responses[1].Response)
+	assert.EqualError(t, responses[1].Err, "boom")
+}
+
+func TestSlogObserver(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	tr := transport.NewObserverTransport(mustTransport(t, newCountingTransport(new(atomic.Int64))), transport.SlogObserver(logger))
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "DEBUG", entry["level"])
+	assert.Equal(t, "rpc request", entry["msg"])
+	assert.Equal(t, "eth_chainId", entry["method"])
+	assert.Contains(t, entry, "duration")
+}
+
+func TestPrometheusObserver(t *testing.T) {
+	failing := transport.Custom(transport.CustomTransportConfig{
+		Request: func(ctx context.Context, req transport.RPCRequest) (*transport.RPCResponse, error) {
+			return nil, errors.New("boom")
+		},
+	})
+
+	var inFlight float64
+	var observed []string
+	tr := transport.NewObserverTransport(mustTransport(t, failing), transport.PrometheusObserver(transport.PrometheusMetrics{
+		ObserveDuration: func(method, status string, seconds float64) {
+			observed = append(observed, method+":"+status)
+		},
+		AddInFlight: func(method string, delta float64) {
+			inFlight += delta
+		},
+	}))
+
+	_, err := tr.Request(context.Background(), transport.RPCRequest{Method: "eth_blockNumber"})
+	require.Error(t, err)
+	assert.Equal(t, []string{"eth_blockNumber:error"}, observed)
+	assert.Zero(t, inFlight)
+}
+
+// fakeEIP1193Provider answers requests from a table of canned results.
+type fakeEIP1193Provider struct {
+	results map[string]json.RawMessage
+	errs    map[string]error
+	calls   []string
+	params  map[string][]any
+}
+
+func (p *fakeEIP1193Provider) Request(ctx context.Context, method string, params []any) (json.RawMessage, error) {
+	p.calls = append(p.calls, method)
+	if p.params == nil {
+		p.params = map[string][]any{}
+	}
+	p.params[method] = params
+	if err, ok := p.errs[method]; ok {
+		return nil, err
+	}
+	if result, ok := p.results[method]; ok {
+		return result, nil
+	}
+	return nil, &transport.RPCError{Code: transport.RPCErrorCodeMethodNotFound, Message: "method not found"}
+}
+
+// fakeSubscriptionProvider also supports eth_subscribe.
+type fakeSubscriptionProvider struct {
+	fakeEIP1193Provider
+	subscribed []any
+}
+
+func (p *fakeSubscriptionProvider) Subscribe(params []any, onData func(json.RawMessage), onError func(error)) (*transport.Subscription, error) {
+	p.subscribed = params
+	onData(json.RawMessage(`{"number":"0x10"}`))
+	return &transport.Subscription{ID: "0xsub", Unsubscribe: func() error { return nil }}, nil
+}
+
+func TestEIP1193Transport(t *testing.T) {
+	provider := &fakeEIP1193Provider{results: map[string]json.RawMessage{
+		"eth_chainId":         json.RawMessage(`"0x1"`),
+		"eth_sendTransaction": json.RawMessage(`"0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"`),
+	}}
+
+	tr, err := transport.EIP1193(provider)(transport.TransportParams{})
+	require.NoError(t, err)
+	defer tr.Close()
+
+	assert.Equal(t, "custom", tr.Config().Type)
+	assert.Equal(t, "eip1193", tr.Config().Key)
+
+	ctx := context.Background()
+	resp, err := tr.Request(ctx, transport.RPCRequest{Method: "eth_chainId"})
+	require.NoError(t, err)
+	assert.Equal(t, `"0x1"`, string(resp.Result))
+	assert.Equal(t, []any{}, provider.params["eth_chainId"])
+
+	tx := map[string]any{"from": "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266", "value": "0x1"}
+	resp, err = tr.Request(ctx, transport.RPCRequest{Method: "eth_sendTransaction", Params: []any{tx}})
+	require.NoError(t, err)
+	assert.Equal(t, `"0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"`, string(resp.Result))
+	assert.Equal(t, []any{tx}, provider.params["eth_sendTransaction"])
+}
+
+func TestEIP1193Transport_ProviderErrors(t *testing.T) {
+	provider := &fakeEIP1193Provider{errs: map[string]error{
+		"eth_sendTransaction": &transport.RPCError{Code: 4001, Message: "User rejected the request."},
+		"eth_accounts":        errors.New("provider disconnected"),
+	}}
+
+	tr, err := transport.EIP1193(provider, transport.EIP1193TransportConfig{RetryCount: 0, Timeout: time.Second})(transport.TransportParams{})
+	require.NoError(t, err)
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_sendTransaction", Params: []any{}})
+	var rpcErr *transport.RPCError
+	require.ErrorAs(t, err, &rpcErr)
+	assert.Equal(t, 4001, rpcErr.Code)
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_accounts"})
+	assert.EqualError(t, err, "provider disconnected")
+}
+
+func TestEIP1193Transport_MethodFilter(t *testing.T) {
+	provider := &fakeEIP1193Provider{}
+	config := transport.DefaultEIP1193TransportConfig()
+	config.Methods = &transport.MethodFilter{Exclude: []string{"eth_sign"}}
+
+	tr, err := transport.EIP1193(provider, config)(transport.TransportParams{})
+	require.NoError(t, err)
+
+	_, err = tr.Request(context.Background(), transport.RPCRequest{Method: "eth_sign"})
+	assert.ErrorIs(t, err, transport.ErrMethodNotSupported)
+	assert.Empty(t, provider.calls)
+}
+
+func TestEIP1193Transport_Subscribe(t *testing.T) {
+	plain := transport.NewEIP1193Transport(&fakeEIP1193Provider{}, transport.DefaultEIP1193TransportConfig())
+	assert.False(t, plain.SupportsSubscriptions())
+	_, err := plain.Subscribe(transport.NewHeadsSubscribeParams(), func(json.RawMessage) {}, func(error) {})
+	assert.ErrorIs(t, err, transport.ErrProviderSubscriptionsNotSupported)
+
+	provider := &fakeSubscriptionProvider{}
+	tr := transport.NewEIP1193Transport(provider, transport.DefaultEIP1193TransportConfig())
+	assert.True(t, tr.SupportsSubscriptions())
+
+	var received []json.RawMessage
+	sub, err := tr.Subscribe(transport.LogsSubscribeParams("0x1111111111111111111111111111111111111111", nil), func(data json.RawMessage) {
+		received = append(received, data)
+	}, func(error) {})
+	require.NoError(t, err)
+	assert.Equal(t, "0xsub", sub.ID)
+	assert.Equal(t, []any{"logs", map[string]any{"address": "0x1111111111111111111111111111111111111111"}}, provider.subscribed)
+	require.Len(t, received, 1)
+	assert.JSONEq(t, `{"number":"0x10"}`, string(received[0]))
+}
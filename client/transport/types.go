@@ -15,6 +15,7 @@ type (
 	RPCError          = rpc.RPCError
 	SubscriptionParam = rpc.SubscriptionParam
 	Subscription      = rpc.Subscription
+	IDFunc            = rpc.IDFunc
 )
 
 // Re-export error types from rpc package
@@ -35,6 +36,7 @@ var (
 var (
 	IsRetryableError = rpc.IsRetryableError
 	NextID           = rpc.NextID
+	IDsEqual         = rpc.IDsEqual
 )
 
 // MethodFilter specifies which methods to include or exclude.
@@ -133,6 +135,9 @@ type TransportParams struct {
 	RetryCount *int
 	// Timeout overrides the default timeout.
 	Timeout *time.Duration
+	// IDGenerator returns the id for each outgoing JSON-RPC request, e.g. a
+	// UUID generator for correlating logs. Defaults to an atomic counter.
+	IDGenerator IDFunc
 }
 
 // TransportFactory is a function that creates a transport instance.
@@ -29,6 +29,9 @@ type WebSocketTransportConfig struct {
 	RetryDelay time.Duration
 	// Timeout is the request timeout.
 	Timeout time.Duration
+	// IDGenerator returns the id for each request. Defaults to
+	// TransportParams.IDGenerator, then to an atomic counter.
+	IDGenerator IDFunc
 }
 
 // KeepAliveConfig contains keep-alive configuration.
@@ -106,6 +109,9 @@ func WebSocket(url string, config ...WebSocketTransportConfig) TransportFactory
 		if params.Timeout != nil {
 			cfg.Timeout = *params.Timeout
 		}
+		if cfg.IDGenerator == nil {
+			cfg.IDGenerator = params.IDGenerator
+		}
 
 		return NewWebSocketTransport(cfg)
 	}
@@ -113,8 +119,14 @@ func WebSocket(url string, config ...WebSocketTransportConfig) TransportFactory
 
 // NewWebSocketTransport creates a new WebSocket transport.
 func NewWebSocketTransport(config WebSocketTransportConfig) (*WebSocketTransport, error) {
+	if config.IDGenerator == nil {
+		config.IDGenerator = func() any { return NextID() }
+	}
+
 	// Build client options
-	clientOpts := rpc.WebSocketClientOptions{}
+	clientOpts := rpc.WebSocketClientOptions{
+		IDGenerator: config.IDGenerator,
+	}
 
 	if config.KeepAlive != nil {
 		clientOpts.KeepAlive = &rpc.KeepAliveConfig{
@@ -171,7 +183,7 @@ func (t *WebSocketTransport) Request(ctx context.Context, req RPCRequest) (*RPCR
 		Params:  req.Params,
 	}
 	if body.ID == nil {
-		body.ID = NextID()
+		body.ID = t.config.IDGenerator()
 	}
 
 	// Send request with retry
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"log/slog"
 	"math/big"
 	"time"
 
@@ -22,10 +23,18 @@ type WalletClientConfig struct {
 	Chain *chain.Chain
 	// CacheTime is the time (in ms) that cached data will remain in memory.
 	CacheTime time.Duration
+	// FeeCacheTime is how long GetGasPrice and EstimateFeesPerGas results are
+	// cached. Default: 0 (no caching).
+	FeeCacheTime time.Duration
 	// Key is a key for the client (default: "wallet").
 	Key string
+	// Logger receives warnings from actions. Default: slog.Default().
+	Logger *slog.Logger
 	// Name is a name for the client (default: "Wallet Client").
 	Name string
+	// NonceManager supplies nonces for transactions sent from local accounts.
+	// If nil, nonces are fetched with eth_getTransactionCount on every send.
+	NonceManager wallet.NonceManager
 	// PollingInterval is the frequency (in ms) for polling enabled actions & events.
 	PollingInterval time.Duration
 	// Transport is the transport factory to use.
@@ -40,6 +49,8 @@ type WalletClientConfig struct {
 // can be used with it directly.
 type WalletClient struct {
 	*BaseClient
+	nonceManager wallet.NonceManager
+	feeCacheTime time.Duration
 }
 
 // CreateWalletClient creates a new wallet client with the given configuration.
@@ -70,6 +81,7 @@ func CreateWalletClient(config WalletClientConfig) (*WalletClient, error) {
 		CacheTime:       config.CacheTime,
 		Chain:           config.Chain,
 		Key:             key,
+		Logger:          config.Logger,
 		Name:            name,
 		PollingInterval: config.PollingInterval,
 		Transport:       config.Transport,
@@ -81,7 +93,7 @@ func CreateWalletClient(config WalletClientConfig) (*WalletClient, error) {
 		return nil, err
 	}
 
-	return &WalletClient{BaseClient: base}, nil
+	return &WalletClient{BaseClient: base, nonceManager: config.NonceManager, feeCacheTime: config.FeeCacheTime}, nil
 }
 
 // ---------------------------------------------------------------------------
@@ -103,6 +115,17 @@ func (c *WalletClient) Account() wallet.Account {
 	return nil
 }
 
+// NonceManager returns the client's nonce manager, or nil if none is configured.
+// This makes WalletClient satisfy wallet.NonceManagerClient.
+func (c *WalletClient) NonceManager() wallet.NonceManager {
+	return c.nonceManager
+}
+
+// FeeCacheTime returns how long fee results are cached.
+func (c *WalletClient) FeeCacheTime() time.Duration {
+	return c.feeCacheTime
+}
+
 // ---------------------------------------------------------------------------
 // Wallet Actions — Signing
 // ---------------------------------------------------------------------------
@@ -139,6 +162,12 @@ func (c *WalletClient) SignPreparedTransaction(ctx context.Context, prepared wal
 	return wallet.SignTransaction(ctx, c, wallet.PreparedToSignParams(prepared))
 }
 
+// SignHash signs a raw 32-byte hash with a local account.
+// Delegates to wallet.SignHash.
+func (c *WalletClient) SignHash(ctx context.Context, params wallet.SignHashParameters) (string, error) {
+	return wallet.SignHash(ctx, c, params)
+}
+
 // SignAuthorization signs an EIP-7702 authorization.
 // Delegates to wallet.SignAuthorization.
 func (c *WalletClient) SignAuthorization(ctx context.Context, params wallet.SignAuthorizationParameters) (*types.SignedAuthorization, error) {
@@ -167,6 +196,13 @@ func (c *WalletClient) SendTransactionSync(ctx context.Context, params wallet.Se
 	return wallet.SendTransactionSync(ctx, c, params)
 }
 
+// SendTransactionAndWait sends a transaction and waits for its receipt
+// with the given confirmations and timeout.
+// Delegates to wallet.SendTransactionAndWait.
+func (c *WalletClient) SendTransactionAndWait(ctx context.Context, params wallet.SendTransactionParameters, opts wallet.WaitOptions) (wallet.SendTransactionAndWaitReturnType, error) {
+	return wallet.SendTransactionAndWait(ctx, c, nil, params, opts)
+}
+
 // SendRawTransaction sends a signed serialized transaction.
 // Delegates to wallet.SendRawTransaction.
 func (c *WalletClient) SendRawTransaction(ctx context.Context, params wallet.SendRawTransactionParameters) (string, error) {
@@ -195,6 +231,12 @@ func (c *WalletClient) WriteContract(ctx context.Context, params wallet.WriteCon
 	return wallet.WriteContract(ctx, c, params)
 }
 
+// WriteContractDetailed executes a write function on a contract and returns
+// the hash with the submitted request. Delegates to wallet.WriteContractDetailed.
+func (c *WalletClient) WriteContractDetailed(ctx context.Context, params wallet.WriteContractParameters) (*wallet.WriteContractResult, error) {
+	return wallet.WriteContractDetailed(ctx, c, params)
+}
+
 // WriteContractSync executes a contract write and waits for the receipt.
 // Delegates to wallet.WriteContractSync.
 func (c *WalletClient) WriteContractSync(ctx context.Context, params wallet.WriteContractSyncParameters) (wallet.WriteContractSyncReturnType, error) {
@@ -113,7 +113,13 @@ func main() {
 		}
 
 		balance := balanceAny.(*big.Int) // ERC20.balanceOf always returns uint256
-		decimals := 6                    // USDC default; you can extend this endpoint to call decimals()
+
+		tokenDecimals, err := erc20.Decimals(ctx, publicClient, common.HexToAddress(req.TokenAddress))
+		if err != nil {
+			writeJSON(w, http.StatusBadGateway, errorResponse{Error: err.Error()})
+			return
+		}
+		decimals := int(tokenDecimals)
 		formatted := unit.FormatUnits(balance, decimals)
 
 		writeJSON(w, http.StatusOK, runReadContractResponse{
@@ -14,10 +14,12 @@
 //
 // Flags:
 //
-//	--abi    Path to the ABI JSON file (default: _contracts_typed/json/<pkg>.json)
+//	--abi    Path to the ABI JSON file or Hardhat/Foundry artifact (default: _contracts_typed/json/<pkg>.json)
 //	--pkg    Go package name for the generated code (required)
 //	--name   Contract name (optional, defaults to package name capitalized)
 //	--out    Output directory (default: _contracts_typed/contract_templates/<pkg>/)
+//	--bin    Path to a file with the contract bytecode (optional, enables Deploy generation;
+//	         overrides bytecode found in an artifact)
 package main
 
 import (
@@ -57,20 +59,22 @@ func main() {
 		packageName  string
 		contractName string
 		outDir       string
+		binPath      string
 		help         bool
 	)
 
-	flag.StringVar(&abiPath, "abi", "", "Path to the ABI JSON file (default: _contracts_typed/json/<pkg>.json)")
+	flag.StringVar(&abiPath, "abi", "", "Path to the ABI JSON file or Hardhat/Foundry artifact (default: _contracts_typed/json/<pkg>.json)")
 	flag.StringVar(&packageName, "pkg", "", "Go package name for the generated code (required)")
 	flag.StringVar(&contractName, "name", "", "Contract name (optional)")
 	flag.StringVar(&outDir, "out", "", "Output directory (default: _contracts_typed/contract_templates/<pkg>/)")
+	flag.StringVar(&binPath, "bin", "", "Path to a file with the contract bytecode (optional, enables Deploy generation)")
 	flag.BoolVar(&help, "h", false, "Show help")
 	flag.BoolVar(&help, "help", false, "Show help")
 
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "viemgen - Generate Go bindings from Ethereum contract ABIs\n\n")
 		fmt.Fprintf(os.Stderr, "Usage:\n")
-		fmt.Fprintf(os.Stderr, "  viemgen --pkg <package> [--abi <path>] [--name <name>] [--out <dir>]\n")
+		fmt.Fprintf(os.Stderr, "  viemgen --pkg <package> [--abi <path>] [--name <name>] [--out <dir>] [--bin <path>]\n")
 		fmt.Fprintf(os.Stderr, "  viemgen init                  # Initialize default directory structure\n\n")
 		fmt.Fprintf(os.Stderr, "Default Directories:\n")
 		fmt.Fprintf(os.Stderr, "  %s/\n", defaultBaseDir)
@@ -80,7 +84,9 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  viemgen init                                    # Setup directories\n")
 		fmt.Fprintf(os.Stderr, "  viemgen --pkg erc20                             # Uses default paths\n")
 		fmt.Fprintf(os.Stderr, "  viemgen --pkg erc20 --abi ./custom/ERC20.json   # Custom ABI path\n")
-		fmt.Fprintf(os.Stderr, "  viemgen --pkg mytoken --out ./contracts/        # Custom output\n\n")
+		fmt.Fprintf(os.Stderr, "  viemgen --pkg mytoken --out ./contracts/        # Custom output\n")
+		fmt.Fprintf(os.Stderr, "  viemgen --pkg mytoken --bin ./MyToken.bin       # Also generate Deploy\n")
+		fmt.Fprintf(os.Stderr, "  viemgen --pkg mytoken --abi ./out/MyToken.sol/MyToken.json  # Foundry artifact\n\n")
 		fmt.Fprintf(os.Stderr, "Flags:\n")
 		flag.PrintDefaults()
 	}
@@ -143,6 +149,19 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Attach bytecode so a Deploy function is generated
+	if binPath != "" {
+		bin, err := os.ReadFile(binPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading bytecode file: %v\n", err)
+			os.Exit(1)
+		}
+		if err := gen.SetBytecode(string(bin)); err != nil {
+			fmt.Fprintf(os.Stderr, "Error setting bytecode: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
 	// Generate code
 	code, err := gen.Generate()
 	if err != nil {
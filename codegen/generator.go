@@ -2,13 +2,18 @@ package codegen
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"go/format"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 	"text/template"
 	"unicode"
 
+	json "github.com/goccy/go-json"
+
 	"github.com/ChefBingbong/viem-go/abi"
 )
 
@@ -18,27 +23,118 @@ type Generator struct {
 	contractName string
 	abi          *abi.ABI
 	abiJSON      []byte
+	bytecode     string
 }
 
 // NewGenerator creates a new code generator.
+//
+// abiJSON is either a bare ABI array or a Hardhat/Foundry artifact object with
+// the ABI under "abi". When an artifact also carries bytecode, it is used as if
+// passed to SetBytecode.
 func NewGenerator(packageName, contractName string, abiJSON []byte) (*Generator, error) {
+	abiJSON, bytecode, err := unwrapArtifact(abiJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	parsedABI, err := abi.Parse(abiJSON)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse ABI: %w", err)
 	}
 
-	return &Generator{
+	g := &Generator{
 		packageName:  packageName,
 		contractName: contractName,
 		abi:          parsedABI,
 		abiJSON:      abiJSON,
-	}, nil
+	}
+	if bytecode != "" {
+		if err := g.SetBytecode(bytecode); err != nil {
+			return nil, fmt.Errorf("invalid artifact bytecode: %w", err)
+		}
+	}
+	return g, nil
+}
+
+// unwrapArtifact returns the ABI and creation bytecode from a Hardhat or
+// Foundry artifact. A top-level JSON array is returned as-is with no bytecode.
+//
+// Hardhat stores bytecode as a hex string; Foundry stores it as {"object": "0x..."}.
+// Abstract contracts and interfaces have empty bytecode ("0x"), which yields "".
+func unwrapArtifact(data []byte) ([]byte, string, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return data, "", nil
+	}
+
+	var artifact struct {
+		ABI      json.RawMessage `json:"abi"`
+		Bytecode json.RawMessage `json:"bytecode"`
+	}
+	if err := json.Unmarshal(trimmed, &artifact); err != nil {
+		return nil, "", fmt.Errorf("failed to parse artifact: %w", err)
+	}
+	if len(artifact.ABI) == 0 {
+		return nil, "", fmt.Errorf("artifact has no \"abi\" field")
+	}
+
+	var bytecode string
+	if len(artifact.Bytecode) > 0 && string(artifact.Bytecode) != "null" {
+		if err := json.Unmarshal(artifact.Bytecode, &bytecode); err != nil {
+			var foundry struct {
+				Object string `json:"object"`
+			}
+			if err := json.Unmarshal(artifact.Bytecode, &foundry); err != nil {
+				return nil, "", fmt.Errorf("failed to parse artifact bytecode: %w", err)
+			}
+			bytecode = foundry.Object
+		}
+	}
+	if raw := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(bytecode), "0x"), "0X"); raw == "" {
+		bytecode = ""
+	}
+
+	return artifact.ABI, bytecode, nil
+}
+
+// libraryPlaceholder matches an unlinked library reference in solc output:
+// "__$<34 hex chars>$__" since solc 0.5, or "__<name>" padded with "_" to 40
+// characters before that. Neither can occur in linked (pure hex) bytecode.
+var libraryPlaceholder = regexp.MustCompile(`__.{36}__`)
+
+// SetBytecode sets the contract creation bytecode. When set, Generate also
+// emits ContractBytecode and a typed Deploy function for the constructor.
+//
+// Bytecode that still references unlinked libraries is rejected; link the
+// library addresses into it (e.g. with forge or hardhat) before generating.
+func (g *Generator) SetBytecode(bytecode string) error {
+	bytecode = strings.TrimSpace(bytecode)
+	raw := strings.TrimPrefix(strings.TrimPrefix(bytecode, "0x"), "0X")
+	if raw == "" {
+		return fmt.Errorf("bytecode is empty")
+	}
+	if placeholders := libraryPlaceholder.FindAllString(raw, -1); len(placeholders) > 0 {
+		slices.Sort(placeholders)
+		return fmt.Errorf("bytecode has unlinked library placeholders %s; link the libraries before generating",
+			strings.Join(slices.Compact(placeholders), ", "))
+	}
+	if _, err := hex.DecodeString(raw); err != nil {
+		return fmt.Errorf("invalid bytecode hex: %w", err)
+	}
+	g.bytecode = "0x" + strings.ToLower(raw)
+	return nil
 }
 
 // Generate generates the Go code for the contract.
 func (g *Generator) Generate() ([]byte, error) {
 	data := g.buildTemplateData()
 
+	if data.HasDeploy {
+		if err := validateConstructorInputs(data.ConstructorInputs); err != nil {
+			return nil, err
+		}
+	}
+
 	tmpl, err := template.New("contract").Funcs(templateFuncs).Parse(contractTemplate)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template: %w", err)
@@ -67,6 +163,12 @@ type TemplateData struct {
 	Functions    []FunctionData
 	Events       []EventData
 	HasEvents    bool
+
+	// Bytecode, HasDeploy and ConstructorInputs are only set when the
+	// generator has bytecode, in which case a Deploy function is emitted.
+	Bytecode          string
+	HasDeploy         bool
+	ConstructorInputs []ParamData
 }
 
 // FunctionData holds data for a single function.
@@ -83,18 +185,20 @@ type FunctionData struct {
 
 // EventData holds data for a single event.
 type EventData struct {
-	Name      string
-	GoName    string
-	Inputs    []ParamData
-	Signature string
+	Name          string
+	GoName        string
+	Inputs        []ParamData
+	IndexedInputs []ParamData
+	Signature     string
 }
 
 // ParamData holds data for a parameter.
 type ParamData struct {
-	Name   string
-	GoName string
-	Type   string
-	GoType string
+	Name    string
+	GoName  string
+	Type    string
+	GoType  string
+	Indexed bool
 }
 
 // buildTemplateData builds the data structure for templates.
@@ -106,8 +210,9 @@ func (g *Generator) buildTemplateData() TemplateData {
 		HasEvents:    len(g.abi.Events) > 0,
 	}
 
-	// Process functions
-	for _, fn := range g.abi.Functions {
+	// Process functions (sorted so generated output is deterministic)
+	for _, key := range sortedKeys(g.abi.Functions) {
+		fn := g.abi.Functions[key]
 		fnData := FunctionData{
 			Name:            fn.Name,
 			GoName:          toExportedName(fn.Name),
@@ -148,8 +253,9 @@ func (g *Generator) buildTemplateData() TemplateData {
 		data.Functions = append(data.Functions, fnData)
 	}
 
-	// Process events
-	for _, ev := range g.abi.Events {
+	// Process events (sorted so generated output is deterministic)
+	for _, key := range sortedKeys(g.abi.Events) {
+		ev := g.abi.Events[key]
 		evData := EventData{
 			Name:      ev.Name,
 			GoName:    toExportedName(ev.Name),
@@ -161,20 +267,68 @@ func (g *Generator) buildTemplateData() TemplateData {
 			if name == "" {
 				name = fmt.Sprintf("arg%d", i)
 			}
-			evData.Inputs = append(evData.Inputs, ParamData{
-				Name:   name,
-				GoName: toExportedName(name),
-				Type:   input.Type,
-				GoType: solidityToGoType(input.Type),
-			})
+			param := ParamData{
+				Name:    name,
+				GoName:  toExportedName(name),
+				Type:    input.Type,
+				GoType:  solidityToGoType(input.Type),
+				Indexed: input.Indexed,
+			}
+			evData.Inputs = append(evData.Inputs, param)
+			if input.Indexed {
+				evData.IndexedInputs = append(evData.IndexedInputs, param)
+			}
 		}
 
 		data.Events = append(data.Events, evData)
 	}
 
+	// Process constructor (only needed for Deploy)
+	if g.bytecode != "" {
+		data.Bytecode = g.bytecode
+		data.HasDeploy = true
+		for i, input := range g.abi.GethABI().Constructor.Inputs {
+			name := input.Name
+			if name == "" {
+				name = fmt.Sprintf("arg%d", i)
+			}
+			typ := input.Type.String()
+			data.ConstructorInputs = append(data.ConstructorInputs, ParamData{
+				Name:   name,
+				GoName: toLowerCamelCase(name),
+				Type:   typ,
+				GoType: solidityToGoType(typ),
+			})
+		}
+	}
+
 	return data
 }
 
+// validateConstructorInputs checks that the constructor inputs map one-to-one
+// onto Deploy parameters, so the generated function takes exactly as many
+// arguments as the constructor and cannot shadow its own ctx/client parameters.
+func validateConstructorInputs(inputs []ParamData) error {
+	seen := map[string]bool{"ctx": true, "c": true}
+	for i, input := range inputs {
+		if seen[input.GoName] {
+			return fmt.Errorf("constructor input %d (%q) maps to duplicate Go parameter %q", i, input.Name, input.GoName)
+		}
+		seen[input.GoName] = true
+	}
+	return nil
+}
+
+// sortedKeys returns the keys of m in ascending order.
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // solidityToGoType converts a Solidity type to a Go type.
 func solidityToGoType(solType string) string {
 	// Handle arrays
@@ -294,6 +448,19 @@ var templateFuncs = template.FuncMap{
 	"add": func(a, b int) int {
 		return a + b
 	},
+	"isNilable": isNilable,
+	"filterType": func(goType string) string {
+		if isNilable(goType) {
+			return goType
+		}
+		return "*" + goType
+	},
+}
+
+// isNilable reports whether a Go type can be nil, so it can express
+// "no filter" for an indexed event argument without a pointer wrapper.
+func isNilable(goType string) bool {
+	return strings.HasPrefix(goType, "*") || strings.HasPrefix(goType, "[]") || goType == "interface{}"
 }
 
 // getTypedMethodDescriptor returns the typed method descriptor type for a function.
@@ -10,10 +10,22 @@ import (
 	"sync"
 
 	"github.com/ChefBingbong/viem-go/abi"
+{{- if or .HasEvents .HasDeploy}}
+	"github.com/ChefBingbong/viem-go/actions/public"
+{{- end}}
+{{- if .HasDeploy}}
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+{{- end}}
 	"github.com/ChefBingbong/viem-go/client"
 	"github.com/ChefBingbong/viem-go/contract"
 	"github.com/ChefBingbong/viem-go/types"
+{{- if .HasEvents}}
+	"github.com/ChefBingbong/viem-go/utils/formatters"
+{{- end}}
 	"github.com/ethereum/go-ethereum/common"
+{{- if .HasDeploy}}
+	"github.com/ethereum/go-ethereum/crypto"
+{{- end}}
 )
 
 // Suppress unused import warnings
@@ -159,14 +171,153 @@ func (c *{{$.ContractName}}) Estimate{{.GoName}}(ctx context.Context, opts contr
 {{end}}
 
 {{if .HasEvents}}
-// Event types
+// ============================================================================
+// Events
+// ============================================================================
 {{range .Events}}
 // {{.GoName}}Event represents a {{.Name}} event.
+// Solidity: {{.Signature}}
 type {{.GoName}}Event struct {
 {{range .Inputs}}	{{.GoName}} {{.GoType}}
-{{end}}}
+{{end}}
+	// Raw is the log the event was decoded from.
+	Raw formatters.Log
+}
+
+// {{.GoName}}FilterOpts filters {{.Name}} events by block range and indexed arguments.
+// Nil indexed arguments match any value.
+type {{.GoName}}FilterOpts struct {
+{{range .IndexedInputs}}	{{.GoName}} {{filterType .GoType}}
+{{end}}
+	// FromBlock is the block to start from. When watching, setting it forces polling.
+	FromBlock *uint64
+	// ToBlock is the block to stop at. Ignored when watching.
+	ToBlock *uint64
+}
+
+// {{.GoName}}WatchResult is a batch of {{.Name}} events, or an error, from Watch{{.GoName}}.
+type {{.GoName}}WatchResult struct {
+	Events []{{.GoName}}Event
+	Error  error
+}
+
+// Filter{{.GoName}} returns the {{.Name}} events matching opts.
+func (c *{{$.ContractName}}) Filter{{.GoName}}(ctx context.Context, opts {{.GoName}}FilterOpts) ([]{{.GoName}}Event, error) {
+	args := make([]any, {{len .IndexedInputs}})
+{{- range $i, $in := .IndexedInputs}}
+	if opts.{{$in.GoName}} != nil {
+		args[{{$i}}] = {{if isNilable $in.GoType}}opts.{{$in.GoName}}{{else}}*opts.{{$in.GoName}}{{end}}
+	}
+{{- end}}
+
+	logs, err := public.GetContractEvents(ctx, c.contract.Client(), public.GetContractEventsParameters{
+		Address:   c.contract.Address(),
+		ABI:       c.contract.ABI(),
+		EventName: "{{.Name}}",
+		Args:      args,
+		FromBlock: opts.FromBlock,
+		ToBlock:   opts.ToBlock,
+		Strict:    true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]{{.GoName}}Event, 0, len(logs))
+	for _, l := range logs {
+		events = append(events, new{{.GoName}}Event(l.DecodedArgs, l.Log))
+	}
+	return events, nil
+}
+
+// Watch{{.GoName}} watches for {{.Name}} events matching opts.
+// The channel is closed when ctx is cancelled.
+func (c *{{$.ContractName}}) Watch{{.GoName}}(ctx context.Context, opts {{.GoName}}FilterOpts) <-chan {{.GoName}}WatchResult {
+	args := map[string]any{}
+{{- range .IndexedInputs}}
+	if opts.{{.GoName}} != nil {
+		args["{{.Name}}"] = {{if isNilable .GoType}}opts.{{.GoName}}{{else}}*opts.{{.GoName}}{{end}}
+	}
+{{- end}}
+
+	watched := public.WatchContractEvent(ctx, c.contract.Client(), public.WatchContractEventParameters{
+		Address:   c.contract.Address(),
+		ABI:       c.contract.ABI(),
+		EventName: "{{.Name}}",
+		Args:      args,
+		FromBlock: opts.FromBlock,
+	})
+
+	out := make(chan {{.GoName}}WatchResult)
+	go func() {
+		defer close(out)
+		for ev := range watched {
+			result := {{.GoName}}WatchResult{Error: ev.Error}
+			for _, l := range ev.Logs {
+				decoded, _ := l.Args.(map[string]any)
+				result.Events = append(result.Events, new{{.GoName}}Event(decoded, l))
+			}
+			// Logs that do not decode as {{.Name}} are skipped.
+			if len(result.Events) == 0 && result.Error == nil {
+				continue
+			}
+			select {
+			case out <- result:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
 
+// new{{.GoName}}Event builds a typed {{.GoName}}Event from decoded log args.
+func new{{.GoName}}Event(args map[string]any, raw formatters.Log) {{.GoName}}Event {
+	ev := {{.GoName}}Event{Raw: raw}
+{{- range .Inputs}}
+	if v, ok := args["{{.Name}}"].({{.GoType}}); ok {
+		ev.{{.GoName}} = v
+	}
+{{- end}}
+	return ev
+}
+{{end}}
 {{end}}
+
+{{if .HasDeploy}}
+// ============================================================================
+// Deployment
+// ============================================================================
+
+// ContractBytecode is the creation bytecode of the {{.ContractName}} contract.
+const ContractBytecode = "{{.Bytecode}}"
+
+// Deploy deploys the {{.ContractName}} contract and returns its address and the
+// deployment transaction hash. The nonce is left to the wallet client (and its
+// nonce manager); the address is derived from the nonce of the sent transaction.
+// Solidity: constructor({{range $i, $in := .ConstructorInputs}}{{if $i}}, {{end}}{{$in.Type}} {{$in.Name}}{{end}})
+func Deploy(ctx context.Context, c *client.WalletClient{{range .ConstructorInputs}}, {{.GoName}} {{.GoType}}{{end}}) (common.Address, string, error) {
+	account := c.Account()
+	if account == nil {
+		return common.Address{}, "", &wallet.AccountNotFoundError{}
+	}
+
+	hash, err := wallet.DeployContract(ctx, c, wallet.DeployContractParameters{
+		ABI:      MustParsedABI(),
+		Bytecode: ContractBytecode,
+		Args:     []any{ {{- range $i, $in := .ConstructorInputs}}{{if $i}}, {{end}}{{$in.GoName}}{{end -}} },
+	})
+	if err != nil {
+		return common.Address{}, "", err
+	}
+
+	txHash := common.HexToHash(hash)
+	tx, err := public.GetTransaction(ctx, c, public.GetTransactionParameters{Hash: &txHash})
+	if err != nil {
+		return common.Address{}, hash, err
+	}
+	return crypto.CreateAddress(account.Address(), tx.Nonce), hash, nil
+}
 {{end}}
 `
 
@@ -0,0 +1,333 @@
+package codegen_test
+
+import (
+	"context"
+	"flag"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/codegen"
+	"github.com/ChefBingbong/viem-go/codegen/test/counter"
+	"github.com/ChefBingbong/viem-go/codegen/test/erc20"
+)
+
+// update regenerates the golden file: go test ./codegen/test -update
+var update = flag.Bool("update", false, "update golden files")
+
+// Golden files are generated bindings. They live in real packages so
+// `go build ./...` also proves the generated code compiles.
+var (
+	goldenPath        = filepath.Join("erc20", "erc20.go")
+	counterGoldenPath = filepath.Join("counter", "counter.go")
+)
+
+func TestGenerate_ERC20Golden(t *testing.T) {
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "erc20.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("erc20", "ERC20", abiJSON)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.WriteFile(goldenPath, generated, 0o644))
+	}
+
+	golden, err := os.ReadFile(goldenPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(golden), string(generated), "generated output differs from %s; run with -update to regenerate", goldenPath)
+}
+
+func TestGenerate_Deterministic(t *testing.T) {
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "erc20.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("erc20", "ERC20", abiJSON)
+	require.NoError(t, err)
+
+	first, err := gen.Generate()
+	require.NoError(t, err)
+	for i := 0; i < 5; i++ {
+		next, err := gen.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, string(first), string(next))
+	}
+}
+
+func TestGeneratedBinding_FilterTransfer(t *testing.T) {
+	token := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	from := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	to := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	transferTopic := "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+	var filterTopics []any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		if req.Method == "eth_getLogs" {
+			filterTopics, _ = req.Params[0].(map[string]any)["topics"].([]any)
+			result = []map[string]any{{
+				"address":          strings.ToLower(token.Hex()),
+				"topics":           []string{transferTopic, common.BytesToHash(from.Bytes()).Hex(), common.BytesToHash(to.Bytes()).Hex()},
+				"data":             "0x00000000000000000000000000000000000000000000000000000000000003e8",
+				"blockNumber":      "0x10",
+				"blockHash":        "0x1234567890123456789012345678901234567890123456789012345678901234",
+				"transactionHash":  "0xabcdef1234567890abcdef1234567890abcdef1234567890abcdef1234567890",
+				"transactionIndex": "0x0",
+				"logIndex":         "0x0",
+				"removed":          false,
+			}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	publicClient, err := client.CreatePublicClient(client.PublicClientConfig{
+		Transport: transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	defer publicClient.Close()
+
+	binding, err := erc20.New(token, publicClient)
+	require.NoError(t, err)
+
+	fromBlock := uint64(0)
+	events, err := binding.FilterTransfer(context.Background(), erc20.TransferFilterOpts{
+		From:      &from,
+		FromBlock: &fromBlock,
+	})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	assert.Equal(t, from, events[0].From)
+	assert.Equal(t, to, events[0].To)
+	assert.Equal(t, big.NewInt(1000), events[0].Value)
+	assert.Equal(t, big.NewInt(0x10), events[0].Raw.BlockNumber)
+
+	// The indexed `from` argument is encoded as the second topic.
+	require.GreaterOrEqual(t, len(filterTopics), 2)
+	assert.True(t, strings.EqualFold(transferTopic, filterTopics[0].(string)))
+	assert.True(t, strings.EqualFold(common.BytesToHash(from.Bytes()).Hex(), filterTopics[1].(string)))
+}
+
+func newCounterGenerator(t *testing.T) *codegen.Generator {
+	t.Helper()
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "counter.json"))
+	require.NoError(t, err)
+	bin, err := os.ReadFile(filepath.Join("testdata", "counter.bin"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", abiJSON)
+	require.NoError(t, err)
+	require.NoError(t, gen.SetBytecode(string(bin)))
+	return gen
+}
+
+func TestGenerate_CounterDeployGolden(t *testing.T) {
+	generated, err := newCounterGenerator(t).Generate()
+	require.NoError(t, err)
+
+	if *update {
+		require.NoError(t, os.WriteFile(counterGoldenPath, generated, 0o644))
+	}
+
+	golden, err := os.ReadFile(counterGoldenPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(golden), string(generated), "generated output differs from %s; run with -update to regenerate", counterGoldenPath)
+	assert.Contains(t, string(generated), "func Deploy(ctx context.Context, c *client.WalletClient, initialCount *big.Int)")
+}
+
+func TestGenerate_NoBytecodeSkipsDeploy(t *testing.T) {
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "counter.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", abiJSON)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+	assert.NotContains(t, string(generated), "func Deploy(")
+	assert.NotContains(t, string(generated), "ContractBytecode")
+}
+
+func TestGenerator_SetBytecodeInvalid(t *testing.T) {
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "counter.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", abiJSON)
+	require.NoError(t, err)
+
+	assert.Error(t, gen.SetBytecode(""))
+	assert.Error(t, gen.SetBytecode("0xzz"))
+}
+
+func TestGenerate_ConstructorParamCollision(t *testing.T) {
+	abiJSON := []byte(`[{"type":"constructor","stateMutability":"nonpayable","inputs":[{"name":"ctx","type":"uint256"}]}]`)
+
+	gen, err := codegen.NewGenerator("collide", "Collide", abiJSON)
+	require.NoError(t, err)
+	require.NoError(t, gen.SetBytecode("0x6080"))
+
+	_, err = gen.Generate()
+	assert.Error(t, err)
+}
+
+func TestGeneratedBinding_Deploy(t *testing.T) {
+	sender := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+
+	var sent map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result any
+		switch req.Method {
+		case "eth_sendTransaction":
+			sent = req.Params[0].(map[string]any)
+			result = "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1"
+		case "eth_getTransactionByHash":
+			result = map[string]any{
+				"hash":  req.Params[0],
+				"from":  sender.Hex(),
+				"nonce": "0x0",
+				"gas":   "0x5208",
+				"input": "0x",
+				"value": "0x0",
+			}
+		default:
+			t.Fatalf("unexpected method %s", req.Method)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+	defer server.Close()
+
+	walletClient, err := client.CreateWalletClient(client.WalletClientConfig{
+		Account:   client.NewAddressAccount(sender),
+		Transport: transport.HTTP(server.URL),
+	})
+	require.NoError(t, err)
+	defer walletClient.Close()
+
+	address, hash, err := counter.Deploy(context.Background(), walletClient, big.NewInt(7))
+	require.NoError(t, err)
+	assert.Equal(t, "0xabc123def456abc123def456abc123def456abc123def456abc123def456abc1", hash)
+	// CREATE address of the first Hardhat/Anvil account at nonce 0.
+	assert.Equal(t, common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3"), address)
+
+	require.NotNil(t, sent)
+	_, hasTo := sent["to"]
+	assert.False(t, hasTo)
+	assert.Equal(t, counter.ContractBytecode+"0000000000000000000000000000000000000000000000000000000000000007", sent["data"])
+	_, hasNonce := sent["nonce"]
+	assert.False(t, hasNonce, "the nonce is left to the wallet client")
+}
+
+func TestNewGenerator_FoundryArtifact(t *testing.T) {
+	artifact, err := os.ReadFile(filepath.Join("testdata", "counter.foundry.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", artifact)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "func (c *Counter) Count(ctx context.Context) (*big.Int, error)")
+	assert.Contains(t, string(generated), `const ContractBytecode = "`+counter.ContractBytecode+`"`)
+	// Only the nested ABI is embedded, not the whole artifact.
+	assert.NotContains(t, string(generated), "deployedBytecode")
+}
+
+func TestNewGenerator_HardhatArtifact(t *testing.T) {
+	artifact := []byte(`{
+		"_format": "hh-sol-artifact-1",
+		"contractName": "Counter",
+		"abi": [{"type":"function","name":"count","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}],
+		"bytecode": "0x6080"
+	}`)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", artifact)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), `const ContractBytecode = "0x6080"`)
+}
+
+func TestNewGenerator_UnlinkedLibrary(t *testing.T) {
+	placeholder := "__$1f1d2e8e9e3a5b6c7d8e9f0a1b2c3d4e5f$__"
+	artifact := []byte(`{
+		"abi": [{"type":"function","name":"count","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}],
+		"bytecode": {"object": "0x608060405273` + placeholder + `6000f3"}
+	}`)
+
+	_, err := codegen.NewGenerator("counter", "Counter", artifact)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unlinked library")
+	assert.Contains(t, err.Error(), placeholder)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", []byte(`[]`))
+	require.NoError(t, err)
+	// Pre-0.5 solc placeholders embed the padded library name.
+	err = gen.SetBytecode("0x73__contracts/Math.sol:Math_______________6000")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unlinked library")
+}
+
+func TestNewGenerator_ArtifactWithoutBytecode(t *testing.T) {
+	// Interfaces and abstract contracts have empty bytecode.
+	artifact := []byte(`{"abi": [{"type":"function","name":"count","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]}], "bytecode": "0x"}`)
+
+	gen, err := codegen.NewGenerator("counter", "Counter", artifact)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+	assert.NotContains(t, string(generated), "func Deploy(")
+}
+
+func TestNewGenerator_RawABIArray(t *testing.T) {
+	abiJSON, err := os.ReadFile(filepath.Join("testdata", "erc20.json"))
+	require.NoError(t, err)
+
+	gen, err := codegen.NewGenerator("erc20", "ERC20", abiJSON)
+	require.NoError(t, err)
+
+	generated, err := gen.Generate()
+	require.NoError(t, err)
+	assert.Contains(t, string(generated), "func (c *ERC20) BalanceOf(")
+	assert.NotContains(t, string(generated), "func Deploy(")
+}
+
+func TestNewGenerator_ArtifactMissingABI(t *testing.T) {
+	_, err := codegen.NewGenerator("counter", "Counter", []byte(`{"bytecode": "0x6080"}`))
+	assert.Error(t, err)
+}
@@ -27,6 +27,13 @@ const Multicall3Bytecode = "0x608060405234801561001057600080fd5b506115b980610020
 // Used to detect if a call is already a multicall to avoid double-batching.
 const Aggregate3Signature = "0x82ad56cb"
 
+// Aggregate3ValueSignature is the function selector for multicall3's aggregate3Value function.
+const Aggregate3ValueSignature = "0x174dea71"
+
+// TryBlockAndAggregateSignature is the function selector for multicall3's
+// tryBlockAndAggregate function.
+const TryBlockAndAggregateSignature = "0x399542e9"
+
 // CounterfactualDeploymentFailedSignature is the error signature for failed
 // counterfactual deployments (selector for custom error).
 const CounterfactualDeploymentFailedSignature = "0x101bb98d"
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -17,6 +18,9 @@ type ReadOptions struct {
 	From *common.Address
 	// Block is the block tag to read from (default: latest).
 	Block client.BlockTag
+	// CacheTime, if positive, reuses the result of an identical read for this
+	// long (see client.PublicClient.CachedCall).
+	CacheTime time.Duration
 }
 
 // Read calls a contract method and returns the decoded return values.
@@ -53,9 +57,9 @@ func (c *Contract) ReadWithOptions(ctx context.Context, opts ReadOptions, method
 	// Make the call
 	var result []byte
 	if opts.Block != "" {
-		result, err = c.client.Call(ctx, callReq, opts.Block)
+		result, err = c.client.CachedCall(ctx, callReq, opts.CacheTime, opts.Block)
 	} else {
-		result, err = c.client.Call(ctx, callReq)
+		result, err = c.client.CachedCall(ctx, callReq, opts.CacheTime)
 	}
 	if err != nil {
 		return nil, fmt.Errorf("eth_call failed for %q: %w", method, err)
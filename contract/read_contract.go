@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -27,6 +28,9 @@ type ReadContractParams struct {
 	BlockTag client.BlockTag
 	// From is the address to use as the caller (optional).
 	From *common.Address
+	// CacheTime, if positive, reuses the result of an identical read for this
+	// long (see client.PublicClient.CachedCall).
+	CacheTime time.Duration
 }
 
 // ReadContract calls a contract function and returns the result as the specified type T.
@@ -71,9 +75,9 @@ func ReadContract[T any](c *client.PublicClient, params ReadContractParams) (T,
 	// Make the call
 	var result []byte
 	if params.BlockTag != "" {
-		result, err = c.Call(context.Background(), callReq, params.BlockTag)
+		result, err = c.CachedCall(context.Background(), callReq, params.CacheTime, params.BlockTag)
 	} else {
-		result, err = c.Call(context.Background(), callReq)
+		result, err = c.CachedCall(context.Background(), callReq, params.CacheTime)
 	}
 	if err != nil {
 		return zero, fmt.Errorf("eth_call failed for %q: %w", params.FunctionName, err)
@@ -109,9 +113,9 @@ func ReadContractWithContext[T any](ctx context.Context, c *client.PublicClient,
 	// Make the call
 	var result []byte
 	if params.BlockTag != "" {
-		result, err = c.Call(ctx, callReq, params.BlockTag)
+		result, err = c.CachedCall(ctx, callReq, params.CacheTime, params.BlockTag)
 	} else {
-		result, err = c.Call(ctx, callReq)
+		result, err = c.CachedCall(ctx, callReq, params.CacheTime)
 	}
 	if err != nil {
 		return zero, fmt.Errorf("eth_call failed for %q: %w", params.FunctionName, err)
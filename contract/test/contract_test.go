@@ -1,8 +1,23 @@
 package contract_test
 
 import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/contract"
 
 	. "github.com/onsi/ginkgo"
@@ -111,4 +126,158 @@ var _ = Describe("Contract", func() {
 			}).ToNot(Panic())
 		})
 	})
+
+	Context("when reading through a client with multicall batching", func() {
+		It("should coalesce concurrent reads into a single aggregate3 call", func() {
+			multicall3 := definitions.Mainnet.Contracts.Multicall3.Address
+			token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+			var mu sync.Mutex
+			var ethCalls []map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					ID     any    `json:"id"`
+					Method string `json:"method"`
+					Params []any  `json:"params"`
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				Expect(req.Method).To(Equal("eth_call"))
+
+				call := req.Params[0].(map[string]any)
+				mu.Lock()
+				ethCalls = append(ethCalls, call)
+				mu.Unlock()
+
+				// Answer every balanceOf(owner) with the owner address as the balance
+				result, err := aggregate3Echo(common.FromHex(call["data"].(string)))
+				Expect(err).ToNot(HaveOccurred())
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": hexutil.Encode(result)})
+			}))
+			defer server.Close()
+
+			c, err := client.CreatePublicClient(client.PublicClientConfig{
+				Chain:     &definitions.Mainnet,
+				Transport: transport.HTTP(server.URL),
+				Batch:     &client.BatchOptions{Multicall: &client.MulticallBatchOptions{Wait: 20 * time.Millisecond}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer c.Close()
+
+			const reads = 5
+			balances := make([]*big.Int, reads)
+			errs := make([]error, reads)
+			var wg sync.WaitGroup
+			for i := 0; i < reads; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					owner := common.BigToAddress(big.NewInt(int64(i + 1)))
+					balances[i], errs[i] = contract.ReadContractWithContext[*big.Int](context.Background(), c, contract.ReadContractParams{
+						Address:      token,
+						ABI:          erc20ABI,
+						FunctionName: "balanceOf",
+						Args:         []any{owner},
+					})
+				}(i)
+			}
+			wg.Wait()
+
+			for i := 0; i < reads; i++ {
+				Expect(errs[i]).ToNot(HaveOccurred())
+				Expect(balances[i]).To(Equal(big.NewInt(int64(i + 1))))
+			}
+			Expect(ethCalls).To(HaveLen(1))
+			Expect(common.HexToAddress(ethCalls[0]["to"].(string))).To(Equal(multicall3))
+		})
+
+		It("should not fail the batch when one caller gives up", func() {
+			token := common.HexToAddress("0x1234567890123456789012345678901234567890")
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var req struct {
+					ID     any    `json:"id"`
+					Method string `json:"method"`
+					Params []any  `json:"params"`
+				}
+				Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+				time.Sleep(50 * time.Millisecond)
+
+				call := req.Params[0].(map[string]any)
+				result, err := aggregate3Echo(common.FromHex(call["data"].(string)))
+				Expect(err).ToNot(HaveOccurred())
+
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": hexutil.Encode(result)})
+			}))
+			defer server.Close()
+
+			c, err := client.CreatePublicClient(client.PublicClientConfig{
+				Chain:     &definitions.Mainnet,
+				Transport: transport.HTTP(server.URL),
+				Batch:     &client.BatchOptions{Multicall: &client.MulticallBatchOptions{Wait: 10 * time.Millisecond}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer c.Close()
+
+			read := func(ctx context.Context, owner int64) (*big.Int, error) {
+				return contract.ReadContractWithContext[*big.Int](ctx, c, contract.ReadContractParams{
+					Address:      token,
+					ABI:          erc20ABI,
+					FunctionName: "balanceOf",
+					Args:         []any{common.BigToAddress(big.NewInt(owner))},
+				})
+			}
+
+			// The first caller opens the batch and cancels while it is in flight.
+			cancelCtx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+			defer cancel()
+
+			var wg sync.WaitGroup
+			var cancelledErr error
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, cancelledErr = read(cancelCtx, 1)
+			}()
+			time.Sleep(2 * time.Millisecond)
+
+			balance, err := read(context.Background(), 2)
+			wg.Wait()
+
+			Expect(cancelledErr).To(MatchError(context.DeadlineExceeded))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(balance).To(Equal(big.NewInt(2)))
+		})
+	})
 })
+
+// aggregate3Echo decodes an aggregate3 calldata and returns an encoded result
+// in which each call returns the last 32 bytes of its own calldata.
+func aggregate3Echo(calldata []byte) ([]byte, error) {
+	parsed, err := gethabi.JSON(strings.NewReader(`[{"name":"aggregate3","type":"function","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`))
+	if err != nil {
+		return nil, err
+	}
+	method := parsed.Methods["aggregate3"]
+	args, err := method.Inputs.Unpack(calldata[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, len(calls))
+	for i, call := range calls {
+		results[i] = result{Success: true, ReturnData: call.CallData[len(call.CallData)-32:]}
+	}
+	return method.Outputs.Pack(results)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
 	"github.com/ChefBingbong/viem-go/client"
 	"github.com/ChefBingbong/viem-go/contract"
 	"github.com/ChefBingbong/viem-go/types"
@@ -280,3 +282,119 @@ func ReadAllowance(c *client.PublicClient, tokenAddr, owner, spender common.Addr
 		Args:         []any{owner, spender},
 	})
 }
+
+// =============================================================================
+// Typed Read Helpers
+// =============================================================================
+
+// parsedABI is ContractABI parsed once for the helpers below.
+var parsedABI = abi.MustParse([]byte(ContractABI))
+
+// BalanceOf returns the token balance of owner.
+//
+// Like the other typed read helpers, it accepts optional contract.ReadOptions
+// to read at a block, as a caller, or through the read cache.
+//
+// Example:
+//
+//	balance, err := erc20.BalanceOf(ctx, client, usdc, owner)
+//	decimals, err := erc20.Decimals(ctx, client, usdc, contract.ReadOptions{CacheTime: time.Hour})
+func BalanceOf(ctx context.Context, c *client.PublicClient, token, owner common.Address, opts ...contract.ReadOptions) (*big.Int, error) {
+	return contract.ReadContractWithContext[*big.Int](ctx, c, withReadOptions(contract.ReadContractParams{
+		Address:      token,
+		ABI:          parsedABI,
+		FunctionName: "balanceOf",
+		Args:         []any{owner},
+	}, opts))
+}
+
+// Decimals returns the number of decimals the token uses.
+func Decimals(ctx context.Context, c *client.PublicClient, token common.Address, opts ...contract.ReadOptions) (uint8, error) {
+	return contract.ReadContractWithContext[uint8](ctx, c, withReadOptions(contract.ReadContractParams{
+		Address:      token,
+		ABI:          parsedABI,
+		FunctionName: "decimals",
+	}, opts))
+}
+
+// Symbol returns the token symbol.
+func Symbol(ctx context.Context, c *client.PublicClient, token common.Address, opts ...contract.ReadOptions) (string, error) {
+	return contract.ReadContractWithContext[string](ctx, c, withReadOptions(contract.ReadContractParams{
+		Address:      token,
+		ABI:          parsedABI,
+		FunctionName: "symbol",
+	}, opts))
+}
+
+// Name returns the token name.
+func Name(ctx context.Context, c *client.PublicClient, token common.Address, opts ...contract.ReadOptions) (string, error) {
+	return contract.ReadContractWithContext[string](ctx, c, withReadOptions(contract.ReadContractParams{
+		Address:      token,
+		ABI:          parsedABI,
+		FunctionName: "name",
+	}, opts))
+}
+
+// TotalSupply returns the total token supply.
+func TotalSupply(ctx context.Context, c *client.PublicClient, token common.Address, opts ...contract.ReadOptions) (*big.Int, error) {
+	return contract.ReadContractWithContext[*big.Int](ctx, c, withReadOptions(contract.ReadContractParams{
+		Address:      token,
+		ABI:          parsedABI,
+		FunctionName: "totalSupply",
+	}, opts))
+}
+
+// withReadOptions applies the first of opts, if any, to params.
+func withReadOptions(params contract.ReadContractParams, opts []contract.ReadOptions) contract.ReadContractParams {
+	if len(opts) > 0 {
+		params.From = opts[0].From
+		params.BlockTag = opts[0].Block
+		params.CacheTime = opts[0].CacheTime
+	}
+	return params
+}
+
+// TokenMetadata contains the descriptive fields of an ERC20 token.
+type TokenMetadata struct {
+	Name     string
+	Symbol   string
+	Decimals uint8
+}
+
+// Metadata reads the token name, symbol and decimals in a single multicall.
+// The chain's multicall3 contract is used when configured; otherwise the
+// multicall is executed deployless.
+//
+// Example:
+//
+//	meta, err := erc20.Metadata(ctx, client, usdc)
+//	formatted := unit.FormatUnits(balance, int(meta.Decimals))
+func Metadata(ctx context.Context, c *client.PublicClient, token common.Address) (*TokenMetadata, error) {
+	allowFailure := false
+	chain := c.Chain()
+	results, err := public.Multicall(ctx, c, public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: token, FunctionName: "name"},
+			{Address: token, FunctionName: "symbol"},
+			{Address: token, FunctionName: "decimals"},
+		},
+		ABI:          parsedABI,
+		AllowFailure: &allowFailure,
+		Deployless:   chain == nil || chain.Contracts == nil || chain.Contracts.Multicall3 == nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var meta TokenMetadata
+	if err := results.DecodeAs(0, &meta.Name); err != nil {
+		return nil, err
+	}
+	if err := results.DecodeAs(1, &meta.Symbol); err != nil {
+		return nil, err
+	}
+	if err := results.DecodeAs(2, &meta.Decimals); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
@@ -0,0 +1,233 @@
+package erc20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+// PermitABI is the ABI of the EIP-2612 permit extension.
+var PermitABI = `[{"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"version","outputs":[{"name":"","type":"string"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"owner","type":"address"}],"name":"nonces","outputs":[{"name":"","type":"uint256"}],"stateMutability":"view","type":"function"},{"inputs":[],"name":"DOMAIN_SEPARATOR","outputs":[{"name":"","type":"bytes32"}],"stateMutability":"view","type":"function"},{"inputs":[{"name":"owner","type":"address"},{"name":"spender","type":"address"},{"name":"value","type":"uint256"},{"name":"deadline","type":"uint256"},{"name":"v","type":"uint8"},{"name":"r","type":"bytes32"},{"name":"s","type":"bytes32"}],"name":"permit","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// parsedPermitABI is PermitABI parsed once for the helpers below.
+var parsedPermitABI = abi.MustParse([]byte(PermitABI))
+
+// ErrPermitDomainMismatch is returned by SignPermit when the EIP-712 domain
+// built from the token's name and version does not hash to the token's
+// DOMAIN_SEPARATOR.
+var ErrPermitDomainMismatch = errors.New("erc20: permit domain does not match DOMAIN_SEPARATOR")
+
+// PermitTypes are the EIP-712 types of an EIP-2612 permit.
+var PermitTypes = map[string][]signature.TypedDataField{
+	"Permit": {
+		{Name: "owner", Type: "address"},
+		{Name: "spender", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+}
+
+// PermitParameters contains the parameters for SignPermit.
+type PermitParameters struct {
+	// Token is the ERC20 token implementing EIP-2612.
+	Token common.Address
+	// Spender is the address allowed to spend the tokens.
+	Spender common.Address
+	// Value is the allowance to grant.
+	Value *big.Int
+	// Deadline is the unix timestamp after which the permit expires.
+	Deadline *big.Int
+	// Version overrides the domain version. If empty, the token's version()
+	// is read, falling back to "1" for tokens without it.
+	Version string
+}
+
+// SignedPermit is a signed EIP-2612 permit, ready to submit with Permit.
+type SignedPermit struct {
+	Token    common.Address
+	Owner    common.Address
+	Spender  common.Address
+	Value    *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+
+	// V, R and S are the signature components passed to permit().
+	V uint8
+	R [32]byte
+	S [32]byte
+
+	// Signature is the 65-byte signature as hex.
+	Signature string
+
+	// TypedData is the EIP-712 typed data that was signed.
+	TypedData signature.TypedDataDefinition
+}
+
+// SignPermit signs an EIP-2612 permit for account.
+//
+// The token's name, version, nonce for account and DOMAIN_SEPARATOR are read
+// in a single multicall. The permit domain is checked against
+// DOMAIN_SEPARATOR before signing, so a token with a non-standard domain
+// returns ErrPermitDomainMismatch instead of an unusable signature.
+//
+// Example:
+//
+//	permit, err := erc20.SignPermit(ctx, walletClient, account, erc20.PermitParameters{
+//	    Token:    usdc,
+//	    Spender:  router,
+//	    Value:    amount,
+//	    Deadline: big.NewInt(time.Now().Add(time.Hour).Unix()),
+//	})
+//	hash, err := erc20.Permit(ctx, walletClient, permit)
+func SignPermit(ctx context.Context, c *client.WalletClient, account wallet.Account, params PermitParameters) (*SignedPermit, error) {
+	if account == nil {
+		account = c.Account()
+	}
+	if account == nil {
+		return nil, &wallet.AccountNotFoundError{DocsPath: "/docs/contract/erc20/signPermit"}
+	}
+	if params.Value == nil || params.Deadline == nil {
+		return nil, fmt.Errorf("erc20: permit value and deadline are required")
+	}
+	owner := account.Address()
+
+	chainID, err := permitChainID(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := c.Chain()
+	results, err := public.Multicall(ctx, c, public.MulticallParameters{
+		Contracts: []public.MulticallContract{
+			{Address: params.Token, FunctionName: "name"},
+			{Address: params.Token, FunctionName: "version"},
+			{Address: params.Token, FunctionName: "nonces", Args: []any{owner}},
+			{Address: params.Token, FunctionName: "DOMAIN_SEPARATOR"},
+		},
+		ABI:        parsedPermitABI,
+		Deployless: chain == nil || chain.Contracts == nil || chain.Contracts.Multicall3 == nil,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var name string
+	if err := results.DecodeAs(0, &name); err != nil {
+		return nil, fmt.Errorf("erc20: failed to read name: %w", err)
+	}
+	version := params.Version
+	if version == "" && results.DecodeAs(1, &version) != nil {
+		version = "1"
+	}
+	var nonce *big.Int
+	if err := results.DecodeAs(2, &nonce); err != nil {
+		return nil, fmt.Errorf("erc20: failed to read nonce: %w", err)
+	}
+	var domainSeparator [32]byte
+	if err := results.DecodeAs(3, &domainSeparator); err != nil {
+		return nil, fmt.Errorf("erc20: failed to read DOMAIN_SEPARATOR: %w", err)
+	}
+
+	domain := signature.TypedDataDomain{
+		Name:              name,
+		Version:           version,
+		ChainId:           new(big.Int).SetUint64(chainID),
+		VerifyingContract: params.Token.Hex(),
+	}
+	domainHash, err := signature.HashDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(domainHash, common.Hash(domainSeparator).Hex()) {
+		return nil, fmt.Errorf("%w (name %q, version %q)", ErrPermitDomainMismatch, name, version)
+	}
+
+	typedData := signature.TypedDataDefinition{
+		Domain:      domain,
+		Types:       PermitTypes,
+		PrimaryType: "Permit",
+		Message: map[string]any{
+			"owner":    owner.Hex(),
+			"spender":  params.Spender.Hex(),
+			"value":    params.Value,
+			"nonce":    nonce,
+			"deadline": params.Deadline,
+		},
+	}
+
+	sig, err := wallet.SignTypedData(ctx, c, wallet.SignTypedDataParameters{
+		Account:     account,
+		Domain:      typedData.Domain,
+		Types:       typedData.Types,
+		PrimaryType: typedData.PrimaryType,
+		Message:     typedData.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := signature.ParseSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignedPermit{
+		Token:     params.Token,
+		Owner:     owner,
+		Spender:   params.Spender,
+		Value:     params.Value,
+		Nonce:     nonce,
+		Deadline:  params.Deadline,
+		V:         uint8(27 + parsed.YParity),
+		R:         common.HexToHash(parsed.R),
+		S:         common.HexToHash(parsed.S),
+		Signature: sig,
+		TypedData: typedData,
+	}, nil
+}
+
+// Permit submits a signed permit to the token, setting the spender's
+// allowance. It can be sent from any account, e.g. a relayer.
+//
+// Example:
+//
+//	hash, err := erc20.Permit(ctx, walletClient, permit)
+func Permit(ctx context.Context, c *client.WalletClient, permit *SignedPermit) (string, error) {
+	if permit == nil {
+		return "", fmt.Errorf("erc20: permit is nil")
+	}
+	return c.WriteContract(ctx, wallet.WriteContractParameters{
+		Address:      permit.Token.Hex(),
+		ABI:          parsedPermitABI,
+		FunctionName: "permit",
+		Args: []any{
+			permit.Owner,
+			permit.Spender,
+			permit.Value,
+			permit.Deadline,
+			permit.V,
+			permit.R,
+			permit.S,
+		},
+	})
+}
+
+// permitChainID returns the client's chain ID, asking the node if the client
+// has no chain.
+func permitChainID(ctx context.Context, c *client.WalletClient) (uint64, error) {
+	if chain := c.Chain(); chain != nil {
+		return uint64(chain.ID), nil
+	}
+	return public.GetChainID(ctx, c)
+}
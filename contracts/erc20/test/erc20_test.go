@@ -0,0 +1,210 @@
+package erc20_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/contract"
+	"github.com/ChefBingbong/viem-go/contracts/erc20"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	usdc   = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	holder = common.HexToAddress("0xd8dA6BF26964aF9D7eEd9e03E53415D37aA96045")
+
+	usdcBalance     = big.NewInt(1_234_560_000)
+	usdcTotalSupply = big.NewInt(25_000_000_000_000_000)
+
+	tokenABI = mustGethABI(erc20.ContractABI)
+
+	multicall3ABI = mustGethABI(`[{"name":"aggregate3","type":"function","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`)
+)
+
+var _ = Describe("ERC20 read helpers", func() {
+	var (
+		server  *httptest.Server
+		c       *client.PublicClient
+		mu      sync.Mutex
+		ethCall []map[string]any
+	)
+
+	BeforeEach(func() {
+		ethCall = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     any    `json:"id"`
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			Expect(req.Method).To(Equal("eth_call"))
+
+			call := req.Params[0].(map[string]any)
+			mu.Lock()
+			ethCall = append(ethCall, call)
+			mu.Unlock()
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"result":  hexutil.Encode(respondUSDC(common.FromHex(call["data"].(string)))),
+			})
+		}))
+
+		var err error
+		c, err = client.CreatePublicClient(client.PublicClientConfig{
+			Chain:     &definitions.Mainnet,
+			Transport: transport.HTTP(server.URL),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = c.Close()
+		server.Close()
+	})
+
+	It("should read typed values", func() {
+		ctx := context.Background()
+
+		name, err := erc20.Name(ctx, c, usdc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(name).To(Equal("USD Coin"))
+
+		symbol, err := erc20.Symbol(ctx, c, usdc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(symbol).To(Equal("USDC"))
+
+		decimals, err := erc20.Decimals(ctx, c, usdc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decimals).To(Equal(uint8(6)))
+
+		supply, err := erc20.TotalSupply(ctx, c, usdc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(supply).To(Equal(usdcTotalSupply))
+
+		balance, err := erc20.BalanceOf(ctx, c, usdc, holder)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(balance).To(Equal(usdcBalance))
+
+		Expect(ethCall).To(HaveLen(5))
+		Expect(common.HexToAddress(ethCall[0]["to"].(string))).To(Equal(usdc))
+	})
+
+	It("should reuse cached reads", func() {
+		ctx := context.Background()
+		opts := contract.ReadOptions{CacheTime: time.Minute}
+
+		for i := 0; i < 2; i++ {
+			decimals, err := erc20.Decimals(ctx, c, usdc, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decimals).To(Equal(uint8(6)))
+
+			symbol, err := erc20.Symbol(ctx, c, usdc, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(symbol).To(Equal("USDC"))
+		}
+
+		Expect(ethCall).To(HaveLen(2))
+	})
+
+	It("should read metadata in a single multicall", func() {
+		meta, err := erc20.Metadata(context.Background(), c, usdc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*meta).To(Equal(erc20.TokenMetadata{Name: "USD Coin", Symbol: "USDC", Decimals: 6}))
+
+		Expect(ethCall).To(HaveLen(1))
+		Expect(common.HexToAddress(ethCall[0]["to"].(string))).To(Equal(definitions.Mainnet.Contracts.Multicall3.Address))
+	})
+})
+
+// respondUSDC answers ERC20 reads (directly or wrapped in aggregate3) with
+// USDC's on-chain metadata.
+func respondUSDC(calldata []byte) []byte {
+	return respondMulticall(calldata, func(calldata []byte) ([]byte, bool) {
+		return respondERC20(calldata), true
+	})
+}
+
+// respondMulticall unwraps aggregate3 calls and answers each with respond,
+// which reports false for a reverted call. Calls that are not aggregate3 are
+// answered directly.
+func respondMulticall(calldata []byte, respond func(calldata []byte) ([]byte, bool)) []byte {
+	method, err := multicall3ABI.MethodById(calldata[:4])
+	if err != nil {
+		out, _ := respond(calldata)
+		return out
+	}
+
+	args, err := method.Inputs.Unpack(calldata[4:])
+	Expect(err).ToNot(HaveOccurred())
+	calls := args[0].([]struct {
+		Target       common.Address `json:"target"`
+		AllowFailure bool           `json:"allowFailure"`
+		CallData     []byte         `json:"callData"`
+	})
+
+	type result struct {
+		Success    bool
+		ReturnData []byte
+	}
+	results := make([]result, len(calls))
+	for i, call := range calls {
+		out, ok := respond(call.CallData)
+		results[i] = result{Success: ok, ReturnData: out}
+	}
+	out, err := method.Outputs.Pack(results)
+	Expect(err).ToNot(HaveOccurred())
+	return out
+}
+
+func respondERC20(calldata []byte) []byte {
+	method, err := tokenABI.MethodById(calldata[:4])
+	Expect(err).ToNot(HaveOccurred())
+
+	var values []any
+	switch method.Name {
+	case "name":
+		values = []any{"USD Coin"}
+	case "symbol":
+		values = []any{"USDC"}
+	case "decimals":
+		values = []any{uint8(6)}
+	case "totalSupply":
+		values = []any{usdcTotalSupply}
+	case "balanceOf":
+		values = []any{usdcBalance}
+	default:
+		Fail("unexpected ERC20 method " + method.Name)
+	}
+
+	out, err := method.Outputs.Pack(values...)
+	Expect(err).ToNot(HaveOccurred())
+	return out
+}
+
+func mustGethABI(raw string) gethabi.ABI {
+	parsed, err := gethabi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
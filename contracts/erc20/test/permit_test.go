@@ -0,0 +1,225 @@
+package erc20_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/accounts"
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/contracts/erc20"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var permitABI = mustGethABI(erc20.PermitABI)
+
+// jsonRPCAccount is an account whose transactions are signed by the node.
+type jsonRPCAccount struct {
+	address common.Address
+}
+
+func (a jsonRPCAccount) Address() common.Address { return a.address }
+
+var _ = Describe("ERC20 permit", func() {
+	var (
+		server       *httptest.Server
+		c            *client.WalletClient
+		tokenVersion string
+		sentTx       map[string]any
+	)
+
+	spender := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	value := big.NewInt(1_000_000)
+	deadline := big.NewInt(1_900_000_000)
+	nonce := big.NewInt(7)
+
+	BeforeEach(func() {
+		tokenVersion = "2"
+		sentTx = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     any    `json:"id"`
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+
+			var result any
+			switch req.Method {
+			case "eth_chainId":
+				result = "0x1"
+			case "eth_call":
+				call := req.Params[0].(map[string]any)
+				result = hexutil.Encode(respondMulticall(common.FromHex(call["data"].(string)), func(calldata []byte) ([]byte, bool) {
+					return respondPermitToken(calldata, tokenVersion, nonce)
+				}))
+			case "eth_sendTransaction":
+				sentTx = req.Params[0].(map[string]any)
+				result = "0x00000000000000000000000000000000000000000000000000000000000000ff"
+			default:
+				Fail("unexpected method " + req.Method)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		}))
+
+		var err error
+		c, err = client.CreateWalletClient(client.WalletClientConfig{
+			Chain:     &definitions.Mainnet,
+			Transport: transport.HTTP(server.URL),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = c.Close()
+		server.Close()
+	})
+
+	It("should sign a permit with the token's name, version and nonce", func() {
+		account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+		Expect(err).ToNot(HaveOccurred())
+
+		permit, err := erc20.SignPermit(context.Background(), c, account, erc20.PermitParameters{
+			Token:    usdc,
+			Spender:  spender,
+			Value:    value,
+			Deadline: deadline,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(permit.TypedData.PrimaryType).To(Equal("Permit"))
+		Expect(permit.TypedData.Domain.Name).To(Equal("USD Coin"))
+		Expect(permit.TypedData.Domain.Version).To(Equal("2"))
+		Expect(permit.TypedData.Domain.ChainId.Int64()).To(Equal(int64(1)))
+		Expect(permit.TypedData.Domain.VerifyingContract).To(Equal(usdc.Hex()))
+		Expect(permit.TypedData.Message).To(Equal(map[string]any{
+			"owner":    account.Address().Hex(),
+			"spender":  spender.Hex(),
+			"value":    value,
+			"nonce":    nonce,
+			"deadline": deadline,
+		}))
+
+		Expect(permit.Owner).To(Equal(account.Address()))
+		Expect(permit.Nonce).To(Equal(nonce))
+		Expect(permit.V).To(BeElementOf(uint8(27), uint8(28)))
+
+		signer, err := signature.RecoverTypedDataAddress(permit.TypedData, permit.Signature)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(common.HexToAddress(signer)).To(Equal(account.Address()))
+	})
+
+	It("should reject a domain that does not match DOMAIN_SEPARATOR", func() {
+		// Without version() the domain falls back to version "1", which does
+		// not match the token's "2" separator.
+		tokenVersion = ""
+		account, err := accounts.PrivateKeyToAccount("0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = erc20.SignPermit(context.Background(), c, account, erc20.PermitParameters{
+			Token:    usdc,
+			Spender:  spender,
+			Value:    value,
+			Deadline: deadline,
+		})
+		Expect(errors.Is(err, erc20.ErrPermitDomainMismatch)).To(BeTrue())
+
+		_, err = erc20.SignPermit(context.Background(), c, account, erc20.PermitParameters{
+			Token:    usdc,
+			Spender:  spender,
+			Value:    value,
+			Deadline: deadline,
+			Version:  "2",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should submit a signed permit", func() {
+		relayer := jsonRPCAccount{address: common.HexToAddress("0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC")}
+		var err error
+		c, err = client.CreateWalletClient(client.WalletClientConfig{
+			Account:   relayer,
+			Chain:     &definitions.Mainnet,
+			Transport: transport.HTTP(server.URL),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		permit := &erc20.SignedPermit{
+			Token:    usdc,
+			Owner:    holder,
+			Spender:  spender,
+			Value:    value,
+			Deadline: deadline,
+			V:        27,
+			R:        common.HexToHash("0x01"),
+			S:        common.HexToHash("0x02"),
+		}
+		hash, err := erc20.Permit(context.Background(), c, permit)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(hash).To(Equal("0x00000000000000000000000000000000000000000000000000000000000000ff"))
+
+		Expect(common.HexToAddress(sentTx["to"].(string))).To(Equal(usdc))
+		data := common.FromHex(sentTx["data"].(string))
+		method, err := permitABI.MethodById(data[:4])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(method.Name).To(Equal("permit"))
+
+		args, err := method.Inputs.Unpack(data[4:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(args[0]).To(Equal(holder))
+		Expect(args[1]).To(Equal(spender))
+		Expect(args[2]).To(Equal(value))
+		Expect(args[3]).To(Equal(deadline))
+		Expect(args[4]).To(Equal(uint8(27)))
+		Expect(args[5]).To(Equal([32]byte(common.HexToHash("0x01"))))
+	})
+})
+
+// respondPermitToken answers EIP-2612 reads for a token named "USD Coin"
+// whose domain uses version "2". An empty version makes version() revert.
+func respondPermitToken(calldata []byte, version string, nonce *big.Int) ([]byte, bool) {
+	method, err := permitABI.MethodById(calldata[:4])
+	Expect(err).ToNot(HaveOccurred())
+
+	var values []any
+	switch method.Name {
+	case "name":
+		values = []any{"USD Coin"}
+	case "version":
+		if version == "" {
+			return nil, false
+		}
+		values = []any{version}
+	case "nonces":
+		values = []any{nonce}
+	case "DOMAIN_SEPARATOR":
+		separator, err := signature.HashDomain(signature.TypedDataDomain{
+			Name:              "USD Coin",
+			Version:           "2",
+			ChainId:           big.NewInt(1),
+			VerifyingContract: usdc.Hex(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		values = []any{[32]byte(common.HexToHash(separator))}
+	default:
+		Fail("unexpected permit method " + method.Name)
+	}
+
+	out, err := method.Outputs.Pack(values...)
+	Expect(err).ToNot(HaveOccurred())
+	return out, true
+}
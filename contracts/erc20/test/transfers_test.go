@@ -0,0 +1,198 @@
+package erc20_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/chain/definitions"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/contracts/erc20"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetTransferHistory", func() {
+	var (
+		server    *httptest.Server
+		c         *client.PublicClient
+		mu        sync.Mutex
+		getLogs   [][2]uint64
+		maxRange  uint64
+		alice     = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+		bob       = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+		transfers = []transferFixture{
+			{holder, alice, 1_000_000, 105, common.HexToHash("0x01")},
+			{holder, bob, 2_500_000, 130, common.HexToHash("0x02")},
+		}
+	)
+
+	BeforeEach(func() {
+		getLogs = nil
+		maxRange = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     any    `json:"id"`
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+			Expect(req.Method).To(Equal("eth_getLogs"))
+
+			filter := req.Params[0].(map[string]any)
+			from := hexutil.MustDecodeUint64(filter["fromBlock"].(string))
+			to := hexutil.MustDecodeUint64(filter["toBlock"].(string))
+			mu.Lock()
+			getLogs = append(getLogs, [2]uint64{from, to})
+			mu.Unlock()
+
+			resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+			if maxRange > 0 && to-from+1 > maxRange {
+				resp["error"] = map[string]any{"code": -32005, "message": "query exceeds max block range"}
+			} else {
+				resp["result"] = matchingTransferLogs(transfers, filter["topics"].([]any), from, to)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(resp)
+		}))
+
+		var err error
+		c, err = client.CreatePublicClient(client.PublicClientConfig{
+			Chain:     &definitions.Mainnet,
+			Transport: transport.HTTP(server.URL),
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = c.Close()
+		server.Close()
+	})
+
+	It("should return decoded transfers", func() {
+		toBlock := uint64(199)
+		history, err := erc20.GetTransferHistory(context.Background(), c, erc20.GetTransferHistoryParameters{
+			Token:     usdc,
+			FromBlock: 100,
+			ToBlock:   &toBlock,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+		Expect(history[0]).To(Equal(erc20.Transfer{
+			From:            holder,
+			To:              alice,
+			Amount:          big.NewInt(1_000_000),
+			BlockNumber:     105,
+			TransactionHash: common.HexToHash("0x01"),
+		}))
+		Expect(history[1].To).To(Equal(bob))
+		Expect(history[1].Amount).To(Equal(big.NewInt(2_500_000)))
+		Expect(history[1].BlockNumber).To(Equal(uint64(130)))
+	})
+
+	It("should filter by recipient", func() {
+		toBlock := uint64(199)
+		history, err := erc20.GetTransferHistory(context.Background(), c, erc20.GetTransferHistoryParameters{
+			Token:     usdc,
+			To:        &bob,
+			FromBlock: 100,
+			ToBlock:   &toBlock,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].From).To(Equal(holder))
+		Expect(history[0].To).To(Equal(bob))
+		Expect(history[0].TransactionHash).To(Equal(common.HexToHash("0x02")))
+	})
+
+	It("should request the range in chunks", func() {
+		toBlock := uint64(199)
+		history, err := erc20.GetTransferHistory(context.Background(), c, erc20.GetTransferHistoryParameters{
+			Token:     usdc,
+			FromBlock: 100,
+			ToBlock:   &toBlock,
+			ChunkSize: 40,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+		Expect(getLogs).To(Equal([][2]uint64{{100, 139}, {140, 179}, {180, 199}}))
+	})
+
+	It("should split ranges the node rejects", func() {
+		maxRange = 30
+		toBlock := uint64(199)
+		history, err := erc20.GetTransferHistory(context.Background(), c, erc20.GetTransferHistoryParameters{
+			Token:     usdc,
+			FromBlock: 100,
+			ToBlock:   &toBlock,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].BlockNumber).To(Equal(uint64(105)))
+		Expect(history[1].BlockNumber).To(Equal(uint64(130)))
+
+		for _, r := range getLogs[1:] {
+			Expect(r[0]).To(BeNumerically(">=", 100))
+			Expect(r[1]).To(BeNumerically("<=", 199))
+		}
+	})
+
+	It("should reject an inverted range", func() {
+		toBlock := uint64(50)
+		_, err := erc20.GetTransferHistory(context.Background(), c, erc20.GetTransferHistoryParameters{
+			Token:     usdc,
+			FromBlock: 100,
+			ToBlock:   &toBlock,
+		})
+		Expect(err).To(MatchError(ContainSubstring("after toBlock")))
+		Expect(getLogs).To(BeEmpty())
+	})
+})
+
+// transferFixture is a Transfer event served by the mock node.
+type transferFixture struct {
+	from, to common.Address
+	amount   int64
+	block    uint64
+	txHash   common.Hash
+}
+
+// matchingTransferLogs returns the RPC logs of the transfers in [from, to]
+// that match the topic filter, like a node would.
+func matchingTransferLogs(transfers []transferFixture, topics []any, from, to uint64) []map[string]any {
+	matches := func(i int, addr common.Address) bool {
+		if i >= len(topics) || topics[i] == nil {
+			return true
+		}
+		return strings.EqualFold(topics[i].(string), common.BytesToHash(addr.Bytes()).Hex())
+	}
+
+	logs := []map[string]any{}
+	for _, t := range transfers {
+		if t.block < from || t.block > to || !matches(1, t.from) || !matches(2, t.to) {
+			continue
+		}
+		logs = append(logs, map[string]any{
+			"address":          usdc.Hex(),
+			"blockHash":        common.HexToHash("0xb10c").Hex(),
+			"blockNumber":      hexutil.EncodeUint64(t.block),
+			"data":             hexutil.Encode(common.LeftPadBytes(big.NewInt(t.amount).Bytes(), 32)),
+			"logIndex":         "0x0",
+			"removed":          false,
+			"topics":           []string{tokenABI.Events["Transfer"].ID.Hex(), common.BytesToHash(t.from.Bytes()).Hex(), common.BytesToHash(t.to.Bytes()).Hex()},
+			"transactionHash":  t.txHash.Hex(),
+			"transactionIndex": "0x0",
+		})
+	}
+	return logs
+}
@@ -0,0 +1,164 @@
+package erc20
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// GetTransferHistoryParameters contains the parameters for GetTransferHistory.
+type GetTransferHistoryParameters struct {
+	// Token is the ERC20 token to read Transfer events from.
+	Token common.Address
+	// From filters transfers by sender. Nil matches any sender.
+	From *common.Address
+	// To filters transfers by recipient. Nil matches any recipient.
+	To *common.Address
+	// FromBlock is the first block to scan.
+	FromBlock uint64
+	// ToBlock is the last block to scan (inclusive). If nil, the latest block
+	// number at the time of the call is used.
+	ToBlock *uint64
+	// ChunkSize is the number of blocks requested per eth_getLogs call.
+	// Default: public.DefaultStreamLogsChunkSize
+	ChunkSize uint64
+}
+
+// Transfer is a decoded ERC20 Transfer event.
+type Transfer struct {
+	From   common.Address
+	To     common.Address
+	Amount *big.Int
+
+	BlockNumber     uint64
+	TransactionHash common.Hash
+	LogIndex        uint
+}
+
+// GetTransferHistory returns the token's Transfer events in a block range,
+// optionally filtered by sender and recipient, in chain order.
+//
+// The range is requested in ChunkSize windows. When the node rejects a window
+// with a JSON-RPC error (e.g. a block range or result count limit), the
+// window is split in half and retried until it covers a single block.
+//
+// Example:
+//
+//	to := uint64(19000000)
+//	transfers, err := erc20.GetTransferHistory(ctx, client, erc20.GetTransferHistoryParameters{
+//	    Token:     usdc,
+//	    To:        &wallet,
+//	    FromBlock: 18000000,
+//	    ToBlock:   &to,
+//	})
+func GetTransferHistory(ctx context.Context, c *client.PublicClient, params GetTransferHistoryParameters) ([]Transfer, error) {
+	chunkSize := params.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = public.DefaultStreamLogsChunkSize
+	}
+
+	var toBlock uint64
+	if params.ToBlock != nil {
+		toBlock = *params.ToBlock
+	} else {
+		latest, err := c.GetBlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("erc20: failed to get latest block number: %w", err)
+		}
+		toBlock = latest
+	}
+	if params.FromBlock > toBlock {
+		return nil, fmt.Errorf("erc20: fromBlock %d is after toBlock %d", params.FromBlock, toBlock)
+	}
+
+	// Indexed args are (from, to); nil matches any address.
+	var args []any
+	if params.From != nil {
+		args = append(args, *params.From)
+	} else {
+		args = append(args, nil)
+	}
+	if params.To != nil {
+		args = append(args, *params.To)
+	}
+
+	var transfers []Transfer
+	for from := params.FromBlock; ; {
+		to := toBlock
+		if toBlock-from >= chunkSize {
+			to = from + chunkSize - 1
+		}
+
+		chunk, err := getTransfersInRange(ctx, c, params.Token, args, from, to)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, chunk...)
+
+		if to == toBlock {
+			return transfers, nil
+		}
+		from = to + 1
+	}
+}
+
+// getTransfersInRange fetches the Transfer events in [from, to], halving the
+// range when the node rejects it.
+func getTransfersInRange(ctx context.Context, c *client.PublicClient, token common.Address, args []any, from, to uint64) ([]Transfer, error) {
+	logs, err := public.GetContractEvents(ctx, c, public.GetContractEventsParameters{
+		Address:   token,
+		ABI:       parsedABI,
+		EventName: "Transfer",
+		Args:      args,
+		FromBlock: &from,
+		ToBlock:   &to,
+		Strict:    true,
+	})
+	if err != nil {
+		if _, ok := transport.AsRPCError(err); ok && from < to && ctx.Err() == nil {
+			mid := from + (to-from)/2
+			lower, err := getTransfersInRange(ctx, c, token, args, from, mid)
+			if err != nil {
+				return nil, err
+			}
+			upper, err := getTransfersInRange(ctx, c, token, args, mid+1, to)
+			if err != nil {
+				return nil, err
+			}
+			return append(lower, upper...), nil
+		}
+		return nil, fmt.Errorf("erc20: failed to get Transfer events for blocks %d-%d: %w", from, to, err)
+	}
+
+	transfers := make([]Transfer, 0, len(logs))
+	for _, log := range logs {
+		transfer := Transfer{}
+		var ok bool
+		if transfer.From, ok = log.DecodedArgs["from"].(common.Address); !ok {
+			return nil, fmt.Errorf("erc20: malformed Transfer event: missing from")
+		}
+		if transfer.To, ok = log.DecodedArgs["to"].(common.Address); !ok {
+			return nil, fmt.Errorf("erc20: malformed Transfer event: missing to")
+		}
+		if transfer.Amount, ok = log.DecodedArgs["value"].(*big.Int); !ok {
+			return nil, fmt.Errorf("erc20: malformed Transfer event: missing value")
+		}
+		if log.BlockNumber != nil {
+			transfer.BlockNumber = log.BlockNumber.Uint64()
+		}
+		if log.TransactionHash != nil {
+			transfer.TransactionHash = common.HexToHash(*log.TransactionHash)
+		}
+		if log.LogIndex != nil {
+			transfer.LogIndex = uint(*log.LogIndex)
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers, nil
+}
@@ -23,6 +23,9 @@ import (
 	"github.com/ChefBingbong/viem-go/client/transport"
 	"github.com/ChefBingbong/viem-go/contracts/erc20"
 	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/data"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
 	"github.com/ChefBingbong/viem-go/utils/unit"
 )
 
@@ -75,18 +78,19 @@ func main() {
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 	} else {
-		// Decode string result (skip first 64 bytes of offset and length)
-		if len(result.Data) > 64 {
-			name := strings.TrimRight(string(result.Data[64:]), "\x00")
+		// Decode the ABI-encoded string result (offset, length, data)
+		if name, err := encoding.AbiBytesToString(result.Data); err == nil {
 			fmt.Printf("Contract Name: %s\n", name)
 		}
-		fmt.Printf("Raw Result: 0x%x\n", truncateBytes(result.Data, 32))
+		rawResult, _ := data.SliceBytes(result.Data, 0, 32)
+		fmt.Printf("Raw Result: 0x%x\n", rawResult)
 	}
 
 	// Example 2: Call with Address Parameter - balanceOf
 	printSection("3. Call with Parameter - balanceOf(address)")
 	// Encode balanceOf(vitalikAddress) - selector + padded address
-	balanceOfData := append(balanceOfSelector, common.LeftPadBytes(vitalikAddress.Bytes(), 32)...)
+	paddedAddress, _ := data.PadLeftBytes(vitalikAddress.Bytes(), 32)
+	balanceOfData := data.Concat(balanceOfSelector, paddedAddress)
 	result, err = public.Call(ctx, publicClient, public.CallParameters{
 		To:   &usdcAddress,
 		Data: balanceOfData,
@@ -197,9 +201,7 @@ func main() {
 	// Override an address to have a large ETH balance
 	overrideBalance, _ := new(big.Int).SetString("1000000000000000000000", 10) // 1000 ETH
 	stateOverride := types.StateOverride{
-		testAddress: types.StateOverrideAccount{
-			Balance: overrideBalance,
-		},
+		testAddress: stateoverride.Account(stateoverride.WithBalance(overrideBalance)),
 	}
 	result, err = public.Call(ctx, publicClient, public.CallParameters{
 		Account:       &testAddress,
@@ -388,13 +390,6 @@ func printSection(title string) {
 	fmt.Printf("\n--- %s ---\n", title)
 }
 
-func truncateBytes(data []byte, maxLen int) []byte {
-	if len(data) <= maxLen {
-		return data
-	}
-	return data[:maxLen]
-}
-
 func truncateAddress(addr common.Address) string {
 	hex := addr.Hex()
 	return hex[:10] + "..." + hex[len(hex)-4:]
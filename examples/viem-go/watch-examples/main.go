@@ -167,7 +167,7 @@ func watchBlocksExample(ctx context.Context, c *client.PublicClient) {
 	events := c.WatchBlocks(ctx, public.WatchBlocksParameters{
 		EmitOnBegin:         true,
 		EmitMissed:          true,
-		IncludeTransactions: false, // Set to true to get full transaction data
+		IncludeTransactions: true,
 	})
 
 	count := 0
@@ -183,6 +183,15 @@ func watchBlocksExample(ctx context.Context, c *client.PublicClient) {
 		fmt.Printf("  Timestamp:    %d\n", block.Timestamp)
 		fmt.Printf("  Gas Used:     %d\n", block.GasUsed)
 		fmt.Printf("  Transactions: %d\n", len(block.Transactions))
+		if event.FullTransactions {
+			for i, tx := range block.FullTransactions {
+				if i == 3 {
+					fmt.Printf("    ... and %d more\n", len(block.FullTransactions)-i)
+					break
+				}
+				fmt.Printf("    %s type=%d from=%s\n", tx.Hash.Hex(), tx.Type, tx.From.Hex())
+			}
+		}
 
 		count++
 		if count >= 3 {
@@ -13,6 +13,8 @@ const (
 	AccountTypeLocal AccountType = "local"
 	// AccountTypeJSONRPC represents a JSON-RPC account (address only).
 	AccountTypeJSONRPC AccountType = "json-rpc"
+	// AccountTypeWebAuthn represents a WebAuthn (passkey) P256 signer.
+	AccountTypeWebAuthn AccountType = "webAuthn"
 )
 
 // AccountSource represents the source of a local account.
@@ -60,6 +60,41 @@ func (b BlockNumberUint64) String() string {
 // BlockNonce is an 8-byte nonce used in block headers.
 type BlockNonce [8]byte
 
+// Withdrawal is a validator withdrawal included in a block (EIP-4895).
+type Withdrawal struct {
+	// Index is the monotonically increasing withdrawal index.
+	Index uint64 `json:"index"`
+	// ValidatorIndex is the index of the withdrawing validator.
+	ValidatorIndex uint64 `json:"validatorIndex"`
+	// Address is the recipient of the withdrawn ether.
+	Address common.Address `json:"address"`
+	// Amount is the withdrawn amount in gwei.
+	Amount uint64 `json:"amount"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler for Withdrawal.
+func (w *Withdrawal) UnmarshalJSON(input []byte) error {
+	var dec struct {
+		Index          hexutil.Uint64 `json:"index"`
+		ValidatorIndex hexutil.Uint64 `json:"validatorIndex"`
+		Address        common.Address `json:"address"`
+		Amount         hexutil.Uint64 `json:"amount"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	w.Index = uint64(dec.Index)
+	w.ValidatorIndex = uint64(dec.ValidatorIndex)
+	w.Address = dec.Address
+	w.Amount = uint64(dec.Amount)
+	return nil
+}
+
+// AmountWei returns the withdrawn amount in wei.
+func (w Withdrawal) AmountWei() *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(w.Amount), big.NewInt(1e9))
+}
+
 // Block represents an Ethereum block.
 type Block struct {
 	Number           uint64         `json:"number"`
@@ -79,10 +114,19 @@ type Block struct {
 	GasLimit         uint64         `json:"gasLimit"`
 	GasUsed          uint64         `json:"gasUsed"`
 	Timestamp        uint64         `json:"timestamp"`
-	Transactions     []common.Hash  `json:"transactions"`
-	Uncles           []common.Hash  `json:"uncles"`
-	BaseFeePerGas    *big.Int       `json:"baseFeePerGas,omitempty"`
-	MixHash          common.Hash    `json:"mixHash"`
+	// Transactions holds the hashes of the block's transactions, whether or
+	// not the block was fetched with full transaction objects.
+	Transactions []common.Hash `json:"transactions"`
+	// FullTransactions holds the decoded transactions, in block order, when
+	// the block was fetched with full transaction objects. It is nil when
+	// the node returned hashes only.
+	FullTransactions []TransactionResponse `json:"-"`
+	Uncles           []common.Hash         `json:"uncles"`
+	BaseFeePerGas    *big.Int              `json:"baseFeePerGas,omitempty"`
+	MixHash          common.Hash           `json:"mixHash"`
+	// EIP-4895 fields
+	Withdrawals     []Withdrawal `json:"withdrawals,omitempty"`
+	WithdrawalsRoot *common.Hash `json:"withdrawalsRoot,omitempty"`
 	// EIP-4844 fields
 	BlobGasUsed   *uint64 `json:"blobGasUsed,omitempty"`
 	ExcessBlobGas *uint64 `json:"excessBlobGas,omitempty"`
@@ -112,10 +156,12 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 		GasLimit         *hexutil.Uint64 `json:"gasLimit"`
 		GasUsed          *hexutil.Uint64 `json:"gasUsed"`
 		Timestamp        *hexutil.Uint64 `json:"timestamp"`
-		Transactions     []common.Hash   `json:"transactions"`
+		Transactions     json.RawMessage `json:"transactions"`
 		Uncles           []common.Hash   `json:"uncles"`
 		BaseFeePerGas    *hexutil.Big    `json:"baseFeePerGas"`
 		MixHash          *common.Hash    `json:"mixHash"`
+		Withdrawals      []Withdrawal    `json:"withdrawals"`
+		WithdrawalsRoot  *common.Hash    `json:"withdrawalsRoot"`
 		BlobGasUsed      *hexutil.Uint64 `json:"blobGasUsed"`
 		ExcessBlobGas    *hexutil.Uint64 `json:"excessBlobGas"`
 		ParentBeaconRoot *common.Hash    `json:"parentBeaconBlockRoot"`
@@ -178,7 +224,9 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 	if dec.Timestamp != nil {
 		b.Timestamp = uint64(*dec.Timestamp)
 	}
-	b.Transactions = dec.Transactions
+	if err := b.unmarshalTransactions(dec.Transactions); err != nil {
+		return err
+	}
 	b.Uncles = dec.Uncles
 	if dec.BaseFeePerGas != nil {
 		b.BaseFeePerGas = (*big.Int)(dec.BaseFeePerGas)
@@ -186,6 +234,8 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 	if dec.MixHash != nil {
 		b.MixHash = *dec.MixHash
 	}
+	b.Withdrawals = dec.Withdrawals
+	b.WithdrawalsRoot = dec.WithdrawalsRoot
 	if dec.BlobGasUsed != nil {
 		val := uint64(*dec.BlobGasUsed)
 		b.BlobGasUsed = &val
@@ -200,3 +250,34 @@ func (b *Block) UnmarshalJSON(input []byte) error {
 
 	return nil
 }
+
+// unmarshalTransactions decodes the transactions field, which holds either
+// hashes or full transaction objects depending on how the block was fetched.
+func (b *Block) unmarshalTransactions(input json.RawMessage) error {
+	var items []json.RawMessage
+	if len(input) == 0 || string(input) == "null" {
+		return nil
+	}
+	if err := json.Unmarshal(input, &items); err != nil {
+		return err
+	}
+
+	b.Transactions = make([]common.Hash, len(items))
+	if len(items) == 0 || items[0][0] == '"' {
+		for i, item := range items {
+			if err := json.Unmarshal(item, &b.Transactions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	b.FullTransactions = make([]TransactionResponse, len(items))
+	for i, item := range items {
+		if err := json.Unmarshal(item, &b.FullTransactions[i]); err != nil {
+			return err
+		}
+		b.Transactions[i] = b.FullTransactions[i].Hash
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NonceManagerParameters identifies the nonce sequence a nonce manager operates on.
+// Sequences are keyed by address and chain ID.
+type NonceManagerParameters struct {
+	// Address is the account whose nonce is being managed.
+	Address common.Address
+
+	// ChainID is the chain the nonce belongs to.
+	ChainID int64
+
+	// Source fetches the next nonce from the chain, typically via
+	// eth_getTransactionCount at the "pending" tag. It is called whenever the
+	// manager has no local state for the sequence.
+	Source func(ctx context.Context) (uint64, error)
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AccountProof is a decoded EIP-1186 account proof as returned by eth_getProof.
+type AccountProof struct {
+	// Address is the account the proof is for.
+	Address common.Address
+
+	// Balance is the account balance in wei.
+	Balance *big.Int
+
+	// CodeHash is the keccak256 hash of the account code.
+	CodeHash common.Hash
+
+	// Nonce is the account nonce.
+	Nonce uint64
+
+	// StorageHash is the root of the account's storage trie.
+	StorageHash common.Hash
+
+	// AccountProof is the list of RLP-encoded state trie nodes from the state
+	// root down to the account, in order.
+	AccountProof [][]byte
+
+	// StorageProof contains a proof for each requested storage key.
+	StorageProof []StorageProofItem
+}
+
+// StorageProofItem is a decoded proof for a single storage slot.
+type StorageProofItem struct {
+	// Key is the storage slot.
+	Key common.Hash
+
+	// Value is the slot value.
+	Value *big.Int
+
+	// Proof is the list of RLP-encoded storage trie nodes from the storage
+	// root down to the slot, in order.
+	Proof [][]byte
+}
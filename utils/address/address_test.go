@@ -1,8 +1,12 @@
 package address_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"golang.org/x/crypto/sha3"
 
 	"github.com/ChefBingbong/viem-go/utils/address"
@@ -106,6 +110,52 @@ func TestChecksumAddress(t *testing.T) {
 	}
 }
 
+func TestChecksum(t *testing.T) {
+	// Canonical vectors from EIP-55 and EIP-1191
+	tests := []struct {
+		name     string
+		input    string
+		chainId  []int64
+		expected string
+	}{
+		{"eip55 all caps", "0x52908400098527886E0F7030069857D2E4169EE7", nil, "0x52908400098527886E0F7030069857D2E4169EE7"},
+		{"eip55 all lower", "0xde709f2102306220921060314715629080e2fb77", nil, "0xde709f2102306220921060314715629080e2fb77"},
+		{"eip55 mixed 1", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"eip55 mixed 2", "0xFB6916095CA1DF60BB79CE92CE3EA74C37C5D359", nil, "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359"},
+		{"eip55 mixed 3", "0xdbf03b407c01e7cd3cbea99509d93f8dddc8c6fb", nil, "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB"},
+		{"eip55 mixed 4", "0xd1220a0cf47c7b9be7a2e6ba89f429762e7b9adb", nil, "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb"},
+		{"no prefix", "5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", nil, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{"eip1191 chain 30", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", []int64{30}, "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{"eip1191 chain 31", "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", []int64{31}, "0x5aAeb6053F3e94c9b9A09F33669435E7EF1BEaEd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := address.Checksum(tt.input, tt.chainId...)
+			if err != nil {
+				t.Fatalf("Checksum(%q) unexpected error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Checksum(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("wrong length", func(t *testing.T) {
+		_, err := address.Checksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1bea")
+		if !errors.Is(err, address.ErrInvalidAddressLength) || !errors.Is(err, address.ErrInvalidAddress) {
+			t.Errorf("expected ErrInvalidAddressLength, got %v", err)
+		}
+	})
+
+	t.Run("non-hex", func(t *testing.T) {
+		_, err := address.Checksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beazz")
+		if !errors.Is(err, address.ErrInvalidAddressHex) || !errors.Is(err, address.ErrInvalidAddress) {
+			t.Errorf("expected ErrInvalidAddressHex, got %v", err)
+		}
+	})
+}
+
 func TestGetAddress(t *testing.T) {
 	t.Run("valid lowercase", func(t *testing.T) {
 		result, err := address.GetAddress("0xa5cc3c03994db5b0d9a5eEdD10Cabab0813678ac")
@@ -214,6 +264,22 @@ func TestGetCreateAddress(t *testing.T) {
 		},
 	}
 
+	// Nonce encoding boundaries, checked against go-ethereum's CREATE derivation
+	deployer := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	for _, nonce := range []uint64{1, 0x7f, 0x80, 0xff, 0x100, 0xffff, 1 << 32} {
+		tests = append(tests, struct {
+			name     string
+			from     string
+			nonce    uint64
+			expected string
+		}{
+			fmt.Sprintf("nonce %#x", nonce),
+			deployer.Hex(),
+			nonce,
+			crypto.CreateAddress(deployer, nonce).Hex(),
+		})
+	}
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			result, err := address.GetCreateAddress(address.GetCreateAddressOptions{
@@ -272,6 +338,38 @@ func TestGetCreate2Address(t *testing.T) {
 		}
 	})
 
+	t.Run("eip-1014 vectors", func(t *testing.T) {
+		// Examples from https://eips.ethereum.org/EIPS/eip-1014
+		zeroSalt := "0x0000000000000000000000000000000000000000000000000000000000000000"
+		vectors := []struct {
+			from     string
+			salt     string
+			initCode string
+			expected string
+		}{
+			{"0x0000000000000000000000000000000000000000", zeroSalt, "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+			{"0xdeadbeef00000000000000000000000000000000", zeroSalt, "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+			{"0xdeadbeef00000000000000000000000000000000", "0x000000000000000000000000feed000000000000000000000000000000000000", "0x00", "0xD04116cDd17beBE565EB2422F2497E06cC1C9833"},
+			{"0x0000000000000000000000000000000000000000", zeroSalt, "0xdeadbeef", "0x70f2b2914A2a4b783FaEFb75f459A580616Fcb5e"},
+			{"0x00000000000000000000000000000000deadbeef", "0x00000000000000000000000000000000000000000000000000000000cafebabe", "0xdeadbeef", "0x60f3f640a8508fC6a86d45DF051962668E1e8AC7"},
+			{"0x0000000000000000000000000000000000000000", zeroSalt, "0x", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+		}
+
+		for _, v := range vectors {
+			result, err := address.GetCreate2Address(address.GetCreate2AddressOptions{
+				From:         v.from,
+				Salt:         hexToBytes(v.salt),
+				BytecodeHash: keccak256(hexToBytes(v.initCode)),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result != address.Address(v.expected) {
+				t.Errorf("GetCreate2Address(%s, %s, %s) = %q, want %q", v.from, v.salt, v.initCode, result, v.expected)
+			}
+		}
+	})
+
 	t.Run("missing bytecode", func(t *testing.T) {
 		_, err := address.GetCreate2Address(address.GetCreate2AddressOptions{
 			From: "0x0000000000000000000000000000000000000000",
@@ -11,6 +11,10 @@ import (
 var (
 	// ErrInvalidAddress is returned when an address is not valid
 	ErrInvalidAddress = errors.New("invalid address")
+	// ErrInvalidAddressLength is returned when an address is not 20 bytes long
+	ErrInvalidAddressLength = fmt.Errorf("%w: expected 40 hex characters", ErrInvalidAddress)
+	// ErrInvalidAddressHex is returned when an address contains non-hex characters
+	ErrInvalidAddressHex = fmt.Errorf("%w: non-hex character", ErrInvalidAddress)
 )
 
 // checksumAddressCache is an LRU cache for checksummed addresses.
@@ -127,6 +131,38 @@ func GetAddress(address string, chainId ...int64) (Address, error) {
 	return ChecksumAddress(address), nil
 }
 
+// Checksum validates an address and returns its EIP-55 checksum encoding.
+// When a chainId is passed, the EIP-1191 chain-aware checksum is used instead.
+//
+// Unlike GetAddress, the input's existing casing is ignored, so a mis-cased
+// address is normalized rather than rejected. Errors distinguish between a
+// wrong length (ErrInvalidAddressLength) and non-hex input (ErrInvalidAddressHex),
+// both of which wrap ErrInvalidAddress.
+//
+// Example:
+//
+//	Checksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed")
+//	// "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+//
+//	Checksum("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", 30)
+//	// "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"
+func Checksum(address string, chainId ...int64) (string, error) {
+	src := address
+	if len(src) >= 2 && src[0] == '0' && (src[1] == 'x' || src[1] == 'X') {
+		src = src[2:]
+	}
+	if len(src) != 40 {
+		return "", fmt.Errorf("%w, got %d: %s", ErrInvalidAddressLength, len(src), address)
+	}
+	for i := 0; i < len(src); i++ {
+		c := src[i]
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F') {
+			return "", fmt.Errorf("%w %q: %s", ErrInvalidAddressHex, c, address)
+		}
+	}
+	return string(ChecksumAddress(address, chainId...)), nil
+}
+
 // keccak256 computes the Keccak-256 hash of input data.
 func keccak256(data []byte) []byte {
 	h := sha3.NewLegacyKeccak256()
@@ -1,70 +1,56 @@
 package blob
 
 import (
-	"bytes"
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 )
 
 // FromBlobs transforms blobs back into the original data.
 // This reverses the encoding performed by ToBlobs.
 //
+// The payload is the last 31 bytes of every field element. The data ends at
+// the last non-zero payload byte, which must be the 0x80 terminator; anything
+// before it (including 0x80 or trailing zero bytes in the data) is returned
+// as-is, so round-tripping recovers the exact input.
+//
 // Example:
 //
 //	blobs, _ := ToBlobs([]byte("hello world"))
 //	data, err := FromBlobs(blobs)
 //	// data = []byte("hello world")
 func FromBlobs(blobs [][]byte) ([]byte, error) {
-	var result bytes.Buffer
-	active := true
+	if len(blobs) == 0 {
+		return nil, kzg.ErrEmptyBlob
+	}
+	if len(blobs) > BlobsPerTransaction {
+		return nil, fmt.Errorf("%w: %d blobs exceeds max %d blobs",
+			kzg.ErrBlobSizeTooLarge, len(blobs), BlobsPerTransaction)
+	}
 
-	for _, blob := range blobs {
-		if !active {
-			break
+	payload := make([]byte, 0, len(blobs)*FieldElementsPerBlob*(BytesPerFieldElement-1))
+	for i, blob := range blobs {
+		if len(blob) != BytesPerBlob {
+			return nil, fmt.Errorf("%w: blob %d is %d bytes, expected %d bytes",
+				kzg.ErrInvalidBlobSize, i, len(blob), BytesPerBlob)
 		}
-
-		pos := 0
-		for pos < len(blob) && active {
+		for fe := 0; fe < FieldElementsPerBlob; fe++ {
 			// Skip the zero byte at the start of each field element
-			pos++
-
-			// Calculate how many bytes to read in this field element
-			consume := 31
-			if len(blob)-pos < 31 {
-				consume = len(blob) - pos
-			}
-
-			// Read bytes from this field element
-			for i := 0; i < consume && active; i++ {
-				b := blob[pos]
-				pos++
-
-				// Check for terminator byte (0x80)
-				// It's a terminator if no more 0x80 bytes exist in the rest of the blob
-				if b == 0x80 {
-					remaining := blob[pos:]
-					if !containsNonZeroData(remaining) {
-						active = false
-						break
-					}
-				}
-
-				result.WriteByte(b)
-			}
+			start := fe*BytesPerFieldElement + 1
+			payload = append(payload, blob[start:start+BytesPerFieldElement-1]...)
 		}
 	}
 
-	return result.Bytes(), nil
-}
-
-// containsNonZeroData checks if there's any meaningful data (non-zero, non-0x80 terminator patterns)
-func containsNonZeroData(data []byte) bool {
-	// Check if there's any 0x80 byte that could be data vs. padding
-	for _, b := range data {
-		if b == 0x80 {
-			return true
-		}
-		// Non-zero bytes other than 0x80 don't matter for terminator detection
+	// The terminator is the last non-zero byte of the payload.
+	end := len(payload) - 1
+	for end >= 0 && payload[end] == 0 {
+		end--
+	}
+	if end < 0 || payload[end] != 0x80 {
+		return nil, kzg.ErrBlobTerminatorNotFound
 	}
-	return false
+
+	return payload[:end], nil
 }
 
 // FromBlobsHex transforms hex-encoded blobs back into the original data as hex.
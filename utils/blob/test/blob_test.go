@@ -3,6 +3,7 @@ package test
 import (
 	"bytes"
 	"crypto/sha256"
+	"errors"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,15 +55,9 @@ var _ = Describe("Blob", func() {
 			// Create data that requires multiple blobs
 			// Each field element holds 31 bytes, and there are 4096 field elements per blob
 			// So each blob holds approximately 31 * 4096 = 127,000 bytes
-			//
-			// Note: We avoid using 0x80 in the test data because the EIP-4844 encoding
-			// uses 0x80 as a terminator byte. If data contains 0x80 followed by no more
-			// 0x80 bytes, it could be mistaken for the terminator. This is inherent to
-			// the encoding scheme used by viem.
 			data := make([]byte, 200000) // Should require ~2 blobs
 			for i := range data {
-				// Use values that won't conflict with terminator (0x80 = 128)
-				data[i] = byte((i % 127) + 1) // Values 1-127, avoiding 0 and 0x80
+				data[i] = byte(i)
 			}
 
 			blobs, err := blob.ToBlobs(data)
@@ -76,6 +71,74 @@ var _ = Describe("Blob", func() {
 		})
 	})
 
+	Describe("ToBlobs and FromBlobs round-trip", func() {
+		// bytesPerBlobPayload is the usable data per blob: 31 bytes per field element.
+		const bytesPerBlobPayload = blob.FieldElementsPerBlob * (blob.BytesPerFieldElement - 1)
+
+		roundTrip := func(data []byte) [][]byte {
+			blobs, err := blob.ToBlobs(data)
+			Expect(err).NotTo(HaveOccurred())
+			for _, b := range blobs {
+				Expect(len(b)).To(Equal(blob.BytesPerBlob))
+			}
+
+			recovered, err := blob.FromBlobs(blobs)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recovered).To(Equal(data))
+			return blobs
+		}
+
+		pattern := func(n int) []byte {
+			data := make([]byte, n)
+			for i := range data {
+				data[i] = byte(i)
+			}
+			return data
+		}
+
+		It("should round-trip short data", func() {
+			for _, n := range []int{1, 30, 31, 32, 62, 1000} {
+				roundTrip(pattern(n))
+			}
+		})
+
+		It("should round-trip data containing the terminator byte and trailing zeros", func() {
+			roundTrip([]byte{0x80})
+			roundTrip([]byte{0x80, 0x01, 0x02})
+			roundTrip([]byte{0x01, 0x80, 0x00, 0x00})
+		})
+
+		It("should fit data one byte short of a full blob in one blob", func() {
+			blobs := roundTrip(pattern(bytesPerBlobPayload - 1))
+			Expect(blobs).To(HaveLen(1))
+		})
+
+		It("should spill the terminator of exactly one full blob into a second blob", func() {
+			blobs := roundTrip(pattern(bytesPerBlobPayload))
+			Expect(blobs).To(HaveLen(2))
+		})
+
+		It("should round-trip the maximum data per transaction", func() {
+			blobs := roundTrip(pattern(blob.MaxBytesPerTransaction))
+			Expect(blobs).To(HaveLen(blob.BlobsPerTransaction))
+		})
+
+		It("should reject data larger than the maximum per transaction", func() {
+			_, err := blob.ToBlobs(pattern(blob.MaxBytesPerTransaction + 1))
+			Expect(errors.Is(err, kzg.ErrBlobSizeTooLarge)).To(BeTrue())
+		})
+
+		It("should reject blobs of the wrong size", func() {
+			_, err := blob.FromBlobs([][]byte{make([]byte, 100)})
+			Expect(errors.Is(err, kzg.ErrInvalidBlobSize)).To(BeTrue())
+		})
+
+		It("should reject blobs without a terminator", func() {
+			_, err := blob.FromBlobs([][]byte{make([]byte, blob.BytesPerBlob)})
+			Expect(errors.Is(err, kzg.ErrBlobTerminatorNotFound)).To(BeTrue())
+		})
+	})
+
 	Describe("ToBlobsHex", func() {
 		It("should return hex-encoded blobs", func() {
 			data := []byte("test data")
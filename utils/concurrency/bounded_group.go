@@ -0,0 +1,42 @@
+// Package concurrency provides the bounded fan-out primitive shared by the
+// batching and streaming actions, so their concurrency limits behave the same.
+package concurrency
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultLimit is the number of goroutines a BoundedGroup runs at once when
+// the requested limit is not positive.
+const DefaultLimit = 10
+
+// BoundedGroup returns an errgroup that runs at most limit goroutines at a
+// time, together with a context derived from ctx that is cancelled when any
+// goroutine returns an error or Wait returns. A limit <= 0 uses DefaultLimit.
+//
+// Go blocks while limit goroutines are running, so a loop that starts one
+// goroutine per item never has more than limit items in flight.
+//
+// Example:
+//
+//	g, ctx := concurrency.BoundedGroup(ctx, params.MaxConcurrentRequests)
+//	for i, item := range items {
+//	    g.Go(func() error {
+//	        result, err := fetch(ctx, item)
+//	        results[i] = result
+//	        return err
+//	    })
+//	}
+//	if err := g.Wait(); err != nil {
+//	    return nil, err
+//	}
+func BoundedGroup(ctx context.Context, limit int) (*errgroup.Group, context.Context) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(limit)
+	return g, ctx
+}
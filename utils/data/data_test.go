@@ -1,6 +1,7 @@
 package data_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ChefBingbong/viem-go/utils/data"
@@ -537,3 +538,49 @@ func BenchmarkTrimBytes(b *testing.B) {
 		data.TrimBytes(bytes, data.TrimLeft)
 	}
 }
+
+func TestSliceOutOfRange(t *testing.T) {
+	value := []byte{0x01, 0x02, 0x03, 0x04}
+
+	// Without Strict, an end past the value is truncated
+	result, err := data.SliceBytes(value, 2, 10)
+	if err != nil || len(result) != 2 {
+		t.Errorf("SliceBytes(2, 10) = %x, %v, want 0304", result, err)
+	}
+
+	tests := []struct {
+		name  string
+		start int
+		end   int
+		opts  []data.SliceOptions
+	}{
+		{"start past end", 4, 0, nil},
+		{"end before start", 3, 1, nil},
+		{"strict end past end", 2, 10, []data.SliceOptions{{Strict: true}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := data.SliceBytes(value, tt.start, tt.end, tt.opts...); !errors.Is(err, data.ErrSliceOffsetOutOfBounds) {
+				t.Errorf("SliceBytes error = %v, want ErrSliceOffsetOutOfBounds", err)
+			}
+			if _, err := data.SliceHex("0x01020304", tt.start, tt.end, tt.opts...); !errors.Is(err, data.ErrSliceOffsetOutOfBounds) {
+				t.Errorf("SliceHex error = %v, want ErrSliceOffsetOutOfBounds", err)
+			}
+		})
+	}
+
+	// Strict accepts an end exactly at the end of the value
+	if hex, err := data.SliceHex("0x01020304", 1, 4, data.SliceOptions{Strict: true}); err != nil || hex != "0x020304" {
+		t.Errorf("SliceHex(1, 4, strict) = %s, %v, want 0x020304", hex, err)
+	}
+}
+
+func TestPadExceedsSize(t *testing.T) {
+	if _, err := data.PadBytes([]byte{0x01, 0x02, 0x03}, data.PadLeft, 2); !errors.Is(err, data.ErrSizeExceedsPaddingSize) {
+		t.Errorf("PadBytes error = %v, want ErrSizeExceedsPaddingSize", err)
+	}
+	if _, err := data.PadHex("0x010203", data.PadRight, 2); !errors.Is(err, data.ErrSizeExceedsPaddingSize) {
+		t.Errorf("PadHex error = %v, want ErrSizeExceedsPaddingSize", err)
+	}
+}
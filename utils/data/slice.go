@@ -11,7 +11,8 @@ var ErrSliceOffsetOutOfBounds = errors.New("slice offset out of bounds")
 
 // SliceOptions configures slice behavior.
 type SliceOptions struct {
-	// Strict validates that the slice produces the expected size.
+	// Strict returns an error when the end offset is past the end of the
+	// value instead of truncating the slice.
 	Strict bool
 }
 
@@ -61,15 +62,19 @@ func SliceBytes(value []byte, start, end int, opts ...SliceOptions) ([]byte, err
 	if start < 0 {
 		start = 0
 	}
+	requestedEnd := end
 	if end <= 0 || end > len(value) {
 		end = len(value)
 	}
+	if end < start {
+		return nil, fmt.Errorf("%w: end offset %d is before start offset %d", ErrSliceOffsetOutOfBounds, end, start)
+	}
 
 	result := value[start:end]
 
-	// Strict mode validates the result size
-	if strict && len(result) != end-start {
-		return nil, fmt.Errorf("%w: end offset %d produces unexpected size", ErrSliceOffsetOutOfBounds, end)
+	// Strict mode rejects an end offset past the end of the value
+	if strict && requestedEnd > 0 && len(result) != requestedEnd-start {
+		return nil, fmt.Errorf("%w: end offset %d exceeds size %d", ErrSliceOffsetOutOfBounds, requestedEnd, len(value))
 	}
 
 	return result, nil
@@ -105,19 +110,20 @@ func SliceHex(value string, start, end int, opts ...SliceOptions) (string, error
 	if start < 0 {
 		start = 0
 	}
+	requestedEnd := end
 	if end <= 0 || end > byteLen {
 		end = byteLen
 	}
+	if end < start {
+		return "", fmt.Errorf("%w: end offset %d is before start offset %d", ErrSliceOffsetOutOfBounds, end, start)
+	}
 
 	// Convert byte offsets to hex character offsets
 	result := "0x" + h[start*2:end*2]
 
-	// Strict mode validates the result size
-	if strict {
-		resultSize := SizeHex(result)
-		if resultSize != end-start {
-			return "", fmt.Errorf("%w: end offset %d produces unexpected size", ErrSliceOffsetOutOfBounds, end)
-		}
+	// Strict mode rejects an end offset past the end of the value
+	if strict && requestedEnd > 0 && SizeHex(result) != requestedEnd-start {
+		return "", fmt.Errorf("%w: end offset %d exceeds size %d", ErrSliceOffsetOutOfBounds, requestedEnd, byteLen)
 	}
 
 	return result, nil
@@ -107,6 +107,52 @@ func TestBytesToString(t *testing.T) {
 	}
 }
 
+func TestAbiBytesToString(t *testing.T) {
+	// name() of USDC: offset 0x20, length 8, "USD Coin" right-padded
+	usdc := "0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000008" +
+		"55534420436f696e000000000000000000000000000000000000000000000000"
+
+	result, err := encoding.AbiHexToString(usdc)
+	if err != nil {
+		t.Fatalf("AbiHexToString error: %v", err)
+	}
+	if result != "USD Coin" {
+		t.Errorf("AbiHexToString = %q, want %q", result, "USD Coin")
+	}
+
+	// Trailing NUL bytes inside the string are kept
+	withNul, _ := encoding.HexToBytes("0x" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"6100000000000000000000000000000000000000000000000000000000000000")
+	if result, _ := encoding.AbiBytesToString(withNul); result != "a\x00" {
+		t.Errorf("AbiBytesToString = %q, want %q", result, "a\x00")
+	}
+
+	invalid := []struct {
+		name  string
+		input string
+	}{
+		{"too short", "0x0000000000000000000000000000000000000000000000000000000000000020"},
+		{"offset out of bounds", "0x" +
+			"0000000000000000000000000000000000000000000000000000000000000040" +
+			"0000000000000000000000000000000000000000000000000000000000000000"},
+		{"length out of bounds", "0x" +
+			"0000000000000000000000000000000000000000000000000000000000000020" +
+			"0000000000000000000000000000000000000000000000000000000000000021" +
+			"55534420436f696e000000000000000000000000000000000000000000000000"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := encoding.AbiHexToString(tt.input); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
 func TestFromBytesBuilder(t *testing.T) {
 	t.Run("to hex", func(t *testing.T) {
 		result, err := encoding.FromBytes([]byte{0x01, 0xa4}).ToHex()
@@ -378,6 +424,30 @@ func TestStringToHex(t *testing.T) {
 	}
 }
 
+func TestHexRoundtrip(t *testing.T) {
+	for _, s := range []string{"", "Hello World!", "viem-go ✓"} {
+		result, err := encoding.HexToString(encoding.StringToHex(s))
+		if err != nil || result != s {
+			t.Errorf("HexToString(StringToHex(%q)) = %q, %v", s, result, err)
+		}
+	}
+
+	for _, n := range []string{"0", "1", "255", "1000000000000000000", "115792089237316195423570985008687907853269984665640564039457584007913129639935"} {
+		value, _ := new(big.Int).SetString(n, 10)
+		result, err := encoding.HexToBigInt(encoding.NumberToHex(value), false)
+		if err != nil || result.Cmp(value) != 0 {
+			t.Errorf("HexToBigInt(NumberToHex(%s)) = %v, %v", n, result, err)
+		}
+	}
+
+	for _, b := range []bool{true, false} {
+		result, err := encoding.HexToBool(encoding.BoolToHex(b))
+		if err != nil || result != b {
+			t.Errorf("HexToBool(BoolToHex(%v)) = %v, %v", b, result, err)
+		}
+	}
+}
+
 func TestToHexBuilder(t *testing.T) {
 	t.Run("number with size", func(t *testing.T) {
 		result, err := encoding.ToHex(big.NewInt(420)).WithSize(32).Hex()
@@ -198,6 +198,41 @@ func BytesToString(b []byte) string {
 	return string(TrimRight(b))
 }
 
+// AbiBytesToString decodes a string from ABI-encoded return data, such as
+// the result of an ERC-20 name() call: a 32-byte offset, then at that offset
+// a 32-byte length followed by the UTF-8 bytes.
+//
+// Example:
+//
+//	name, err := AbiBytesToString(result.Data)
+func AbiBytesToString(b []byte) (string, error) {
+	if len(b) < 64 {
+		return "", errors.New("ABI string data too short")
+	}
+
+	offset := new(big.Int).SetBytes(b[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(b)-32) {
+		return "", errors.New("ABI string offset out of bounds")
+	}
+	start := int(offset.Uint64()) + 32
+
+	length := new(big.Int).SetBytes(b[start-32 : start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(b)-start) {
+		return "", errors.New("ABI string length out of bounds")
+	}
+
+	return string(b[start : start+int(length.Uint64())]), nil
+}
+
+// AbiHexToString is AbiBytesToString for hex-encoded return data.
+func AbiHexToString(s string) (string, error) {
+	b, err := HexToBytes(s)
+	if err != nil {
+		return "", err
+	}
+	return AbiBytesToString(b)
+}
+
 // Helper functions
 
 func assertSize(data []byte, size int) error {
@@ -2,6 +2,7 @@ package encoding
 
 import (
 	"errors"
+	"math"
 )
 
 // RecursiveBytes represents a recursive array of bytes for RLP encoding.
@@ -206,7 +207,7 @@ func rlpDecode(data []byte, offset int) (any, int, error) {
 			return nil, 0, errors.New("invalid RLP string length")
 		}
 		length := decodeLength(data[offset+1 : offset+1+lenOfLen])
-		if offset+1+lenOfLen+length > len(data) {
+		if length < 0 || length > len(data)-offset-1-lenOfLen {
 			return nil, 0, errors.New("invalid RLP string length")
 		}
 		return data[offset+1+lenOfLen : offset+1+lenOfLen+length], offset + 1 + lenOfLen + length, nil
@@ -227,21 +228,30 @@ func rlpDecode(data []byte, offset int) (any, int, error) {
 	return decodeList(data, offset+1+lenOfLen, length)
 }
 
+// decodeLength decodes a big-endian length prefix. It returns -1 if the
+// length doesn't fit in an int.
 func decodeLength(data []byte) int {
 	var length int
 	for _, b := range data {
+		if length > (math.MaxInt-int(b))>>8 {
+			return -1
+		}
 		length = (length << 8) | int(b)
 	}
 	return length
 }
 
 func decodeList(data []byte, start, length int) ([]any, int, error) {
+	if length < 0 || length > len(data)-start {
+		return nil, 0, errors.New("invalid RLP list length")
+	}
+
 	var result []any
 	end := start + length
 	offset := start
 
 	for offset < end {
-		item, newOffset, err := rlpDecode(data, offset)
+		item, newOffset, err := rlpDecode(data[:end], offset)
 		if err != nil {
 			return nil, 0, err
 		}
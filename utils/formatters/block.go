@@ -12,15 +12,17 @@ package formatters
 //	block := FormatBlock(rpcBlock)
 func FormatBlock(block RpcBlock) Block {
 	result := Block{
-		ExtraData:        block.ExtraData,
-		Miner:            block.Miner,
-		MixHash:          block.MixHash,
-		ParentHash:       block.ParentHash,
-		ReceiptsRoot:     block.ReceiptsRoot,
-		Sha3Uncles:       block.Sha3Uncles,
-		StateRoot:        block.StateRoot,
-		TransactionsRoot: block.TransactionsRoot,
-		Uncles:           block.Uncles,
+		ExtraData:             block.ExtraData,
+		Miner:                 block.Miner,
+		MixHash:               block.MixHash,
+		ParentBeaconBlockRoot: block.ParentBeaconBlockRoot,
+		ParentHash:            block.ParentHash,
+		ReceiptsRoot:          block.ReceiptsRoot,
+		Sha3Uncles:            block.Sha3Uncles,
+		StateRoot:             block.StateRoot,
+		TransactionsRoot:      block.TransactionsRoot,
+		Uncles:                block.Uncles,
+		WithdrawalsRoot:       block.WithdrawalsRoot,
 	}
 
 	// Base fee per gas
@@ -93,9 +95,27 @@ func FormatBlock(block RpcBlock) Block {
 		result.Transactions = formatBlockTransactions(block.Transactions)
 	}
 
+	// Withdrawals
+	if len(block.Withdrawals) > 0 {
+		result.Withdrawals = make([]Withdrawal, len(block.Withdrawals))
+		for i, w := range block.Withdrawals {
+			result.Withdrawals[i] = FormatWithdrawal(w)
+		}
+	}
+
 	return result
 }
 
+// FormatWithdrawal formats an RPC withdrawal into a Withdrawal struct.
+func FormatWithdrawal(withdrawal RpcWithdrawal) Withdrawal {
+	return Withdrawal{
+		Address:        withdrawal.Address,
+		Amount:         hexToBigInt(withdrawal.Amount),
+		Index:          hexToBigInt(withdrawal.Index),
+		ValidatorIndex: hexToBigInt(withdrawal.ValidatorIndex),
+	}
+}
+
 // formatBlockTransactions formats block transactions.
 // Transactions can be either transaction hashes (strings) or full transaction objects.
 func formatBlockTransactions(txs []any) []any {
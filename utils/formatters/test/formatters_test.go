@@ -147,6 +147,31 @@ var _ = Describe("Formatters", func() {
 			Expect(len(block.Transactions)).To(Equal(2))
 			Expect(block.Transactions[0]).To(Equal("0xtx1"))
 		})
+
+		It("should format Cancun fields and withdrawals", func() {
+			rpcBlock := formatters.RpcBlock{
+				Number:                "0x12a05f2",
+				BlobGasUsed:           "0x40000",
+				ExcessBlobGas:         "0x4b00000",
+				ParentBeaconBlockRoot: "0xbeacon",
+				WithdrawalsRoot:       "0xwroot",
+				Withdrawals: []formatters.RpcWithdrawal{
+					{Index: "0x2a9b1f0", ValidatorIndex: "0x10f3a5", Address: "0xrecipient", Amount: "0x1124e47"},
+				},
+			}
+
+			block := formatters.FormatBlock(rpcBlock)
+
+			Expect(block.BlobGasUsed.Cmp(big.NewInt(0x40000))).To(Equal(0))
+			Expect(block.ExcessBlobGas.Cmp(big.NewInt(0x4b00000))).To(Equal(0))
+			Expect(block.ParentBeaconBlockRoot).To(Equal("0xbeacon"))
+			Expect(block.WithdrawalsRoot).To(Equal("0xwroot"))
+			Expect(block.Withdrawals).To(HaveLen(1))
+			Expect(block.Withdrawals[0].Address).To(Equal("0xrecipient"))
+			Expect(block.Withdrawals[0].Index.Cmp(big.NewInt(0x2a9b1f0))).To(Equal(0))
+			Expect(block.Withdrawals[0].ValidatorIndex.Cmp(big.NewInt(0x10f3a5))).To(Equal(0))
+			Expect(block.Withdrawals[0].Amount.Cmp(big.NewInt(0x1124e47))).To(Equal(0))
+		})
 	})
 
 	Describe("FormatTransactionReceipt", func() {
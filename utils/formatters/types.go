@@ -68,56 +68,78 @@ type SignedAuthorization struct {
 
 // RpcBlock represents a block as returned by RPC.
 type RpcBlock struct {
-	BaseFeePerGas    string   `json:"baseFeePerGas,omitempty"`
-	BlobGasUsed      string   `json:"blobGasUsed,omitempty"`
-	Difficulty       string   `json:"difficulty,omitempty"`
-	ExcessBlobGas    string   `json:"excessBlobGas,omitempty"`
-	ExtraData        string   `json:"extraData,omitempty"`
-	GasLimit         string   `json:"gasLimit,omitempty"`
-	GasUsed          string   `json:"gasUsed,omitempty"`
-	Hash             string   `json:"hash,omitempty"`
-	LogsBloom        string   `json:"logsBloom,omitempty"`
-	Miner            string   `json:"miner,omitempty"`
-	MixHash          string   `json:"mixHash,omitempty"`
-	Nonce            string   `json:"nonce,omitempty"`
-	Number           string   `json:"number,omitempty"`
-	ParentHash       string   `json:"parentHash,omitempty"`
-	ReceiptsRoot     string   `json:"receiptsRoot,omitempty"`
-	Sha3Uncles       string   `json:"sha3Uncles,omitempty"`
-	Size             string   `json:"size,omitempty"`
-	StateRoot        string   `json:"stateRoot,omitempty"`
-	Timestamp        string   `json:"timestamp,omitempty"`
-	TotalDifficulty  string   `json:"totalDifficulty,omitempty"`
-	Transactions     []any    `json:"transactions,omitempty"`
-	TransactionsRoot string   `json:"transactionsRoot,omitempty"`
-	Uncles           []string `json:"uncles,omitempty"`
+	BaseFeePerGas         string          `json:"baseFeePerGas,omitempty"`
+	BlobGasUsed           string          `json:"blobGasUsed,omitempty"`
+	Difficulty            string          `json:"difficulty,omitempty"`
+	ExcessBlobGas         string          `json:"excessBlobGas,omitempty"`
+	ExtraData             string          `json:"extraData,omitempty"`
+	GasLimit              string          `json:"gasLimit,omitempty"`
+	GasUsed               string          `json:"gasUsed,omitempty"`
+	Hash                  string          `json:"hash,omitempty"`
+	LogsBloom             string          `json:"logsBloom,omitempty"`
+	Miner                 string          `json:"miner,omitempty"`
+	MixHash               string          `json:"mixHash,omitempty"`
+	Nonce                 string          `json:"nonce,omitempty"`
+	Number                string          `json:"number,omitempty"`
+	ParentBeaconBlockRoot string          `json:"parentBeaconBlockRoot,omitempty"`
+	ParentHash            string          `json:"parentHash,omitempty"`
+	ReceiptsRoot          string          `json:"receiptsRoot,omitempty"`
+	Sha3Uncles            string          `json:"sha3Uncles,omitempty"`
+	Size                  string          `json:"size,omitempty"`
+	StateRoot             string          `json:"stateRoot,omitempty"`
+	Timestamp             string          `json:"timestamp,omitempty"`
+	TotalDifficulty       string          `json:"totalDifficulty,omitempty"`
+	Transactions          []any           `json:"transactions,omitempty"`
+	TransactionsRoot      string          `json:"transactionsRoot,omitempty"`
+	Uncles                []string        `json:"uncles,omitempty"`
+	Withdrawals           []RpcWithdrawal `json:"withdrawals,omitempty"`
+	WithdrawalsRoot       string          `json:"withdrawalsRoot,omitempty"`
 }
 
 // Block represents a formatted block.
 type Block struct {
-	BaseFeePerGas    *big.Int `json:"baseFeePerGas"`
-	BlobGasUsed      *big.Int `json:"blobGasUsed,omitempty"`
-	Difficulty       *big.Int `json:"difficulty,omitempty"`
-	ExcessBlobGas    *big.Int `json:"excessBlobGas,omitempty"`
-	ExtraData        string   `json:"extraData,omitempty"`
-	GasLimit         *big.Int `json:"gasLimit,omitempty"`
-	GasUsed          *big.Int `json:"gasUsed,omitempty"`
-	Hash             *string  `json:"hash"`
-	LogsBloom        *string  `json:"logsBloom"`
-	Miner            string   `json:"miner,omitempty"`
-	MixHash          string   `json:"mixHash,omitempty"`
-	Nonce            *string  `json:"nonce"`
-	Number           *big.Int `json:"number"`
-	ParentHash       string   `json:"parentHash,omitempty"`
-	ReceiptsRoot     string   `json:"receiptsRoot,omitempty"`
-	Sha3Uncles       string   `json:"sha3Uncles,omitempty"`
-	Size             *big.Int `json:"size,omitempty"`
-	StateRoot        string   `json:"stateRoot,omitempty"`
-	Timestamp        *big.Int `json:"timestamp,omitempty"`
-	TotalDifficulty  *big.Int `json:"totalDifficulty"`
-	Transactions     []any    `json:"transactions,omitempty"`
-	TransactionsRoot string   `json:"transactionsRoot,omitempty"`
-	Uncles           []string `json:"uncles,omitempty"`
+	BaseFeePerGas         *big.Int     `json:"baseFeePerGas"`
+	BlobGasUsed           *big.Int     `json:"blobGasUsed,omitempty"`
+	Difficulty            *big.Int     `json:"difficulty,omitempty"`
+	ExcessBlobGas         *big.Int     `json:"excessBlobGas,omitempty"`
+	ExtraData             string       `json:"extraData,omitempty"`
+	GasLimit              *big.Int     `json:"gasLimit,omitempty"`
+	GasUsed               *big.Int     `json:"gasUsed,omitempty"`
+	Hash                  *string      `json:"hash"`
+	LogsBloom             *string      `json:"logsBloom"`
+	Miner                 string       `json:"miner,omitempty"`
+	MixHash               string       `json:"mixHash,omitempty"`
+	Nonce                 *string      `json:"nonce"`
+	Number                *big.Int     `json:"number"`
+	ParentBeaconBlockRoot string       `json:"parentBeaconBlockRoot,omitempty"`
+	ParentHash            string       `json:"parentHash,omitempty"`
+	ReceiptsRoot          string       `json:"receiptsRoot,omitempty"`
+	Sha3Uncles            string       `json:"sha3Uncles,omitempty"`
+	Size                  *big.Int     `json:"size,omitempty"`
+	StateRoot             string       `json:"stateRoot,omitempty"`
+	Timestamp             *big.Int     `json:"timestamp,omitempty"`
+	TotalDifficulty       *big.Int     `json:"totalDifficulty"`
+	Transactions          []any        `json:"transactions,omitempty"`
+	TransactionsRoot      string       `json:"transactionsRoot,omitempty"`
+	Uncles                []string     `json:"uncles,omitempty"`
+	Withdrawals           []Withdrawal `json:"withdrawals,omitempty"`
+	WithdrawalsRoot       string       `json:"withdrawalsRoot,omitempty"`
+}
+
+// RpcWithdrawal represents a validator withdrawal (EIP-4895) as returned by RPC.
+type RpcWithdrawal struct {
+	Address        string `json:"address,omitempty"`
+	Amount         string `json:"amount,omitempty"`
+	Index          string `json:"index,omitempty"`
+	ValidatorIndex string `json:"validatorIndex,omitempty"`
+}
+
+// Withdrawal represents a formatted validator withdrawal. Amount is in gwei.
+type Withdrawal struct {
+	Address        string   `json:"address"`
+	Amount         *big.Int `json:"amount"`
+	Index          *big.Int `json:"index"`
+	ValidatorIndex *big.Int `json:"validatorIndex"`
 }
 
 // RpcLog represents a log as returned by RPC.
@@ -148,6 +170,9 @@ type Log struct {
 	TransactionIndex *int     `json:"transactionIndex"`
 	Args             any      `json:"args,omitempty"`
 	EventName        string   `json:"eventName,omitempty"`
+	// HashedArgs marks indexed dynamic args (string, bytes, arrays, tuples)
+	// whose Args entry is the keccak256 topic hash rather than the value.
+	HashedArgs map[string]bool `json:"-"`
 }
 
 // RpcTransaction represents a transaction as returned by RPC.
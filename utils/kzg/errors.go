@@ -20,4 +20,8 @@ var (
 
 	// ErrBlobSizeTooLarge is returned when data exceeds the maximum blob size.
 	ErrBlobSizeTooLarge = errors.New("blob size too large")
+
+	// ErrBlobTerminatorNotFound is returned when decoding blobs that don't end
+	// with the 0x80 terminator written by ToBlobs.
+	ErrBlobTerminatorNotFound = errors.New("blob terminator not found")
 )
@@ -0,0 +1,18 @@
+package p256
+
+import "errors"
+
+var (
+	// ErrInvalidPrivateKey is returned when a private key is not a 32-byte scalar in [1, n).
+	ErrInvalidPrivateKey = errors.New("invalid p256 private key")
+
+	// ErrInvalidPublicKey is returned when a public key is not a point on the P256 curve.
+	ErrInvalidPublicKey = errors.New("invalid p256 public key")
+
+	// ErrInvalidSignature is returned when a signature is neither a 64-byte
+	// r||s pair nor a valid DER sequence, or r or s is out of range.
+	ErrInvalidSignature = errors.New("invalid p256 signature")
+
+	// ErrInvalidHashLength is returned when a hash is not 32 bytes.
+	ErrInvalidHashLength = errors.New("p256: hash must be 32 bytes")
+)
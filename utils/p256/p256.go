@@ -0,0 +1,233 @@
+// Package p256 provides secp256r1 (NIST P-256) signing and verification for
+// passkey and RIP-7212 flows. It is built on the standard library and does
+// not depend on go-ethereum's secp256k1 implementation.
+package p256
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/asn1"
+	"math/big"
+)
+
+var (
+	// N is the order of the P256 curve.
+	N, _ = new(big.Int).SetString("ffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551", 16)
+
+	// halfN is N / 2, the largest s accepted by malleability-checking verifiers.
+	halfN = new(big.Int).Rsh(N, 1)
+)
+
+// Signature is a P256 signature.
+type Signature struct {
+	R *big.Int
+	S *big.Int
+}
+
+// PublicKey is an uncompressed P256 public key.
+type PublicKey struct {
+	X *big.Int
+	Y *big.Int
+}
+
+// Sign signs a 32-byte hash with a 32-byte private key.
+//
+// Signing uses the standard library's constant-time ECDSA with a
+// deterministic nonce (RFC 6979), so the same inputs always produce the same
+// signature, and s is normalized to the lower half of the curve order as
+// on-chain verifiers require.
+//
+// Example:
+//
+//	sig, err := p256.Sign(hash, privateKey)
+//	ok := p256.Verify(hash, sig.Bytes(), publicKey)
+func Sign(hash []byte, privateKey []byte) (*Signature, error) {
+	if len(hash) != 32 {
+		return nil, ErrInvalidHashLength
+	}
+	d, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := scalarBaseMult(d)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &ecdsa.PrivateKey{
+		PublicKey: ecdsa.PublicKey{Curve: elliptic.P256(), X: pub.X, Y: pub.Y},
+		D:         d,
+	}
+	// A nil random source selects RFC 6979 deterministic signing.
+	der, err := key.Sign(nil, hash, crypto.SHA256)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := ParseSignature(der)
+	if err != nil {
+		return nil, err
+	}
+	sig.S = NormalizeS(sig.S)
+	return sig, nil
+}
+
+// Verify reports whether sig is a valid signature of hash by pubKey.
+//
+// sig may be 64 bytes r||s or ASN.1 DER, as returned by browser passkeys
+// (navigator.credentials.get). pubKey may be 64 bytes x||y or 65 bytes with the
+// 0x04 prefix. Both low-s and high-s signatures are accepted; use NormalizeS
+// before submitting a passkey signature to a verifier that rejects high s.
+func Verify(hash []byte, sig []byte, pubKey []byte) bool {
+	signature, err := ParseSignature(sig)
+	if err != nil {
+		return false
+	}
+	pub, err := ParsePublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	return VerifySignature(hash, signature, pub)
+}
+
+// VerifySignature reports whether sig is a valid signature of hash by pub.
+func VerifySignature(hash []byte, sig *Signature, pub *PublicKey) bool {
+	if sig == nil || pub == nil || !validScalar(sig.R) || !validScalar(sig.S) {
+		return false
+	}
+	key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: pub.X, Y: pub.Y}
+	return ecdsa.Verify(key, hash, sig.R, sig.S)
+}
+
+// GetPublicKey returns the public key for a 32-byte private key.
+func GetPublicKey(privateKey []byte) (*PublicKey, error) {
+	d, err := parsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return scalarBaseMult(d)
+}
+
+// NormalizeS returns s in the lower half of the curve order (n - s if s > n/2).
+func NormalizeS(s *big.Int) *big.Int {
+	if s.Cmp(halfN) > 0 {
+		return new(big.Int).Sub(N, s)
+	}
+	return s
+}
+
+// ParseSignature parses a 64-byte r||s signature or an ASN.1 DER signature.
+func ParseSignature(sig []byte) (*Signature, error) {
+	var r, s *big.Int
+	if len(sig) == 64 {
+		r = new(big.Int).SetBytes(sig[:32])
+		s = new(big.Int).SetBytes(sig[32:])
+	} else {
+		var der struct{ R, S *big.Int }
+		rest, err := asn1.Unmarshal(sig, &der)
+		if err != nil || len(rest) != 0 {
+			return nil, ErrInvalidSignature
+		}
+		r, s = der.R, der.S
+	}
+	if !validScalar(r) || !validScalar(s) {
+		return nil, ErrInvalidSignature
+	}
+	return &Signature{R: r, S: s}, nil
+}
+
+// Bytes returns the signature as 64 bytes r||s, the layout used by the
+// RIP-7212 precompile and WebAuthn verifier contracts.
+func (s *Signature) Bytes() []byte {
+	out := make([]byte, 64)
+	s.R.FillBytes(out[:32])
+	s.S.FillBytes(out[32:])
+	return out
+}
+
+// ParsePublicKey parses an uncompressed public key, either 64 bytes x||y or
+// 65 bytes with the 0x04 prefix, and checks that it is on the curve.
+func ParsePublicKey(pub []byte) (*PublicKey, error) {
+	if len(pub) == 64 {
+		pub = append([]byte{0x04}, pub...)
+	}
+	if len(pub) != 65 || pub[0] != 0x04 {
+		return nil, ErrInvalidPublicKey
+	}
+	if _, err := ecdh.P256().NewPublicKey(pub); err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	return &PublicKey{
+		X: new(big.Int).SetBytes(pub[1:33]),
+		Y: new(big.Int).SetBytes(pub[33:]),
+	}, nil
+}
+
+// Bytes returns the public key as 64 bytes x||y, the layout used by the
+// RIP-7212 precompile and WebAuthn verifier contracts.
+func (p *PublicKey) Bytes() []byte {
+	out := make([]byte, 64)
+	p.X.FillBytes(out[:32])
+	p.Y.FillBytes(out[32:])
+	return out
+}
+
+// EncodePrecompileInput returns the 160-byte RIP-7212 precompile input:
+// hash || r || s || x || y.
+func EncodePrecompileInput(hash []byte, sig *Signature, pub *PublicKey) ([]byte, error) {
+	if len(hash) != 32 {
+		return nil, ErrInvalidHashLength
+	}
+	out := make([]byte, 0, 160)
+	out = append(out, hash...)
+	out = append(out, sig.Bytes()...)
+	return append(out, pub.Bytes()...), nil
+}
+
+// DecodePrecompileInput splits a 160-byte RIP-7212 precompile input into its
+// hash, signature and public key.
+func DecodePrecompileInput(input []byte) ([]byte, *Signature, *PublicKey, error) {
+	if len(input) != 160 {
+		return nil, nil, nil, ErrInvalidSignature
+	}
+	sig, err := ParseSignature(input[32:96])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	pub, err := ParsePublicKey(input[96:])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return input[:32], sig, pub, nil
+}
+
+// parsePrivateKey parses a 32-byte private key scalar.
+func parsePrivateKey(privateKey []byte) (*big.Int, error) {
+	if len(privateKey) != 32 {
+		return nil, ErrInvalidPrivateKey
+	}
+	d := new(big.Int).SetBytes(privateKey)
+	if !validScalar(d) {
+		return nil, ErrInvalidPrivateKey
+	}
+	return d, nil
+}
+
+// validScalar reports whether v is in [1, n).
+func validScalar(v *big.Int) bool {
+	return v != nil && v.Sign() > 0 && v.Cmp(N) < 0
+}
+
+// scalarBaseMult returns k·G.
+func scalarBaseMult(k *big.Int) (*PublicKey, error) {
+	key, err := ecdh.P256().NewPrivateKey(k.FillBytes(make([]byte, 32)))
+	if err != nil {
+		return nil, ErrInvalidPrivateKey
+	}
+	point := key.PublicKey().Bytes()
+	return &PublicKey{
+		X: new(big.Int).SetBytes(point[1:33]),
+		Y: new(big.Int).SetBytes(point[33:]),
+	}, nil
+}
@@ -0,0 +1,142 @@
+package test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/utils/p256"
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	Expect(err).NotTo(HaveOccurred())
+	return b
+}
+
+func mustBig(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 16)
+	Expect(ok).To(BeTrue())
+	return v
+}
+
+// RFC 6979 appendix A.2.5: P-256 with SHA-256, message "sample".
+var (
+	rfcPrivateKey = "c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721"
+	rfcPublicX    = "60fed4ba255a9d31c961eb74c6356d68c049b8923b61fa6ce669622e60f29fb6"
+	rfcPublicY    = "7903fe1008b8bc99a41ae9e95628bc64f2f1b20c2d7e9f5177a3c294d4462299"
+	rfcR          = "efd48b2aacb6a8fd1140dd9cd45e81d69d2c877b56aaf991c34d0ea84eaf3716"
+	rfcS          = "f7cb1c942d657c41d436c7a1b6e29f65f3e900dbb9aff4064dc4ab2f843acda8"
+)
+
+var _ = Describe("P256", func() {
+	hash := sha256.Sum256([]byte("sample"))
+	privateKey := mustHex(rfcPrivateKey)
+	publicKey := mustHex(rfcPublicX + rfcPublicY)
+
+	Describe("GetPublicKey", func() {
+		It("should derive the RFC 6979 public key", func() {
+			pub, err := p256.GetPublicKey(privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pub.Bytes()).To(Equal(publicKey))
+		})
+
+		It("should reject an out-of-range private key", func() {
+			_, err := p256.GetPublicKey(make([]byte, 32))
+			Expect(err).To(MatchError(p256.ErrInvalidPrivateKey))
+		})
+	})
+
+	Describe("Sign", func() {
+		It("should match the RFC 6979 deterministic signature with low s", func() {
+			sig, err := p256.Sign(hash[:], privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sig.R).To(Equal(mustBig(rfcR)))
+			// The RFC signature has a high s; Sign normalizes it to n - s.
+			Expect(sig.S).To(Equal(new(big.Int).Sub(p256.N, mustBig(rfcS))))
+		})
+
+		It("should match the RFC 6979 signature for message \"test\"", func() {
+			testHash := sha256.Sum256([]byte("test"))
+			sig, err := p256.Sign(testHash[:], privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sig.R).To(Equal(mustBig("f1abb023518351cd71d881567b1ea663ed3efcf6c5132b354f28d3b0b7d38367")))
+			// Already low s, so it is returned unchanged.
+			Expect(sig.S).To(Equal(mustBig("019f4113742a2b14bd25926b49c649155f267e60d3814b4c0cc84250e46f0083")))
+		})
+
+		It("should reject a hash that is not 32 bytes", func() {
+			_, err := p256.Sign([]byte{0x01}, privateKey)
+			Expect(err).To(MatchError(p256.ErrInvalidHashLength))
+		})
+	})
+
+	Describe("Verify", func() {
+		It("should verify the RFC 6979 vector with high and low s", func() {
+			sig := append(mustHex(rfcR), mustHex(rfcS)...)
+			Expect(p256.Verify(hash[:], sig, publicKey)).To(BeTrue())
+
+			low := &p256.Signature{R: mustBig(rfcR), S: p256.NormalizeS(mustBig(rfcS))}
+			Expect(p256.Verify(hash[:], low.Bytes(), append([]byte{0x04}, publicKey...))).To(BeTrue())
+		})
+
+		It("should verify a DER signature from a passkey-style signer", func() {
+			key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			der, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+			Expect(err).NotTo(HaveOccurred())
+
+			pub := &p256.PublicKey{X: key.X, Y: key.Y}
+			Expect(p256.Verify(hash[:], der, pub.Bytes())).To(BeTrue())
+		})
+
+		It("should reject a signature for a different hash", func() {
+			other := sha256.Sum256([]byte("test"))
+			sig := append(mustHex(rfcR), mustHex(rfcS)...)
+			Expect(p256.Verify(other[:], sig, publicKey)).To(BeFalse())
+		})
+
+		It("should reject malformed signatures", func() {
+			Expect(p256.Verify(hash[:], []byte{0x30, 0x01}, publicKey)).To(BeFalse())
+			Expect(p256.Verify(hash[:], make([]byte, 64), publicKey)).To(BeFalse())
+
+			outOfRange := append(p256.N.FillBytes(make([]byte, 32)), mustHex(rfcS)...)
+			Expect(p256.Verify(hash[:], outOfRange, publicKey)).To(BeFalse())
+
+			_, err := p256.ParseSignature([]byte{0x01, 0x02, 0x03})
+			Expect(err).To(MatchError(p256.ErrInvalidSignature))
+		})
+
+		It("should reject a public key that is not on the curve", func() {
+			sig := append(mustHex(rfcR), mustHex(rfcS)...)
+			bad := append([]byte{}, publicKey...)
+			bad[63] ^= 0x01
+			Expect(p256.Verify(hash[:], sig, bad)).To(BeFalse())
+		})
+	})
+
+	Describe("Precompile input", func() {
+		It("should round-trip the RIP-7212 input layout", func() {
+			sig, err := p256.Sign(hash[:], privateKey)
+			Expect(err).NotTo(HaveOccurred())
+			pub, err := p256.ParsePublicKey(publicKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			input, err := p256.EncodePrecompileInput(hash[:], sig, pub)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(input).To(HaveLen(160))
+			Expect(input[:32]).To(Equal(hash[:]))
+			Expect(input[96:]).To(Equal(publicKey))
+
+			gotHash, gotSig, gotPub, err := p256.DecodePrecompileInput(input)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(p256.VerifySignature(gotHash, gotSig, gotPub)).To(BeTrue())
+		})
+	})
+})
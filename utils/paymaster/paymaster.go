@@ -0,0 +1,194 @@
+// Package paymaster provides a client for ERC-7677 paymaster web services.
+package paymaster
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/utils/rpc"
+)
+
+// Client calls the JSON-RPC methods of an ERC-7677 paymaster service.
+type Client struct {
+	rpc *rpc.HTTPClient
+}
+
+// NewClient creates a client for the paymaster service at url.
+//
+// Example:
+//
+//	pm, err := paymaster.NewClient("https://paymaster.example.com/rpc")
+func NewClient(url string, opts ...rpc.HTTPClientOptions) (*Client, error) {
+	httpClient, err := rpc.NewHTTPClient(url, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{rpc: httpClient}, nil
+}
+
+// Close closes the underlying HTTP client.
+func (c *Client) Close() error {
+	return c.rpc.Close()
+}
+
+// GetPaymasterDataParameters contains the parameters for GetPaymasterData
+// and GetPaymasterStubData.
+type GetPaymasterDataParameters struct {
+	// UserOperation is the (partially filled) user operation to sponsor,
+	// in its JSON-RPC form.
+	UserOperation any
+
+	// EntryPointAddress is the EntryPoint the user operation targets.
+	EntryPointAddress common.Address
+
+	// ChainID is the chain the user operation is for.
+	ChainID int64
+
+	// Context is service specific data, e.g. a sponsorship policy ID
+	// (optional).
+	Context map[string]any
+}
+
+// Sponsor identifies the party sponsoring a user operation.
+type Sponsor struct {
+	Name string `json:"name"`
+	Icon string `json:"icon,omitempty"`
+}
+
+// GetPaymasterStubDataReturnType is the result of pm_getPaymasterStubData.
+//
+// For EntryPoint v0.7+ Paymaster and PaymasterData are set; for v0.6
+// PaymasterAndData is set.
+type GetPaymasterStubDataReturnType struct {
+	Sponsor                       *Sponsor
+	Paymaster                     *common.Address
+	PaymasterData                 []byte
+	PaymasterAndData              []byte
+	PaymasterVerificationGasLimit *big.Int
+	PaymasterPostOpGasLimit       *big.Int
+
+	// IsFinal reports that the stub data is final, so GetPaymasterData
+	// does not need to be called.
+	IsFinal bool
+}
+
+// GetPaymasterDataReturnType is the result of pm_getPaymasterData.
+//
+// For EntryPoint v0.7+ Paymaster and PaymasterData are set; for v0.6
+// PaymasterAndData is set.
+type GetPaymasterDataReturnType struct {
+	Paymaster        *common.Address
+	PaymasterData    []byte
+	PaymasterAndData []byte
+}
+
+// rpcPaymasterData is the raw result of pm_getPaymasterStubData and
+// pm_getPaymasterData.
+type rpcPaymasterData struct {
+	Sponsor                       *Sponsor        `json:"sponsor,omitempty"`
+	Paymaster                     *common.Address `json:"paymaster,omitempty"`
+	PaymasterData                 hexutil.Bytes   `json:"paymasterData,omitempty"`
+	PaymasterAndData              hexutil.Bytes   `json:"paymasterAndData,omitempty"`
+	PaymasterVerificationGasLimit *hexutil.Big    `json:"paymasterVerificationGasLimit,omitempty"`
+	PaymasterPostOpGasLimit       *hexutil.Big    `json:"paymasterPostOpGasLimit,omitempty"`
+	IsFinal                       bool            `json:"isFinal,omitempty"`
+}
+
+// GetPaymasterStubData returns stub paymaster values to use while estimating
+// gas for a user operation.
+//
+// JSON-RPC Method: pm_getPaymasterStubData (ERC-7677)
+//
+// Example:
+//
+//	stub, err := pm.GetPaymasterStubData(ctx, paymaster.GetPaymasterDataParameters{
+//	    UserOperation:     userOp,
+//	    EntryPointAddress: entryPoint,
+//	    ChainID:           8453,
+//	})
+func (c *Client) GetPaymasterStubData(ctx context.Context, params GetPaymasterDataParameters) (*GetPaymasterStubDataReturnType, error) {
+	var result rpcPaymasterData
+	if err := c.request(ctx, "pm_getPaymasterStubData", params, &result); err != nil {
+		return nil, err
+	}
+
+	stub := &GetPaymasterStubDataReturnType{
+		Sponsor:          result.Sponsor,
+		Paymaster:        result.Paymaster,
+		PaymasterData:    result.PaymasterData,
+		PaymasterAndData: result.PaymasterAndData,
+		IsFinal:          result.IsFinal,
+	}
+	if result.PaymasterVerificationGasLimit != nil {
+		stub.PaymasterVerificationGasLimit = result.PaymasterVerificationGasLimit.ToInt()
+	}
+	if result.PaymasterPostOpGasLimit != nil {
+		stub.PaymasterPostOpGasLimit = result.PaymasterPostOpGasLimit.ToInt()
+	}
+	return stub, nil
+}
+
+// GetPaymasterData returns the paymaster values to sign and submit with a
+// user operation.
+//
+// JSON-RPC Method: pm_getPaymasterData (ERC-7677)
+//
+// Example:
+//
+//	data, err := pm.GetPaymasterData(ctx, paymaster.GetPaymasterDataParameters{
+//	    UserOperation:     userOp,
+//	    EntryPointAddress: entryPoint,
+//	    ChainID:           8453,
+//	})
+func (c *Client) GetPaymasterData(ctx context.Context, params GetPaymasterDataParameters) (*GetPaymasterDataReturnType, error) {
+	var result rpcPaymasterData
+	if err := c.request(ctx, "pm_getPaymasterData", params, &result); err != nil {
+		return nil, err
+	}
+
+	return &GetPaymasterDataReturnType{
+		Paymaster:        result.Paymaster,
+		PaymasterData:    result.PaymasterData,
+		PaymasterAndData: result.PaymasterAndData,
+	}, nil
+}
+
+// request sends method with the ERC-7677 positional params
+// [userOp, entryPoint, chainId, context] and decodes the result into out.
+func (c *Client) request(ctx context.Context, method string, params GetPaymasterDataParameters, out any) error {
+	if params.UserOperation == nil {
+		return fmt.Errorf("%s: user operation is required", method)
+	}
+
+	paymasterContext := params.Context
+	if paymasterContext == nil {
+		paymasterContext = map[string]any{}
+	}
+
+	resp, err := c.rpc.Request(ctx, rpc.RPCRequest{
+		Method: method,
+		Params: []any{
+			params.UserOperation,
+			params.EntryPointAddress.Hex(),
+			hexutil.EncodeUint64(uint64(params.ChainID)),
+			paymasterContext,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s failed: %w", method, resp.Error)
+	}
+
+	if err := json.Unmarshal(resp.Result, out); err != nil {
+		return fmt.Errorf("failed to unmarshal %s result: %w", method, err)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/utils/paymaster"
+)
+
+type rpcRequest struct {
+	ID     any    `json:"id"`
+	Method string `json:"method"`
+	Params []any  `json:"params"`
+}
+
+// newPaymasterServer serves result for every request and records the last one.
+func newPaymasterServer(result any, last *rpcRequest) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcRequest
+		Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+		*last = req
+
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result}
+		if result == nil {
+			resp = map[string]any{
+				"jsonrpc": "2.0",
+				"id":      req.ID,
+				"error":   map[string]any{"code": -32000, "message": "policy rejected"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+}
+
+var _ = Describe("Paymaster", func() {
+	entryPoint := common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+	paymasterAddress := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	userOp := map[string]any{"sender": "0x2222222222222222222222222222222222222222", "nonce": "0x0"}
+
+	Describe("GetPaymasterStubData", func() {
+		It("should send ERC-7677 params and decode the stub", func() {
+			var last rpcRequest
+			server := newPaymasterServer(map[string]any{
+				"sponsor":                       map[string]any{"name": "Sponsor"},
+				"paymaster":                     paymasterAddress.Hex(),
+				"paymasterData":                 "0x1234",
+				"paymasterVerificationGasLimit": "0x186a0",
+				"paymasterPostOpGasLimit":       "0x0",
+				"isFinal":                       false,
+			}, &last)
+			defer server.Close()
+
+			pm, err := paymaster.NewClient(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer pm.Close()
+
+			stub, err := pm.GetPaymasterStubData(context.Background(), paymaster.GetPaymasterDataParameters{
+				UserOperation:     userOp,
+				EntryPointAddress: entryPoint,
+				ChainID:           8453,
+				Context:           map[string]any{"policyId": "abc"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(last.Method).To(Equal("pm_getPaymasterStubData"))
+			Expect(last.Params).To(HaveLen(4))
+			Expect(last.Params[1]).To(Equal(entryPoint.Hex()))
+			Expect(last.Params[2]).To(Equal("0x2105"))
+			Expect(last.Params[3]).To(Equal(map[string]any{"policyId": "abc"}))
+
+			Expect(stub.Sponsor.Name).To(Equal("Sponsor"))
+			Expect(*stub.Paymaster).To(Equal(paymasterAddress))
+			Expect(stub.PaymasterData).To(Equal([]byte{0x12, 0x34}))
+			Expect(stub.PaymasterVerificationGasLimit.Int64()).To(Equal(int64(100000)))
+			Expect(stub.PaymasterPostOpGasLimit.Sign()).To(Equal(0))
+			Expect(stub.IsFinal).To(BeFalse())
+		})
+	})
+
+	Describe("GetPaymasterData", func() {
+		It("should decode v0.6 paymasterAndData", func() {
+			var last rpcRequest
+			server := newPaymasterServer(map[string]any{"paymasterAndData": "0xabcd"}, &last)
+			defer server.Close()
+
+			pm, err := paymaster.NewClient(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer pm.Close()
+
+			data, err := pm.GetPaymasterData(context.Background(), paymaster.GetPaymasterDataParameters{
+				UserOperation:     userOp,
+				EntryPointAddress: entryPoint,
+				ChainID:           1,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(last.Method).To(Equal("pm_getPaymasterData"))
+			Expect(last.Params[3]).To(Equal(map[string]any{}))
+			Expect(data.Paymaster).To(BeNil())
+			Expect(data.PaymasterAndData).To(Equal([]byte{0xab, 0xcd}))
+		})
+
+		It("should return the service error", func() {
+			var last rpcRequest
+			server := newPaymasterServer(nil, &last)
+			defer server.Close()
+
+			pm, err := paymaster.NewClient(server.URL)
+			Expect(err).NotTo(HaveOccurred())
+			defer pm.Close()
+
+			_, err = pm.GetPaymasterData(context.Background(), paymaster.GetPaymasterDataParameters{
+				UserOperation:     userOp,
+				EntryPointAddress: entryPoint,
+				ChainID:           1,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("policy rejected"))
+		})
+
+		It("should require a user operation", func() {
+			pm, err := paymaster.NewClient("http://127.0.0.1:1")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = pm.GetPaymasterData(context.Background(), paymaster.GetPaymasterDataParameters{})
+			Expect(err).To(MatchError(ContainSubstring("user operation is required")))
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package proof
+
+import "errors"
+
+var (
+	// ErrNilProof is returned when no proof is given.
+	ErrNilProof = errors.New("proof is nil")
+
+	// ErrProofIncomplete is returned when the proof ends before reaching a value
+	// or an empty slot.
+	ErrProofIncomplete = errors.New("proof is incomplete")
+
+	// ErrProofNodeHashMismatch is returned when a proof node does not hash to
+	// the reference held by its parent (or to the root, for the first node).
+	ErrProofNodeHashMismatch = errors.New("proof node hash mismatch")
+
+	// ErrInvalidProofNode is returned when a proof node is not a valid trie node.
+	ErrInvalidProofNode = errors.New("invalid proof node")
+
+	// ErrAccountMismatch is returned when the proven account does not match the
+	// balance, nonce, storage hash or code hash in the proof.
+	ErrAccountMismatch = errors.New("account does not match proof")
+
+	// ErrStorageValueMismatch is returned when the proven slot value does not
+	// match the value in the proof.
+	ErrStorageValueMismatch = errors.New("storage value does not match proof")
+)
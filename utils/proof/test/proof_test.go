@@ -0,0 +1,308 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+	"github.com/ChefBingbong/viem-go/utils/proof"
+)
+
+// getProofFixture is a sample eth_getProof response together with the state
+// root it was generated against.
+type getProofFixture struct {
+	StateRoot common.Hash     `json:"stateRoot"`
+	Proof     json.RawMessage `json:"proof"`
+}
+
+// fetchFixtureProof serves the fixture over JSON-RPC and decodes it with public.GetProof.
+func fetchFixtureProof() (common.Hash, types.AccountProof) {
+	raw, err := os.ReadFile(filepath.Join("testdata", "get_proof.json"))
+	Expect(err).NotTo(HaveOccurred())
+
+	var fixture getProofFixture
+	Expect(json.Unmarshal(raw, &fixture)).To(Succeed())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID any `json:"id"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": fixture.Proof})
+	}))
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+	Expect(err).NotTo(HaveOccurred())
+	defer c.Close()
+
+	p, err := public.GetProof(context.Background(), c, public.GetProofParameters{
+		Address:     common.HexToAddress("0x1234567890123456789012345678901234567890"),
+		StorageKeys: []common.Hash{common.HexToHash("0x0"), common.HexToHash("0x5")},
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return fixture.StateRoot, p
+}
+
+// emptyStorageFixture builds a state trie holding a single account without
+// storage and returns its root with the eth_getProof response a node gives
+// for slot 0x0 of that account: an empty storage proof.
+func emptyStorageFixture(addr common.Address) (common.Hash, map[string]any) {
+	account, err := encoding.RlpEncode([]any{
+		[]byte{0x03},
+		big.NewInt(1000).Bytes(),
+		proof.EmptyRootHash.Bytes(),
+		proof.EmptyCodeHash.Bytes(),
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// A lone leaf is the root node; its path is the whole hashed key.
+	leaf, err := encoding.RlpEncode([]any{
+		append([]byte{0x20}, crypto.Keccak256(addr.Bytes())...),
+		account,
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	return crypto.Keccak256Hash(leaf), map[string]any{
+		"address":      addr.Hex(),
+		"accountProof": []string{hexutil.Encode(leaf)},
+		"balance":      "0x3e8",
+		"codeHash":     proof.EmptyCodeHash.Hex(),
+		"nonce":        "0x3",
+		"storageHash":  proof.EmptyRootHash.Hex(),
+		"storageProof": []any{map[string]any{
+			"key":   common.Hash{}.Hex(),
+			"proof": []string{},
+			"value": "0x0",
+		}},
+	}
+}
+
+var _ = Describe("Proof", func() {
+	var (
+		stateRoot common.Hash
+		p         types.AccountProof
+	)
+
+	BeforeEach(func() {
+		stateRoot, p = fetchFixtureProof()
+	})
+
+	Describe("decoded eth_getProof response", func() {
+		It("should decode every hex field", func() {
+			Expect(p.Address).To(Equal(common.HexToAddress("0x1234567890123456789012345678901234567890")))
+			Expect(p.Balance.String()).To(Equal("1000000000000000000"))
+			Expect(p.Nonce).To(Equal(uint64(1)))
+			Expect(p.AccountProof).To(HaveLen(2))
+			Expect(p.StorageProof).To(HaveLen(2))
+			Expect(p.StorageProof[0].Key).To(Equal(common.HexToHash("0x0")))
+			Expect(p.StorageProof[0].Value).To(Equal(big.NewInt(42)))
+			Expect(p.StorageProof[1].Key).To(Equal(common.HexToHash("0x5")))
+			Expect(p.StorageProof[1].Value.Sign()).To(Equal(0))
+		})
+	})
+
+	Describe("VerifyAccountProof", func() {
+		It("should verify the account and its storage against the state root", func() {
+			Expect(proof.VerifyAccountProof(stateRoot, &p)).To(Succeed())
+		})
+
+		It("should reject a different state root", func() {
+			err := proof.VerifyAccountProof(common.HexToHash("0x01"), &p)
+			Expect(err).To(MatchError(proof.ErrProofNodeHashMismatch))
+		})
+
+		It("should reject a tampered balance", func() {
+			p.Balance = big.NewInt(1)
+			Expect(proof.VerifyAccountProof(stateRoot, &p)).To(MatchError(proof.ErrAccountMismatch))
+		})
+
+		It("should reject a truncated proof", func() {
+			p.AccountProof = p.AccountProof[:1]
+			Expect(proof.VerifyAccountProof(stateRoot, &p)).To(MatchError(proof.ErrProofIncomplete))
+		})
+
+		It("should reject a nil proof", func() {
+			Expect(proof.VerifyAccountProof(stateRoot, nil)).To(MatchError(proof.ErrNilProof))
+		})
+	})
+
+	Describe("VerifyStorageProof", func() {
+		It("should verify a single slot against the storage root", func() {
+			Expect(proof.VerifyStorageProof(p.StorageHash, p.StorageProof[0])).To(Succeed())
+		})
+
+		It("should verify an empty slot by its absence", func() {
+			Expect(proof.VerifyStorageProof(p.StorageHash, p.StorageProof[1])).To(Succeed())
+		})
+
+		It("should reject a tampered slot value", func() {
+			item := p.StorageProof[0]
+			item.Value = big.NewInt(43)
+			Expect(proof.VerifyStorageProof(p.StorageHash, item)).To(MatchError(proof.ErrStorageValueMismatch))
+		})
+
+		It("should reject a non-zero value for an absent slot", func() {
+			item := p.StorageProof[1]
+			item.Value = big.NewInt(1)
+			Expect(proof.VerifyStorageProof(p.StorageHash, item)).To(MatchError(proof.ErrStorageValueMismatch))
+		})
+
+		It("should verify an empty proof against the empty storage root", func() {
+			item := types.StorageProofItem{Key: common.HexToHash("0x0"), Value: new(big.Int)}
+			Expect(proof.VerifyStorageProof(proof.EmptyRootHash, item)).To(Succeed())
+		})
+
+		It("should reject a non-zero value against the empty storage root", func() {
+			item := types.StorageProofItem{Key: common.HexToHash("0x0"), Value: big.NewInt(1)}
+			Expect(proof.VerifyStorageProof(proof.EmptyRootHash, item)).To(MatchError(proof.ErrStorageValueMismatch))
+		})
+
+		It("should reject an empty proof against a non-empty storage root", func() {
+			item := p.StorageProof[0]
+			item.Proof = nil
+			Expect(proof.VerifyStorageProof(p.StorageHash, item)).To(MatchError(proof.ErrProofIncomplete))
+		})
+	})
+})
+
+var _ = Describe("GetStorageAtVerified", func() {
+	var (
+		fixture      getProofFixture
+		proofResult  map[string]any
+		proofBlocks  []string
+		server       *httptest.Server
+		c            *client.PublicClient
+		contractAddr = common.HexToAddress("0x1234567890123456789012345678901234567890")
+	)
+
+	BeforeEach(func() {
+		raw, err := os.ReadFile(filepath.Join("testdata", "get_proof.json"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(json.Unmarshal(raw, &fixture)).To(Succeed())
+		proofResult = nil
+		Expect(json.Unmarshal(fixture.Proof, &proofResult)).To(Succeed())
+		proofBlocks = nil
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				ID     any    `json:"id"`
+				Method string `json:"method"`
+				Params []any  `json:"params"`
+			}
+			Expect(json.NewDecoder(r.Body).Decode(&req)).To(Succeed())
+
+			var result any
+			switch req.Method {
+			case "eth_getBlockByNumber":
+				result = map[string]any{
+					"number":     "0x64",
+					"hash":       common.HexToHash("0xb10c").Hex(),
+					"parentHash": common.Hash{}.Hex(),
+					"stateRoot":  fixture.StateRoot.Hex(),
+					"timestamp":  "0x1",
+				}
+			case "eth_getProof":
+				proofBlocks = append(proofBlocks, req.Params[2].(string))
+				result = proofResult
+			default:
+				Fail("unexpected method " + req.Method)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+		}))
+
+		c, err = client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		_ = c.Close()
+		server.Close()
+	})
+
+	// onlySlot trims the fixture proof to the storage proof for slot i.
+	onlySlot := func(i int) {
+		storageProof := proofResult["storageProof"].([]any)
+		proofResult["storageProof"] = []any{storageProof[i]}
+	}
+
+	It("should return a value whose proof verifies", func() {
+		onlySlot(0)
+		value, err := c.GetStorageAtVerified(context.Background(), contractAddr, common.HexToHash("0x0"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(common.BigToHash(big.NewInt(42)).Bytes()))
+
+		// The proof is requested at the resolved block, not the tag.
+		Expect(proofBlocks).To(Equal([]string{"0x64"}))
+	})
+
+	It("should return zero for a slot proven absent", func() {
+		onlySlot(1)
+		value, err := public.GetStorageAtVerified(context.Background(), c, public.GetStorageAtVerifiedParameters{
+			Address: contractAddr,
+			Slot:    common.HexToHash("0x5"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(make([]byte, 32)))
+	})
+
+	It("should return zero for an account without storage", func() {
+		eoa := common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+		fixture.StateRoot, proofResult = emptyStorageFixture(eoa)
+
+		value, err := public.GetStorageAtVerified(context.Background(), c, public.GetStorageAtVerifiedParameters{
+			Address: eoa,
+			Slot:    common.HexToHash("0x0"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal(make([]byte, 32)))
+	})
+
+	It("should reject a tampered value", func() {
+		onlySlot(0)
+		proofResult["storageProof"].([]any)[0].(map[string]any)["value"] = "0x2b"
+
+		_, err := c.GetStorageAtVerified(context.Background(), contractAddr, common.HexToHash("0x0"))
+		var verifyErr *public.ProofVerificationError
+		Expect(errors.As(err, &verifyErr)).To(BeTrue())
+		Expect(verifyErr.BlockNumber).To(Equal(uint64(100)))
+		Expect(verifyErr.StateRoot).To(Equal(fixture.StateRoot))
+		Expect(err).To(MatchError(proof.ErrStorageValueMismatch))
+	})
+
+	It("should reject a tampered account proof", func() {
+		onlySlot(0)
+		proofResult["balance"] = "0x1"
+
+		_, err := c.GetStorageAtVerified(context.Background(), contractAddr, common.HexToHash("0x0"))
+		var verifyErr *public.ProofVerificationError
+		Expect(errors.As(err, &verifyErr)).To(BeTrue())
+		Expect(err).To(MatchError(proof.ErrAccountMismatch))
+	})
+
+	It("should reject a proof for another slot", func() {
+		onlySlot(1)
+		_, err := c.GetStorageAtVerified(context.Background(), contractAddr, common.HexToHash("0x0"))
+		var verifyErr *public.ProofVerificationError
+		Expect(errors.As(err, &verifyErr)).To(BeTrue())
+	})
+})
@@ -0,0 +1,242 @@
+// Package proof verifies EIP-1186 Merkle-Patricia proofs returned by eth_getProof.
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/ChefBingbong/viem-go/types"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+)
+
+var (
+	// EmptyRootHash is the root of an empty trie, used as the storage hash of
+	// accounts without storage.
+	EmptyRootHash = common.HexToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
+
+	// EmptyCodeHash is the keccak256 hash of empty code.
+	EmptyCodeHash = common.HexToHash("0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470")
+)
+
+// VerifyAccountProof verifies an account proof and all of its storage proofs
+// against a state root, typically the stateRoot of the block the proof was
+// requested at.
+//
+// The account proof must prove the balance, nonce, storage hash and code hash
+// in p (or, for an account that does not exist, prove its absence with all
+// fields empty). Each storage proof is then verified against p.StorageHash.
+//
+// Example:
+//
+//	block, _ := public.GetBlock(ctx, client, public.GetBlockParameters{BlockNumber: &n})
+//	p, _ := public.GetProof(ctx, client, public.GetProofParameters{Address: addr, StorageKeys: keys, BlockNumber: &n})
+//	if err := proof.VerifyAccountProof(block.StateRoot, &p); err != nil {
+//	    // the node returned an invalid proof
+//	}
+func VerifyAccountProof(stateRoot common.Hash, p *types.AccountProof) error {
+	if p == nil {
+		return ErrNilProof
+	}
+
+	value, err := verifyProof(stateRoot, crypto.Keccak256(p.Address.Bytes()), p.AccountProof)
+	if err != nil {
+		return fmt.Errorf("account proof: %w", err)
+	}
+
+	balance := p.Balance
+	if balance == nil {
+		balance = new(big.Int)
+	}
+
+	if value == nil {
+		// Absent account: only an empty account may be claimed.
+		if p.Nonce != 0 || balance.Sign() != 0 ||
+			(p.StorageHash != EmptyRootHash && p.StorageHash != common.Hash{}) ||
+			(p.CodeHash != EmptyCodeHash && p.CodeHash != common.Hash{}) {
+			return fmt.Errorf("%w: account is not in the state trie", ErrAccountMismatch)
+		}
+	} else {
+		expected, encErr := encoding.RlpEncode([]any{
+			new(big.Int).SetUint64(p.Nonce).Bytes(),
+			balance.Bytes(),
+			p.StorageHash.Bytes(),
+			p.CodeHash.Bytes(),
+		})
+		if encErr != nil {
+			return fmt.Errorf("failed to encode account: %w", encErr)
+		}
+		if !bytes.Equal(value, expected) {
+			return ErrAccountMismatch
+		}
+	}
+
+	storageRoot := p.StorageHash
+	if value == nil {
+		// An absent account has no storage, whichever empty hash the node reports.
+		storageRoot = EmptyRootHash
+	}
+	for _, item := range p.StorageProof {
+		if err := VerifyStorageProof(storageRoot, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyStorageProof verifies a single storage proof against a storage root
+// (the StorageHash of an account proof). A zero value is proven by the slot's
+// absence from the trie. Against EmptyRootHash the proof may be empty, as
+// nodes return for every slot of an account without storage.
+func VerifyStorageProof(storageRoot common.Hash, item types.StorageProofItem) error {
+	value, err := verifyProof(storageRoot, crypto.Keccak256(item.Key.Bytes()), item.Proof)
+	if err != nil {
+		return fmt.Errorf("storage proof for slot %s: %w", item.Key.Hex(), err)
+	}
+
+	proven := new(big.Int)
+	if value != nil {
+		decoded, decErr := encoding.RlpDecode(value)
+		if decErr != nil {
+			return fmt.Errorf("storage proof for slot %s: %w: %v", item.Key.Hex(), ErrInvalidProofNode, decErr)
+		}
+		b, ok := decoded.([]byte)
+		if !ok {
+			return fmt.Errorf("storage proof for slot %s: %w: value is a list", item.Key.Hex(), ErrInvalidProofNode)
+		}
+		proven.SetBytes(b)
+	}
+
+	expected := item.Value
+	if expected == nil {
+		expected = new(big.Int)
+	}
+	if proven.Cmp(expected) != 0 {
+		return fmt.Errorf("%w: slot %s proves %s, got %s", ErrStorageValueMismatch, item.Key.Hex(), proven, expected)
+	}
+	return nil
+}
+
+// verifyProof walks a Merkle-Patricia proof for key from root and returns the
+// value stored at key, or nil if the proof shows key is not in the trie.
+func verifyProof(root common.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == EmptyRootHash && len(proof) == 0 {
+		// Nothing is stored in an empty trie, so there is nothing to walk.
+		return nil, nil
+	}
+
+	path := keyToNibbles(key)
+	wantHash := root.Bytes()
+
+	var (
+		embedded []any // set when the next node is inlined in its parent
+		next     int
+	)
+	for {
+		var items []any
+		if embedded != nil {
+			items, embedded = embedded, nil
+		} else {
+			if next >= len(proof) {
+				return nil, ErrProofIncomplete
+			}
+			node := proof[next]
+			next++
+			if !bytes.Equal(crypto.Keccak256(node), wantHash) {
+				return nil, fmt.Errorf("%w at node %d", ErrProofNodeHashMismatch, next-1)
+			}
+			decoded, err := encoding.RlpDecode(node)
+			if err != nil {
+				return nil, fmt.Errorf("%w at node %d: %w", ErrInvalidProofNode, next-1, err)
+			}
+			list, ok := decoded.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%w at node %d: not a list", ErrInvalidProofNode, next-1)
+			}
+			items = list
+		}
+
+		var ref any
+		switch len(items) {
+		case 17:
+			// Branch node
+			if len(path) == 0 {
+				value, ok := items[16].([]byte)
+				if !ok {
+					return nil, ErrInvalidProofNode
+				}
+				if len(value) == 0 {
+					return nil, nil
+				}
+				return value, nil
+			}
+			ref = items[path[0]]
+			path = path[1:]
+		case 2:
+			// Leaf or extension node
+			encodedPath, ok := items[0].([]byte)
+			if !ok || len(encodedPath) == 0 {
+				return nil, ErrInvalidProofNode
+			}
+			nodePath, isLeaf := compactToNibbles(encodedPath)
+			if isLeaf {
+				if !bytes.Equal(nodePath, path) {
+					return nil, nil
+				}
+				value, ok := items[1].([]byte)
+				if !ok {
+					return nil, ErrInvalidProofNode
+				}
+				return value, nil
+			}
+			if !bytes.HasPrefix(path, nodePath) {
+				return nil, nil
+			}
+			path = path[len(nodePath):]
+			ref = items[1]
+		default:
+			return nil, ErrInvalidProofNode
+		}
+
+		switch r := ref.(type) {
+		case []any:
+			embedded = r
+		case []byte:
+			if len(r) == 0 {
+				return nil, nil
+			}
+			if len(r) != common.HashLength {
+				return nil, ErrInvalidProofNode
+			}
+			wantHash = r
+		default:
+			return nil, ErrInvalidProofNode
+		}
+	}
+}
+
+// keyToNibbles splits each byte of key into two 4-bit nibbles.
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, len(key)*2)
+	for _, b := range key {
+		nibbles = append(nibbles, b>>4, b&0x0f)
+	}
+	return nibbles
+}
+
+// compactToNibbles decodes a hex-prefix encoded path into nibbles and reports
+// whether it belongs to a leaf node.
+func compactToNibbles(compact []byte) ([]byte, bool) {
+	flag := compact[0] >> 4
+	nibbles := keyToNibbles(compact)
+	if flag&1 == 1 {
+		// Odd length: the low nibble of the first byte is part of the path.
+		nibbles = nibbles[1:]
+	} else {
+		nibbles = nibbles[2:]
+	}
+	return nibbles, flag&2 == 2
+}
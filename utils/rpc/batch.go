@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 )
@@ -134,10 +135,10 @@ func (s *BatchScheduler) flush() {
 	responses, err := s.client.BatchRequest(s.ctx, bodies)
 
 	// Map responses back to requests
-	responseMap := make(map[any]RPCResponse)
+	responseMap := make(map[string]RPCResponse)
 	if err == nil {
 		for _, resp := range responses {
-			responseMap[resp.ID] = resp
+			responseMap[idKey(resp.ID)] = resp
 		}
 	}
 
@@ -146,10 +147,10 @@ func (s *BatchScheduler) flush() {
 		result := batchResult{}
 		if err != nil {
 			result.err = err
-		} else if resp, ok := responseMap[p.body.ID]; ok {
+		} else if resp, ok := responseMap[idKey(p.body.ID)]; ok {
 			result.resp = &resp
 		} else {
-			result.err = NewHTTPRequestError(s.client.URL(), 0, "", p.body, nil)
+			result.err = fmt.Errorf("%w: no response for request id %s", ErrResponseIDMismatch, idKey(p.body.ID))
 		}
 
 		select {
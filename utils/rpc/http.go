@@ -3,9 +3,11 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
@@ -18,20 +20,47 @@ import (
 type HTTPClientOptions struct {
 	// Timeout is the request timeout.
 	Timeout time.Duration
+	// DialTimeout bounds establishing the TCP connection, so a dead
+	// endpoint fails well before Timeout. Defaults to DefaultDialTimeout.
+	DialTimeout time.Duration
+	// KeepAlive is the TCP keep-alive period of connections.
+	// Defaults to DefaultKeepAlive.
+	KeepAlive time.Duration
+	// MaxIdleConns is the maximum number of idle (keep-alive) connections
+	// kept open. Defaults to DefaultMaxIdleConns.
+	MaxIdleConns int
+	// TLSConfig configures TLS, e.g. custom root CAs or client certificates.
+	TLSConfig *tls.Config
 	// Headers are additional HTTP headers to send with each request.
 	Headers map[string]string
-	// HTTPClient allows providing a custom HTTP client.
+	// HTTPClient allows providing a custom HTTP client. Timeout,
+	// DialTimeout, KeepAlive, MaxIdleConns and TLSConfig are not applied
+	// to a custom client.
 	HTTPClient *http.Client
 	// OnRequest is called before each request is sent.
 	OnRequest func(req *http.Request) error
 	// OnResponse is called after each response is received.
 	OnResponse func(resp *http.Response) error
+	// IDGenerator returns ids for requests sent without one.
+	// Defaults to an atomic counter.
+	IDGenerator IDFunc
 }
 
+// Defaults for the request and connection settings of HTTPClientOptions.
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultDialTimeout  = 10 * time.Second
+	DefaultKeepAlive    = 30 * time.Second
+	DefaultMaxIdleConns = 100
+)
+
 // DefaultHTTPClientOptions returns default options.
 func DefaultHTTPClientOptions() HTTPClientOptions {
 	return HTTPClientOptions{
-		Timeout: 10 * time.Second,
+		Timeout:      DefaultTimeout,
+		DialTimeout:  DefaultDialTimeout,
+		KeepAlive:    DefaultKeepAlive,
+		MaxIdleConns: DefaultMaxIdleConns,
 	}
 }
 
@@ -42,7 +71,7 @@ type HTTPClient struct {
 	httpClient *http.Client
 	onRequest  func(req *http.Request) error
 	onResponse func(resp *http.Response) error
-	idGen      *IDGenerator
+	nextID     IDFunc
 }
 
 // NewHTTPClient creates a new HTTP RPC client.
@@ -68,25 +97,62 @@ func NewHTTPClient(rawURL string, opts ...HTTPClientOptions) (*HTTPClient, error
 	httpClient := opt.HTTPClient
 	if httpClient == nil {
 		httpClient = &http.Client{
-			Timeout: opt.Timeout,
+			Timeout:   opt.Timeout,
+			Transport: newHTTPRoundTripper(opt),
 		}
 	}
 
+	nextID := opt.IDGenerator
+	if nextID == nil {
+		nextID = NewIDGenerator().Func()
+	}
+
 	return &HTTPClient{
 		url:        parsedURL,
 		headers:    allHeaders,
 		httpClient: httpClient,
 		onRequest:  opt.OnRequest,
 		onResponse: opt.OnResponse,
-		idGen:      NewIDGenerator(),
+		nextID:     nextID,
 	}, nil
 }
 
+// newHTTPRoundTripper returns a transport with the dial, keep-alive, idle
+// connection and TLS settings of opt. Zero values use the defaults.
+func newHTTPRoundTripper(opt HTTPClientOptions) *http.Transport {
+	dialTimeout := opt.DialTimeout
+	if dialTimeout <= 0 {
+		dialTimeout = DefaultDialTimeout
+	}
+	keepAlive := opt.KeepAlive
+	if keepAlive == 0 {
+		keepAlive = DefaultKeepAlive
+	}
+	maxIdleConns := opt.MaxIdleConns
+	if maxIdleConns <= 0 {
+		maxIdleConns = DefaultMaxIdleConns
+	}
+
+	rt := http.DefaultTransport.(*http.Transport).Clone()
+	rt.DialContext = (&net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: keepAlive,
+	}).DialContext
+	rt.TLSHandshakeTimeout = dialTimeout
+	rt.MaxIdleConns = maxIdleConns
+	// RPC clients talk to a single host, so allow the whole pool for it.
+	rt.MaxIdleConnsPerHost = maxIdleConns
+	if opt.TLSConfig != nil {
+		rt.TLSClientConfig = opt.TLSConfig.Clone()
+	}
+	return rt
+}
+
 // Request sends a single JSON-RPC request.
 func (c *HTTPClient) Request(ctx context.Context, body RPCRequest) (*RPCResponse, error) {
 	// Ensure request has an ID
 	if body.ID == nil {
-		body.ID = c.idGen.Next()
+		body.ID = c.nextID()
 	}
 	if body.JSONRPC == "" {
 		body.JSONRPC = "2.0"
@@ -101,6 +167,9 @@ func (c *HTTPClient) Request(ctx context.Context, body RPCRequest) (*RPCResponse
 	if len(responses) == 0 {
 		return nil, fmt.Errorf("empty response")
 	}
+	if err := checkResponseID(body.ID, responses[0]); err != nil {
+		return nil, err
+	}
 
 	return &responses[0], nil
 }
@@ -110,13 +179,23 @@ func (c *HTTPClient) BatchRequest(ctx context.Context, bodies []RPCRequest) ([]R
 	// Ensure all requests have IDs
 	for i := range bodies {
 		if bodies[i].ID == nil {
-			bodies[i].ID = c.idGen.Next()
+			bodies[i].ID = c.nextID()
 		}
 		if bodies[i].JSONRPC == "" {
 			bodies[i].JSONRPC = "2.0"
 		}
 	}
 
+	// Reject duplicate ids, since responses are matched to requests by id
+	seen := make(map[string]struct{}, len(bodies))
+	for _, body := range bodies {
+		key := idKey(body.ID)
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateRequestID, key)
+		}
+		seen[key] = struct{}{}
+	}
+
 	return c.doRequest(ctx, bodies)
 }
 
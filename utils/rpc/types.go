@@ -16,6 +16,12 @@ var (
 	ErrSocketClosed = errors.New("socket is closed")
 	// ErrTimeout is returned when a request times out.
 	ErrTimeout = errors.New("request timeout")
+	// ErrResponseIDMismatch is returned when a response id does not match the
+	// id of the request it answers (e.g. a misbehaving load balancer).
+	ErrResponseIDMismatch = errors.New("response id does not match request id")
+	// ErrDuplicateRequestID is returned when a request is sent with the id of
+	// a request that is still in flight.
+	ErrDuplicateRequestID = errors.New("duplicate request id")
 )
 
 // RPCRequest represents a JSON-RPC request.
@@ -202,6 +208,41 @@ func (g *IDGenerator) Next() uint64 {
 	return atomic.AddUint64(&g.counter, 1)
 }
 
+// IDFunc returns the id for an outgoing JSON-RPC request. Implementations
+// must be safe for concurrent use and should not repeat ids.
+type IDFunc func() any
+
+// Func returns the generator as an IDFunc.
+func (g *IDGenerator) Func() IDFunc {
+	return func() any { return g.Next() }
+}
+
+// IDsEqual reports whether two JSON-RPC ids are equal. Ids are compared by
+// their JSON encoding, so a uint64 request id matches the float64 it decodes
+// to in a response.
+func IDsEqual(a, b any) bool {
+	return idKey(a) == idKey(b)
+}
+
+// idKey returns a canonical map key for a JSON-RPC id.
+func idKey(id any) string {
+	b, err := json.Marshal(id)
+	if err != nil {
+		return fmt.Sprint(id)
+	}
+	return string(b)
+}
+
+// checkResponseID returns ErrResponseIDMismatch if resp does not answer a
+// request with the given id. Error responses with a null id are let through
+// so the server's error is surfaced.
+func checkResponseID(id any, resp RPCResponse) error {
+	if IDsEqual(id, resp.ID) || (resp.ID == nil && resp.Error != nil) {
+		return nil
+	}
+	return fmt.Errorf("%w: sent %s, received %s", ErrResponseIDMismatch, idKey(id), idKey(resp.ID))
+}
+
 // Global ID generator instance.
 var globalIDGenerator = NewIDGenerator()
 
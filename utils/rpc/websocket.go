@@ -3,6 +3,7 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"sync"
 	"time"
 
@@ -17,6 +18,9 @@ type WebSocketClientOptions struct {
 	KeepAlive *KeepAliveConfig
 	// Reconnect enables automatic reconnection.
 	Reconnect *ReconnectConfig
+	// IDGenerator returns ids for requests sent without one.
+	// Defaults to an atomic counter.
+	IDGenerator IDFunc
 }
 
 // KeepAliveConfig contains keep-alive configuration.
@@ -76,8 +80,8 @@ type WebSocketClient struct {
 	dialer        *websocket.Dialer
 	keepAlive     *KeepAliveConfig
 	reconnect     *ReconnectConfig
-	idGen         *IDGenerator
-	requests      map[any]*callbackFn
+	nextID        IDFunc
+	requests      map[string]*callbackFn
 	subscriptions map[string]*callbackFn
 	mu            sync.RWMutex
 	closed        bool
@@ -94,13 +98,18 @@ func NewWebSocketClient(url string, opts ...WebSocketClientOptions) (*WebSocketC
 		opt = opts[0]
 	}
 
+	nextID := opt.IDGenerator
+	if nextID == nil {
+		nextID = NewIDGenerator().Func()
+	}
+
 	client := &WebSocketClient{
 		url:           url,
 		dialer:        websocket.DefaultDialer,
 		keepAlive:     opt.KeepAlive,
 		reconnect:     opt.Reconnect,
-		idGen:         NewIDGenerator(),
-		requests:      make(map[any]*callbackFn),
+		nextID:        nextID,
+		requests:      make(map[string]*callbackFn),
 		subscriptions: make(map[string]*callbackFn),
 		closeCh:       make(chan struct{}),
 	}
@@ -175,11 +184,11 @@ func (c *WebSocketClient) handleMessages() {
 
 // handleResponse processes a received response.
 func (c *WebSocketClient) handleResponse(resp RPCResponse) {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
-
 	// Check if it's a subscription notification
 	if resp.Method == "eth_subscription" && resp.Params != nil {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+
 		subID := resp.Params.Subscription
 		if callback, ok := c.subscriptions[subID]; ok {
 			callback.onResponse(resp)
@@ -187,11 +196,36 @@ func (c *WebSocketClient) handleResponse(resp RPCResponse) {
 		return
 	}
 
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	// Regular request response
-	if callback, ok := c.requests[resp.ID]; ok {
+	key := idKey(resp.ID)
+	if callback, ok := c.requests[key]; ok {
 		callback.onResponse(resp)
-		delete(c.requests, resp.ID)
+		delete(c.requests, key)
+		return
 	}
+
+	// A null id answers a request the server could not parse (a JSON-RPC
+	// parse error). It can only be attributed when one request is in flight.
+	if resp.ID == nil && len(c.requests) == 1 {
+		for pendingKey, callback := range c.requests {
+			if err := checkResponseID(callback.body.ID, resp); err != nil {
+				if callback.onError != nil {
+					callback.onError(err)
+				}
+			} else {
+				callback.onResponse(resp)
+			}
+			delete(c.requests, pendingKey)
+		}
+		return
+	}
+
+	// Any other unknown id is a late response to a request that already
+	// timed out (or a misbehaving server); it must not fail unrelated requests.
+	slog.Warn("dropping websocket response with unknown id", slog.String("url", c.url), slog.String("id", key))
 }
 
 // handleError processes connection errors.
@@ -358,7 +392,7 @@ func (c *WebSocketClient) Request(
 
 	// Ensure request has an ID
 	if body.ID == nil {
-		body.ID = c.idGen.Next()
+		body.ID = c.nextID()
 	}
 	if body.JSONRPC == "" {
 		body.JSONRPC = "2.0"
@@ -371,15 +405,20 @@ func (c *WebSocketClient) Request(
 		body:       &body,
 	}
 
+	key := idKey(body.ID)
 	c.mu.Lock()
-	c.requests[body.ID] = callback
+	if _, exists := c.requests[key]; exists {
+		c.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrDuplicateRequestID, key)
+	}
+	c.requests[key] = callback
 	c.mu.Unlock()
 
 	// Marshal and send
 	data, err := json.Marshal(body)
 	if err != nil {
 		c.mu.Lock()
-		delete(c.requests, body.ID)
+		delete(c.requests, key)
 		c.mu.Unlock()
 		return err
 	}
@@ -390,7 +429,7 @@ func (c *WebSocketClient) Request(
 
 	if err != nil {
 		c.mu.Lock()
-		delete(c.requests, body.ID)
+		delete(c.requests, key)
 		c.mu.Unlock()
 		return NewWebSocketRequestError(c.url, body, err)
 	}
@@ -400,6 +439,10 @@ func (c *WebSocketClient) Request(
 
 // RequestAsync sends a request and waits for the response.
 func (c *WebSocketClient) RequestAsync(ctx context.Context, body RPCRequest, timeout time.Duration) (*RPCResponse, error) {
+	if body.ID == nil {
+		body.ID = c.nextID()
+	}
+
 	respCh := make(chan RPCResponse, 1)
 	errCh := make(chan error, 1)
 
@@ -423,6 +466,10 @@ func (c *WebSocketClient) RequestAsync(ctx context.Context, body RPCRequest, tim
 	case err := <-errCh:
 		return nil, err
 	case <-timeoutCtx.Done():
+		// Stop tracking the request so a late response is not misattributed
+		c.mu.Lock()
+		delete(c.requests, idKey(body.ID))
+		c.mu.Unlock()
 		return nil, NewTimeoutError(c.url, body)
 	}
 }
@@ -435,7 +482,7 @@ func (c *WebSocketClient) Subscribe(
 ) (*Subscription, error) {
 	body := RPCRequest{
 		JSONRPC: "2.0",
-		ID:      c.idGen.Next(),
+		ID:      c.nextID(),
 		Method:  "eth_subscribe",
 		Params:  params,
 	}
@@ -506,7 +553,7 @@ func (c *WebSocketClient) Unsubscribe(subscriptionID string) error {
 
 	body := RPCRequest{
 		JSONRPC: "2.0",
-		ID:      c.idGen.Next(),
+		ID:      c.nextID(),
 		Method:  "eth_unsubscribe",
 		Params:  []any{subscriptionID},
 	}
@@ -0,0 +1,65 @@
+package signature
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrNonCanonicalSignature is returned when a signature's s value is in the
+	// upper half of the curve order and cannot be represented in compact form.
+	ErrNonCanonicalSignature = errors.New("non-canonical signature: s is in the upper half of the curve order")
+
+	// secp256k1HalfN is the secp256k1 curve order divided by two.
+	secp256k1HalfN, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a0", 16)
+)
+
+// ToCompact converts a 65-byte hex signature to a 64-byte EIP-2098 compact
+// signature by packing yParity into the top bit of s.
+// https://eips.ethereum.org/EIPS/eip-2098
+//
+// Signatures with a high s value are rejected, since the top bit of s would
+// collide with yParity.
+//
+// Example:
+//
+//	compact, err := ToCompact("0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bf4a90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db81c")
+//	// "0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bfca90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db8"
+func ToCompact(sig string) (string, error) {
+	parsed, err := ParseSignature(sig)
+	if err != nil {
+		return "", err
+	}
+
+	s := new(big.Int).SetBytes(hexToBytes(parsed.S))
+	if s.Cmp(secp256k1HalfN) > 0 {
+		return "", ErrNonCanonicalSignature
+	}
+
+	compact, err := SignatureToCompactSignature(parsed)
+	if err != nil {
+		return "", err
+	}
+	return SerializeCompactSignature(compact)
+}
+
+// FromCompact expands a 64-byte EIP-2098 compact signature into a 65-byte hex
+// signature with v set to 27 or 28.
+// https://eips.ethereum.org/EIPS/eip-2098
+//
+// Example:
+//
+//	sig, err := FromCompact("0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bfca90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db8")
+//	// "0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bf4a90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db81c"
+func FromCompact(compact string) (string, error) {
+	parsed, err := ParseCompactSignature(compact)
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := CompactSignatureToSignature(parsed)
+	if err != nil {
+		return "", err
+	}
+	return SerializeSignature(sig)
+}
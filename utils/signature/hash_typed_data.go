@@ -3,6 +3,7 @@ package signature
 import (
 	"fmt"
 	"math/big"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -48,6 +49,10 @@ import (
 //		},
 //	})
 func HashTypedData(data TypedDataDefinition) (string, error) {
+	if err := ValidateTypedDataDomain(data.Domain); err != nil {
+		return "", err
+	}
+
 	// Get domain types
 	domainTypes := getTypesForEIP712Domain(data.Domain)
 
@@ -84,6 +89,10 @@ func HashTypedData(data TypedDataDefinition) (string, error) {
 
 // HashDomain hashes just the EIP-712 domain.
 func HashDomain(domain TypedDataDomain) (string, error) {
+	if err := ValidateTypedDataDomain(domain); err != nil {
+		return "", err
+	}
+
 	types := map[string][]TypedDataField{
 		"EIP712Domain": getTypesForEIP712Domain(domain),
 	}
@@ -264,6 +273,17 @@ func encodeAbiValue(fieldType string, value any) ([]byte, error) {
 		return nil, err
 	}
 
+	// Fixed-size bytes must be packed as a [N]byte array
+	if abiType.T == abi.FixedBytesTy {
+		b, _ := converted.([]byte)
+		if len(b) != abiType.Size {
+			return nil, fmt.Errorf("expected %d bytes for type %s, got %d", abiType.Size, fieldType, len(b))
+		}
+		array := reflect.New(abiType.GetType()).Elem()
+		reflect.Copy(array, reflect.ValueOf(b))
+		converted = array.Interface()
+	}
+
 	return args.Pack(converted)
 }
 
@@ -5,6 +5,9 @@ import (
 )
 
 // RecoverAddress recovers the Ethereum address from a hash and signature.
+// The hash is used as-is (no EIP-191 prefix), and the signature may be a
+// 65-byte signature, a 64-byte EIP-2098 compact signature, or a structured
+// *Signature / *CompactSignature.
 //
 // Example:
 //
@@ -3,6 +3,7 @@ package signature
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -39,7 +40,7 @@ func RecoverPublicKey(hash string, signature any) (string, error) {
 	// Recover public key using go-ethereum's crypto package
 	pubKey, err := crypto.SigToPub(hashBytes, sigBytes)
 	if err != nil {
-		return "", fmt.Errorf("%w: %v", ErrRecoveryFailed, err)
+		return "", fmt.Errorf("%w: %w", ErrRecoveryFailed, err)
 	}
 
 	// Convert to uncompressed public key bytes (65 bytes with 04 prefix)
@@ -66,23 +67,48 @@ func signatureToRecoveryBytes(signature any) ([]byte, error) {
 		return parseSignatureToRecoveryBytes(sig)
 
 	case []byte:
-		// Raw bytes
-		if len(sig) != 65 {
-			return nil, fmt.Errorf("%w: expected 65 bytes, got %d", ErrInvalidSignatureLength, len(sig))
+		// Raw bytes: 65-byte r||s||v or 64-byte EIP-2098 compact
+		switch len(sig) {
+		case 65:
+			return convertToRecoveryFormat(sig), nil
+		case 64:
+			return parseSignatureToRecoveryBytes(bytesToHex(sig))
+		default:
+			return nil, fmt.Errorf("%w: expected 64 or 65 bytes, got %d", ErrInvalidSignatureLength, len(sig))
 		}
-		return convertToRecoveryFormat(sig), nil
 
 	case *Signature:
 		// Structured signature
 		return structuredSignatureToBytes(sig)
 
+	case *CompactSignature:
+		// EIP-2098 compact signature
+		full, err := CompactSignatureToSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		return structuredSignatureToBytes(full)
+
 	default:
 		return nil, errors.New("unsupported signature type")
 	}
 }
 
 // parseSignatureToRecoveryBytes parses a hex signature string to recovery bytes.
+// Both 65-byte signatures and 64-byte EIP-2098 compact signatures are accepted.
 func parseSignatureToRecoveryBytes(signatureHex string) ([]byte, error) {
+	if len(strings.TrimPrefix(strings.TrimPrefix(signatureHex, "0x"), "0X")) == 128 {
+		compact, err := ParseCompactSignature(signatureHex)
+		if err != nil {
+			return nil, err
+		}
+		full, err := CompactSignatureToSignature(compact)
+		if err != nil {
+			return nil, err
+		}
+		return structuredSignatureToBytes(full)
+	}
+
 	sig, err := ParseSignature(signatureHex)
 	if err != nil {
 		return nil, err
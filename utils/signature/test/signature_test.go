@@ -3,6 +3,9 @@ package test
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -108,6 +111,111 @@ var _ = Describe("Signature", func() {
 		})
 	})
 
+	Describe("ToCompact", func() {
+		const (
+			sig     = "0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bf4a90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db81c"
+			compact = "0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bfca90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db8"
+		)
+
+		It("should round-trip a 65-byte signature through compact form", func() {
+			c, err := signature.ToCompact(sig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c).To(Equal(compact))
+
+			full, err := signature.FromCompact(c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(full).To(Equal(sig))
+		})
+
+		It("should keep yParity 0 out of the top bit", func() {
+			low := sig[:len(sig)-2] + "1b"
+			c, err := signature.ToCompact(low)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(c[66:68]).To(Equal("4a"))
+
+			full, err := signature.FromCompact(c)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(full).To(Equal(low))
+		})
+
+		It("should reject a high-s signature", func() {
+			highS := "0x6e100a352ec6ad1b70802290e18aeed190704973570f3b8ed42cb9808e2ea6bf" +
+				"ff90a229a244495b41890987806fcbd2d5d23fc0dbe5f5256c2613c039d76db8" + "1c"
+			_, err := signature.ToCompact(highS)
+			Expect(err).To(MatchError(signature.ErrNonCanonicalSignature))
+		})
+
+		It("should reject inputs with the wrong length", func() {
+			_, err := signature.ToCompact(compact)
+			Expect(err).To(MatchError(signature.ErrInvalidSignatureLength))
+
+			_, err = signature.FromCompact(sig)
+			Expect(err).To(MatchError(signature.ErrInvalidSignatureLength))
+		})
+	})
+
+	Describe("VerifyHash", func() {
+		const (
+			// keccak256("order:42") signed by the first anvil account
+			signer = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+			hash   = "0x587e78da853891da467df1037d50fa3f69df3c9bb6aea29d1b9f917f5e3ef190"
+			sig    = "0x9b861de9bb7f3ab1e936ef249fce2c0b4ebdc679a0080c5572c107d63d7a9d8d2a12d290a03af7d411ddafee0b7a1d77a158b10e340f105f0a60a6fe6c324e6b1b"
+		)
+
+		compactSig := func() string {
+			parsed, err := signature.ParseSignature(sig)
+			Expect(err).NotTo(HaveOccurred())
+			compact, err := signature.SignatureToCompactSignature(parsed)
+			Expect(err).NotTo(HaveOccurred())
+			serialized, err := signature.SerializeCompactSignature(compact)
+			Expect(err).NotTo(HaveOccurred())
+			return serialized
+		}
+
+		It("should recover the signer from a raw hash", func() {
+			address, err := signature.RecoverAddress(hash, sig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal(signer))
+		})
+
+		It("should recover the signer from a compact signature", func() {
+			compact := compactSig()
+			Expect(compact).To(HaveLen(130))
+
+			address, err := signature.RecoverAddress(hash, compact)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal(signer))
+
+			valid, err := signature.VerifyHash(signer, hash, compact)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(valid).To(BeTrue())
+		})
+
+		It("should verify a valid signature", func() {
+			valid, err := signature.VerifyHash(signer, hash, sig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(valid).To(BeTrue())
+		})
+
+		It("should reject a tampered hash", func() {
+			tampered := "0x587e78da853891da467df1037d50fa3f69df3c9bb6aea29d1b9f917f5e3ef191"
+			valid, err := signature.VerifyHash(signer, tampered, sig)
+			if err == nil {
+				Expect(valid).To(BeFalse())
+			}
+
+			valid, err = signature.VerifyHash(signer, tampered, compactSig())
+			if err == nil {
+				Expect(valid).To(BeFalse())
+			}
+		})
+
+		It("should reject signatures with an invalid length", func() {
+			_, err := signature.RecoverAddress(hash, "0x1234")
+			Expect(err).To(MatchError(signature.ErrInvalidSignatureLength))
+		})
+	})
+
 	Describe("IsErc6492Signature", func() {
 		It("should detect ERC-6492 signature", func() {
 			erc6492Sig := "0x000000000000000000000000cafebabecafebabecafebabecafebabecafebabe000000000000000000000000000000000000000000000000000000000000006000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000004deadbeef000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000041a461f509887bd19e312c0c58467ce8ff8e300d3c1a90b608a760c5b80318eaf15fe57c96f9175d6cd4daad4663763baa7e78836e067d0163e9a2ccf2ff753f5b1b000000000000000000000000000000000000000000000000000000000000006492649264926492649264926492649264926492649264926492649264926492"
@@ -161,6 +269,54 @@ var _ = Describe("Signature", func() {
 		})
 	})
 
+	Describe("ValidateTypedDataDomain", func() {
+		salt := "0x0000000000000000000000000000000000000000000000000000000000000001"
+
+		It("should accept a salt-only domain", func() {
+			Expect(signature.ValidateTypedDataDomain(signature.TypedDataDomain{Salt: salt})).To(Succeed())
+		})
+
+		It("should reject a malformed salt", func() {
+			for _, bad := range []string{"0x01", "0x" + "zz" + salt[4:], salt[2:]} {
+				err := signature.ValidateTypedDataDomain(signature.TypedDataDomain{Salt: bad})
+				var fieldErr *signature.InvalidDomainFieldError
+				Expect(err).To(BeAssignableToTypeOf(fieldErr))
+				Expect(err.(*signature.InvalidDomainFieldError).Field).To(Equal("salt"))
+			}
+		})
+
+		It("should reject an invalid verifying contract", func() {
+			err := signature.ValidateTypedDataDomain(signature.TypedDataDomain{
+				VerifyingContract: "CcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+			})
+			Expect(err).To(MatchError(ContainSubstring("invalid verifying contract address")))
+			Expect(err.(*signature.InvalidDomainFieldError).Field).To(Equal("verifyingContract"))
+		})
+
+		It("should reject a negative chain id", func() {
+			err := signature.ValidateTypedDataDomain(signature.TypedDataDomain{ChainId: big.NewInt(-1)})
+			Expect(err.(*signature.InvalidDomainFieldError).Field).To(Equal("chainId"))
+		})
+	})
+
+	Describe("HashDomain", func() {
+		It("should hash a salt-only domain", func() {
+			salt := common.HexToHash("0x0000000000000000000000000000000000000000000000000000000000000001")
+
+			hash, err := signature.HashDomain(signature.TypedDataDomain{Salt: salt.Hex()})
+			Expect(err).NotTo(HaveOccurred())
+
+			typeHash := crypto.Keccak256([]byte("EIP712Domain(bytes32 salt)"))
+			expected := crypto.Keccak256(append(typeHash, salt.Bytes()...))
+			Expect(hash).To(Equal(hexutil.Encode(expected)))
+		})
+
+		It("should reject a malformed salt", func() {
+			_, err := signature.HashDomain(signature.TypedDataDomain{Salt: "0x1234"})
+			Expect(err).To(MatchError(ContainSubstring("invalid domain salt")))
+		})
+	})
+
 	Describe("EncodeType", func() {
 		It("should encode type string correctly", func() {
 			types := map[string][]signature.TypedDataField{
@@ -0,0 +1,93 @@
+package signature
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// InvalidDomainFieldError is returned when a field of an EIP-712 domain is
+// malformed.
+type InvalidDomainFieldError struct {
+	// Field is the domain field name ("name", "version", "chainId",
+	// "verifyingContract" or "salt").
+	Field string
+	// Value is the invalid value.
+	Value string
+	// Reason describes why the value is invalid.
+	Reason string
+}
+
+func (e *InvalidDomainFieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %s (%s)", domainFieldLabels[e.Field], e.Value, e.Reason)
+}
+
+var domainFieldLabels = map[string]string{
+	"name":              "domain name",
+	"version":           "domain version",
+	"chainId":           "domain chain id",
+	"verifyingContract": "verifying contract address",
+	"salt":              "domain salt",
+}
+
+// maxUint256 is the largest value a uint256 chainId can hold.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// ValidateTypedDataDomain checks that each set field of an EIP-712 domain is
+// well formed. Unset fields are not part of the domain and are skipped.
+//
+// Returns *InvalidDomainFieldError for the first malformed field:
+//   - name and version must be valid UTF-8
+//   - chainId must fit in a uint256
+//   - verifyingContract must be a 20-byte hex address
+//   - salt must be a 32-byte hex string
+//
+// Example:
+//
+//	err := signature.ValidateTypedDataDomain(signature.TypedDataDomain{
+//		Name: "Dai Stablecoin",
+//		Salt: "0x0000000000000000000000000000000000000000000000000000000000000001",
+//	})
+func ValidateTypedDataDomain(domain TypedDataDomain) error {
+	if !utf8.ValidString(domain.Name) {
+		return &InvalidDomainFieldError{Field: "name", Value: domain.Name, Reason: "not valid UTF-8"}
+	}
+	if !utf8.ValidString(domain.Version) {
+		return &InvalidDomainFieldError{Field: "version", Value: domain.Version, Reason: "not valid UTF-8"}
+	}
+	if domain.ChainId != nil && (domain.ChainId.Sign() < 0 || domain.ChainId.Cmp(maxUint256) > 0) {
+		return &InvalidDomainFieldError{Field: "chainId", Value: domain.ChainId.String(), Reason: "out of uint256 range"}
+	}
+	if domain.VerifyingContract != "" && !isPrefixedHexAddress(domain.VerifyingContract) {
+		return &InvalidDomainFieldError{Field: "verifyingContract", Value: domain.VerifyingContract, Reason: "not a valid address"}
+	}
+	if domain.Salt != "" && !isBytes32Hex(domain.Salt) {
+		return &InvalidDomainFieldError{Field: "salt", Value: domain.Salt, Reason: "not a 32-byte hex string"}
+	}
+	return nil
+}
+
+// isPrefixedHexAddress reports whether s is a 0x-prefixed 20-byte hex address.
+func isPrefixedHexAddress(s string) bool {
+	return (strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X")) && common.IsHexAddress(s)
+}
+
+// isBytes32Hex reports whether s is "0x" followed by exactly 64 hex digits.
+func isBytes32Hex(s string) bool {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return false
+	}
+	s = s[2:]
+	if len(s) != 64 {
+		return false
+	}
+	for _, c := range s {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (c < 'A' || c > 'F') {
+			return false
+		}
+	}
+	return true
+}
@@ -5,6 +5,9 @@ import (
 )
 
 // VerifyHash verifies that a hash was signed by the provided address.
+// Unlike VerifyMessage, the hash is not prefixed before recovery, which makes
+// it suitable for meta-transactions and order hashes. Compact (EIP-2098)
+// signatures are accepted.
 //
 // Note: Only supports Externally Owned Accounts. Does not support Contract Accounts.
 //
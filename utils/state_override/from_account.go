@@ -0,0 +1,147 @@
+package stateoverride
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// Client is the RPC client used by FromAccount. Public and wallet clients
+// satisfy it.
+type Client interface {
+	Request(ctx context.Context, method string, params ...any) (*transport.RPCResponse, error)
+}
+
+// Option modifies an account state override.
+type Option func(*types.StateOverrideAccount)
+
+// WithBalance overrides the account balance.
+func WithBalance(balance *big.Int) Option {
+	return func(account *types.StateOverrideAccount) {
+		account.Balance = balance
+	}
+}
+
+// WithNonce overrides the account nonce.
+func WithNonce(nonce uint64) Option {
+	return func(account *types.StateOverrideAccount) {
+		account.Nonce = &nonce
+	}
+}
+
+// WithCode overrides the account bytecode.
+func WithCode(code []byte) Option {
+	return func(account *types.StateOverrideAccount) {
+		account.Code = code
+	}
+}
+
+// WithStorage overrides a single storage slot, leaving the rest of the
+// account's storage as it is (a stateDiff entry). Setting the same slot again
+// replaces the earlier value.
+func WithStorage(slot, value common.Hash) Option {
+	return func(account *types.StateOverrideAccount) {
+		for i, entry := range account.StateDiff {
+			if entry.Slot == slot {
+				account.StateDiff[i].Value = value
+				return
+			}
+		}
+		account.StateDiff = append(account.StateDiff, types.StateMappingEntry{Slot: slot, Value: value})
+	}
+}
+
+// Account builds an account state override from options.
+//
+// Example:
+//
+//	override := types.StateOverride{
+//	    token: stateoverride.Account(
+//	        stateoverride.WithStorage(balanceSlot, common.BigToHash(amount)),
+//	    ),
+//	}
+func Account(opts ...Option) types.StateOverrideAccount {
+	var account types.StateOverrideAccount
+	for _, opt := range opts {
+		opt(&account)
+	}
+	return account
+}
+
+// FromAccount returns an account state override holding the balance, nonce
+// and code of addr at blockTag (default: latest), with opts applied on top.
+// Assign it to another address to simulate a call against a copy of addr.
+// Code is left unset when addr has none.
+//
+// Example:
+//
+//	copied, err := stateoverride.FromAccount(ctx, client, deployed, types.BlockTagLatest,
+//	    stateoverride.WithStorage(ownerSlot, common.BytesToHash(me.Bytes())),
+//	)
+//	result, err := public.Call(ctx, client, public.CallParameters{
+//	    To:            &target,
+//	    Data:          calldata,
+//	    StateOverride: types.StateOverride{target: copied},
+//	})
+func FromAccount(ctx context.Context, client Client, addr common.Address, blockTag types.BlockTag, opts ...Option) (types.StateOverrideAccount, error) {
+	if blockTag == "" {
+		blockTag = types.BlockTagLatest
+	}
+
+	balance, err := requestHex(ctx, client, "eth_getBalance", addr, blockTag)
+	if err != nil {
+		return types.StateOverrideAccount{}, err
+	}
+	nonce, err := requestHex(ctx, client, "eth_getTransactionCount", addr, blockTag)
+	if err != nil {
+		return types.StateOverrideAccount{}, err
+	}
+	code, err := requestHex(ctx, client, "eth_getCode", addr, blockTag)
+	if err != nil {
+		return types.StateOverrideAccount{}, err
+	}
+
+	account := types.StateOverrideAccount{
+		Balance: new(big.Int).SetBytes(balance),
+		Nonce:   new(uint64),
+	}
+	*account.Nonce = new(big.Int).SetBytes(nonce).Uint64()
+	if len(code) > 0 {
+		account.Code = code
+	}
+
+	for _, opt := range opts {
+		opt(&account)
+	}
+	return account, nil
+}
+
+// requestHex calls an account method and decodes its hex result to bytes.
+func requestHex(ctx context.Context, client Client, method string, addr common.Address, blockTag types.BlockTag) ([]byte, error) {
+	resp, err := client.Request(ctx, method, addr.Hex(), string(blockTag))
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	var hexValue string
+	if err := json.Unmarshal(resp.Result, &hexValue); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s result: %w", method, err)
+	}
+	// Quantities may have an odd number of digits, e.g. "0x1"
+	if len(hexValue)%2 == 1 {
+		hexValue = "0x0" + hexValue[2:]
+	}
+	value, err := hexutil.Decode(hexValue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s result: %w", method, err)
+	}
+	return value, nil
+}
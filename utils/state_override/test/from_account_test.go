@@ -0,0 +1,116 @@
+package stateoverride_test
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	json "github.com/goccy/go-json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/client"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/types"
+	stateoverride "github.com/ChefBingbong/viem-go/utils/state_override"
+)
+
+var (
+	sourceAddr = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	targetAddr = common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+)
+
+// accountServer serves eth_getBalance, eth_getTransactionCount and
+// eth_getCode for sourceAddr and records the block requested.
+func accountServer(t *testing.T, blocks *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     any    `json:"id"`
+			Method string `json:"method"`
+			Params []any  `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, sourceAddr, common.HexToAddress(req.Params[0].(string)))
+		*blocks = append(*blocks, req.Params[1].(string))
+
+		var result any
+		switch req.Method {
+		case "eth_getBalance":
+			result = "0xde0b6b3a7640000" // 1 ether
+		case "eth_getTransactionCount":
+			result = "0x5"
+		case "eth_getCode":
+			result = "0x6080604052"
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": req.ID, "result": result})
+	}))
+}
+
+func TestFromAccount(t *testing.T) {
+	var blocks []string
+	server := accountServer(t, &blocks)
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+	require.NoError(t, err)
+	defer c.Close()
+
+	slot := common.HexToHash("0x01")
+	value := common.HexToHash("0x2a")
+	copied, err := stateoverride.FromAccount(context.Background(), c, sourceAddr, "0x10",
+		stateoverride.WithStorage(slot, value),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, big.NewInt(1e18), copied.Balance)
+	require.NotNil(t, copied.Nonce)
+	assert.Equal(t, uint64(5), *copied.Nonce)
+	assert.Equal(t, common.FromHex("0x6080604052"), copied.Code)
+	assert.Equal(t, types.StateMapping{{Slot: slot, Value: value}}, copied.StateDiff)
+	assert.Equal(t, []string{"0x10", "0x10", "0x10"}, blocks)
+
+	// The copy serializes as an override of another address
+	rpcOverride, err := stateoverride.SerializeStateOverride(types.StateOverride{targetAddr: copied})
+	require.NoError(t, err)
+	assert.Equal(t, types.RpcAccountStateOverride{
+		Balance:   "0xde0b6b3a7640000",
+		Nonce:     "0x5",
+		Code:      "0x6080604052",
+		StateDiff: types.RpcStateMapping{slot.Hex(): value.Hex()},
+	}, rpcOverride[targetAddr.Hex()])
+}
+
+func TestFromAccount_DefaultsToLatest(t *testing.T) {
+	var blocks []string
+	server := accountServer(t, &blocks)
+	defer server.Close()
+
+	c, err := client.CreatePublicClient(client.PublicClientConfig{Transport: transport.HTTP(server.URL)})
+	require.NoError(t, err)
+	defer c.Close()
+
+	_, err = stateoverride.FromAccount(context.Background(), c, sourceAddr, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"latest", "latest", "latest"}, blocks)
+}
+
+func TestAccount(t *testing.T) {
+	slot := common.HexToHash("0x01")
+	account := stateoverride.Account(
+		stateoverride.WithBalance(big.NewInt(100)),
+		stateoverride.WithNonce(3),
+		stateoverride.WithCode([]byte{0x60, 0x00}),
+		stateoverride.WithStorage(slot, common.HexToHash("0x01")),
+		stateoverride.WithStorage(slot, common.HexToHash("0x02")),
+	)
+
+	assert.Equal(t, big.NewInt(100), account.Balance)
+	assert.Equal(t, uint64(3), *account.Nonce)
+	assert.Equal(t, []byte{0x60, 0x00}, account.Code)
+	assert.Equal(t, types.StateMapping{{Slot: slot, Value: common.HexToHash("0x02")}}, account.StateDiff)
+}
@@ -7,18 +7,40 @@ import (
 
 // AssertTransactionEIP7702 validates an EIP-7702 transaction.
 func AssertTransactionEIP7702(tx *Transaction) error {
-	// Validate authorization list
-	for _, auth := range tx.AuthorizationList {
+	if len(tx.AuthorizationList) == 0 {
+		return ErrEmptyAuthorizationList
+	}
+	if err := AssertAuthorizationList(tx.AuthorizationList, tx.ChainId); err != nil {
+		return err
+	}
+	for i, auth := range tx.AuthorizationList {
+		if auth.R == "" || auth.S == "" {
+			return fmt.Errorf("%w: authorization %d is not signed", ErrInvalidSerializableTransaction, i)
+		}
+	}
+
+	// Also validate as EIP-1559
+	return AssertTransactionEIP1559(tx)
+}
+
+// AssertAuthorizationList validates the authorizations of an EIP-7702
+// transaction. Each authorization must have a valid address and a chain ID
+// that is either 0 (valid on any chain) or equal to chainId. A chainId of 0
+// skips the chain check.
+func AssertAuthorizationList(authList []SignedAuthorization, chainId int) error {
+	for i, auth := range authList {
 		if !isValidAddress(auth.Address) {
 			return fmt.Errorf("%w: %s", ErrInvalidAddress, auth.Address)
 		}
 		if auth.ChainId < 0 {
 			return fmt.Errorf("%w: %d", ErrInvalidChainId, auth.ChainId)
 		}
+		if chainId > 0 && auth.ChainId != 0 && auth.ChainId != chainId {
+			return fmt.Errorf("%w: authorization %d is for chain %d, transaction is for chain %d",
+				ErrInvalidChainId, i, auth.ChainId, chainId)
+		}
 	}
-
-	// Also validate as EIP-1559
-	return AssertTransactionEIP1559(tx)
+	return nil
 }
 
 // AssertTransactionEIP4844 validates an EIP-4844 (blob) transaction.
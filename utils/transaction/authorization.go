@@ -0,0 +1,41 @@
+package transaction
+
+import (
+	"github.com/ChefBingbong/viem-go/types"
+)
+
+// NewSignedAuthorizationList converts signed authorizations, as returned by
+// an account's SignAuthorization, into the form used by Transaction.
+//
+// Example:
+//
+//	auth, err := account.SignAuthorization(types.AuthorizationRequest{...})
+//	tx.AuthorizationList = NewSignedAuthorizationList(*auth)
+func NewSignedAuthorizationList(auths ...types.SignedAuthorization) []SignedAuthorization {
+	result := make([]SignedAuthorization, len(auths))
+	for i, auth := range auths {
+		yParity := auth.YParity
+		if auth.V != nil {
+			switch v := auth.V.Int64(); v {
+			case 0, 1:
+				yParity = int(v)
+			case 27:
+				yParity = 0
+			case 28:
+				yParity = 1
+			}
+		}
+
+		result[i] = SignedAuthorization{
+			Authorization: Authorization{
+				Address: auth.Address,
+				ChainId: auth.ChainId,
+				Nonce:   auth.Nonce,
+			},
+			R:       auth.R,
+			S:       auth.S,
+			YParity: yParity,
+		}
+	}
+	return result
+}
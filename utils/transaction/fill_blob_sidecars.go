@@ -0,0 +1,103 @@
+package transaction
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ChefBingbong/viem-go/utils/blob"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+)
+
+// FillBlobSidecars derives the EIP-4844 blob fields of a transaction from its
+// raw Blobs using tx.Kzg: the blob versioned hashes and the sidecars
+// (blob, commitment, proof) carried by the network wrapper.
+//
+// Supplied BlobVersionedHashes and Sidecars are preserved, but the hashes must
+// match the commitments (computed from the blobs, or taken from the supplied
+// sidecars); otherwise ErrBlobVersionedHashesMismatch is returned. The
+// transaction is returned unchanged if it has no Blobs or no Kzg; otherwise a
+// copy is returned.
+//
+// This mirrors the blob handling in viem's serializeTransactionEIP4844.
+//
+// Example:
+//
+//	blobs, _ := blob.ToBlobsHex([]byte("hello world"))
+//	filled, err := FillBlobSidecars(&Transaction{
+//		Type:  TransactionTypeEIP4844,
+//		Blobs: blobs,
+//		Kzg:   kzgImpl,
+//	})
+func FillBlobSidecars(tx *Transaction) (*Transaction, error) {
+	if len(tx.Blobs) == 0 || tx.Kzg == nil {
+		return tx, nil
+	}
+	if len(tx.BlobVersionedHashes) > 0 && len(tx.Sidecars) > 0 {
+		commitments := make([][]byte, len(tx.Sidecars))
+		for i, sidecar := range tx.Sidecars {
+			commitment, err := encoding.HexToBytes(sidecar.Commitment)
+			if err != nil {
+				return nil, fmt.Errorf("%w: sidecar %d commitment: %w", ErrInvalidSerializableTransaction, i, err)
+			}
+			commitments[i] = commitment
+		}
+		if err := checkVersionedHashes(tx.BlobVersionedHashes, commitments); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	}
+
+	blobs := make([][]byte, len(tx.Blobs))
+	for i, b := range tx.Blobs {
+		decoded, err := encoding.HexToBytes(b)
+		if err != nil {
+			return nil, fmt.Errorf("%w: blob %d: %w", ErrInvalidSerializableTransaction, i, err)
+		}
+		blobs[i] = decoded
+	}
+
+	commitments, err := blob.BlobsToCommitments(blobs, tx.Kzg)
+	if err != nil {
+		return nil, err
+	}
+
+	filled := *tx
+
+	if len(filled.BlobVersionedHashes) == 0 {
+		filled.BlobVersionedHashes = blob.CommitmentsToVersionedHashesHex(commitments, VersionedHashVersionKzg)
+	} else if err := checkVersionedHashes(filled.BlobVersionedHashes, commitments); err != nil {
+		return nil, err
+	}
+
+	if len(filled.Sidecars) == 0 {
+		proofs, err := blob.BlobsToProofs(blobs, commitments, tx.Kzg)
+		if err != nil {
+			return nil, err
+		}
+		filled.Sidecars = make([]BlobSidecar, len(blobs))
+		for i := range blobs {
+			filled.Sidecars[i] = BlobSidecar{
+				Blob:       tx.Blobs[i],
+				Commitment: encoding.BytesToHex(commitments[i]),
+				Proof:      encoding.BytesToHex(proofs[i]),
+			}
+		}
+	}
+
+	return &filled, nil
+}
+
+// checkVersionedHashes returns ErrBlobVersionedHashesMismatch unless hashes
+// are exactly the versioned hashes of commitments, in order.
+func checkVersionedHashes(hashes []string, commitments [][]byte) error {
+	expected := blob.CommitmentsToVersionedHashesHex(commitments, VersionedHashVersionKzg)
+	if len(hashes) != len(expected) {
+		return fmt.Errorf("%w: got %d hashes for %d blobs", ErrBlobVersionedHashesMismatch, len(hashes), len(expected))
+	}
+	for i, hash := range hashes {
+		if !strings.EqualFold(hash, expected[i]) {
+			return fmt.Errorf("%w: hash %d is %s, expected %s", ErrBlobVersionedHashesMismatch, i, hash, expected[i])
+		}
+	}
+	return nil
+}
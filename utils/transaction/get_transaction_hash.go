@@ -0,0 +1,46 @@
+package transaction
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+	"github.com/ChefBingbong/viem-go/utils/hash"
+)
+
+// GetTransactionHash returns the hash of a signed serialized transaction,
+// i.e. the hash the network will know it by.
+//
+// Blob transactions serialized in the network wrapper form (with blobs,
+// commitments and proofs) are hashed over the transaction payload only.
+//
+// Example:
+//
+//	txHash, err := GetTransactionHash("0x02f8...")
+func GetTransactionHash(serializedTx string) (string, error) {
+	txType, err := GetSerializedTransactionType(serializedTx)
+	if err != nil {
+		return "", err
+	}
+	if txType != TransactionTypeEIP4844 {
+		return hash.Keccak256(serializedTx), nil
+	}
+
+	data, err := decodeTransactionRlp(serializedTx)
+	if err != nil {
+		return "", err
+	}
+	items, ok := data.([]any)
+	if !ok {
+		return "", ErrInvalidSerializedTransaction
+	}
+	if len(items) != 4 {
+		return hash.Keccak256(serializedTx), nil
+	}
+
+	payload, err := encoding.RlpEncodeToHex(items[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidSerializedTransaction, err)
+	}
+	return hash.Keccak256("0x03" + strings.TrimPrefix(payload, "0x")), nil
+}
@@ -6,6 +6,11 @@ import (
 
 // GetTransactionType determines the transaction type based on the transaction fields.
 //
+// An explicit tx.Type overrides inference. In both cases the fields are checked
+// against the type, and ErrConflictingTransactionFields is returned if they
+// can't be serialized together (e.g. gasPrice with maxFeePerGas, or blobs on a
+// legacy transaction).
+//
 // Example:
 //
 //	txType, err := GetTransactionType(&Transaction{
@@ -13,37 +18,94 @@ import (
 //	})
 //	// txType = "eip1559"
 func GetTransactionType(tx *Transaction) (TransactionType, error) {
-	// If type is explicitly set, use it
-	if tx.Type != "" {
-		return tx.Type, nil
+	txType := tx.Type
+	if txType == "" {
+		txType = inferTransactionType(tx)
+		if txType == "" {
+			return "", fmt.Errorf("%w: cannot determine transaction type from fields", ErrInvalidSerializableTransaction)
+		}
 	}
 
+	if err := assertTransactionTypeFields(tx, txType); err != nil {
+		return "", err
+	}
+	return txType, nil
+}
+
+// inferTransactionType returns the type implied by the transaction's fields,
+// or "" if there isn't enough information.
+func inferTransactionType(tx *Transaction) TransactionType {
 	// EIP-7702: has authorizationList
 	if len(tx.AuthorizationList) > 0 {
-		return TransactionTypeEIP7702, nil
+		return TransactionTypeEIP7702
 	}
 
 	// EIP-4844: has blob-related fields
-	if len(tx.Blobs) > 0 || len(tx.BlobVersionedHashes) > 0 ||
-		tx.MaxFeePerBlobGas != nil || len(tx.Sidecars) > 0 {
-		return TransactionTypeEIP4844, nil
+	if hasBlobFields(tx) {
+		return TransactionTypeEIP4844
 	}
 
 	// EIP-1559: has maxFeePerGas or maxPriorityFeePerGas
 	if tx.MaxFeePerGas != nil || tx.MaxPriorityFeePerGas != nil {
-		return TransactionTypeEIP1559, nil
+		return TransactionTypeEIP1559
 	}
 
 	// Legacy or EIP-2930: has gasPrice
 	if tx.GasPrice != nil {
 		// EIP-2930: has accessList
 		if len(tx.AccessList) > 0 {
-			return TransactionTypeEIP2930, nil
+			return TransactionTypeEIP2930
 		}
-		return TransactionTypeLegacy, nil
+		return TransactionTypeLegacy
 	}
 
-	return "", fmt.Errorf("%w: cannot determine transaction type from fields", ErrInvalidSerializableTransaction)
+	return ""
+}
+
+// assertTransactionTypeFields checks that the transaction has no fields that
+// the given type can't carry.
+func assertTransactionTypeFields(tx *Transaction, txType TransactionType) error {
+	hasGasPrice := tx.GasPrice != nil
+	hasEIP1559Fees := tx.MaxFeePerGas != nil || tx.MaxPriorityFeePerGas != nil
+
+	if hasGasPrice && hasEIP1559Fees {
+		return fmt.Errorf("%w: cannot specify both gasPrice and maxFeePerGas/maxPriorityFeePerGas", ErrConflictingTransactionFields)
+	}
+
+	var conflict string
+	switch txType {
+	case TransactionTypeLegacy, TransactionTypeEIP2930:
+		switch {
+		case hasEIP1559Fees:
+			conflict = "maxFeePerGas/maxPriorityFeePerGas"
+		case hasBlobFields(tx):
+			conflict = "blob fields"
+		case len(tx.AuthorizationList) > 0:
+			conflict = "authorizationList"
+		case txType == TransactionTypeLegacy && len(tx.AccessList) > 0:
+			conflict = "accessList"
+		}
+	case TransactionTypeEIP1559, TransactionTypeEIP4844, TransactionTypeEIP7702:
+		switch {
+		case hasGasPrice:
+			conflict = "gasPrice"
+		case txType != TransactionTypeEIP4844 && hasBlobFields(tx):
+			conflict = "blob fields"
+		case txType != TransactionTypeEIP7702 && len(tx.AuthorizationList) > 0:
+			conflict = "authorizationList"
+		}
+	}
+
+	if conflict != "" {
+		return fmt.Errorf("%w: %s is not allowed for %s transactions", ErrConflictingTransactionFields, conflict, txType)
+	}
+	return nil
+}
+
+// hasBlobFields reports whether the transaction has any EIP-4844 blob fields.
+func hasBlobFields(tx *Transaction) bool {
+	return len(tx.Blobs) > 0 || len(tx.BlobVersionedHashes) > 0 ||
+		tx.MaxFeePerBlobGas != nil || len(tx.Sidecars) > 0
 }
 
 // MustGetTransactionType returns the transaction type or panics.
@@ -0,0 +1,64 @@
+package transaction
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+// ParsedTransaction is a signed transaction decoded by Parse.
+type ParsedTransaction struct {
+	Transaction
+
+	// Hash is the transaction hash.
+	Hash string
+	// From is the sender, recovered from the signature.
+	From string
+}
+
+// Parse decodes a raw signed transaction of any type (legacy, EIP-2930,
+// EIP-1559, EIP-4844 with or without the blob network wrapper, EIP-7702),
+// and recovers its sender from the signature.
+//
+// Unlike ParseTransaction, the input must be signed. Malformed input is
+// reported as an error wrapping ErrInvalidSerializedTransaction, so Parse is
+// safe to use on transactions from untrusted sources such as the mempool.
+//
+// Example:
+//
+//	tx, err := Parse(common.FromHex("0x02f8..."))
+//	fmt.Println(tx.From, tx.ChainId, tx.Nonce, tx.Hash)
+func Parse(raw []byte) (*ParsedTransaction, error) {
+	serialized := BytesToHex(raw)
+	tx, err := ParseTransaction(serialized)
+	if err != nil {
+		if errors.Is(err, ErrInvalidSerializedTransaction) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSerializedTransaction, err)
+	}
+	if !tx.HasSignature() {
+		return nil, fmt.Errorf("%w: transaction is not signed", ErrInvalidSerializedTransaction)
+	}
+
+	signingHash, err := GetSigningHash(*tx)
+	if err != nil {
+		return nil, err
+	}
+	from, err := signature.RecoverAddress(signingHash, &signature.Signature{
+		R:       padHex(tx.R, 32),
+		S:       padHex(tx.S, 32),
+		YParity: tx.YParity,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to recover transaction sender: %w", err)
+	}
+
+	txHash, err := GetTransactionHash(serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParsedTransaction{Transaction: *tx, Hash: txHash, From: from}, nil
+}
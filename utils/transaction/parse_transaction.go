@@ -143,6 +143,9 @@ func parseTransactionEIP4844(serializedTx string) (*Transaction, error) {
 		blobs, _ := items[1].([]any)
 		commitments, _ := items[2].([]any)
 		proofs, _ := items[3].([]any)
+		if len(commitments) != len(blobs) || len(proofs) != len(blobs) {
+			return nil, fmt.Errorf("%w: got %d blobs, %d commitments and %d proofs", ErrInvalidSerializedTransaction, len(blobs), len(commitments), len(proofs))
+		}
 
 		if len(blobs) > 0 {
 			tx.Sidecars = make([]BlobSidecar, len(blobs))
@@ -242,7 +245,7 @@ func parseTransactionLegacy(serializedTx string) (*Transaction, error) {
 	// Legacy transactions are RLP encoded directly (no type prefix)
 	data, err := encoding.RlpDecodeHex(serializedTx)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSerializedTransaction, err)
 	}
 
 	items, ok := data.([]any)
@@ -273,9 +276,16 @@ func parseTransactionLegacy(serializedTx string) (*Transaction, error) {
 }
 
 func decodeTransactionRlp(serializedTx string) (any, error) {
+	if len(serializedTx) < 4 {
+		return nil, fmt.Errorf("%w: transaction too short", ErrInvalidSerializedTransaction)
+	}
 	// Remove type prefix (first 2 bytes after 0x)
 	rlpData := "0x" + serializedTx[4:]
-	return encoding.RlpDecodeHex(rlpData)
+	data, err := encoding.RlpDecodeHex(rlpData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSerializedTransaction, err)
+	}
+	return data, nil
 }
 
 func parseEIP155Signature(tx *Transaction, sigItems []any) {
@@ -332,7 +342,7 @@ func parseLegacySignature(tx *Transaction, sigItems []any) {
 		if chainId > 0 {
 			tx.ChainId = int(chainId)
 		}
-		tx.YParity = int(vInt % 2)
+		tx.YParity = int((vInt - 35) % 2)
 	} else if vInt == 27 {
 		tx.YParity = 0
 	} else if vInt == 28 {
@@ -0,0 +1,65 @@
+package transaction
+
+import (
+	"fmt"
+
+	"github.com/ChefBingbong/viem-go/utils/hash"
+	"github.com/ChefBingbong/viem-go/utils/signature"
+)
+
+// GetSigningHash returns the hash a sender signs to authorize tx: the
+// Keccak-256 hash of the unsigned serialized transaction. Any signature on
+// tx is ignored, and blob sidecars are not part of the signed payload.
+//
+// Together with Serialize it lets a transaction be signed by an external or
+// air-gapped signer.
+//
+// Example:
+//
+//	signingHash, err := GetSigningHash(tx)
+//	sig := externalSigner.Sign(signingHash)
+//	signedTx, err := Serialize(tx, sig)
+func GetSigningHash(tx Transaction) (string, error) {
+	// Derive the blob versioned hashes first, so the hash commits to them.
+	filled, err := FillBlobSidecars(&tx)
+	if err != nil {
+		return "", err
+	}
+	unsigned := *filled
+	unsigned.Sidecars = nil
+	unsigned.Kzg = nil
+	unsigned.R, unsigned.S, unsigned.V, unsigned.YParity = "", "", nil, 0
+
+	serialized, err := SerializeTransaction(&unsigned, nil)
+	if err != nil {
+		return "", err
+	}
+	return hash.Keccak256(serialized), nil
+}
+
+// Serialize serializes tx signed with signature, a 65-byte hex signature
+// (r || s || v, with v either 0/1 or 27/28) over GetSigningHash(tx). The
+// result can be broadcast with eth_sendRawTransaction.
+//
+// Example:
+//
+//	signedTx, err := Serialize(Transaction{
+//		Type:                 TransactionTypeEIP1559,
+//		ChainId:              1,
+//		MaxFeePerGas:         big.NewInt(2000000000),
+//		MaxPriorityFeePerGas: big.NewInt(1000000000),
+//		Gas:                  big.NewInt(21000),
+//		To:                   "0x...",
+//	}, "0x6e10...1c")
+func Serialize(tx Transaction, signatureHex string) (string, error) {
+	sig, err := signature.ParseSignature(signatureHex)
+	if err != nil {
+		return "", fmt.Errorf("invalid transaction signature: %w", err)
+	}
+	return SerializeTransaction(&tx, &Signature{
+		R:       sig.R,
+		S:       sig.S,
+		V:       sig.V,
+		YParity: sig.YParity,
+	})
+}
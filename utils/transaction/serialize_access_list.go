@@ -65,8 +65,9 @@ func ParseAccessList(data []any) (AccessList, error) {
 			continue
 		}
 
-		address, ok := itemSlice[0].(string)
-		if !ok {
+		// Decoded RLP items are bytes, hand-built ones may be hex strings
+		address := getHexString(itemSlice[0])
+		if address == "0x" {
 			continue
 		}
 
@@ -82,8 +83,8 @@ func ParseAccessList(data []any) (AccessList, error) {
 
 		storageKeys := make([]string, 0, len(storageKeysRaw))
 		for _, keyRaw := range storageKeysRaw {
-			key, ok := keyRaw.(string)
-			if !ok {
+			key := getHexString(keyRaw)
+			if key == "0x" {
 				continue
 			}
 			// Normalize the key (trim if needed, but keep 32 bytes)
@@ -83,6 +83,11 @@ func serializeTransactionEIP7702(tx *Transaction, signature *Signature) (string,
 }
 
 func serializeTransactionEIP4844(tx *Transaction, signature *Signature) (string, error) {
+	tx, err := FillBlobSidecars(tx)
+	if err != nil {
+		return "", err
+	}
+
 	if err := AssertTransactionEIP4844(tx); err != nil {
 		return "", err
 	}
@@ -336,8 +341,8 @@ func serializeAuthorizationList(authList []SignedAuthorization) ([]any, error) {
 			auth.Address,
 			numberToHexRlp(auth.Nonce),
 			yParityHex,
-			auth.R,
-			auth.S,
+			trimmedHexOrEmpty(trimHex(auth.R)),
+			trimmedHexOrEmpty(trimHex(auth.S)),
 		}
 	}
 
@@ -1,14 +1,41 @@
 package test
 
 import (
+	"crypto/sha256"
 	"math/big"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/ChefBingbong/viem-go/utils/blob"
+	"github.com/ChefBingbong/viem-go/utils/encoding"
+	"github.com/ChefBingbong/viem-go/utils/hash"
 	"github.com/ChefBingbong/viem-go/utils/transaction"
 )
 
+// mockKzg is a mock KZG implementation for testing
+type mockKzg struct{}
+
+func (m *mockKzg) BlobToKzgCommitment(b []byte) ([]byte, error) {
+	// Return a mock 48-byte commitment (hash of blob)
+	hash := sha256.Sum256(b)
+	commitment := make([]byte, 48)
+	copy(commitment, hash[:])
+	return commitment, nil
+}
+
+func (m *mockKzg) ComputeBlobKzgProof(b []byte, commitment []byte) ([]byte, error) {
+	// Return a mock 48-byte proof
+	hash := sha256.Sum256(append(b, commitment...))
+	proof := make([]byte, 48)
+	copy(proof, hash[:])
+	return proof, nil
+}
+
 var _ = Describe("Transaction", func() {
 	Describe("GetTransactionType", func() {
 		It("should detect EIP-1559 transaction", func() {
@@ -73,12 +100,47 @@ var _ = Describe("Transaction", func() {
 
 		It("should use explicit type if set", func() {
 			tx := &transaction.Transaction{
-				Type:     transaction.TransactionTypeEIP1559,
+				Type:     transaction.TransactionTypeEIP2930,
 				GasPrice: big.NewInt(1000000000), // Would normally be legacy
 			}
 			txType, err := transaction.GetTransactionType(tx)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(txType).To(Equal(transaction.TransactionTypeEIP1559))
+			Expect(txType).To(Equal(transaction.TransactionTypeEIP2930))
+		})
+
+		It("should reject gasPrice together with maxFeePerGas", func() {
+			tx := &transaction.Transaction{
+				GasPrice:     big.NewInt(1000000000),
+				MaxFeePerGas: big.NewInt(1000000000),
+			}
+			_, err := transaction.GetTransactionType(tx)
+			Expect(err).To(MatchError(transaction.ErrConflictingTransactionFields))
+		})
+
+		It("should reject fields the explicit type can't carry", func() {
+			_, err := transaction.GetTransactionType(&transaction.Transaction{
+				Type:     transaction.TransactionTypeEIP1559,
+				GasPrice: big.NewInt(1000000000),
+			})
+			Expect(err).To(MatchError(transaction.ErrConflictingTransactionFields))
+
+			_, err = transaction.GetTransactionType(&transaction.Transaction{
+				Type:         transaction.TransactionTypeLegacy,
+				MaxFeePerGas: big.NewInt(1000000000),
+			})
+			Expect(err).To(MatchError(transaction.ErrConflictingTransactionFields))
+
+			_, err = transaction.GetTransactionType(&transaction.Transaction{
+				Type:                transaction.TransactionTypeEIP1559,
+				MaxFeePerGas:        big.NewInt(1000000000),
+				BlobVersionedHashes: []string{"0x01..."},
+			})
+			Expect(err).To(MatchError(transaction.ErrConflictingTransactionFields))
+		})
+
+		It("should fail when the type cannot be inferred", func() {
+			_, err := transaction.GetTransactionType(&transaction.Transaction{})
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializableTransaction))
 		})
 	})
 
@@ -115,6 +177,33 @@ var _ = Describe("Transaction", func() {
 		})
 	})
 
+	Describe("GetTransactionHash", func() {
+		It("should hash a typed transaction as serialized", func() {
+			serialized := "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33"
+			txHash, err := transaction.GetTransactionHash(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(txHash).To(Equal(hash.Keccak256(serialized)))
+		})
+
+		It("should hash a blob transaction without its network wrapper", func() {
+			payload := []any{"0x01", "0x05", "0x01", "0x02", "0x5208", "0x" + strings.Repeat("11", 20), "0x", "0x", []any{}, "0x01", []any{"0x01" + strings.Repeat("22", 31)}, "0x01", "0x" + strings.Repeat("33", 32), "0x" + strings.Repeat("44", 32)}
+			payloadHex, err := encoding.RlpEncodeToHex(payload)
+			Expect(err).NotTo(HaveOccurred())
+			wrapperHex, err := encoding.RlpEncodeToHex([]any{payload, []any{"0xaa"}, []any{"0xbb"}, []any{"0xcc"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			bare := "0x03" + strings.TrimPrefix(payloadHex, "0x")
+			wrapped := "0x03" + strings.TrimPrefix(wrapperHex, "0x")
+
+			bareHash, err := transaction.GetTransactionHash(bare)
+			Expect(err).NotTo(HaveOccurred())
+			wrappedHash, err := transaction.GetTransactionHash(wrapped)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bareHash).To(Equal(hash.Keccak256(bare)))
+			Expect(wrappedHash).To(Equal(bareHash))
+		})
+	})
+
 	Describe("SerializeAccessList", func() {
 		It("should serialize an empty access list", func() {
 			result, err := transaction.SerializeAccessList(nil)
@@ -241,4 +330,224 @@ var _ = Describe("Transaction", func() {
 			Expect(parsed.To).To(Equal("0x1234567890123456789012345678901234567890"))
 		})
 	})
+
+	Describe("SerializeTransaction EIP-4844", func() {
+		newBlobTx := func() *transaction.Transaction {
+			blobs, err := blob.ToBlobsHex([]byte("hello world"))
+			Expect(err).NotTo(HaveOccurred())
+			return &transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP4844,
+				ChainId:              1,
+				Nonce:                0,
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				MaxFeePerGas:         big.NewInt(2000000000),
+				MaxFeePerBlobGas:     big.NewInt(1000000000),
+				Gas:                  big.NewInt(21000),
+				To:                   "0x1234567890123456789012345678901234567890",
+				Value:                big.NewInt(0),
+				Blobs:                blobs,
+				Kzg:                  &mockKzg{},
+			}
+		}
+
+		It("should derive versioned hashes and sidecars from blobs", func() {
+			filled, err := transaction.FillBlobSidecars(newBlobTx())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filled.BlobVersionedHashes).To(HaveLen(1))
+			Expect(filled.Sidecars).To(HaveLen(1))
+
+			commitment, err := encoding.HexToBytes(filled.Sidecars[0].Commitment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filled.BlobVersionedHashes[0]).To(Equal(
+				blob.CommitmentToVersionedHashHex(commitment, transaction.VersionedHashVersionKzg),
+			))
+			Expect(filled.BlobVersionedHashes[0]).To(HavePrefix("0x01"))
+		})
+
+		It("should serialize a single-blob transaction with the network wrapper", func() {
+			tx := newBlobTx()
+			serialized, err := transaction.SerializeTransaction(tx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serialized).To(HavePrefix("0x03"))
+
+			parsed, err := transaction.ParseTransaction(serialized)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Type).To(Equal(transaction.TransactionTypeEIP4844))
+			Expect(parsed.BlobVersionedHashes).To(HaveLen(1))
+			Expect(parsed.Sidecars).To(HaveLen(1))
+			Expect(parsed.Sidecars[0].Blob).To(Equal(tx.Blobs[0]))
+
+			commitment, err := encoding.HexToBytes(parsed.Sidecars[0].Commitment)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.BlobVersionedHashes[0]).To(Equal(
+				blob.CommitmentToVersionedHashHex(commitment, transaction.VersionedHashVersionKzg),
+			))
+		})
+
+		It("should preserve supplied versioned hashes that match the blobs", func() {
+			derived, err := transaction.FillBlobSidecars(newBlobTx())
+			Expect(err).NotTo(HaveOccurred())
+
+			tx := newBlobTx()
+			tx.BlobVersionedHashes = derived.BlobVersionedHashes
+			filled, err := transaction.FillBlobSidecars(tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filled.BlobVersionedHashes).To(Equal(tx.BlobVersionedHashes))
+			Expect(filled.Sidecars).To(HaveLen(1))
+		})
+
+		It("should reject supplied versioned hashes that don't match the blobs", func() {
+			tx := newBlobTx()
+			tx.BlobVersionedHashes = []string{"0x01" + strings.Repeat("ab", 31)}
+			_, err := transaction.FillBlobSidecars(tx)
+			Expect(err).To(MatchError(transaction.ErrBlobVersionedHashesMismatch))
+
+			derived, err := transaction.FillBlobSidecars(newBlobTx())
+			Expect(err).NotTo(HaveOccurred())
+			tx = newBlobTx()
+			tx.Sidecars = derived.Sidecars
+			tx.BlobVersionedHashes = []string{"0x01" + strings.Repeat("ab", 31)}
+			_, err = transaction.FillBlobSidecars(tx)
+			Expect(err).To(MatchError(transaction.ErrBlobVersionedHashesMismatch))
+		})
+
+		It("should leave transactions without a KZG implementation unchanged", func() {
+			tx := newBlobTx()
+			tx.Kzg = nil
+			filled, err := transaction.FillBlobSidecars(tx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filled).To(BeIdenticalTo(tx))
+		})
+	})
+
+	Describe("Parse", func() {
+		key, _ := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+		address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+		sign := func(tx transaction.Transaction) []byte {
+			signingHash, err := transaction.GetSigningHash(tx)
+			Expect(err).NotTo(HaveOccurred())
+			sig, err := crypto.Sign(common.FromHex(signingHash), key)
+			Expect(err).NotTo(HaveOccurred())
+			serialized, err := transaction.Serialize(tx, hexutil.Encode(sig))
+			Expect(err).NotTo(HaveOccurred())
+			return common.FromHex(serialized)
+		}
+
+		It("should parse a signed EIP-1559 transaction", func() {
+			serialized := "0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33"
+
+			parsed, err := transaction.Parse(common.FromHex(serialized))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Type).To(Equal(transaction.TransactionTypeEIP1559))
+			Expect(parsed.ChainId).To(Equal(1))
+			Expect(parsed.Nonce).To(Equal(785))
+			Expect(parsed.Gas.Int64()).To(Equal(int64(21000)))
+			Expect(parsed.From).To(HavePrefix("0x"))
+			Expect(parsed.Hash).To(Equal(hash.Keccak256(serialized)))
+		})
+
+		It("should recover the sender of every transaction type", func() {
+			to := "0x70997970c51812dc3a010c7d01b50e0d17dc79c8"
+			txs := []transaction.Transaction{
+				{
+					Type:     transaction.TransactionTypeLegacy,
+					ChainId:  1,
+					Nonce:    7,
+					GasPrice: big.NewInt(1000000000),
+					Gas:      big.NewInt(21000),
+					To:       to,
+					Value:    big.NewInt(1),
+				},
+				{
+					Type:     transaction.TransactionTypeEIP2930,
+					ChainId:  1,
+					Nonce:    8,
+					GasPrice: big.NewInt(1000000000),
+					Gas:      big.NewInt(21000),
+					To:       to,
+					AccessList: transaction.AccessList{
+						{Address: to, StorageKeys: []string{"0x0000000000000000000000000000000000000000000000000000000000000001"}},
+					},
+				},
+				{
+					Type:                 transaction.TransactionTypeEIP1559,
+					ChainId:              10,
+					Nonce:                9,
+					MaxFeePerGas:         big.NewInt(2000000000),
+					MaxPriorityFeePerGas: big.NewInt(1000000000),
+					Gas:                  big.NewInt(21000),
+					To:                   to,
+					Data:                 "0xdeadbeef",
+				},
+				{
+					Type:                 transaction.TransactionTypeEIP7702,
+					ChainId:              1,
+					Nonce:                10,
+					MaxFeePerGas:         big.NewInt(2000000000),
+					MaxPriorityFeePerGas: big.NewInt(1000000000),
+					Gas:                  big.NewInt(100000),
+					To:                   to,
+					AuthorizationList: []transaction.SignedAuthorization{
+						{
+							Authorization: transaction.Authorization{Address: to, ChainId: 1, Nonce: 11},
+							R:             "0x01",
+							S:             "0x02",
+							YParity:       1,
+						},
+					},
+				},
+			}
+
+			for _, tx := range txs {
+				raw := sign(tx)
+
+				parsed, err := transaction.Parse(raw)
+				Expect(err).NotTo(HaveOccurred(), "type %s", tx.Type)
+				Expect(parsed.Type).To(Equal(tx.Type))
+				Expect(parsed.ChainId).To(Equal(tx.ChainId))
+				Expect(parsed.Nonce).To(Equal(tx.Nonce))
+				Expect(strings.EqualFold(parsed.From, address)).To(BeTrue(), "type %s: recovered %s", tx.Type, parsed.From)
+				Expect(parsed.Hash).To(Equal(crypto.Keccak256Hash(raw).Hex()))
+			}
+		})
+
+		It("should fail for an unsigned transaction", func() {
+			serialized, err := transaction.SerializeTransaction(&transaction.Transaction{
+				Type:                 transaction.TransactionTypeEIP1559,
+				ChainId:              1,
+				MaxFeePerGas:         big.NewInt(2000000000),
+				MaxPriorityFeePerGas: big.NewInt(1000000000),
+				Gas:                  big.NewInt(21000),
+			}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = transaction.Parse(common.FromHex(serialized))
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction))
+		})
+
+		It("should fail for malformed input", func() {
+			_, err := transaction.Parse([]byte{0x02, 0xc1})
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction))
+		})
+
+		It("should fail for truncated input", func() {
+			raw := common.FromHex("0x02f850018203118080825208808080c080a04012522854168b27e5dc3d5839bab5e6b39e1a0ffd343901ce1622e3d64b48f1a04e00902ae0502c4728cbf12156290df99c3ed7de85b1dbfe20b5c36931733a33")
+			for _, n := range []int{0, 1, 2, 3, 20, len(raw) - 1} {
+				_, err := transaction.Parse(raw[:n])
+				Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction), "length %d", n)
+			}
+		})
+
+		It("should fail for an overflowing length prefix", func() {
+			_, err := transaction.Parse([]byte{0x02, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction))
+		})
+
+		It("should fail for a list item running past the end of the list", func() {
+			// The list claims 2 bytes of payload but its first item is a 3-byte string.
+			_, err := transaction.Parse([]byte{0x02, 0xc2, 0x82, 0x01, 0x02})
+			Expect(err).To(MatchError(transaction.ErrInvalidSerializedTransaction))
+		})
+	})
 })
@@ -3,6 +3,8 @@ package transaction
 import (
 	"errors"
 	"math/big"
+
+	"github.com/ChefBingbong/viem-go/utils/kzg"
 )
 
 // TransactionType represents the type of Ethereum transaction.
@@ -31,6 +33,9 @@ var (
 	ErrInvalidVersionedHashSize         = errors.New("invalid versioned hash size")
 	ErrInvalidVersionedHashVersion      = errors.New("invalid versioned hash version")
 	ErrMaxFeePerGasNotAllowed           = errors.New("maxFeePerGas/maxPriorityFeePerGas is not allowed for this transaction type")
+	ErrConflictingTransactionFields     = errors.New("conflicting transaction fields")
+	ErrEmptyAuthorizationList           = errors.New("eip7702 transaction requires a non-empty authorization list")
+	ErrBlobVersionedHashesMismatch      = errors.New("blob versioned hashes do not match the blob commitments")
 )
 
 // MaxUint256 is 2^256 - 1
@@ -154,6 +159,10 @@ type Transaction struct {
 	Blobs               []string      `json:"blobs,omitempty"`
 	Sidecars            []BlobSidecar `json:"sidecars,omitempty"`
 
+	// Kzg computes commitments and proofs for Blobs when BlobVersionedHashes
+	// or Sidecars are not supplied. See FillBlobSidecars.
+	Kzg kzg.Kzg `json:"-"`
+
 	// EIP-7702 fields
 	AuthorizationList []SignedAuthorization `json:"authorizationList,omitempty"`
 
@@ -73,6 +73,51 @@ func FormatUnits(value *big.Int, decimals int) string {
 	return b.String()
 }
 
+// RoundingMode controls how FormatUnitsRounded drops excess fraction digits.
+type RoundingMode int
+
+const (
+	// RoundTruncate drops excess digits (rounds toward zero).
+	RoundTruncate RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, with ties rounded away from zero.
+	RoundHalfUp
+)
+
+// FormatUnitsRounded is like FormatUnits but limits the output to at most
+// fractionDigits digits after the decimal point, using the given rounding mode.
+// Trailing zeros are trimmed as in FormatUnits.
+//
+// Example:
+//
+//	FormatUnitsRounded(big.NewInt(1234567), 6, 2, RoundTruncate)
+//	// "1.23"
+//
+//	FormatUnitsRounded(big.NewInt(1235000), 6, 2, RoundHalfUp)
+//	// "1.24"
+func FormatUnitsRounded(value *big.Int, decimals, fractionDigits int, mode RoundingMode) string {
+	if value == nil {
+		return "0"
+	}
+	if fractionDigits < 0 {
+		fractionDigits = 0
+	}
+	if fractionDigits >= decimals {
+		return FormatUnits(value, decimals)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals-fractionDigits)), nil)
+	quotient, remainder := new(big.Int).QuoRem(new(big.Int).Abs(value), scale, new(big.Int))
+
+	if mode == RoundHalfUp && remainder.Lsh(remainder, 1).Cmp(scale) >= 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	if value.Sign() < 0 {
+		quotient.Neg(quotient)
+	}
+
+	return FormatUnits(quotient, fractionDigits)
+}
+
 // FormatUnitsInt64 is a convenience function that takes an int64 value.
 func FormatUnitsInt64(value int64, decimals int) string {
 	return FormatUnits(big.NewInt(value), decimals)
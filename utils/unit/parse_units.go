@@ -2,13 +2,19 @@ package unit
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
-	"strconv"
 	"sync"
 )
 
-// ErrInvalidDecimalNumber is returned when the value is not a valid decimal number.
-var ErrInvalidDecimalNumber = errors.New("invalid decimal number")
+var (
+	// ErrInvalidDecimalNumber is returned when the value is not a valid decimal number.
+	ErrInvalidDecimalNumber = errors.New("invalid decimal number")
+
+	// ErrTooManyDecimals is returned by ParseUnits when the fractional part
+	// has more significant digits than the unit supports.
+	ErrTooManyDecimals = errors.New("fractional part exceeds decimals")
+)
 
 // Cached powers of 10 for common decimal values.
 // Avoids repeated big.Int allocations for the most common units (6, 8, 9, 18).
@@ -90,6 +96,10 @@ func parseAndSplit(s string) (integer, fraction string, negative, valid bool) {
 // ParseUnits multiplies a string representation of a number by a given exponent
 // of base 10 (10^decimals).
 //
+// A value with more significant fraction digits than decimals cannot be
+// represented exactly and returns ErrTooManyDecimals rather than being rounded;
+// trailing zeros don't count.
+//
 // Example:
 //
 //	ParseUnits("420", 9)
@@ -100,6 +110,9 @@ func parseAndSplit(s string) (integer, fraction string, negative, valid bool) {
 //
 //	ParseUnits("1.5", 18)
 //	// big.Int representing 1500000000000000000
+//
+//	ParseUnits("1.0000001", 6)
+//	// ErrTooManyDecimals
 func ParseUnits(value string, decimals int) (*big.Int, error) {
 	// Single-pass validation + splitting
 	integer, fraction, negative, valid := parseAndSplit(value)
@@ -110,48 +123,21 @@ func ParseUnits(value string, decimals int) (*big.Int, error) {
 	// Trim trailing zeros from fraction
 	fraction = trimRight(fraction, '0')
 
-	// Handle rounding when fraction is larger than decimals
-	if decimals == 0 {
-		if len(fraction) > 0 && fraction[0] >= '5' {
-			integer = incrementString(integer)
-		}
-		fraction = ""
-	} else if len(fraction) > decimals {
-		left := fraction[:decimals-1]
-		unit := fraction[decimals-1]
-		right := fraction[decimals:]
-
-		roundVal, _ := strconv.ParseFloat(string(unit)+"."+right, 64)
-		rounded := int(roundVal + 0.5)
+	if len(fraction) > decimals {
+		return nil, fmt.Errorf("%w: %q has %d fraction digits, max %d", ErrTooManyDecimals, value, len(fraction), decimals)
+	}
 
-		if rounded > 9 {
-			fraction = incrementString(left) + "0"
-			for len(fraction) < len(left)+1 {
-				fraction = "0" + fraction
-			}
+	// Pad fraction with trailing zeros — use pre-computed pad to avoid allocation
+	if pad := decimals - len(fraction); pad > 0 {
+		if pad < len(zeroPad) {
+			fraction = fraction + zeroPad[pad]
 		} else {
-			fraction = left + strconv.Itoa(rounded)
-		}
-
-		if len(fraction) > decimals {
-			fraction = fraction[1:]
-			integer = incrementString(integer)
-		}
-
-		fraction = fraction[:decimals]
-	} else {
-		// Pad fraction with trailing zeros — use pre-computed pad to avoid allocation
-		if pad := decimals - len(fraction); pad > 0 {
-			if pad < len(zeroPad) {
-				fraction = fraction + zeroPad[pad]
-			} else {
-				buf := make([]byte, len(fraction)+pad)
-				copy(buf, fraction)
-				for i := len(fraction); i < len(buf); i++ {
-					buf[i] = '0'
-				}
-				fraction = string(buf)
+			buf := make([]byte, len(fraction)+pad)
+			copy(buf, fraction)
+			for i := len(fraction); i < len(buf); i++ {
+				buf[i] = '0'
 			}
+			fraction = string(buf)
 		}
 	}
 
@@ -239,34 +225,6 @@ func trimRight(s string, b byte) string {
 	return s[:i]
 }
 
-// incrementString increments a decimal number string by 1.
-// Avoids big.Int allocation for simple carry operations.
-func incrementString(s string) string {
-	if s == "" || s == "0" {
-		return "1"
-	}
-
-	// Fast path: try uint64
-	if len(s) <= 18 {
-		val, err := strconv.ParseUint(s, 10, 64)
-		if err == nil {
-			return strconv.FormatUint(val+1, 10)
-		}
-	}
-
-	// Fallback to big.Int for huge numbers
-	v := getBigInt()
-	_, ok := v.SetString(s, 10)
-	if !ok {
-		bigIntPool.Put(v)
-		return "1"
-	}
-	v.Add(v, big.NewInt(1))
-	result := v.String()
-	bigIntPool.Put(v)
-	return result
-}
-
 // MustParseUnits is like ParseUnits but panics on error.
 func MustParseUnits(value string, decimals int) *big.Int {
 	result, err := ParseUnits(value, decimals)
@@ -1,6 +1,7 @@
 package unit_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -165,11 +166,11 @@ func TestParseUnits(t *testing.T) {
 			false,
 		},
 		{
-			"rounding up",
+			"more fraction digits than decimals",
 			"1.99999999999999999999",
 			18,
-			"2000000000000000000",
-			false,
+			"",
+			true,
 		},
 	}
 
@@ -450,6 +451,73 @@ func TestRoundTrip(t *testing.T) {
 	}
 }
 
+func TestFormatUnitsRounded(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          *big.Int
+		decimals       int
+		fractionDigits int
+		mode           unit.RoundingMode
+		expected       string
+	}{
+		{"truncate", big.NewInt(1235000), 6, 2, unit.RoundTruncate, "1.23"},
+		{"half up at tie", big.NewInt(1235000), 6, 2, unit.RoundHalfUp, "1.24"},
+		{"half up below tie", big.NewInt(1234999), 6, 2, unit.RoundHalfUp, "1.23"},
+		{"half up carries into integer", big.NewInt(1999999), 6, 2, unit.RoundHalfUp, "2"},
+		{"truncate never carries", big.NewInt(1999999), 6, 2, unit.RoundTruncate, "1.99"},
+		{"negative half up", big.NewInt(-1235000), 6, 2, unit.RoundHalfUp, "-1.24"},
+		{"negative truncate", big.NewInt(-1235000), 6, 2, unit.RoundTruncate, "-1.23"},
+		{"zero fraction digits", big.NewInt(1500000), 6, 0, unit.RoundHalfUp, "2"},
+		{"more digits than decimals", big.NewInt(1234567), 6, 10, unit.RoundTruncate, "1.234567"},
+		{"nil", nil, 18, 4, unit.RoundHalfUp, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := unit.FormatUnitsRounded(tt.value, tt.decimals, tt.fractionDigits, tt.mode)
+			if result != tt.expected {
+				t.Errorf("FormatUnitsRounded(%v, %d, %d) = %q, want %q", tt.value, tt.decimals, tt.fractionDigits, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseUnits_Precision(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		decimals int
+		expected string
+		err      error
+	}{
+		{"within decimals", "1.5", 6, "1500000", nil},
+		{"exact decimals", "1.123456", 6, "1123456", nil},
+		{"trailing zeros ignored", "1.1234560000", 6, "1123456", nil},
+		{"over-precise", "1.1234567", 6, "", unit.ErrTooManyDecimals},
+		{"fraction with zero decimals", "1.5", 0, "", unit.ErrTooManyDecimals},
+		{"invalid", "abc", 6, "", unit.ErrInvalidDecimalNumber},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unit.ParseUnits(tt.value, tt.decimals)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Errorf("ParseUnits(%q, %d) error = %v, want %v", tt.value, tt.decimals, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ParseUnits(%q, %d) unexpected error: %v", tt.value, tt.decimals, err)
+				return
+			}
+			if result.String() != tt.expected {
+				t.Errorf("ParseUnits(%q, %d) = %s, want %s", tt.value, tt.decimals, result.String(), tt.expected)
+			}
+		})
+	}
+}
+
 // Benchmark tests
 
 func BenchmarkFormatUnits(b *testing.B) {
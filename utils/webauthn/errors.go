@@ -0,0 +1,12 @@
+package webauthn
+
+import "errors"
+
+var (
+	// ErrInvalidSignature is returned when a signature is neither a 64-byte
+	// r||s pair nor a valid DER-encoded ECDSA signature.
+	ErrInvalidSignature = errors.New("invalid webauthn signature")
+
+	// ErrInvalidPublicKey is returned when a public key is not a valid P256 point.
+	ErrInvalidPublicKey = errors.New("invalid webauthn public key")
+)
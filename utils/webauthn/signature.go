@@ -0,0 +1,78 @@
+package webauthn
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/utils/p256"
+)
+
+// ParseSignature parses a P256 signature as returned by an authenticator,
+// either a 64-byte r||s pair or an ASN.1 DER sequence, and normalizes s to
+// the lower half of the curve order.
+func ParseSignature(sig []byte) (r, s *big.Int, err error) {
+	parsed, err := p256.ParseSignature(sig)
+	if err != nil {
+		return nil, nil, ErrInvalidSignature
+	}
+	return parsed.R, NormalizeS(parsed.S), nil
+}
+
+// NormalizeS returns s in the lower half of the curve order (n - s if s > n/2).
+// WebAuthn verifiers reject high-s signatures to prevent malleability, but
+// authenticators produce either form.
+func NormalizeS(s *big.Int) *big.Int {
+	return p256.NormalizeS(s)
+}
+
+// ParsePublicKey parses an uncompressed P256 public key, either 64 bytes (x||y)
+// or 65 bytes with the 0x04 prefix, and returns its coordinates.
+func ParsePublicKey(pub []byte) (x, y *big.Int, err error) {
+	parsed, err := p256.ParsePublicKey(pub)
+	if err != nil {
+		return nil, nil, ErrInvalidPublicKey
+	}
+	return parsed.X, parsed.Y, nil
+}
+
+// webAuthnAuthParams are the fields of the WebAuthnAuth struct used by
+// Solidity WebAuthn verifiers (e.g. Coinbase's WebAuthn.sol and Solady's WebAuthn).
+var webAuthnAuthParams = []abi.AbiParam{
+	{Name: "authenticatorData", Type: "bytes"},
+	{Name: "clientDataJSON", Type: "string"},
+	{Name: "challengeIndex", Type: "uint256"},
+	{Name: "typeIndex", Type: "uint256"},
+	{Name: "r", Type: "uint256"},
+	{Name: "s", Type: "uint256"},
+}
+
+// EncodeAuth ABI-encodes a WebAuthn signature as abi.encode(WebAuthnAuth), the
+// format Solidity WebAuthn verifiers decode:
+//
+//	struct WebAuthnAuth {
+//	    bytes authenticatorData;
+//	    string clientDataJSON;
+//	    uint256 challengeIndex;
+//	    uint256 typeIndex;
+//	    uint256 r;
+//	    uint256 s;
+//	}
+func EncodeAuth(metadata Metadata, r, s *big.Int) ([]byte, error) {
+	fields, err := abi.EncodeAbiParameters(webAuthnAuthParams, []any{
+		metadata.AuthenticatorData,
+		metadata.ClientDataJSON,
+		big.NewInt(int64(metadata.ChallengeIndex)),
+		big.NewInt(int64(metadata.TypeIndex)),
+		r,
+		s,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webauthn auth: %w", err)
+	}
+
+	// A dynamic struct is encoded as a head offset followed by its fields.
+	offset := make([]byte, 32)
+	offset[31] = 0x20
+	return append(offset, fields...), nil
+}
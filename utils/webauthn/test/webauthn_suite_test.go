@@ -0,0 +1,13 @@
+package test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestWebAuthn(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "WebAuthn Suite")
+}
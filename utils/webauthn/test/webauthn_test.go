@@ -0,0 +1,128 @@
+package test
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/ChefBingbong/viem-go/utils/webauthn"
+)
+
+func mustHex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	Expect(err).NotTo(HaveOccurred())
+	return b
+}
+
+var p256N, _ = new(big.Int).SetString("ffffffff00000000ffffffffffffffffbce6faada7179e84f3b9cac2fc632551", 16)
+
+var _ = Describe("WebAuthn", func() {
+	challenge := mustHex("f631058a3ba1116acce12396fad0a125b5041c43f8e15723709f81aa8d5f4ccf")
+
+	Describe("GetClientDataJSON", func() {
+		It("should embed the base64url challenge without padding", func() {
+			clientDataJSON := webauthn.GetClientDataJSON(challenge, "https://example.com", false)
+			Expect(clientDataJSON).To(Equal(`{"type":"webauthn.get","challenge":"9jEFijuhEWrM4SOW-tChJbUEHEP44VcjcJ-Bqo1fTM8","origin":"https://example.com","crossOrigin":false}`))
+
+			hash := sha256.Sum256([]byte(clientDataJSON))
+			Expect(hex.EncodeToString(hash[:])).To(Equal("d7b2a152dcb3216606c47a0b0d33e95648d478c8ba8d8f651fb2c7321a23a1fc"))
+		})
+
+		It("should escape the origin as a JSON string", func() {
+			clientDataJSON := webauthn.GetClientDataJSON(challenge, `https://a"b\c<d>`, true)
+			Expect(clientDataJSON).To(Equal(`{"type":"webauthn.get","challenge":"9jEFijuhEWrM4SOW-tChJbUEHEP44VcjcJ-Bqo1fTM8","origin":"https://a\"b\\c<d>","crossOrigin":true}`))
+		})
+	})
+
+	Describe("GetAuthenticatorData", func() {
+		It("should be sha256(rpId) || flag || signCount", func() {
+			data := webauthn.GetAuthenticatorData("example.com", webauthn.DefaultFlag, 0)
+			Expect(hex.EncodeToString(data)).To(Equal("a379a6f6eeafb9a55e378c118034e2751e682fab9f2d30ab13d2125586ce19470500000000"))
+		})
+	})
+
+	Describe("GetSignPayload", func() {
+		It("should build the signed payload and metadata for a known challenge", func() {
+			payload, metadata := webauthn.GetSignPayload(challenge, webauthn.SignPayloadOptions{
+				RpID:   "example.com",
+				Origin: "https://example.com",
+			})
+
+			Expect(hex.EncodeToString(payload)).To(Equal(
+				"a379a6f6eeafb9a55e378c118034e2751e682fab9f2d30ab13d2125586ce19470500000000" +
+					"d7b2a152dcb3216606c47a0b0d33e95648d478c8ba8d8f651fb2c7321a23a1fc"))
+			digest := webauthn.HashPayload(payload)
+			Expect(hex.EncodeToString(digest[:])).To(Equal("3e4d5dafd974f1812c8c97e4e7bd767eaeae351251eb3294d435aabdb1532cdf"))
+
+			Expect(metadata.ChallengeIndex).To(Equal(23))
+			Expect(metadata.TypeIndex).To(Equal(1))
+			Expect(metadata.UserVerificationRequired).To(BeTrue())
+			Expect(metadata.Payload()).To(Equal(payload))
+		})
+	})
+
+	Describe("ParseSignature", func() {
+		r := big.NewInt(0x1234)
+		s := big.NewInt(0x5678)
+
+		It("should parse a raw r||s signature", func() {
+			raw := make([]byte, 64)
+			r.FillBytes(raw[:32])
+			s.FillBytes(raw[32:])
+			gotR, gotS, err := webauthn.ParseSignature(raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotR).To(Equal(r))
+			Expect(gotS).To(Equal(s))
+		})
+
+		It("should parse a DER signature", func() {
+			der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+			Expect(err).NotTo(HaveOccurred())
+			gotR, gotS, err := webauthn.ParseSignature(der)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotR).To(Equal(r))
+			Expect(gotS).To(Equal(s))
+		})
+
+		It("should normalize a high s", func() {
+			raw := make([]byte, 64)
+			r.FillBytes(raw[:32])
+			new(big.Int).Sub(p256N, s).FillBytes(raw[32:])
+			_, gotS, err := webauthn.ParseSignature(raw)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gotS).To(Equal(s))
+		})
+
+		It("should reject malformed signatures", func() {
+			_, _, err := webauthn.ParseSignature([]byte{0x01, 0x02})
+			Expect(err).To(MatchError(webauthn.ErrInvalidSignature))
+			_, _, err = webauthn.ParseSignature(make([]byte, 64))
+			Expect(err).To(MatchError(webauthn.ErrInvalidSignature))
+		})
+	})
+
+	Describe("EncodeAuth", func() {
+		It("should encode abi.encode(WebAuthnAuth)", func() {
+			_, metadata := webauthn.GetSignPayload(challenge, webauthn.SignPayloadOptions{
+				RpID:   "example.com",
+				Origin: "https://example.com",
+			})
+			encoded, err := webauthn.EncodeAuth(metadata, big.NewInt(1), big.NewInt(2))
+			Expect(err).NotTo(HaveOccurred())
+
+			word := func(i int) *big.Int { return new(big.Int).SetBytes(encoded[i*32 : (i+1)*32]) }
+			Expect(word(0).Int64()).To(Equal(int64(0x20)))   // struct offset
+			Expect(word(1).Int64()).To(Equal(int64(6 * 32))) // authenticatorData offset within the struct
+			Expect(word(3).Int64()).To(Equal(int64(23)))     // challengeIndex
+			Expect(word(4).Int64()).To(Equal(int64(1)))      // typeIndex
+			Expect(word(5).Int64()).To(Equal(int64(1)))      // r
+			Expect(word(6).Int64()).To(Equal(int64(2)))      // s
+			Expect(word(7).Int64()).To(Equal(int64(37)))     // authenticatorData length
+			Expect(encoded[8*32 : 8*32+37]).To(Equal(metadata.AuthenticatorData))
+		})
+	})
+})
@@ -0,0 +1,154 @@
+// Package webauthn builds WebAuthn (passkey) assertion payloads and encodes
+// their signatures for on-chain verification, either with the RIP-7212 P256
+// precompile or a Solidity WebAuthn verifier.
+//
+// An authenticator does not sign the challenge directly. It signs
+//
+//	authenticatorData || sha256(clientDataJSON)
+//
+// with ECDSA over secp256r1 (P256) and SHA-256, where clientDataJSON embeds the
+// base64url-encoded challenge. Verifiers rebuild the same payload from the
+// Metadata and check the signature against sha256(payload).
+package webauthn
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+
+	json "github.com/goccy/go-json"
+)
+
+// DefaultFlag is the default authenticator data flag: user present (0x01)
+// and user verified (0x04).
+const DefaultFlag byte = 0x05
+
+// Metadata is the WebAuthn assertion data a verifier needs, alongside r and s,
+// to check a passkey signature over a challenge.
+// This mirrors ox's WebAuthnP256.SignMetadata.
+type Metadata struct {
+	// AuthenticatorData is rpIdHash || flags || signCount.
+	AuthenticatorData []byte
+	// ClientDataJSON is the client data the authenticator hashed.
+	ClientDataJSON string
+	// ChallengeIndex is the index of `"challenge":` in ClientDataJSON.
+	ChallengeIndex int
+	// TypeIndex is the index of `"type":` in ClientDataJSON.
+	TypeIndex int
+	// UserVerificationRequired indicates the verifier should check the UV flag.
+	UserVerificationRequired bool
+}
+
+// SignPayloadOptions configures GetSignPayload.
+type SignPayloadOptions struct {
+	// RpID is the relying party ID (usually the site's hostname).
+	RpID string
+	// Origin is the origin recorded in clientDataJSON, e.g. "https://example.com".
+	Origin string
+	// CrossOrigin is recorded in clientDataJSON.
+	CrossOrigin bool
+	// Flag is the authenticator data flag byte. If nil, DefaultFlag is used.
+	Flag *byte
+	// SignCount is the authenticator signature counter.
+	SignCount uint32
+	// UserVerification is the user verification requirement ("required",
+	// "preferred" or "discouraged"). Default: "required".
+	UserVerification string
+}
+
+// GetAuthenticatorData returns the authenticator data for rpID:
+// sha256(rpID) || flag || signCount (big-endian uint32).
+func GetAuthenticatorData(rpID string, flag byte, signCount uint32) []byte {
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	data := make([]byte, 0, 37)
+	data = append(data, rpIDHash[:]...)
+	data = append(data, flag)
+	data = binary.BigEndian.AppendUint32(data, signCount)
+	return data
+}
+
+// clientData is the CollectedClientData of a webauthn.get assertion. Field
+// order matches what browsers produce, which verifiers locate by index.
+type clientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// GetClientDataJSON returns the clientDataJSON for a webauthn.get assertion
+// over challenge. The challenge is base64url-encoded without padding.
+//
+// Example:
+//
+//	GetClientDataJSON(challenge, "https://example.com", false)
+//	// {"type":"webauthn.get","challenge":"...","origin":"https://example.com","crossOrigin":false}
+func GetClientDataJSON(challenge []byte, origin string, crossOrigin bool) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	// Browsers don't HTML-escape clientDataJSON.
+	enc.SetEscapeHTML(false)
+	// Encoding a struct of strings and a bool cannot fail.
+	_ = enc.Encode(clientData{
+		Type:        "webauthn.get",
+		Challenge:   base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:      origin,
+		CrossOrigin: crossOrigin,
+	})
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// GetSignPayload returns the bytes an authenticator signs for challenge,
+// authenticatorData || sha256(clientDataJSON), together with the Metadata
+// needed to verify the signature.
+//
+// Example:
+//
+//	payload, metadata := webauthn.GetSignPayload(hash, webauthn.SignPayloadOptions{
+//	    RpID:   "example.com",
+//	    Origin: "https://example.com",
+//	})
+//	digest := webauthn.HashPayload(payload) // what the P256 signature covers
+func GetSignPayload(challenge []byte, opts SignPayloadOptions) ([]byte, Metadata) {
+	flag := DefaultFlag
+	if opts.Flag != nil {
+		flag = *opts.Flag
+	}
+	userVerification := opts.UserVerification
+	if userVerification == "" {
+		userVerification = "required"
+	}
+
+	authenticatorData := GetAuthenticatorData(opts.RpID, flag, opts.SignCount)
+	clientDataJSON := GetClientDataJSON(challenge, opts.Origin, opts.CrossOrigin)
+	clientDataHash := sha256.Sum256([]byte(clientDataJSON))
+
+	payload := make([]byte, 0, len(authenticatorData)+len(clientDataHash))
+	payload = append(payload, authenticatorData...)
+	payload = append(payload, clientDataHash[:]...)
+
+	return payload, Metadata{
+		AuthenticatorData:        authenticatorData,
+		ClientDataJSON:           clientDataJSON,
+		ChallengeIndex:           strings.Index(clientDataJSON, `"challenge":`),
+		TypeIndex:                strings.Index(clientDataJSON, `"type":`),
+		UserVerificationRequired: userVerification == "required",
+	}
+}
+
+// HashPayload returns sha256(payload), the digest the P256 signature covers
+// and the hash passed to the RIP-7212 precompile.
+func HashPayload(payload []byte) [32]byte {
+	return sha256.Sum256(payload)
+}
+
+// Payload rebuilds the signed payload from metadata:
+// authenticatorData || sha256(clientDataJSON).
+func (m Metadata) Payload() []byte {
+	clientDataHash := sha256.Sum256([]byte(m.ClientDataJSON))
+	payload := make([]byte, 0, len(m.AuthenticatorData)+len(clientDataHash))
+	payload = append(payload, m.AuthenticatorData...)
+	return append(payload, clientDataHash[:]...)
+}
@@ -0,0 +1,42 @@
+package viemerr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/client/transport"
+	"github.com/ChefBingbong/viem-go/viemerr"
+)
+
+func TestCallExecutionErrorChain(t *testing.T) {
+	rpcErr := &transport.RPCError{Code: 3, Message: "execution reverted"}
+	err := fmt.Errorf("read: %w", &public.CallExecutionError{
+		Cause: &transport.RPCRequestError{RPCError: rpcErr},
+	})
+
+	var execErr *viemerr.CallExecutionError
+	require.True(t, errors.As(err, &execErr))
+
+	var requestErr *viemerr.RPCRequestError
+	require.True(t, errors.As(err, &requestErr))
+
+	got, ok := viemerr.AsRPCError(err)
+	require.True(t, ok)
+	assert.Same(t, rpcErr, got)
+}
+
+func TestSentinels(t *testing.T) {
+	err := fmt.Errorf("watch: %w", public.ErrSubscriptionNotSupported)
+	assert.True(t, errors.Is(err, viemerr.ErrSubscriptionNotSupported))
+
+	var watchErr *viemerr.WatchError
+	assert.True(t, errors.As(err, &watchErr))
+
+	_, ok := viemerr.AsRPCError(err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,113 @@
+// Package viemerr collects the error types returned by viem-go actions and
+// transports in one place, so callers can match them with errors.Is and
+// errors.As without importing each action package.
+//
+// Every error type that wraps another error implements Unwrap, so matching
+// works through any number of fmt.Errorf("...: %w", err) layers. The main
+// chains are:
+//
+//	CallExecutionError            eth_call failed or reverted
+//	└── RPCRequestError           the node answered with an error
+//	    └── RPCError              code, message and revert data
+//	CallTimeoutError              CallParameters.Timeout elapsed
+//	└── context.DeadlineExceeded
+//	SimulateContractError         simulation failed
+//	└── CallExecutionError ...
+//	FillTransactionError          eth_fillTransaction failed
+//	CreateAccessListError         eth_createAccessList failed
+//	SimulateBlocksError           eth_simulateV1 failed
+//	GetProofError                 eth_getProof failed
+//	ProofVerificationError        a storage proof did not verify
+//	└── proof.ErrProofNodeHashMismatch, proof.ErrStorageValueMismatch, ...
+//	MethodNotSupportedError       the node does not implement the method
+//	MulticallAggregateError       one or more multicall calls failed
+//	└── each MulticallFailure.Err (Unwrap() []error)
+//	WatchError                    a watch action failed
+//	└── the RPC or subscription error
+//	AbiDecodingError              return data is malformed or truncated
+//
+// Sentinel errors are compared with errors.Is. ErrSubscriptionNotSupported
+// also matches a WatchError carrying the same message and a Cause.
+//
+// Example:
+//
+//	_, err := public.Call(ctx, client, params)
+//	var execErr *viemerr.CallExecutionError
+//	if errors.As(err, &execErr) {
+//	    if rpcErr, ok := viemerr.AsRPCError(err); ok {
+//	        log.Printf("reverted: %s (data %v)", rpcErr.Message, rpcErr.Data)
+//	    }
+//	}
+package viemerr
+
+import (
+	"github.com/ChefBingbong/viem-go/abi"
+	"github.com/ChefBingbong/viem-go/actions/public"
+	"github.com/ChefBingbong/viem-go/actions/wallet"
+	"github.com/ChefBingbong/viem-go/client/transport"
+)
+
+// ABI errors.
+type AbiDecodingError = abi.AbiDecodingError
+
+// Public action errors.
+type (
+	CallExecutionError                           = public.CallExecutionError
+	CallTimeoutError                             = public.CallTimeoutError
+	CounterfactualDeploymentFailedError          = public.CounterfactualDeploymentFailedError
+	RawContractError                             = public.RawContractError
+	InvalidCallParamsError                       = public.InvalidCallParamsError
+	SimulateContractError                        = public.SimulateContractError
+	SimulateBlocksError                          = public.SimulateBlocksError
+	FillTransactionError                         = public.FillTransactionError
+	CreateAccessListError                        = public.CreateAccessListError
+	GetProofError                                = public.GetProofError
+	ProofVerificationError                       = public.ProofVerificationError
+	MulticallAggregateError                      = public.MulticallAggregateError
+	BlockNotFoundError                           = public.BlockNotFoundError
+	TransactionNotFoundError                     = public.TransactionNotFoundError
+	TransactionReceiptNotFoundError              = public.TransactionReceiptNotFoundError
+	WaitForTransactionReceiptTimeoutError        = public.WaitForTransactionReceiptTimeoutError
+	WaitForTransactionReceiptByNonceTimeoutError = public.WaitForTransactionReceiptByNonceTimeoutError
+	WatchError                                   = public.WatchError
+	LogDecodeError                               = public.LogDecodeError
+)
+
+// Wallet action errors.
+type (
+	AccountNotFoundError            = wallet.AccountNotFoundError
+	AccountTypeNotSupportedError    = wallet.AccountTypeNotSupportedError
+	TransactionReceiptRevertedError = wallet.TransactionReceiptRevertedError
+	WaitForCallsStatusTimeoutError  = wallet.WaitForCallsStatusTimeoutError
+	BundleFailedError               = wallet.BundleFailedError
+)
+
+// Transport errors.
+type (
+	RPCError                = transport.RPCError
+	RPCRequestError         = transport.RPCRequestError
+	MethodNotSupportedError = transport.MethodNotSupportedError
+)
+
+// Sentinel errors.
+var (
+	// ErrSubscriptionNotSupported is returned when subscribing on a client
+	// whose transport cannot subscribe.
+	ErrSubscriptionNotSupported = public.ErrSubscriptionNotSupported
+	// ErrProviderSubscriptionsNotSupported is returned when an EIP-1193
+	// provider cannot subscribe.
+	ErrProviderSubscriptionsNotSupported = transport.ErrProviderSubscriptionsNotSupported
+	// ErrMethodNotSupported is returned when a method is filtered out by the
+	// transport.
+	ErrMethodNotSupported = transport.ErrMethodNotSupported
+	// ErrTimeout is returned when a transport request times out.
+	ErrTimeout = transport.ErrTimeout
+	// ErrCircuitOpen is returned by a circuit-breaking transport while its
+	// circuit is open.
+	ErrCircuitOpen = transport.ErrCircuitOpen
+)
+
+// AsRPCError returns the JSON-RPC error in err's chain, if any.
+func AsRPCError(err error) (*RPCError, bool) {
+	return transport.AsRPCError(err)
+}